@@ -91,6 +91,7 @@ const rateLimitInfo: Record<string, RateLimitInfo> = {
   google_docs: { rate: "300 req/min" },
   google_sheets: { rate: "300 req/min" },
   google_apps_script: { rate: "制限あり", note: "スクリプト実行は 1,500 req/日 (Consumer)" },
+  google_forms: { rate: "300 req/min", note: "書き込みは 60 req/min" },
   microsoft_todo: { rate: "制限あり", note: "Microsoft Graph: ユーザーあたり 10,000 req/10min" },
   todoist: { rate: "450 req/15min" },
   trello: { rate: "100 req/10s", note: "APIキーごと。300 req/10s (トークンごと)" },
@@ -100,6 +101,8 @@ const rateLimitInfo: Record<string, RateLimitInfo> = {
   ticktick: { rate: "制限あり", note: "公式ドキュメント非公開" },
   dropbox: { rate: "制限あり", note: "エンドポイントにより異なる。約 1,000 req/5min" },
   grafana: { rate: "制限なし", note: "セルフホスト: 制限なし。Cloud: プランに依存" },
+  stripe: { rate: "100 req/s", note: "ライブモード。テストモードは 25 req/s" },
+  shopify: { rate: "2 req/s", note: "REST Admin API（リーキーバケット 40）。Shopify Plus は 20 req/s" },
 }
 
 const authConfig: Record<string, AuthConfig> = {
@@ -193,6 +196,11 @@ const authConfig: Record<string, AuthConfig> = {
     helpText: "Googleアカウントでログインして、Apps Scriptプロジェクトへのアクセスを許可します",
     authType: "oauth",
   },
+  google_forms: {
+    authLabel: "Google OAuth",
+    helpText: "Googleアカウントでログインして、フォームと回答へのアクセスを許可します",
+    authType: "oauth",
+  },
   microsoft_todo: {
     authLabel: "Microsoft OAuth",
     helpText: "Microsoftアカウントでログインして、タスクへのアクセスを許可します",
@@ -255,6 +263,21 @@ const authConfig: Record<string, AuthConfig> = {
       { name: "base_url", label: "Grafana URL", type: "text", placeholder: "https://grafana.example.com" },
     ],
   },
+  stripe: {
+    authLabel: "Restricted API Key",
+    helpText: "Stripe Dashboard > Developers > API keys から読み取り専用の制限付きキー（rk_...）を発行してください",
+    helpUrl: "https://dashboard.stripe.com/apikeys",
+    authType: "api_key",
+  },
+  shopify: {
+    authLabel: "Admin API Access Token",
+    helpText: "Shopify 管理画面 > 設定 > アプリと販売チャネル > アプリを開発 からカスタムアプリを作成し、Admin API アクセストークン（shpat_...）を発行してください。ショップのドメインも合わせて設定が必要です",
+    helpUrl: "https://help.shopify.com/manual/apps/app-types/custom-apps",
+    authType: "api_key",
+    extraFields: [
+      { name: "shop_domain", label: "ショップドメイン", type: "text", placeholder: "your-store.myshopify.com" },
+    ],
+  },
 }
 
 // モジュールレベルキャッシュ
@@ -403,6 +426,9 @@ export default function ServicesPage() {
       } else if (connectDialog === "grafana") {
         // Grafana: base_url を metadata に格納
         upsertParams.metadata = { base_url: extraFields.base_url }
+      } else if (connectDialog === "shopify") {
+        // Shopify: shop_domain を metadata に格納
+        upsertParams.metadata = { shop_domain: extraFields.shop_domain }
       }
 
       await upsertTokenWithVerification(
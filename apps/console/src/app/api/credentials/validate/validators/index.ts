@@ -13,6 +13,8 @@ import { validateJiraToken } from './jira'
 import { validateConfluenceToken } from './confluence'
 import { validateTrelloToken } from './trello'
 import { validateGrafanaToken } from './grafana'
+import { validateStripeToken } from './stripe'
+import { validateShopifyToken } from './shopify'
 
 export type { ValidationResult, ValidationParams, ValidatorFunction }
 
@@ -24,6 +26,8 @@ export const validators: Record<string, ValidatorFunction> = {
   confluence: validateConfluenceToken,
   trello: validateTrelloToken,
   grafana: validateGrafanaToken,
+  stripe: validateStripeToken,
+  shopify: validateShopifyToken,
 }
 
 export const requiredParams: Record<string, string[]> = {
@@ -31,6 +35,7 @@ export const requiredParams: Record<string, string[]> = {
   confluence: ['email', 'domain'],
   trello: ['api_key'],
   grafana: ['base_url'],
+  shopify: ['shop_domain'],
 }
 
 /**
@@ -0,0 +1,68 @@
+import { ValidationParams, ValidationResult } from './types'
+
+const SHOPIFY_API_VERSION = '2025-01'
+
+export async function validateShopifyToken(params: ValidationParams): Promise<ValidationResult> {
+  const { token, shop_domain } = params
+
+  if (!shop_domain) {
+    return {
+      valid: false,
+      error: 'Shopifyにはショップドメインが必要です',
+    }
+  }
+
+  // サーバー側と同じ正規化: "my-shop" → "my-shop.myshopify.com"
+  let domain = shop_domain.trim().toLowerCase().replace(/^https?:\/\//, '').replace(/\/+$/, '')
+  if (!domain.includes('.')) {
+    domain += '.myshopify.com'
+  }
+  if (!/^[a-z0-9][a-z0-9-]*\.myshopify\.com$/.test(domain)) {
+    return { valid: false, error: 'ショップドメインは xxx.myshopify.com の形式で入力してください。' }
+  }
+
+  try {
+    const response = await fetch(`https://${domain}/admin/api/${SHOPIFY_API_VERSION}/shop.json`, {
+      method: 'GET',
+      headers: {
+        'X-Shopify-Access-Token': token,
+      },
+      signal: AbortSignal.timeout(5000),
+      redirect: 'error',
+    })
+
+    if (response.ok) {
+      const data = await response.json()
+      return {
+        valid: true,
+        details: {
+          shop: data.shop?.name,
+        },
+      }
+    }
+
+    if (response.status === 401) {
+      return {
+        valid: false,
+        error: 'アクセストークンが無効です。Admin API アクセストークンを確認してください。',
+      }
+    }
+
+    if (response.status === 404) {
+      return {
+        valid: false,
+        error: 'ショップが見つかりません。ショップドメインを確認してください。',
+      }
+    }
+
+    return {
+      valid: false,
+      error: `API接続エラー (${response.status})`,
+    }
+  } catch {
+    return {
+      valid: false,
+      error: 'ネットワークエラーが発生しました',
+    }
+  }
+}
@@ -0,0 +1,49 @@
+import { ValidationParams, ValidationResult } from './types'
+
+export async function validateStripeToken(params: ValidationParams): Promise<ValidationResult> {
+  const { token } = params
+
+  try {
+    const response = await fetch('https://api.stripe.com/v1/balance', {
+      method: 'GET',
+      headers: {
+        'Authorization': `Bearer ${token}`,
+      },
+      signal: AbortSignal.timeout(5000),
+      redirect: 'error',
+    })
+
+    if (response.ok) {
+      return {
+        valid: true,
+        details: {
+          livemode: (await response.json()).livemode,
+        },
+      }
+    }
+
+    if (response.status === 401) {
+      return {
+        valid: false,
+        error: 'APIキーが無効です。正しい制限付きキーを入力してください。',
+      }
+    }
+
+    if (response.status === 403) {
+      return {
+        valid: false,
+        error: 'アクセス権限がありません。制限付きキーに Balance の読み取り権限があるか確認してください。',
+      }
+    }
+
+    return {
+      valid: false,
+      error: `API接続エラー (${response.status})`,
+    }
+  } catch {
+    return {
+      valid: false,
+      error: 'ネットワークエラーが発生しました',
+    }
+  }
+}
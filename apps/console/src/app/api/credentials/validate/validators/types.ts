@@ -14,6 +14,7 @@ export interface ValidationParams {
   domain?: string
   api_key?: string
   base_url?: string
+  shop_domain?: string
 }
 
 export type ValidatorFunction = (params: ValidationParams) => Promise<ValidationResult>
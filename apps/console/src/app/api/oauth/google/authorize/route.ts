@@ -33,6 +33,11 @@ const MODULE_SCOPES: Record<string, string[]> = {
     "https://www.googleapis.com/auth/script.scriptapp",  // For run_function (execute scripts)
     "https://www.googleapis.com/auth/drive.readonly",  // For listing projects
   ],
+  google_forms: [
+    "https://www.googleapis.com/auth/forms.body",
+    "https://www.googleapis.com/auth/forms.responses.readonly",
+    "https://www.googleapis.com/auth/drive.readonly",  // For searching forms
+  ],
 }
 
 export async function GET(request: Request) {
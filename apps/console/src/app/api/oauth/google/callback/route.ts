@@ -107,6 +107,7 @@ export async function GET(request: Request) {
       google_drive: "Google Drive",
       google_docs: "Google Docs",
       google_sheets: "Google Sheets",
+      google_forms: "Google Forms",
     }
     const displayName = moduleDisplayNames[moduleName] || moduleName
 
@@ -11,6 +11,7 @@ import {
   SiGoogledrive,
   SiGoogledocs,
   SiGooglesheets,
+  SiGoogleforms,
   SiTodoist,
   SiTrello,
   SiAsana,
@@ -18,6 +19,8 @@ import {
   SiDropbox,
   SiPostgresql,
   SiTicktick,
+  SiStripe,
+  SiShopify,
 } from "react-icons/si"
 import { VscAzure } from "react-icons/vsc"
 import { SiGoogleappsscript } from "react-icons/si"
@@ -37,6 +40,7 @@ const brandColors: Record<string, string> = {
   google_docs: "#4285F4",
   google_sheets: "#0F9D58",
   google_apps_script: "#4285F4",
+  google_forms: "#7248B9",
   microsoft_todo: "#0078D4",
   postgresql: "#4169E1",
   ticktick: "#4772FA",
@@ -45,6 +49,8 @@ const brandColors: Record<string, string> = {
   asana: "#F06A6A",
   grafana: "#F46800",
   dropbox: "#0061FF",
+  stripe: "#635BFF",
+  shopify: "#7AB55C",
 }
 
 const iconComponents: Record<string, React.ComponentType<{ className?: string; style?: React.CSSProperties }>> = {
@@ -60,6 +66,7 @@ const iconComponents: Record<string, React.ComponentType<{ className?: string; s
   google_docs: SiGoogledocs,
   google_sheets: SiGooglesheets,
   google_apps_script: SiGoogleappsscript,
+  google_forms: SiGoogleforms,
   microsoft_todo: VscAzure,
   postgresql: SiPostgresql,
   ticktick: SiTicktick,
@@ -68,6 +75,8 @@ const iconComponents: Record<string, React.ComponentType<{ className?: string; s
   asana: SiAsana,
   grafana: SiGrafana,
   dropbox: SiDropbox,
+  stripe: SiStripe,
+  shopify: SiShopify,
 }
 
 interface ModuleIconProps {
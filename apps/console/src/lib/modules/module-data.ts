@@ -154,6 +154,7 @@ export const moduleDisplayNames: Record<string, string> = {
   google_docs: "Google Docs",
   google_sheets: "Google Sheets",
   google_apps_script: "Google Apps Script",
+  google_forms: "Google Forms",
   microsoft_todo: "Microsoft To Do",
   postgresql: "PostgreSQL",
   ticktick: "TickTick",
@@ -162,6 +163,8 @@ export const moduleDisplayNames: Record<string, string> = {
   asana: "Asana",
   grafana: "Grafana",
   dropbox: "Dropbox",
+  stripe: "Stripe",
+  shopify: "Shopify",
 }
 
 export function getModuleDisplayName(moduleId: string): string {
@@ -182,6 +185,7 @@ export const moduleIcons: Record<string, string> = {
   google_docs: "file-text",
   google_sheets: "sheet",
   google_apps_script: "code",
+  google_forms: "clipboard-list",
   microsoft_todo: "check-square",
   postgresql: "database",
   ticktick: "check-circle-2",
@@ -190,6 +194,8 @@ export const moduleIcons: Record<string, string> = {
   asana: "briefcase",
   grafana: "activity",
   dropbox: "cloud",
+  stripe: "credit-card",
+  shopify: "shopping-bag",
 }
 
 export function getModuleIcon(moduleId: string): string {
@@ -225,6 +225,15 @@ export const OAUTH_CONFIGS: Record<string, OAuthConfig> = {
     ],
     serviceId: "google_apps_script",
   },
+  "google-forms": {
+    authUrl: "https://accounts.google.com/o/oauth2/v2/auth",
+    scopes: [
+      "https://www.googleapis.com/auth/forms.body",
+      "https://www.googleapis.com/auth/forms.responses.readonly",
+      "https://www.googleapis.com/auth/drive.readonly",
+    ],
+    serviceId: "google_forms",
+  },
   microsoft: {
     authUrl: "https://login.microsoftonline.com/common/oauth2/v2.0/authorize",
     scopes: [
@@ -340,7 +349,7 @@ export async function getOAuthAuthorizationUrl(
     params.set("returnTo", returnTo)
   }
 
-  // google-tasks, google-drive は google の authorize を使い、module パラメータで区別
+  // google-tasks, google-drive, google-forms 等は google の authorize を使い、module パラメータで区別
   // atlassian-* は atlassian の authorize を使い、module パラメータで区別
   let apiPath = provider
   if (provider === "google-tasks") {
@@ -358,6 +367,9 @@ export async function getOAuthAuthorizationUrl(
   } else if (provider === "google-apps-script") {
     apiPath = "google"
     params.set("module", "google_apps_script")
+  } else if (provider === "google-forms") {
+    apiPath = "google"
+    params.set("module", "google_forms")
   } else if (provider === "atlassian-jira") {
     apiPath = "atlassian"
     params.set("module", "jira")
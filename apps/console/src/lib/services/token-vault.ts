@@ -104,11 +104,13 @@ export async function upsertTokenWithVerification(
   // Step 1: トークンを外部APIで検証（最低1秒表示）
   onProgress({ step: 'validating', message: 'トークンを検証中...' })
 
-  let validationExtra: { email?: string; domain?: string; api_key?: string; base_url?: string } | undefined
+  let validationExtra: { email?: string; domain?: string; api_key?: string; base_url?: string; shop_domain?: string } | undefined
   if (params.service === 'trello' && params.username) {
     validationExtra = { api_key: params.username }
   } else if (params.service === 'grafana' && params.metadata?.base_url) {
     validationExtra = { base_url: params.metadata.base_url }
+  } else if (params.service === 'shopify' && params.metadata?.shop_domain) {
+    validationExtra = { shop_domain: params.metadata.shop_domain }
   } else if (params.username && params.metadata?.domain) {
     validationExtra = { email: params.username, domain: params.metadata.domain }
   }
@@ -15,6 +15,7 @@ import (
 	"mcpist/server/internal/auth"
 	"mcpist/server/internal/broker"
 	"mcpist/server/internal/db"
+	"mcpist/server/internal/events"
 	"mcpist/server/internal/mcp"
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -29,6 +30,7 @@ import (
 	"mcpist/server/internal/modules/google_calendar"
 	"mcpist/server/internal/modules/google_docs"
 	"mcpist/server/internal/modules/google_drive"
+	"mcpist/server/internal/modules/google_forms"
 	"mcpist/server/internal/modules/google_sheets"
 	"mcpist/server/internal/modules/google_tasks"
 	"mcpist/server/internal/modules/grafana"
@@ -36,6 +38,8 @@ import (
 	"mcpist/server/internal/modules/microsoft_todo"
 	"mcpist/server/internal/modules/notion"
 	"mcpist/server/internal/modules/postgresql"
+	"mcpist/server/internal/modules/shopify"
+	"mcpist/server/internal/modules/stripe"
 	"mcpist/server/internal/modules/supabase"
 	"mcpist/server/internal/modules/ticktick"
 	"mcpist/server/internal/modules/todoist"
@@ -65,11 +69,15 @@ func init() {
 	modules.RegisterModule(asana.New())
 	modules.RegisterModule(grafana.New())
 	modules.RegisterModule(dropbox.New())
+	modules.RegisterModule(stripe.New())
+	modules.RegisterModule(shopify.New())
+	modules.RegisterModule(google_forms.New())
 }
 
 func main() {
-	// Initialize observability (Loki)
+	// Initialize observability (Loki logs, OpenTelemetry traces)
 	observability.Init()
+	shutdownTracing := observability.InitTracing(context.Background())
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -86,6 +94,9 @@ func main() {
 		instanceRegion = "local"
 	}
 
+	// Count upstream traffic per tool call for _include_meta
+	http.DefaultTransport = modules.MeteredTransport(http.DefaultTransport)
+
 	// Log registered modules
 	moduleNames := modules.ListModules()
 	log.Printf("Registered modules: %v", moduleNames)
@@ -141,10 +152,14 @@ func main() {
 		fmt.Fprintf(w, `{"status":"ok","instance":"%s","region":"%s","db":"%s"}`, instanceID, instanceRegion, dbStatus)
 	})
 
-	// MCP endpoint with authorization + rate limit + transport middleware
+	// Per-module connectivity probe for the authenticated user
+	mux.Handle("GET /health/modules", middleware.Recovery(authorizer.Authorize(http.HandlerFunc(handleModuleHealth))))
+
+	// MCP endpoint with authorization + rate limit + concurrency cap + transport middleware
 	rateLimiter := middleware.NewRateLimiter(10)
+	concurrencyLimiter := middleware.NewConcurrencyLimiter(5)
 	mcpHandler := mcp.NewHandler(userStore)
-	mux.Handle("/v1/mcp", middleware.Recovery(authorizer.Authorize(rateLimiter.Middleware(middleware.Transport(mcpHandler)))))
+	mux.Handle("/v1/mcp", middleware.Recovery(authorizer.Authorize(rateLimiter.Middleware(concurrencyLimiter.Middleware(middleware.Transport(mcpHandler))))))
 
 	// REST endpoints (ogen-generated server)
 	ogenHandler := ogenserver.NewHandler(database)
@@ -158,6 +173,11 @@ func main() {
 	// Stripe webhook (outside ogen — needs raw body + Stripe signature)
 	mux.HandleFunc("POST /v1/stripe/webhook", ogenserver.NewStripeWebhookHandler(database))
 
+	// Upstream webhook deliveries for subscribe_events (authenticated by the token in the URL)
+	eventsService := events.NewService(userStore)
+	mux.HandleFunc("HEAD /v1/events/{id}", eventsService.DeliveryHandler())
+	mux.HandleFunc("POST /v1/events/{id}", eventsService.DeliveryHandler())
+
 	// JWKS endpoint (public, for API key verification)
 	mux.HandleFunc("GET /.well-known/jwks.json", handleJWKS)
 
@@ -187,6 +207,9 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Printf("Server forced to shutdown: %v", err)
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("Failed to flush traces: %v", err)
+	}
 
 	log.Printf("Server stopped")
 }
@@ -228,6 +251,27 @@ func buildSyncEntries(moduleNames []string) []broker.SyncModuleEntry {
 	return entries
 }
 
+// handleModuleHealth probes each of the user's enabled modules with its
+// read-only health tool and reports per-module status.
+func handleModuleHealth(w http.ResponseWriter, r *http.Request) {
+	authCtx := middleware.GetAuthContext(r.Context())
+	results := modules.ProbeModules(r.Context(), authCtx.EnabledModules)
+
+	status := "ok"
+	for _, res := range results {
+		if res.Status == "error" {
+			status = "degraded"
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":  status,
+		"modules": results,
+	})
+}
+
 // handleJWKS serves the JWKS endpoint for API key verification.
 func handleJWKS(w http.ResponseWriter, r *http.Request) {
 	kp := auth.GetKeyPair()
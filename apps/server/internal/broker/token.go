@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 
 	"mcpist/server/internal/db"
@@ -44,6 +45,10 @@ func GetTokenBroker() *TokenBroker {
 type TokenBroker struct {
 	db     *gorm.DB
 	client *http.Client
+
+	// refreshes collapses concurrent refreshes of the same user/module token
+	// (e.g. parallel batch or composite sub-calls) into a single upstream request.
+	refreshes singleflight.Group
 }
 
 // AuthType constants for API request authentication methods
@@ -117,6 +122,22 @@ type Credentials struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// BaseURL returns the API base URL for a self-hosted instance (GitHub Enterprise
+// Server, self-hosted Grafana or Supabase) or a custom Jira/Confluence host, read
+// from metadata["base_url"] without a trailing slash, or defaultURL when it is not set.
+func (c *Credentials) BaseURL(defaultURL string) (string, error) {
+	base, _ := c.Metadata["base_url"].(string)
+	base = strings.TrimRight(strings.TrimSpace(base), "/")
+	if base == "" {
+		return defaultURL, nil
+	}
+	u, err := url.Parse(base)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid base_url %q: must be an http(s) URL", base)
+	}
+	return base, nil
+}
+
 // CredentialResult represents the result of credential lookup
 type CredentialResult struct {
 	Found       bool                   `json:"found"`
@@ -160,6 +181,7 @@ var oauthRefreshConfigs = map[string]OAuthRefreshConfig{
 	"google_docs":        {Provider: "google", TokenURL: "https://oauth2.googleapis.com/token", AuthMethod: "form", ContentType: "urlencoded"},
 	"google_sheets":      {Provider: "google", TokenURL: "https://oauth2.googleapis.com/token", AuthMethod: "form", ContentType: "urlencoded"},
 	"google_apps_script": {Provider: "google", TokenURL: "https://oauth2.googleapis.com/token", AuthMethod: "form", ContentType: "urlencoded"},
+	"google_forms":       {Provider: "google", TokenURL: "https://oauth2.googleapis.com/token", AuthMethod: "form", ContentType: "urlencoded"},
 	"asana":              {Provider: "asana", TokenURL: "https://app.asana.com/-/oauth_token", AuthMethod: "form", ContentType: "urlencoded", RotatesRefreshToken: true},
 	"dropbox":            {Provider: "dropbox", TokenURL: "https://api.dropboxapi.com/oauth2/token", AuthMethod: "form", ContentType: "urlencoded"},
 	"microsoft_todo":     {Provider: "microsoft", TokenURL: "https://login.microsoftonline.com/common/oauth2/v2.0/token", AuthMethod: "form", ContentType: "urlencoded", ExtraParams: map[string]string{"scope": "offline_access Tasks.ReadWrite"}, RotatesRefreshToken: true},
@@ -195,14 +217,31 @@ func (b *TokenBroker) GetModuleToken(ctx context.Context, userID, module string)
 		return creds, nil
 	}
 
-	log.Printf("[broker] Token expired or expiring soon for %s, refreshing...", module)
-	refreshed, err := b.refreshOAuthToken(ctx, userID, module, creds, config)
+	v, err, _ := b.refreshes.Do(userID+"/"+module, func() (any, error) {
+		// A caller that waited on an earlier flight may see a token that is
+		// already refreshed; re-read before spending the refresh token.
+		current := creds
+		if latest, err := b.fetchCredentials(ctx, userID, module); err == nil {
+			if !needsRefresh(latest) {
+				return latest, nil
+			}
+			// An earlier flight may have rotated the refresh token
+			current = latest
+		}
+		log.Printf("[broker] Token expired or expiring soon for %s, refreshing...", module)
+		// Shared by every waiter, so one caller's cancellation must not fail the others
+		refreshed, err := b.refreshOAuthToken(context.WithoutCancel(ctx), userID, module, current, config)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[broker] Token refreshed successfully for %s", module)
+		return refreshed, nil
+	})
 	if err != nil {
 		log.Printf("[broker] Token refresh failed for %s: %v", module, err)
 		return creds, nil // Fall back to existing token
 	}
-	log.Printf("[broker] Token refreshed successfully for %s", module)
-	return refreshed, nil
+	return v.(*Credentials), nil
 }
 
 // fetchCredentials retrieves raw credentials from DB (no refresh)
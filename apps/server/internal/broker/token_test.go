@@ -79,3 +79,29 @@ func TestNeedsRefresh(t *testing.T) {
 		})
 	}
 }
+
+func TestCredentialsBaseURL(t *testing.T) {
+	const def = "https://api.github.com"
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		want     string
+		wantErr  bool
+	}{
+		{"no metadata", nil, def, false},
+		{"empty base_url", map[string]interface{}{"base_url": ""}, def, false},
+		{"self-hosted", map[string]interface{}{"base_url": "https://github.example.com/api/v3/"}, "https://github.example.com/api/v3", false},
+		{"http allowed", map[string]interface{}{"base_url": "http://grafana.internal:3000"}, "http://grafana.internal:3000", false},
+		{"no scheme", map[string]interface{}{"base_url": "github.example.com"}, "", true},
+		{"bad scheme", map[string]interface{}{"base_url": "ftp://example.com"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Credentials{Metadata: tt.metadata}).BaseURL(def)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("BaseURL() = %q, %v, want %q (error: %v)", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package broker
 
 import (
+	"encoding/json"
 	"log"
 	"sync"
 	"time"
@@ -142,6 +143,39 @@ func (s *UserBroker) RecordUsage(userID, metaTool, requestID string, details []T
 	}()
 }
 
+// ToolCallAudit is one tool invocation recorded in the audit trail (never its params)
+type ToolCallAudit struct {
+	Module     string
+	Tool       string
+	Annotation string // read_only, create, update, delete, destructive
+	Status     string // success, error, denied, skipped
+	Duration   time.Duration
+}
+
+// RecordToolCalls records tool invocations in the audit trail asynchronously (fire-and-forget).
+func (s *UserBroker) RecordToolCalls(userID, metaTool, requestID string, calls []ToolCallAudit) {
+	rows := make([]db.ToolCall, len(calls))
+	for i, c := range calls {
+		rows[i] = db.ToolCall{
+			UserID:     userID,
+			MetaTool:   metaTool,
+			Module:     c.Module,
+			Tool:       c.Tool,
+			Annotation: c.Annotation,
+			Status:     c.Status,
+			DurationMs: int(c.Duration.Milliseconds()),
+		}
+		if requestID != "" {
+			rows[i].RequestID = &requestID
+		}
+	}
+	go func() {
+		if err := db.RecordToolCalls(s.db, rows); err != nil {
+			log.Printf("RecordToolCalls: failed: %v", err)
+		}
+	}()
+}
+
 // SyncModuleEntry represents a module to sync to the database
 type SyncModuleEntry struct {
 	Name         string            `json:"name"`
@@ -275,3 +309,96 @@ func (s *UserBroker) GetUserPromptByName(userID, promptName string) (*UserPrompt
 		Enabled:     p.Enabled,
 	}, nil
 }
+
+// =============================================================================
+// Event Subscriptions
+// =============================================================================
+
+// EventSubscription represents an upstream webhook registered with subscribe_events
+type EventSubscription struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"-"`
+	Module      string    `json:"module"`
+	Resource    string    `json:"resource"`
+	Events      []string  `json:"events"`
+	CallbackURL string    `json:"callback_url"`
+	HookID      string    `json:"hook_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	TokenHash   string    `json:"-"` // SHA-256 of the delivery URL token
+	Secret      string    `json:"-"` // HMAC key for signing forwarded events
+}
+
+// CreateEventSubscription stores a new subscription and returns it with its ID
+func (s *UserBroker) CreateEventSubscription(sub EventSubscription) (*EventSubscription, error) {
+	events, err := json.Marshal(sub.Events)
+	if err != nil {
+		return nil, err
+	}
+	row := db.EventSubscription{
+		UserID:      sub.UserID,
+		Module:      sub.Module,
+		Resource:    sub.Resource,
+		Events:      db.JSONB(events),
+		CallbackURL: sub.CallbackURL,
+		TokenHash:   sub.TokenHash,
+		Secret:      sub.Secret,
+	}
+	if err := db.CreateEventSubscription(s.db, &row); err != nil {
+		return nil, err
+	}
+	sub.ID = row.ID
+	sub.CreatedAt = row.CreatedAt
+	return &sub, nil
+}
+
+// SetEventSubscriptionHook records the upstream webhook ID once it is registered
+func (s *UserBroker) SetEventSubscriptionHook(subscriptionID, hookID string) error {
+	return db.SetEventSubscriptionHook(s.db, subscriptionID, hookID)
+}
+
+// ListEventSubscriptions retrieves all event subscriptions for a user
+func (s *UserBroker) ListEventSubscriptions(userID string) ([]EventSubscription, error) {
+	rows, err := db.ListEventSubscriptions(s.db, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]EventSubscription, len(rows))
+	for i, r := range rows {
+		result[i] = toEventSubscription(r)
+	}
+	return result, nil
+}
+
+// GetEventSubscription retrieves a subscription with its token hash and secret (for event delivery)
+func (s *UserBroker) GetEventSubscription(subscriptionID string) (*EventSubscription, error) {
+	r, err := db.GetEventSubscription(s.db, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	sub := toEventSubscription(*r)
+	sub.TokenHash = r.TokenHash
+	sub.Secret = r.Secret
+	return &sub, nil
+}
+
+// DeleteEventSubscription removes a user's event subscription
+func (s *UserBroker) DeleteEventSubscription(userID, subscriptionID string) error {
+	return db.DeleteEventSubscription(s.db, userID, subscriptionID)
+}
+
+func toEventSubscription(r db.EventSubscription) EventSubscription {
+	sub := EventSubscription{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		Module:      r.Module,
+		Resource:    r.Resource,
+		CallbackURL: r.CallbackURL,
+		CreatedAt:   r.CreatedAt,
+	}
+	_ = json.Unmarshal(r.Events, &sub.Events)
+	if r.HookID != nil {
+		sub.HookID = *r.HookID
+	}
+	return sub
+}
@@ -170,6 +170,21 @@ type UsageLog struct {
 
 func (UsageLog) TableName() string { return "mcpist.usage_log" }
 
+type ToolCall struct {
+	ID         string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	UserID     string    `gorm:"type:uuid;not null" json:"user_id"`
+	RequestID  *string   `gorm:"type:text" json:"request_id,omitempty"`
+	MetaTool   string    `gorm:"type:text;not null" json:"meta_tool"`
+	Module     string    `gorm:"type:text;not null" json:"module"`
+	Tool       string    `gorm:"type:text;not null" json:"tool"`
+	Annotation string    `gorm:"type:text;not null" json:"annotation"`
+	Status     string    `gorm:"type:text;not null" json:"status"`
+	DurationMs int       `gorm:"not null;default:0" json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (ToolCall) TableName() string { return "mcpist.tool_calls" }
+
 type ProcessedWebhookEvent struct {
 	EventID     string    `gorm:"primaryKey;type:text" json:"event_id"`
 	UserID      string    `gorm:"type:uuid;not null" json:"user_id"`
@@ -177,3 +192,19 @@ type ProcessedWebhookEvent struct {
 }
 
 func (ProcessedWebhookEvent) TableName() string { return "mcpist.processed_webhook_events" }
+
+type EventSubscription struct {
+	ID              string    `gorm:"primaryKey;type:uuid;default:gen_random_uuid()" json:"id"`
+	UserID          string    `gorm:"type:uuid;not null" json:"user_id"`
+	Module          string    `gorm:"type:text;not null" json:"module"`
+	Resource        string    `gorm:"type:text;not null" json:"resource"`
+	Events          JSONB     `gorm:"type:jsonb;not null;default:'[]'" json:"events"`
+	CallbackURL     string    `gorm:"type:text;not null" json:"callback_url"`
+	TokenHash       string    `gorm:"type:text;not null" json:"-"`
+	Secret          string    `gorm:"-" json:"-"`
+	EncryptedSecret string    `gorm:"type:text;not null" json:"-"`
+	HookID          *string   `gorm:"type:text" json:"hook_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (EventSubscription) TableName() string { return "mcpist.event_subscriptions" }
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// CreateEventSubscription creates an event subscription.
+// Stores the signing secret encrypted.
+func CreateEventSubscription(db *gorm.DB, sub *EventSubscription) error {
+	enc, err := encrypt([]byte(sub.Secret))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	sub.EncryptedSecret = enc
+	return db.Create(sub).Error
+}
+
+// SetEventSubscriptionHook records the upstream webhook ID of a subscription.
+func SetEventSubscriptionHook(db *gorm.DB, subscriptionID, hookID string) error {
+	return db.Model(&EventSubscription{}).Where("id = ?", subscriptionID).Update("hook_id", hookID).Error
+}
+
+// ListEventSubscriptions returns all event subscriptions for a user.
+func ListEventSubscriptions(db *gorm.DB, userID string) ([]EventSubscription, error) {
+	var subs []EventSubscription
+	if err := db.Where("user_id = ?", userID).Order("created_at DESC").Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// GetEventSubscription returns a single event subscription by ID (used by event delivery).
+// Decrypts encrypted_secret into the in-memory Secret field.
+func GetEventSubscription(db *gorm.DB, subscriptionID string) (*EventSubscription, error) {
+	var sub EventSubscription
+	if err := db.Where("id = ?", subscriptionID).First(&sub).Error; err != nil {
+		return nil, fmt.Errorf("event subscription not found: %w", err)
+	}
+	plain, err := decrypt(sub.EncryptedSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret for event subscription %s: %w", subscriptionID, err)
+	}
+	sub.Secret = string(plain)
+	return &sub, nil
+}
+
+// DeleteEventSubscription deletes an event subscription, scoped to user.
+func DeleteEventSubscription(db *gorm.DB, userID, subscriptionID string) error {
+	result := db.Where("id = ? AND user_id = ?", subscriptionID, userID).Delete(&EventSubscription{})
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("event subscription not found")
+	}
+	return result.Error
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"gorm.io/gorm"
+)
+
+// RecordToolCalls appends tool invocations to the audit trail.
+func RecordToolCalls(db *gorm.DB, calls []ToolCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+	return db.Create(&calls).Error
+}
+
+// ListToolCalls returns a user's most recent tool calls, optionally filtered by module.
+func ListToolCalls(db *gorm.DB, userID string, moduleName *string, limit int) ([]ToolCall, error) {
+	q := db.Where("user_id = ?", userID)
+	if moduleName != nil {
+		q = q.Where("module = ?", *moduleName)
+	}
+	var calls []ToolCall
+	if err := q.Order("created_at DESC").Limit(limit).Find(&calls).Error; err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
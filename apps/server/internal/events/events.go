@@ -0,0 +1,330 @@
+// Package events registers upstream webhooks for the subscribe_events
+// meta-tool and forwards the events they deliver to the client's callback URL.
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/modules"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of a forwarded event, keyed with the subscription secret.
+	SignatureHeader = "X-Mcpist-Signature"
+
+	maxEventBytes  = 1 << 20 // 1 MB
+	forwardTimeout = 10 * time.Second
+)
+
+// Envelope is the body POSTed to the callback URL for each event.
+type Envelope struct {
+	SubscriptionID string          `json:"subscription_id"`
+	Module         string          `json:"module"`
+	Resource       string          `json:"resource"`
+	Event          string          `json:"event"`
+	ReceivedAt     string          `json:"received_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
+
+// Service manages event subscriptions.
+type Service struct {
+	store  *broker.UserBroker
+	client *http.Client
+}
+
+// NewService creates a Service backed by the user broker.
+func NewService(store *broker.UserBroker) *Service {
+	return &Service{
+		store:  store,
+		client: newCallbackClient(),
+	}
+}
+
+// newCallbackClient returns the client that POSTs events to callback URLs.
+// Callback URLs are user-supplied, so it only connects to public addresses:
+// the check runs on the resolved IP at dial time, which also covers redirects
+// and hostnames that resolve to internal addresses.
+func newCallbackClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: forwardTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || !publicAddr(ap.Addr()) {
+				return fmt.Errorf("callback address %s is not a public address", address)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout:   forwardTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+}
+
+// cgnatPrefix is the shared address space (RFC 6598), not covered by netip.Addr.IsPrivate.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// publicAddr reports whether addr is a globally routable unicast address, i.e.
+// not loopback, private (RFC 1918/4193), link-local (incl. 169.254.169.254
+// metadata endpoints), CGNAT, multicast or unspecified.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !cgnatPrefix.Contains(addr)
+}
+
+// Subscribe registers a webhook for module events on resource and stores the
+// subscription. It returns the subscription and the secret that signs forwarded
+// events; the secret is only returned here.
+func (s *Service) Subscribe(ctx context.Context, userID, module, resource string, events []string, callbackURL string) (*broker.EventSubscription, string, error) {
+	source, err := eventSource(module)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := validateCallbackURL(callbackURL); err != nil {
+		return nil, "", err
+	}
+	baseURL := strings.TrimRight(os.Getenv("EVENTS_BASE_URL"), "/")
+	if baseURL == "" {
+		return nil, "", fmt.Errorf("event subscriptions are not configured on this server (EVENTS_BASE_URL is not set)")
+	}
+
+	token, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, "", err
+	}
+	sub, err := s.store.CreateEventSubscription(broker.EventSubscription{
+		UserID:      userID,
+		Module:      module,
+		Resource:    resource,
+		Events:      events,
+		CallbackURL: callbackURL,
+		TokenHash:   hashToken(token),
+		Secret:      secret,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	deliveryURL := fmt.Sprintf("%s/v1/events/%s?token=%s", baseURL, sub.ID, token)
+	hookID, err := source.Subscribe(ctx, resource, events, deliveryURL)
+	if err != nil {
+		if delErr := s.store.DeleteEventSubscription(userID, sub.ID); delErr != nil {
+			log.Printf("[events] failed to remove subscription %s: %v", sub.ID, delErr)
+		}
+		return nil, "", modules.WithUpstreamStatus(err)
+	}
+	if err := s.store.SetEventSubscriptionHook(sub.ID, hookID); err != nil {
+		// Without the ID the webhook could never be removed; undo it
+		if unErr := source.Unsubscribe(ctx, resource, hookID); unErr != nil {
+			log.Printf("[events] failed to remove webhook %s for %s: %v", hookID, sub.ID, unErr)
+		}
+		if delErr := s.store.DeleteEventSubscription(userID, sub.ID); delErr != nil {
+			log.Printf("[events] failed to remove subscription %s: %v", sub.ID, delErr)
+		}
+		return nil, "", fmt.Errorf("failed to save webhook ID: %w", err)
+	}
+	sub.HookID = hookID
+	return sub, secret, nil
+}
+
+// List returns the user's subscriptions.
+func (s *Service) List(userID string) ([]broker.EventSubscription, error) {
+	return s.store.ListEventSubscriptions(userID)
+}
+
+// Unsubscribe deletes the upstream webhook and the subscription.
+func (s *Service) Unsubscribe(ctx context.Context, userID, subscriptionID string) error {
+	sub, err := s.store.GetEventSubscription(subscriptionID)
+	if err != nil || sub.UserID != userID {
+		return fmt.Errorf("event subscription not found: %s", subscriptionID)
+	}
+	source, err := eventSource(sub.Module)
+	if err != nil {
+		return err
+	}
+	if sub.HookID != "" {
+		if err := source.Unsubscribe(ctx, sub.Resource, sub.HookID); err != nil {
+			return modules.WithUpstreamStatus(err)
+		}
+	}
+	return s.store.DeleteEventSubscription(userID, subscriptionID)
+}
+
+// DeliveryHandler returns the handler for /v1/events/{id}, the URL registered
+// with the upstream service. HEAD answers webhook validation probes (Trello);
+// POST verifies the URL token and forwards matching events to the callback URL.
+func (s *Service) DeliveryHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sub, err := s.store.GetEventSubscription(r.PathValue("id"))
+		if err != nil || !validToken(sub.TokenHash, r.URL.Query().Get("token")) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxEventBytes))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+		defer r.Body.Close()
+
+		source, err := eventSource(sub.Module)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		event := source.EventType(r.Header, body)
+		if !matchesEvent(sub.Events, event) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if !json.Valid(body) {
+			body, _ = json.Marshal(string(body))
+		}
+
+		// Acknowledge immediately; upstream services retry or disable slow webhooks
+		go s.forward(sub, Envelope{
+			SubscriptionID: sub.ID,
+			Module:         sub.Module,
+			Resource:       sub.Resource,
+			Event:          event,
+			ReceivedAt:     time.Now().UTC().Format(time.RFC3339),
+			Payload:        body,
+		})
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// forward POSTs the envelope to the subscription's callback URL, signed with its secret.
+func (s *Service) forward(sub *broker.EventSubscription, env Envelope) {
+	body, err := json.Marshal(env)
+	if err != nil {
+		log.Printf("[events] failed to encode event for %s: %v", sub.ID, err)
+		return
+	}
+	req, err := http.NewRequest(http.MethodPost, sub.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("[events] invalid callback URL for %s: %v", sub.ID, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, body))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		log.Printf("[events] forward to %s failed: %v", sub.ID, err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		log.Printf("[events] callback for %s returned %d", sub.ID, resp.StatusCode)
+	}
+}
+
+// Sign returns the SignatureHeader value for body: "sha256=" + hex HMAC-SHA256.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// eventSource returns the module's EventSource implementation.
+func eventSource(module string) (modules.EventSource, error) {
+	m, ok := modules.GetModule(module)
+	if !ok {
+		return nil, fmt.Errorf("unknown module: %s", module)
+	}
+	source, ok := m.(modules.EventSource)
+	if !ok {
+		return nil, fmt.Errorf("module %s does not support event subscriptions", module)
+	}
+	return source, nil
+}
+
+// EventModules returns the registered modules that support event subscriptions.
+func EventModules() []string {
+	var names []string
+	for _, name := range modules.ListModules() {
+		if _, err := eventSource(name); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matchesEvent reports whether event is in the subscribed list ("*" or an empty list match all).
+func matchesEvent(events []string, event string) bool {
+	if len(events) == 0 {
+		return true
+	}
+	for _, e := range events {
+		if e == "*" || strings.EqualFold(e, event) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCallbackURL rejects callback URLs that are not https or that name an
+// internal host directly. Hostnames are checked again on the resolved address
+// when events are forwarded (see newCallbackClient).
+func validateCallbackURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("callback_url must be an https URL")
+	}
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil && !publicAddr(addr) {
+		return fmt.Errorf("callback_url must not point to a private, loopback or link-local address")
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("callback_url must not point to a private, loopback or link-local address")
+	}
+	return nil
+}
+
+func validToken(hash, token string) bool {
+	if token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(token))) == 1
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
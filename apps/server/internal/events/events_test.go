@@ -0,0 +1,93 @@
+package events
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestMatchesEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []string
+		event  string
+		want   bool
+	}{
+		{"empty list matches all", nil, "push", true},
+		{"wildcard", []string{"*"}, "issues", true},
+		{"listed", []string{"push", "issues"}, "issues", true},
+		{"case insensitive", []string{"createCard"}, "createcard", true},
+		{"not listed", []string{"push"}, "issues", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesEvent(tt.events, tt.event); got != tt.want {
+				t.Errorf("matchesEvent(%v, %q) = %v, want %v", tt.events, tt.event, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidToken(t *testing.T) {
+	hash := hashToken("secret-token")
+	if !validToken(hash, "secret-token") {
+		t.Error("validToken rejected the matching token")
+	}
+	if validToken(hash, "other-token") {
+		t.Error("validToken accepted a different token")
+	}
+	if validToken(hash, "") {
+		t.Error("validToken accepted an empty token")
+	}
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"push"}`)
+	mac := hmac.New(sha256.New, []byte("k"))
+	mac.Write(body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if got := Sign("k", body); got != want {
+		t.Errorf("Sign = %q, want %q", got, want)
+	}
+}
+
+func TestValidateCallbackURL(t *testing.T) {
+	for _, u := range []string{"https://example.com/hook", "https://example.com:8443/a?b=c"} {
+		if err := validateCallbackURL(u); err != nil {
+			t.Errorf("validateCallbackURL(%q) error: %v", u, err)
+		}
+	}
+	for _, u := range []string{
+		"", "http://example.com/hook", "example.com/hook", "https://",
+		"https://127.0.0.1/hook", "https://localhost:8443/hook", "https://10.0.0.5/hook",
+		"https://169.254.169.254/latest/meta-data", "https://[::1]/hook", "https://[fd00::1]/hook",
+	} {
+		if err := validateCallbackURL(u); err == nil {
+			t.Errorf("validateCallbackURL(%q) succeeded, want error", u)
+		}
+	}
+}
+
+func TestCallbackClientRejectsInternalAddresses(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	client := newCallbackClient()
+	client.Transport.(*http.Transport).TLSClientConfig = srv.Client().Transport.(*http.Transport).TLSClientConfig
+	if _, err := client.Post(srv.URL, "application/json", nil); err == nil {
+		t.Error("POST to a loopback callback succeeded, want the dial to be refused")
+	}
+
+	for addr, want := range map[string]bool{
+		"93.184.216.34": true, "2606:2800:220:1::": true,
+		"192.168.1.1": false, "100.64.0.1": false, "169.254.169.254": false, "::ffff:127.0.0.1": false, "0.0.0.0": false,
+	} {
+		if got := publicAddr(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("publicAddr(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
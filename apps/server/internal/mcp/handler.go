@@ -7,8 +7,10 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"mcpist/server/internal/broker"
+	"mcpist/server/internal/events"
 	"mcpist/server/internal/jsonrpc"
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -17,11 +19,13 @@ import (
 
 type Handler struct {
 	userStore *broker.UserBroker
+	events    *events.Service
 }
 
 func NewHandler(userStore *broker.UserBroker) *Handler {
 	return &Handler{
 		userStore: userStore,
+		events:    events.NewService(userStore),
 	}
 }
 
@@ -143,7 +147,7 @@ func (h *Handler) handleToolsList(ctx context.Context) (*ToolsListResult, *jsonr
 	if authCtx == nil {
 		return nil, &jsonrpc.Error{Code: InternalError, Message: "auth context missing"}
 	}
-	return &ToolsListResult{Tools: modules.DynamicMetaTools(authCtx.EnabledModules)}, nil
+	return &ToolsListResult{Tools: modules.DynamicMetaTools(authCtx.EnabledModules, authCtx.EnabledTools)}, nil
 }
 
 func (h *Handler) handleToolCall(ctx context.Context, req *jsonrpc.Request) (*ToolCallResult, *jsonrpc.Error) {
@@ -160,12 +164,16 @@ func (h *Handler) handleToolCall(ctx context.Context, req *jsonrpc.Request) (*To
 	switch params.Name {
 	case "get_module_schema":
 		return h.handleGetModuleSchema(ctx, params.Arguments)
+	case "describe_tool":
+		return h.handleDescribeTool(ctx, params.Arguments)
 	case "run":
 		return h.handleRun(ctx, params.Arguments)
 	case "batch":
 		return h.handleBatch(ctx, params.Arguments)
+	case "subscribe_events":
+		return h.handleSubscribeEvents(ctx, params.Arguments)
 	default:
-		return nil, &jsonrpc.Error{Code: InvalidParams, Message: fmt.Sprintf("Unknown tool: %s", params.Name)}
+		return nil, &jsonrpc.Error{Code: InvalidParams, Message: unknownMetaToolMessage(ctx, params.Name)}
 	}
 }
 
@@ -198,6 +206,20 @@ func (h *Handler) handleGetModuleSchema(ctx context.Context, args map[string]int
 		return nil, &jsonrpc.Error{Code: InternalError, Message: "auth context missing"}
 	}
 
+	// When no requested module exists, suggest the closest ones instead of listing every module
+	var unknownMsgs []string
+	for _, name := range moduleNames {
+		if msg, unknown := unknownModuleMessage(ctx, authCtx, name); unknown {
+			unknownMsgs = append(unknownMsgs, msg)
+		}
+	}
+	if len(unknownMsgs) == len(moduleNames) {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: strings.Join(unknownMsgs, "\n\n")}},
+			IsError: true,
+		}, nil
+	}
+
 	result, err := modules.GetModuleSchemas(moduleNames, authCtx.EnabledModules, authCtx.EnabledTools, authCtx.ModuleDescriptions)
 	if err != nil {
 		return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
@@ -206,6 +228,36 @@ func (h *Handler) handleGetModuleSchema(ctx context.Context, args map[string]int
 	return result, nil
 }
 
+func (h *Handler) handleDescribeTool(ctx context.Context, args map[string]interface{}) (*ToolCallResult, *jsonrpc.Error) {
+	moduleName, ok := args["module"].(string)
+	if !ok || moduleName == "" {
+		return nil, &jsonrpc.Error{Code: InvalidParams, Message: "module must be a string"}
+	}
+	toolName, ok := args["tool"].(string)
+	if !ok || toolName == "" {
+		return nil, &jsonrpc.Error{Code: InvalidParams, Message: "tool must be a string"}
+	}
+
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil {
+		return nil, &jsonrpc.Error{Code: InternalError, Message: "auth context missing"}
+	}
+
+	resolved, _ := modules.ResolveToolAlias(moduleName, toolName)
+	if msg, unknown := unknownNameMessage(ctx, authCtx, moduleName, resolved); unknown {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: msg}},
+			IsError: true,
+		}, nil
+	}
+
+	result, err := modules.DescribeTool(moduleName, toolName, authCtx.EnabledTools)
+	if err != nil {
+		return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
+	}
+	return result, nil
+}
+
 func (h *Handler) handleRun(ctx context.Context, args map[string]interface{}) (*ToolCallResult, *jsonrpc.Error) {
 	moduleName, ok := args["module"].(string)
 	if !ok {
@@ -227,25 +279,110 @@ func (h *Handler) handleRun(ctx context.Context, args map[string]interface{}) (*
 		return nil, &jsonrpc.Error{Code: InternalError, Message: "auth context missing"}
 	}
 
+	// Renamed tools keep working under their old name; permissions and dispatch use the current name
+	var deprecation string
+	if current, aliased := modules.ResolveToolAlias(moduleName, toolName); aliased {
+		deprecation = modules.DeprecationNotice(moduleName, toolName, current)
+		toolName = current
+	}
+
+	if msg, unknown := unknownNameMessage(ctx, authCtx, moduleName, toolName); unknown {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: msg}},
+			IsError: true,
+		}, nil
+	}
+
 	if err := authCtx.CanAccessTool(moduleName, toolName, 1); err != nil {
 		observability.LogSecurityEvent(middleware.GetRequestID(ctx), authCtx.UserID, "run_permission_denied", map[string]any{
 			"module": moduleName,
 			"tool":   toolName,
 			"reason": err.Error(),
 		})
+		h.auditRun(ctx, authCtx.UserID, moduleName, toolName, "denied", 0)
 		return nil, authErrorToRPC(err)
 	}
 
-	result, err := modules.Run(ctx, moduleName, toolName, params)
+	// Meta-parameters are for the server; strip them before validation and dispatch
+	meta, err := modules.TakeMetaParams(params)
+	if err != nil {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: "validation error: " + err.Error()}},
+			IsError: true,
+		}, nil
+	}
+
+	// Coerce string-encoded numbers/booleans and reject malformed params before dispatch,
+	// so the model gets one clear, complete error instead of a failure deep inside the module.
+	validated, err := modules.ValidateToolParams(moduleName, toolName, params)
+	if err != nil {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: "validation error: " + err.Error()}},
+			IsError: true,
+		}, nil
+	}
+	params = validated
+
+	var meter *modules.CallMeter
+	if meta.IncludeMeta {
+		ctx, meter = modules.WithCallMeter(ctx)
+	}
+
+	// Deletes with a large blast radius return a preview and token first, and run only when the token is echoed back
+	if modules.RequiresConfirmation(moduleName, toolName) {
+		if meta.ConfirmationToken == "" {
+			return h.previewConfirmation(ctx, authCtx.UserID, moduleName, toolName, params), nil
+		}
+		if err := modules.VerifyConfirmation(meta.ConfirmationToken, authCtx.UserID, moduleName, toolName, params); err != nil {
+			h.auditRun(ctx, authCtx.UserID, moduleName, toolName, "denied", 0)
+			return toolError(err), nil
+		}
+	}
+
+	// _return_diff snapshots the resource first; the update is not run if that fails
+	var before any
+	if meta.ReturnDiff {
+		if before, err = modules.Snapshot(ctx, moduleName, toolName, params); err != nil {
+			return toolError(err), nil
+		}
+	}
+
+	start := time.Now()
+	var result *ToolCallResult
+	var fetched *modules.FetchAllInfo
+	if meta.FetchAll > 0 {
+		// _fetch_all follows the tool's pagination; tools that don't declare it are rejected
+		if result, fetched, err = modules.FetchAll(ctx, moduleName, toolName, params, meta.FetchAll); err != nil {
+			result, err = toolError(err), nil
+		}
+	} else {
+		result, err = modules.Run(ctx, moduleName, toolName, params)
+	}
+	status := "success"
+	if err != nil || result.IsError {
+		status = "error"
+	}
+	h.auditRun(ctx, authCtx.UserID, moduleName, toolName, status, time.Since(start))
 	if err != nil {
 		return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
 	}
 
-	// Apply compact format unless format=json is explicitly requested
+	// Apply the requested output format (compact unless _format is "json"), then enforce the size cap
 	if !result.IsError {
-		if f, _ := params["format"].(string); f != "json" {
-			result.Content[0].Text = modules.ApplyCompact(moduleName, toolName, result.Content[0].Text)
-		}
+		result.Content[0].Text = modules.FormatResult(moduleName, toolName, result.Content[0].Text, meta.Format, meta.MaxBytes)
+	}
+	if meta.ReturnDiff && !result.IsError {
+		result.Content = append(result.Content, diffBlock(ctx, moduleName, toolName, params, before))
+	}
+	if fetched != nil {
+		b, _ := json.Marshal(map[string]*modules.FetchAllInfo{"_fetch_all": fetched})
+		result.Content = append(result.Content, ContentBlock{Type: "text", Text: string(b)})
+	}
+	if deprecation != "" {
+		result.Content = append(result.Content, ContentBlock{Type: "text", Text: deprecation})
+	}
+	if meter != nil {
+		result.Content = append(result.Content, metaBlock(meter, result))
 	}
 
 	// Record usage asynchronously (fire-and-forget)
@@ -259,6 +396,124 @@ func (h *Handler) handleRun(ctx context.Context, args map[string]interface{}) (*
 	return result, nil
 }
 
+// previewConfirmation answers a call to a tool that requires confirmation
+// without running it: a summary of what would be deleted and the token to confirm with.
+func (h *Handler) previewConfirmation(ctx context.Context, userID, moduleName, toolName string, params map[string]interface{}) *ToolCallResult {
+	start := time.Now()
+	preview, err := modules.PreviewConfirmation(ctx, userID, moduleName, toolName, params)
+	if err != nil {
+		h.auditRun(ctx, userID, moduleName, toolName, "error", time.Since(start))
+		return toolError(fmt.Errorf("failed to preview %s:%s: %w", moduleName, toolName, err))
+	}
+	h.auditRun(ctx, userID, moduleName, toolName, "confirmation_required", time.Since(start))
+	b, err := json.Marshal(preview)
+	if err != nil {
+		return toolError(err)
+	}
+	return &ToolCallResult{Content: []ContentBlock{{Type: "text", Text: string(b)}}}
+}
+
+// auditRun records a run call in the tool call audit trail. Params are never recorded.
+func (h *Handler) auditRun(ctx context.Context, userID, moduleName, toolName, status string, duration time.Duration) {
+	h.userStore.RecordToolCalls(userID, "run", middleware.GetRequestID(ctx), []broker.ToolCallAudit{{
+		Module:     moduleName,
+		Tool:       toolName,
+		Annotation: modules.ToolAnnotation(moduleName, toolName),
+		Status:     status,
+		Duration:   duration,
+	}})
+}
+
+// diffBlock snapshots the resource again after an update and returns
+// {"_diff": {field: {before, after}}}, or {"_diff_error": ...} if that fetch failed.
+func diffBlock(ctx context.Context, moduleName, toolName string, params map[string]interface{}, before any) ContentBlock {
+	var out map[string]any
+	if after, err := modules.Snapshot(ctx, moduleName, toolName, params); err != nil {
+		out = map[string]any{"_diff_error": "update succeeded but the resource could not be fetched again: " + err.Error()}
+	} else {
+		out = map[string]any{"_diff": modules.DiffFields(before, after)}
+	}
+	b, _ := json.Marshal(out)
+	return ContentBlock{Type: "text", Text: string(b)}
+}
+
+// metaBlock renders the call metrics of a run as a {"_meta": {...}} content block.
+func metaBlock(meter *modules.CallMeter, result *ToolCallResult) ContentBlock {
+	bytesOut := 0
+	for _, c := range result.Content {
+		bytesOut += len(c.Text)
+	}
+	b, _ := json.Marshal(map[string]modules.CallMeta{"_meta": meter.Meta(bytesOut)})
+	return ContentBlock{Type: "text", Text: string(b)}
+}
+
+func (h *Handler) handleSubscribeEvents(ctx context.Context, args map[string]interface{}) (*ToolCallResult, *jsonrpc.Error) {
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil {
+		return nil, &jsonrpc.Error{Code: InternalError, Message: "auth context missing"}
+	}
+
+	action, _ := args["action"].(string)
+	var result interface{}
+	switch action {
+	case "", "subscribe":
+		moduleName, _ := args["module"].(string)
+		resource, _ := args["resource"].(string)
+		callbackURL, _ := args["callback_url"].(string)
+		if moduleName == "" || resource == "" || callbackURL == "" {
+			return nil, &jsonrpc.Error{Code: InvalidParams, Message: "module, resource and callback_url are required"}
+		}
+		if err := authCtx.CanAccessModule(moduleName); err != nil {
+			return nil, authErrorToRPC(err)
+		}
+		eventNames, _ := args["events"].([]interface{})
+		sub, secret, err := h.events.Subscribe(ctx, authCtx.UserID, moduleName, resource, modules.ToStringSlice(eventNames), callbackURL)
+		if err != nil {
+			return toolError(err), nil
+		}
+		result = map[string]interface{}{
+			"subscription": sub,
+			"secret":       secret,
+			"message":      "Events are signed with this secret in the X-Mcpist-Signature header. It is not shown again.",
+		}
+	case "list":
+		subs, err := h.events.List(authCtx.UserID)
+		if err != nil {
+			return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
+		}
+		if subs == nil {
+			subs = []broker.EventSubscription{}
+		}
+		result = map[string]interface{}{"subscriptions": subs}
+	case "unsubscribe":
+		id, _ := args["subscription_id"].(string)
+		if id == "" {
+			return nil, &jsonrpc.Error{Code: InvalidParams, Message: "subscription_id is required"}
+		}
+		if err := h.events.Unsubscribe(ctx, authCtx.UserID, id); err != nil {
+			return toolError(err), nil
+		}
+		result = map[string]interface{}{"success": true, "message": "Unsubscribed " + id}
+	default:
+		return nil, &jsonrpc.Error{Code: InvalidParams, Message: fmt.Sprintf("unknown action: %s (use subscribe, list or unsubscribe)", action)}
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
+	}
+
+	return &ToolCallResult{Content: []ContentBlock{{Type: "text", Text: string(b)}}}, nil
+}
+
+// toolError returns err as an error result the model can read and act on.
+func toolError(err error) *ToolCallResult {
+	return &ToolCallResult{
+		Content: []ContentBlock{{Type: "text", Text: "error: " + err.Error()}},
+		IsError: true,
+	}
+}
+
 func (h *Handler) handleBatch(ctx context.Context, args map[string]interface{}) (*ToolCallResult, *jsonrpc.Error) {
 	commands, ok := args["commands"].(string)
 	if !ok {
@@ -271,12 +526,20 @@ func (h *Handler) handleBatch(ctx context.Context, args map[string]interface{})
 	}
 
 	// All-or-Nothing: pre-check all commands before execution
+	if mcpErr := checkBatchTools(ctx, authCtx, commands); mcpErr != nil {
+		return nil, mcpErr
+	}
 	requestID := middleware.GetRequestID(ctx)
 	if mcpErr := checkBatchPermissions(requestID, authCtx, commands); mcpErr != nil {
 		return nil, mcpErr
 	}
 
-	batchResult, err := modules.Batch(ctx, commands)
+	maxParallel := 0
+	if v, ok := args["max_parallel"].(float64); ok {
+		maxParallel = int(v)
+	}
+
+	batchResult, err := modules.Batch(ctx, commands, maxParallel)
 	if err != nil {
 		return nil, &jsonrpc.Error{Code: InternalError, Message: err.Error()}
 	}
@@ -300,9 +563,64 @@ func (h *Handler) handleBatch(ctx context.Context, args map[string]interface{})
 		)
 	}
 
+	// Record every task in the audit trail, including failed and skipped ones
+	if len(batchResult.Tasks) > 0 {
+		calls := make([]broker.ToolCallAudit, len(batchResult.Tasks))
+		for i, task := range batchResult.Tasks {
+			tool, _ := modules.ResolveToolAlias(task.Module, task.Tool)
+			calls[i] = broker.ToolCallAudit{
+				Module:     task.Module,
+				Tool:       tool,
+				Annotation: modules.ToolAnnotation(task.Module, tool),
+				Status:     task.Status,
+				Duration:   task.Duration,
+			}
+		}
+		h.userStore.RecordToolCalls(authCtx.UserID, "batch", requestID, calls)
+	}
+
 	return batchResult.Result, nil
 }
 
+// checkBatchTools rejects a batch naming an unknown module or tool, with
+// the same "did you mean" error as run. Other problems are left to checkBatchPermissions.
+func checkBatchTools(ctx context.Context, authCtx *middleware.AuthContext, commands string) *jsonrpc.Error {
+	for _, line := range strings.Split(strings.TrimSpace(commands), "\n") {
+		var cmd struct {
+			ID     string                 `json:"id"`
+			Module string                 `json:"module"`
+			Tool   string                 `json:"tool"`
+			Params map[string]interface{} `json:"params"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(line)), &cmd); err != nil || cmd.Module == "" || cmd.Tool == "" {
+			continue
+		}
+		tool, _ := modules.ResolveToolAlias(cmd.Module, cmd.Tool)
+		if msg, unknown := unknownNameMessage(ctx, authCtx, cmd.Module, tool); unknown {
+			return &jsonrpc.Error{Code: InvalidParams, Message: message(ctx, "batch_task_rejected", cmd.ID, msg)}
+		}
+		if modules.RequiresConfirmation(cmd.Module, tool) && !batchTaskConfirmed(authCtx.UserID, cmd.Module, tool, cmd.Params) {
+			return &jsonrpc.Error{Code: InvalidParams, Message: message(ctx, "batch_task_rejected", cmd.ID, message(ctx, "confirmation_required", cmd.Module, tool))}
+		}
+	}
+	return nil
+}
+
+// batchTaskConfirmed reports whether a batch task carries a valid confirmation
+// token. Batches cannot show a preview, so the token must come from a prior run
+// with the same params (tasks whose params reference other tasks never match).
+func batchTaskConfirmed(userID, moduleName, toolName string, params map[string]interface{}) bool {
+	if params == nil {
+		return false
+	}
+	meta, _ := modules.TakeMetaParams(params)
+	validated, err := modules.ValidateToolParams(moduleName, toolName, params)
+	if err != nil || meta.ConfirmationToken == "" {
+		return false
+	}
+	return modules.VerifyConfirmation(meta.ConfirmationToken, userID, moduleName, toolName, validated) == nil
+}
+
 // checkBatchPermissions parses batch JSONL and checks all tools are permitted.
 // Returns an MCP error if any tool is denied (All-or-Nothing).
 // Client receives a vague message; server log records specific denied tools (Layer 3: Detection).
@@ -330,6 +648,7 @@ func checkBatchPermissions(requestID string, authCtx *middleware.AuthContext, co
 		}
 
 		toolCount++
+		cmd.Tool, _ = modules.ResolveToolAlias(cmd.Module, cmd.Tool)
 
 		// creditCost=0: skip credit check (credits are consumed after execution)
 		if err := authCtx.CanAccessTool(cmd.Module, cmd.Tool, 0); err != nil {
@@ -233,3 +233,47 @@ func TestProcessRequestInitialized(t *testing.T) {
 		t.Errorf("expected nil result for initialized, got %v", result)
 	}
 }
+
+func TestClosestNames(t *testing.T) {
+	candidates := []string{"get_cards", "get_card", "get_checklists", "create_card", "list_boards"}
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"get_checklist", []string{"get_checklists"}},
+		{"get_crads", []string{"get_cards", "get_card"}},
+		{"get_car", []string{"get_card", "get_cards"}},
+		{"search_everything", []string{}},
+	}
+	for _, tt := range tests {
+		got := closestNames(tt.name, candidates, maxSuggestions)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("closestNames(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFirstLine(t *testing.T) {
+	tests := map[string]string{
+		"Get cards in a list. Supports filters.": "Get cards in a list.",
+		"カードを取得します。フィルタ対応。":                      "カードを取得します。",
+		"Single line":        "Single line",
+		"Line one\nLine two": "Line one",
+	}
+	for in, want := range tests {
+		if got := firstLine(in); got != want {
+			t.Errorf("firstLine(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMessageLanguage(t *testing.T) {
+	ctx := context.Background()
+	if got := message(ctx, "unknown_tool", "trello", "get_crads"); got != "Unknown tool: trello:get_crads." {
+		t.Errorf("default language message = %q", got)
+	}
+	ctx = context.WithValue(ctx, middleware.LanguageKey, "ja-JP")
+	if got := message(ctx, "unknown_tool", "trello", "get_crads"); got != "不明なツールです: trello:get_crads。" {
+		t.Errorf("ja-JP message = %q", got)
+	}
+}
@@ -0,0 +1,55 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"mcpist/server/internal/middleware"
+)
+
+// messages holds the meta-tool error messages the model reads, as format
+// strings per language. English is the fallback for missing translations.
+var messages = map[string]map[string]string{
+	"unknown_meta_tool": {
+		"en-US": "Unknown tool: %s.",
+		"ja-JP": "不明なツールです: %s。",
+	},
+	"unknown_tool": {
+		"en-US": "Unknown tool: %s:%s.",
+		"ja-JP": "不明なツールです: %s:%s。",
+	},
+	"unknown_module": {
+		"en-US": "Unknown module: %s.",
+		"ja-JP": "不明なモジュールです: %s。",
+	},
+	"did_you_mean": {
+		"en-US": " Did you mean:",
+		"ja-JP": " もしかして:",
+	},
+	"available_modules": {
+		"en-US": " Available: %s",
+		"ja-JP": " 利用可能なモジュール: %s",
+	},
+	"available_tools": {
+		"en-US": " Available: %s",
+		"ja-JP": " 利用可能なツール: %s",
+	},
+	"batch_task_rejected": {
+		"en-US": "batch rejected: task %s: %s",
+		"ja-JP": "バッチを拒否しました: タスク %s: %s",
+	},
+	"confirmation_required": {
+		"en-US": "%s:%s deletes data and requires confirmation. Call it with run first to get a confirmation_token, then add it to the task params.",
+		"ja-JP": "%s:%s はデータを削除するため確認が必要です。先にrunで呼び出してconfirmation_tokenを取得し、タスクのparamsに追加してください。",
+	},
+}
+
+// message formats the message for key in the language negotiated for the request.
+func message(ctx context.Context, key string, args ...any) string {
+	texts := messages[key]
+	text, ok := texts[middleware.GetLanguage(ctx)]
+	if !ok {
+		text = texts[middleware.DefaultLanguage]
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,191 @@
+package mcp
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"mcpist/server/internal/middleware"
+	"mcpist/server/internal/modules"
+)
+
+// maxSuggestions is how many close names an unknown-name error offers.
+const maxSuggestions = 3
+
+// candidate is a valid name that may be offered for an unknown one,
+// with its description in the request language.
+type candidate struct {
+	Name        string
+	Description string
+}
+
+// unknownModuleMessage reports whether moduleName is not a registered module
+// and, if so, returns a localized error suggesting the closest enabled modules.
+func unknownModuleMessage(ctx context.Context, authCtx *middleware.AuthContext, moduleName string) (string, bool) {
+	if _, ok := modules.GetModule(moduleName); ok {
+		return "", false
+	}
+	lang := middleware.GetLanguage(ctx)
+	candidates := make([]candidate, 0, len(authCtx.EnabledModules))
+	for _, name := range authCtx.EnabledModules {
+		m, ok := modules.GetModule(name)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, candidate{Name: name, Description: localized(m.Descriptions(), lang, m.Description())})
+	}
+	return message(ctx, "unknown_module", moduleName) + suggestionText(ctx, moduleName, candidates, "available_modules"), true
+}
+
+// unknownToolMessage reports whether toolName is not a tool of moduleName and,
+// if so, returns a localized error suggesting the closest enabled tools. Unknown
+// or disabled modules and disabled tools are left to the permission checks.
+func unknownToolMessage(ctx context.Context, authCtx *middleware.AuthContext, moduleName, toolName string) (string, bool) {
+	m, ok := modules.GetModule(moduleName)
+	if !ok {
+		return "", false
+	}
+	for _, t := range m.Tools() {
+		if t.Name == toolName {
+			return "", false
+		}
+	}
+	enabled := authCtx.EnabledTools[moduleName]
+	if len(enabled) == 0 {
+		return "", false
+	}
+	lang := middleware.GetLanguage(ctx)
+	candidates := make([]candidate, 0, len(enabled))
+	for _, t := range m.Tools() {
+		if containsString(enabled, moduleName+":"+t.Name) {
+			candidates = append(candidates, candidate{Name: t.Name, Description: localized(t.Descriptions, lang, t.Description)})
+		}
+	}
+	return message(ctx, "unknown_tool", moduleName, toolName) + suggestionText(ctx, toolName, candidates, "available_tools"), true
+}
+
+// unknownNameMessage checks the module and then the tool of a run or batch
+// command, returning the error for whichever is unknown.
+func unknownNameMessage(ctx context.Context, authCtx *middleware.AuthContext, moduleName, toolName string) (string, bool) {
+	if msg, unknown := unknownModuleMessage(ctx, authCtx, moduleName); unknown {
+		return msg, true
+	}
+	return unknownToolMessage(ctx, authCtx, moduleName, toolName)
+}
+
+// unknownMetaToolMessage returns a localized error for an unknown tools/call
+// name, suggesting the closest meta tools.
+func unknownMetaToolMessage(ctx context.Context, name string) string {
+	var enabledModules []string
+	var enabledTools map[string][]string
+	if authCtx := middleware.GetAuthContext(ctx); authCtx != nil {
+		enabledModules = authCtx.EnabledModules
+		enabledTools = authCtx.EnabledTools
+	}
+	var candidates []candidate
+	for _, t := range modules.DynamicMetaTools(enabledModules, enabledTools) {
+		candidates = append(candidates, candidate{Name: t.Name, Description: t.Description})
+	}
+	return message(ctx, "unknown_meta_tool", name) + suggestionText(ctx, name, candidates, "available_tools")
+}
+
+// suggestionText lists the candidates closest to name with their one-line
+// descriptions or, when none is close, every candidate name under availableKey.
+func suggestionText(ctx context.Context, name string, candidates []candidate, availableKey string) string {
+	names := make([]string, len(candidates))
+	byName := make(map[string]string, len(candidates))
+	for i, c := range candidates {
+		names[i] = c.Name
+		byName[c.Name] = c.Description
+	}
+	closest := closestNames(name, names, maxSuggestions)
+	if len(closest) == 0 {
+		sort.Strings(names)
+		return message(ctx, availableKey, strings.Join(names, ", "))
+	}
+	var sb strings.Builder
+	sb.WriteString(message(ctx, "did_you_mean"))
+	for _, c := range closest {
+		sb.WriteString("\n- " + c)
+		if desc := firstLine(byName[c]); desc != "" {
+			sb.WriteString(": " + desc)
+		}
+	}
+	return sb.String()
+}
+
+// closestNames returns up to n candidates nearest to name by edit distance,
+// nearest first (ties in candidate order), leaving out any too far off to be
+// a plausible typo or near-miss.
+func closestNames(name string, candidates []string, n int) []string {
+	type scored struct {
+		name string
+		dist int
+	}
+	limit := max(2, len(name)/2)
+	var matches []scored
+	for _, c := range candidates {
+		if d := levenshtein(name, c); d <= limit {
+			matches = append(matches, scored{c, d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].dist < matches[j].dist })
+	out := make([]string, 0, min(n, len(matches)))
+	for _, m := range matches[:min(n, len(matches))] {
+		out = append(out, m.name)
+	}
+	return out
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// localized returns texts[lang], falling back to English and then def.
+func localized(texts modules.LocalizedText, lang, def string) string {
+	if t := texts[lang]; t != "" {
+		return t
+	}
+	if t := texts[middleware.DefaultLanguage]; t != "" {
+		return t
+	}
+	return def
+}
+
+// firstLine cuts a description down to its first sentence.
+func firstLine(text string) string {
+	text, _, _ = strings.Cut(strings.TrimSpace(text), "\n")
+	for _, sep := range []string{". ", "。"} {
+		if i := strings.Index(text, sep); i >= 0 {
+			return text[:i+len(strings.TrimSpace(sep))]
+		}
+	}
+	return text
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,10 @@ const (
 	AuthContextKey ContextKey = "authContext"
 	// RequestIDKey is the context key for request tracing ID
 	RequestIDKey ContextKey = "requestID"
+	// TraceIDKey is the context key for the per-MCP-call trace ID
+	TraceIDKey ContextKey = "traceID"
+	// LanguageKey is the context key for the language negotiated from Accept-Language
+	LanguageKey ContextKey = "language"
 )
 
 // AuthContext contains user authentication and authorization info
@@ -80,6 +84,7 @@ func (a *Authorizer) Authorize(next http.Handler) http.Handler {
 		// Add auth context and request ID to request context
 		ctx := context.WithValue(r.Context(), AuthContextKey, authCtx)
 		ctx = context.WithValue(ctx, RequestIDKey, requestID)
+		ctx = context.WithValue(ctx, LanguageKey, NegotiateLanguage(r.Header.Get("Accept-Language")))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -283,6 +288,25 @@ func GetRequestID(ctx context.Context) string {
 	return id
 }
 
+// GetLanguage returns the language negotiated for the request (en-US or ja-JP).
+func GetLanguage(ctx context.Context) string {
+	if lang, ok := ctx.Value(LanguageKey).(string); ok && lang != "" {
+		return lang
+	}
+	return DefaultLanguage
+}
+
+// GetTraceID extracts the per-MCP-call trace ID from context
+func GetTraceID(ctx context.Context) string {
+	id, _ := ctx.Value(TraceIDKey).(string)
+	return id
+}
+
+// WithTraceID returns a copy of ctx carrying the given trace ID
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, TraceIDKey, traceID)
+}
+
 // generateRequestID creates a random 16-byte hex request ID
 func generateRequestID() string {
 	b := make([]byte, 16)
@@ -161,3 +161,24 @@ func TestCanAccessToolHTTPStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", "en-US"},
+		{"ja", "ja-JP"},
+		{"ja-JP,ja;q=0.9,en-US;q=0.8", "ja-JP"},
+		{"en-US,en;q=0.9,ja;q=0.8", "en-US"},
+		{"fr-FR,ja;q=0.5", "ja-JP"},
+		{"de,fr", "en-US"},
+		{"en;q=0.2, ja;q=0.7", "ja-JP"},
+		{"ja;q=bad,en", "en-US"},
+	}
+	for _, tt := range tests {
+		if got := NegotiateLanguage(tt.header); got != tt.want {
+			t.Errorf("NegotiateLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+// ConcurrencyLimiter caps the number of in-flight requests per user, so one
+// user running many slow composite tools in parallel can't tie up the
+// connection pools and upstream quotas shared with everyone else.
+// Uses in-memory state — each Go Server instance enforces independently.
+type ConcurrencyLimiter struct {
+	maxInFlight int
+	mu          sync.Mutex
+	inFlight    map[string]int
+}
+
+// NewConcurrencyLimiter creates a limiter allowing maxInFlight concurrent requests per user.
+func NewConcurrencyLimiter(maxInFlight int) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		maxInFlight: maxInFlight,
+		inFlight:    make(map[string]int),
+	}
+}
+
+// Acquire reserves a slot for the user, returning false when the user is at the cap.
+// Every successful Acquire must be paired with a Release.
+func (cl *ConcurrencyLimiter) Acquire(userID string) bool {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if cl.inFlight[userID] >= cl.maxInFlight {
+		return false
+	}
+	cl.inFlight[userID]++
+	return true
+}
+
+// Release frees a slot taken by Acquire.
+func (cl *ConcurrencyLimiter) Release(userID string) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	if cl.inFlight[userID] <= 1 {
+		delete(cl.inFlight, userID)
+		return
+	}
+	cl.inFlight[userID]--
+}
+
+// Middleware returns an HTTP middleware that applies the concurrency cap.
+// GET requests open long-lived SSE streams and are not counted.
+// Must be placed AFTER Authorize middleware (reads userID from context).
+func (cl *ConcurrencyLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authCtx := GetAuthContext(r.Context())
+		if authCtx == nil || r.Method == http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+		userID := authCtx.UserID
+
+		if !cl.Acquire(userID) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error":        "BUSY",
+				"message":      "Too many requests in progress. Wait for a running request to finish, or combine calls with batch.",
+				"max_inflight": cl.maxInFlight,
+				"retry_after":  1,
+			})
+			return
+		}
+		defer cl.Release(userID)
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestConcurrencyLimiterAcquire(t *testing.T) {
+	cl := NewConcurrencyLimiter(2)
+
+	if !cl.Acquire("user1") || !cl.Acquire("user1") {
+		t.Fatal("first 2 requests should be allowed")
+	}
+	if cl.Acquire("user1") {
+		t.Error("3rd concurrent request should be denied")
+	}
+	if !cl.Acquire("user2") {
+		t.Error("user2 should be allowed (independent cap)")
+	}
+
+	cl.Release("user1")
+	if !cl.Acquire("user1") {
+		t.Error("should be allowed after a request finished")
+	}
+
+	cl.Release("user1")
+	cl.Release("user1")
+	cl.Release("user2")
+	if len(cl.inFlight) != 0 {
+		t.Errorf("expected idle users to be removed, got %v", cl.inFlight)
+	}
+}
+
+func TestConcurrencyLimiterMiddleware(t *testing.T) {
+	cl := NewConcurrencyLimiter(1)
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := cl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			entered <- struct{}{}
+			<-release
+		}
+	}))
+	serve := func(method string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/mcp", nil)
+		req = req.WithContext(context.WithValue(req.Context(), AuthContextKey, &AuthContext{UserID: "user1"}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		serve(http.MethodPost)
+	}()
+	<-entered
+
+	if rec := serve(http.MethodPost); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("concurrent request status = %d, want 429", rec.Code)
+	}
+	if rec := serve(http.MethodGet); rec.Code != http.StatusOK {
+		t.Errorf("SSE stream status = %d, want 200 (not counted)", rec.Code)
+	}
+
+	close(release)
+	wg.Wait()
+	go func() { <-entered }()
+	if rec := serve(http.MethodPost); rec.Code != http.StatusOK {
+		t.Errorf("request after the first finished: status = %d, want 200", rec.Code)
+	}
+}
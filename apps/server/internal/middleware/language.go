@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultLanguage is used when Accept-Language names no supported language.
+const DefaultLanguage = "en-US"
+
+// supportedLanguages maps a primary language subtag to the BCP47 code used in
+// LocalizedText.
+var supportedLanguages = map[string]string{
+	"en": "en-US",
+	"ja": "ja-JP",
+}
+
+// NegotiateLanguage picks the supported language with the highest quality in
+// an Accept-Language header (e.g. "ja,en-US;q=0.8"). Ties go to the earlier
+// entry; unsupported or malformed entries are skipped.
+func NegotiateLanguage(header string) string {
+	best, bestQ := DefaultLanguage, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		lang, ok := supportedLanguages[primary]
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > bestQ {
+			best, bestQ = lang, q
+		}
+	}
+	return best
+}
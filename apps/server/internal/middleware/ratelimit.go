@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -34,6 +36,13 @@ func NewRateLimiter(maxPerSecond int) *RateLimiter {
 
 // Allow checks if a request from the given user is allowed.
 func (rl *RateLimiter) Allow(userID string) bool {
+	ok, _ := rl.allow(userID)
+	return ok
+}
+
+// allow is Allow that also returns, for a denied request, how long until the
+// oldest request in the window expires and the next one would be allowed.
+func (rl *RateLimiter) allow(userID string) (bool, time.Duration) {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -54,11 +63,11 @@ func (rl *RateLimiter) Allow(userID string) bool {
 	uw.lastAccess = now
 
 	if len(uw.timestamps) >= rl.maxRequests {
-		return false
+		return false, uw.timestamps[0].Add(rl.window).Sub(now)
 	}
 
 	uw.timestamps = append(uw.timestamps, now)
-	return true
+	return true, 0
 }
 
 // cleanup removes stale user entries every 60 seconds.
@@ -88,13 +97,15 @@ func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
 		}
 		userID := authCtx.UserID
 
-		if !rl.Allow(userID) {
+		if ok, wait := rl.allow(userID); !ok {
+			retryAfter := max(int(math.Ceil(wait.Seconds())), 1)
 			w.Header().Set("Content-Type", "application/json")
-			w.Header().Set("Retry-After", "1")
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
 			w.WriteHeader(http.StatusTooManyRequests)
 			json.NewEncoder(w).Encode(map[string]interface{}{
-				"error":   "RATE_LIMIT_EXCEEDED",
-				"message": "Too many requests. Please slow down or use batch mode.",
+				"error":       "RATE_LIMIT_EXCEEDED",
+				"message":     "Too many requests. Please slow down or use batch mode.",
+				"retry_after": retryAfter,
 			})
 			return
 		}
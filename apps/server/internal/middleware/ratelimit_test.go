@@ -1,6 +1,9 @@
 package middleware
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -68,3 +71,30 @@ func TestRateLimiterUserIsolation(t *testing.T) {
 		t.Error("user2 should be allowed (independent window)")
 	}
 }
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	rl := &RateLimiter{
+		maxRequests: 1,
+		window:      10 * time.Second,
+		users:       make(map[string]*userWindow),
+	}
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+		req = req.WithContext(context.WithValue(req.Context(), AuthContextKey, &AuthContext{UserID: "user1"}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := serve(); rec.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want 200", rec.Code)
+	}
+	rec := serve()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request status = %d, want 429", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "10" {
+		t.Errorf("Retry-After = %q, want the time left in the window (10)", got)
+	}
+}
@@ -143,9 +143,10 @@ func (t *transport) handleMessage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log.Printf("Received request: method=%s id=%v session=%s", req.Method, req.ID, sessionID)
+	ctx, traceID := withRequestTrace(r)
+	log.Printf("Received request: method=%s id=%v session=%s trace=%s", req.Method, req.ID, sessionID, traceID)
 
-	result, rpcErr := t.processor.ProcessRequest(r.Context(), &req)
+	result, rpcErr := t.processor.ProcessRequest(ctx, &req)
 	if rpcErr != nil {
 		t.sendToSession(s, req.ID, rpcErr)
 	} else if req.ID != nil {
@@ -170,9 +171,10 @@ func (t *transport) handleInlineMessage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	log.Printf("Received inline request: method=%s id=%v", req.Method, req.ID)
+	ctx, traceID := withRequestTrace(r)
+	log.Printf("Received inline request: method=%s id=%v trace=%s", req.Method, req.ID, traceID)
 
-	result, rpcErr := t.processor.ProcessRequest(r.Context(), &req)
+	result, rpcErr := t.processor.ProcessRequest(ctx, &req)
 
 	w.Header().Set("Content-Type", "application/json")
 	var resp jsonrpc.Response
@@ -184,6 +186,17 @@ func (t *transport) handleInlineMessage(w http.ResponseWriter, r *http.Request)
 	json.NewEncoder(w).Encode(resp)
 }
 
+// withRequestTrace injects a trace ID for a single JSON-RPC message into the request context.
+// An incoming X-Trace-ID header is honored so callers can correlate across services;
+// otherwise a fresh ID is generated. Every module handler and upstream client logs it.
+func withRequestTrace(r *http.Request) (context.Context, string) {
+	traceID := r.Header.Get("X-Trace-ID")
+	if traceID == "" {
+		traceID = generateRequestID()
+	}
+	return WithTraceID(r.Context(), traceID), traceID
+}
+
 func (t *transport) sendToSession(s *session, id interface{}, err *jsonrpc.Error) {
 	resp := jsonrpc.Response{JSONRPC: "2.0", ID: id, Error: err}
 	data, _ := json.Marshal(resp)
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithRequestTrace(t *testing.T) {
+	r := httptest.NewRequest("POST", "/v1/mcp", nil)
+	r.Header.Set("X-Trace-ID", "abc123")
+	ctx, traceID := withRequestTrace(r)
+	if traceID != "abc123" {
+		t.Errorf("traceID = %q, want header value", traceID)
+	}
+	if got := GetTraceID(ctx); got != "abc123" {
+		t.Errorf("GetTraceID = %q, want abc123", got)
+	}
+
+	r = httptest.NewRequest("POST", "/v1/mcp", nil)
+	ctx, traceID = withRequestTrace(r)
+	if len(traceID) != 32 {
+		t.Errorf("generated traceID = %q, want 32 hex chars", traceID)
+	}
+	if got := GetTraceID(ctx); got != traceID {
+		t.Errorf("GetTraceID = %q, want %q", got, traceID)
+	}
+}
@@ -18,6 +18,8 @@ func formatCompact(toolName, jsonStr string) string {
 		return tablesToCSV(jsonStr)
 	case "get_table_views":
 		return viewsToCSV(jsonStr)
+	case "get_base_schema":
+		return baseSchemaToCompact(jsonStr)
 	case "create_table", "update_table":
 		return pickKeys(jsonStr, "id", "name")
 	default:
@@ -109,6 +111,71 @@ func viewsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// baseSchemaToCompact: one section per table, fields as CSV (name,type,options)
+func baseSchemaToCompact(jsonStr string) string {
+	var tables []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &tables); err != nil {
+		return jsonStr
+	}
+	if len(tables) == 0 {
+		return "# 0 tables"
+	}
+	var sb strings.Builder
+	for i, t := range tables {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("## %s (%s)\n", str(t, "name"), str(t, "id")))
+		primary := str(t, "primaryFieldId")
+		sb.WriteString("```csv\nid,name,type,options\n")
+		fields, _ := t["fields"].([]any)
+		for _, raw := range fields {
+			f, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			name := str(f, "name")
+			if str(f, "id") == primary {
+				name += " (primary)"
+			}
+			sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+				str(f, "id"),
+				csvEscape(name),
+				str(f, "type"),
+				csvEscape(fieldOptionsSummary(f["options"])),
+			))
+		}
+		sb.WriteString("```\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// fieldOptionsSummary renders the useful part of a field's options:
+// select choices, linked table, or the raw options object otherwise.
+func fieldOptionsSummary(v any) string {
+	opts, ok := v.(map[string]any)
+	if !ok || len(opts) == 0 {
+		return ""
+	}
+	if choices, ok := opts["choices"].([]any); ok {
+		names := make([]string, 0, len(choices))
+		for _, c := range choices {
+			if m, ok := c.(map[string]any); ok {
+				names = append(names, str(m, "name"))
+			}
+		}
+		return strings.Join(names, "|")
+	}
+	if linked := str(opts, "linkedTableId"); linked != "" {
+		return "linkedTableId=" + linked
+	}
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -44,6 +44,7 @@ func (m *AirtableModule) ExecuteTool(ctx context.Context, name string, params ma
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -51,6 +52,12 @@ func (m *AirtableModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *AirtableModule) HealthProbe() (string, map[string]any) {
+	return "list_bases", nil
+}
+
 // =============================================================================
 // Token and Headers
 // =============================================================================
@@ -72,6 +79,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 // =============================================================================
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "airtable", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -117,6 +125,22 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"base_id"},
 		},
 	},
+	{
+		ID:   "airtable:get_base_schema",
+		Name: "get_base_schema",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the full schema of a base: every table with its primary field and field definitions (name, type, options such as select choices). Use before writing records to get exact table and field names.",
+			"ja-JP": "ベースの完全なスキーマを取得します：各テーブルのプライマリフィールドとフィールド定義（名前、タイプ、選択肢などのオプション）。レコード書き込み前に正確なテーブル名とフィールド名を確認するために使用します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"base_id": {Type: "string", Description: "Base ID (starts with 'app')"},
+			},
+			Required: []string{"base_id"},
+		},
+	},
 	{
 		ID:   "airtable:get_table_fields",
 		Name: "get_table_fields",
@@ -245,7 +269,7 @@ var toolDefinitions = []modules.Tool{
 			Properties: map[string]modules.Property{
 				"base_id":  {Type: "string", Description: "Base ID (starts with 'app')"},
 				"table":    {Type: "string", Description: "Table name or ID"},
-				"records":  {Type: "array", Description: "Array of records to create. Each record is {fields: {fieldName: value}}"},
+				"records":  {Type: "array", Description: "Array of records to create. Each record is {fields: {fieldName: value}}. " + attachmentFieldHelp},
 				"typecast": {Type: "boolean", Description: "Automatically typecast field values (default: false)"},
 			},
 			Required: []string{"base_id", "table", "records"},
@@ -255,17 +279,18 @@ var toolDefinitions = []modules.Tool{
 		ID:   "airtable:update_records",
 		Name: "update_records",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update existing records (max 10 per request). Uses PATCH (partial update)",
-			"ja-JP": "既存のレコードを更新します（1リクエストあたり最大10件）。PATCH（部分更新）を使用",
+			"en-US": "Update existing records (max 10 per request). Uses PATCH (partial update). With fields_to_merge_on, performs an upsert: records are matched on those fields instead of by id, updated if found and created otherwise",
+			"ja-JP": "既存のレコードを更新します（1リクエストあたり最大10件）。PATCH（部分更新）を使用。fields_to_merge_onを指定するとアップサートを行い、idの代わりに指定フィールドでレコードを照合して、一致すれば更新、なければ作成します",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"base_id":  {Type: "string", Description: "Base ID (starts with 'app')"},
-				"table":    {Type: "string", Description: "Table name or ID"},
-				"records":  {Type: "array", Description: "Array of records to update. Each record is {id: recordId, fields: {fieldName: value}}"},
-				"typecast": {Type: "boolean", Description: "Automatically typecast field values (default: false)"},
+				"base_id":            {Type: "string", Description: "Base ID (starts with 'app')"},
+				"table":              {Type: "string", Description: "Table name or ID"},
+				"records":            {Type: "array", Description: "Array of records to update. Each record is {id: recordId, fields: {fieldName: value}}. In upsert mode id may be omitted. " + attachmentFieldHelp},
+				"typecast":           {Type: "boolean", Description: "Automatically typecast field values (default: false)"},
+				"fields_to_merge_on": {Type: "array", Description: "Enable upsert (performUpsert): field names (1-3) used as an external ID to match existing records", Items: &modules.Property{Type: "string"}},
 			},
 			Required: []string{"base_id", "table", "records"},
 		},
@@ -320,6 +345,7 @@ type toolHandler func(ctx context.Context, params map[string]any) (string, error
 var toolHandlers = map[string]toolHandler{
 	"list_bases":       listBases,
 	"get_base_tables":  getBaseTables,
+	"get_base_schema":  getBaseSchema,
 	"get_table_fields": getTableFields,
 	"get_table_views":  getTableViews,
 	"create_table":     createTable,
@@ -373,6 +399,36 @@ func getBaseTables(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(tables)
 }
 
+// tableSchema is one table in get_base_schema output (views omitted)
+type tableSchema struct {
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	PrimaryFieldID string      `json:"primaryFieldId,omitempty"`
+	Fields         []gen.Field `json:"fields"`
+}
+
+func getBaseSchema(ctx context.Context, params map[string]any) (string, error) {
+	baseID, _ := params["base_id"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.ListTables(ctx, gen.ListTablesParams{BaseId: baseID})
+	if err != nil {
+		return "", err
+	}
+	tables := make([]tableSchema, 0, len(res.Tables))
+	for _, t := range res.Tables {
+		tables = append(tables, tableSchema{
+			ID:             t.ID.Value,
+			Name:           t.Name.Value,
+			PrimaryFieldID: t.PrimaryFieldId.Value,
+			Fields:         t.Fields.Value,
+		})
+	}
+	return toJSON(tables)
+}
+
 func getTableFields(ctx context.Context, params map[string]any) (string, error) {
 	baseID, _ := params["base_id"].(string)
 	tableName, _ := params["table"].(string)
@@ -565,6 +621,14 @@ func createRecords(ctx context.Context, params map[string]any) (string, error) {
 		return "", fmt.Errorf("records is required and must not be empty")
 	}
 
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := prepareAttachments(ctx, c, baseID, table, recordsRaw); err != nil {
+		return "", err
+	}
+
 	// Build ogen request - records contain {fields: {...}} objects
 	// RecordFields is map[string]jx.Raw with JSON marshal support, so use JSON roundtrip.
 	records := make([]gen.CreateRecordsReqRecordsItem, 0, len(recordsRaw))
@@ -588,10 +652,6 @@ func createRecords(ctx context.Context, params map[string]any) (string, error) {
 		req.Typecast.SetTo(typecast)
 	}
 
-	c, err := newOgenClient(ctx)
-	if err != nil {
-		return "", err
-	}
 	res, err := c.CreateRecords(ctx, req, gen.CreateRecordsParams{
 		BaseId: baseID, TableIdOrName: table,
 	})
@@ -609,6 +669,14 @@ func updateRecords(ctx context.Context, params map[string]any) (string, error) {
 		return "", fmt.Errorf("records is required and must not be empty")
 	}
 
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := prepareAttachments(ctx, c, baseID, table, recordsRaw); err != nil {
+		return "", err
+	}
+
 	records := make([]gen.UpdateRecordsReqRecordsItem, 0, len(recordsRaw))
 	for _, r := range recordsRaw {
 		rm, ok := r.(map[string]interface{})
@@ -632,11 +700,14 @@ func updateRecords(ctx context.Context, params map[string]any) (string, error) {
 	if typecast, ok := params["typecast"].(bool); ok {
 		req.Typecast.SetTo(typecast)
 	}
-
-	c, err := newOgenClient(ctx)
-	if err != nil {
-		return "", err
+	if raw, ok := params["fields_to_merge_on"].([]interface{}); ok && len(raw) > 0 {
+		mergeOn := modules.ToStringSlice(raw)
+		if len(mergeOn) > 3 {
+			return "", fmt.Errorf("fields_to_merge_on accepts at most 3 fields, got %d", len(mergeOn))
+		}
+		req.PerformUpsert.SetTo(gen.UpdateRecordsReqPerformUpsert{FieldsToMergeOn: mergeOn})
 	}
+
 	res, err := c.UpdateRecords(ctx, req, gen.UpdateRecordsParams{
 		BaseId: baseID, TableIdOrName: table,
 	})
@@ -646,6 +717,119 @@ func updateRecords(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// attachmentFieldHelp documents attachment values in create_records/update_records.
+const attachmentFieldHelp = "Attachment fields take a URL, {url, filename}, or an array of them; Airtable downloads each URL server-side, so it must be publicly reachable. On update the array replaces the field: include existing attachments as {id} to keep them"
+
+// prepareAttachments rewrites the attachment field values of records into
+// Airtable's attachment objects ([{url, filename}] or [{id}]). The table
+// schema is only fetched when some value could be attachment input.
+func prepareAttachments(ctx context.Context, c *gen.Client, baseID, table string, records []interface{}) error {
+	var candidates []map[string]any
+	for _, r := range records {
+		rm, _ := r.(map[string]interface{})
+		fields, _ := rm["fields"].(map[string]interface{})
+		for _, v := range fields {
+			if maybeAttachment(v) {
+				candidates = append(candidates, fields)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	res, err := c.ListTables(ctx, gen.ListTablesParams{BaseId: baseID})
+	if err != nil {
+		return err
+	}
+	attachmentFields := map[string]bool{}
+	for _, t := range res.Tables {
+		if t.ID.Value != table && t.Name.Value != table {
+			continue
+		}
+		for _, f := range t.Fields.Value {
+			if f.Type.Value == "multipleAttachments" {
+				attachmentFields[f.Name.Value] = true
+				attachmentFields[f.ID.Value] = true
+			}
+		}
+	}
+
+	for _, fields := range candidates {
+		for name, v := range fields {
+			if !attachmentFields[name] {
+				continue
+			}
+			attachments, err := attachmentValue(v)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", name, err)
+			}
+			fields[name] = attachments
+		}
+	}
+	return nil
+}
+
+// maybeAttachment reports whether v has a shape accepted as attachment input.
+func maybeAttachment(v any) bool {
+	switch x := v.(type) {
+	case string:
+		return strings.HasPrefix(x, "http://") || strings.HasPrefix(x, "https://")
+	case map[string]interface{}:
+		_, hasURL := x["url"]
+		return hasURL
+	case []interface{}:
+		for _, item := range x {
+			if maybeAttachment(item) {
+				return true
+			}
+			if m, ok := item.(map[string]interface{}); ok && m["id"] != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// attachmentValue converts a URL, {url, filename}, {id} or an array of them
+// to Airtable's attachment array. null clears the field.
+func attachmentValue(v any) ([]map[string]any, error) {
+	var items []interface{}
+	switch x := v.(type) {
+	case nil:
+		return []map[string]any{}, nil
+	case []interface{}:
+		items = x
+	default:
+		items = []interface{}{x}
+	}
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		switch a := item.(type) {
+		case string:
+			out = append(out, map[string]any{"url": a})
+		case map[string]interface{}:
+			if id, ok := a["id"].(string); ok && id != "" {
+				out = append(out, map[string]any{"id": id})
+				continue
+			}
+			url, _ := a["url"].(string)
+			if url == "" {
+				return nil, fmt.Errorf("each attachment needs a url (or the id of an existing attachment)")
+			}
+			att := map[string]any{"url": url}
+			if filename, ok := a["filename"].(string); ok && filename != "" {
+				att["filename"] = filename
+			}
+			out = append(out, att)
+		default:
+			return nil, fmt.Errorf("attachments must be URLs or {url, filename} objects")
+		}
+	}
+	return out, nil
+}
+
 func deleteRecords(ctx context.Context, params map[string]any) (string, error) {
 	baseID, _ := params["base_id"].(string)
 	table, _ := params["table"].(string)
@@ -3,6 +3,7 @@ package asana
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -13,10 +14,14 @@ import (
 func formatCompact(toolName, jsonStr string) string {
 	switch toolName {
 	// Read: lists → CSV
+	case "list_users":
+		return usersToCSV(jsonStr)
 	case "list_workspaces":
 		return workspacesToCSV(jsonStr)
 	case "list_projects":
 		return projectsToCSV(jsonStr)
+	case "list_portfolios":
+		return portfoliosToCSV(jsonStr)
 	case "list_sections":
 		return sectionsToCSV(jsonStr)
 	case "list_tasks":
@@ -25,17 +30,21 @@ func formatCompact(toolName, jsonStr string) string {
 		return subtasksToCSV(jsonStr)
 	case "list_stories":
 		return storiesToCompact(jsonStr)
+	case "get_events":
+		return eventsToCompact(jsonStr)
 	case "list_tags":
 		return tagsToCSV(jsonStr)
 	case "search_tasks":
 		return tasksToCSV(jsonStr)
 	// Read: single item → MD
-	case "get_me":
+	case "get_me", "get_user":
 		return userToCompact(jsonStr)
 	case "get_workspace":
 		return workspaceToCompact(jsonStr)
 	case "get_project":
 		return projectToCompact(jsonStr)
+	case "get_portfolio":
+		return portfolioToCompact(jsonStr)
 	case "get_task":
 		return taskToCompact(jsonStr)
 	// Write
@@ -43,11 +52,13 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "gid", "name")
 	case "delete_project":
 		return pickKeys(jsonStr, "success", "message")
+	case "create_portfolio":
+		return pickKeys(jsonStr, "gid", "name", "permalink_url")
 	case "create_section":
 		return pickKeys(jsonStr, "gid", "name")
 	case "create_task", "update_task", "complete_task":
 		return pickKeys(jsonStr, "gid", "name", "completed")
-	case "delete_task":
+	case "delete_task", "add_task_to_project", "remove_task_from_project":
 		return pickKeys(jsonStr, "success", "message")
 	case "create_subtask":
 		return pickKeys(jsonStr, "gid", "name")
@@ -94,6 +105,28 @@ func userToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// usersToCSV: gid,name,email
+func usersToCSV(jsonStr string) string {
+	var users []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &users); err != nil {
+		return jsonStr
+	}
+	if len(users) == 0 {
+		return "# 0 users"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\ngid,name,email\n")
+	for _, u := range users {
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n",
+			csvEscape(str(u, "gid")),
+			csvEscape(str(u, "name")),
+			csvEscape(str(u, "email")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // workspacesToCSV: gid,name
 func workspacesToCSV(jsonStr string) string {
 	var workspaces []map[string]any
@@ -183,6 +216,77 @@ func projectToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// portfoliosToCSV: gid,name,owner,public,color
+func portfoliosToCSV(jsonStr string) string {
+	var portfolios []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &portfolios); err != nil {
+		return jsonStr
+	}
+	if len(portfolios) == 0 {
+		return "# 0 portfolios"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\ngid,name,owner,public,color\n")
+	for _, p := range portfolios {
+		owner, _ := p["owner"].(map[string]any)
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%v,%s\n",
+			csvEscape(str(p, "gid")),
+			csvEscape(str(p, "name")),
+			csvEscape(str(owner, "name")),
+			boolVal(p, "public"),
+			str(p, "color"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// portfolioToCompact: portfolio summary + items CSV
+func portfolioToCompact(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	p, ok := data["portfolio"].(map[string]any)
+	if !ok {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(p, "name")))
+	sb.WriteString(fmt.Sprintf("- **GID**: %s\n", str(p, "gid")))
+	if owner, ok := p["owner"].(map[string]any); ok {
+		sb.WriteString(fmt.Sprintf("- **Owner**: %s\n", str(owner, "name")))
+	}
+	if color := str(p, "color"); color != "" {
+		sb.WriteString(fmt.Sprintf("- **Color**: %s\n", color))
+	}
+	sb.WriteString(fmt.Sprintf("- **Public**: %v\n", boolVal(p, "public")))
+	if url := str(p, "permalink_url"); url != "" {
+		sb.WriteString(fmt.Sprintf("- **URL**: %s\n", url))
+	}
+
+	items, _ := data["items"].([]any)
+	if len(items) == 0 {
+		sb.WriteString("\n# 0 items")
+		return sb.String()
+	}
+	sb.WriteString("\n```csv\ngid,name,archived,due_on\n")
+	for _, it := range items {
+		item, ok := it.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%v,%s\n",
+			csvEscape(str(item, "gid")),
+			csvEscape(str(item, "name")),
+			boolVal(item, "archived"),
+			str(item, "due_on"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // sectionsToCSV: gid,name
 func sectionsToCSV(jsonStr string) string {
 	var sections []map[string]any
@@ -304,7 +408,52 @@ func taskToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
-// storiesToCompact: stories in MD
+// eventsToCompact: sync token line, then CSV created_at,action,resource_type,resource,field,user
+func eventsToCompact(jsonStr string) string {
+	var res struct {
+		Events  []map[string]any `json:"events"`
+		Sync    string           `json:"sync"`
+		HasMore bool             `json:"has_more"`
+		Message string           `json:"message"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &res); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("sync: %s\n", res.Sync))
+	if res.Message != "" {
+		sb.WriteString(res.Message + "\n")
+	}
+	if len(res.Events) == 0 {
+		sb.WriteString("# 0 events")
+		return sb.String()
+	}
+	if res.HasMore {
+		sb.WriteString("has_more: true (call again with this sync token for the rest)\n")
+	}
+	sb.WriteString("```csv\ncreated_at,action,resource_type,resource,field,user\n")
+	for _, e := range res.Events {
+		resource, _ := e["resource"].(map[string]any)
+		change, _ := e["change"].(map[string]any)
+		user, _ := e["user"].(map[string]any)
+		name := str(resource, "name")
+		if name == "" {
+			name = str(resource, "gid")
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s\n",
+			str(e, "created_at"),
+			str(e, "action"),
+			str(resource, "resource_type"),
+			csvEscape(name),
+			str(change, "field"),
+			csvEscape(str(user, "name")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// storiesToCompact: comments as a chronological thread in MD, then system activity
 func storiesToCompact(jsonStr string) string {
 	var stories []map[string]any
 	if err := json.Unmarshal([]byte(jsonStr), &stories); err != nil {
@@ -313,28 +462,60 @@ func storiesToCompact(jsonStr string) string {
 	if len(stories) == 0 {
 		return "# 0 stories"
 	}
-	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("# %d stories\n\n", len(stories)))
+	// RFC3339 timestamps sort chronologically as strings
+	sort.SliceStable(stories, func(i, j int) bool {
+		return str(stories[i], "created_at") < str(stories[j], "created_at")
+	})
+
+	var comments, events []map[string]any
 	for _, s := range stories {
-		sType := str(s, "type")
-		createdBy := ""
-		if cb, ok := s["created_by"].(map[string]any); ok {
-			createdBy = str(cb, "name")
+		if str(s, "text") == "" {
+			continue
 		}
-		created := str(s, "created_at")
-		if len(created) > 16 {
-			created = created[:16]
+		if str(s, "type") == "comment" {
+			comments = append(comments, s)
+		} else {
+			events = append(events, s)
 		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Thread: %d comments\n\n", len(comments)))
+	for _, s := range comments {
 		text := str(s, "text")
-		if text == "" {
-			continue
-		}
 		if len(text) > 500 {
 			text = text[:500] + "..."
 		}
-		sb.WriteString(fmt.Sprintf("**%s** [%s] (%s):\n%s\n\n", createdBy, sType, created, text))
+		sb.WriteString(fmt.Sprintf("**%s** (%s):\n%s\n\n", storyAuthor(s), storyTime(s), text))
+	}
+	if len(events) > 0 {
+		sb.WriteString(fmt.Sprintf("# Activity: %d system events\n", len(events)))
+		for _, s := range events {
+			text := strings.ReplaceAll(str(s, "text"), "\n", " ")
+			if len(text) > 200 {
+				text = text[:200] + "..."
+			}
+			sb.WriteString(fmt.Sprintf("- %s %s: %s\n", storyTime(s), storyAuthor(s), text))
+		}
 	}
-	return strings.TrimSuffix(sb.String(), "\n")
+	return strings.TrimSuffix(strings.TrimSuffix(sb.String(), "\n"), "\n")
+}
+
+// storyAuthor returns the name of the story's creator ("Asana" for system events without one).
+func storyAuthor(s map[string]any) string {
+	if cb, ok := s["created_by"].(map[string]any); ok && str(cb, "name") != "" {
+		return str(cb, "name")
+	}
+	return "Asana"
+}
+
+// storyTime returns created_at to the minute.
+func storyTime(s map[string]any) string {
+	created := str(s, "created_at")
+	if len(created) > 16 {
+		created = created[:16]
+	}
+	return created
 }
 
 // tagsToCSV: gid,name,color
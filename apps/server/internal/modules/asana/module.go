@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"mcpist/server/internal/broker"
 	"mcpist/server/internal/middleware"
@@ -61,6 +62,7 @@ func (m *AsanaModule) ExecuteTool(ctx context.Context, name string, params map[s
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -70,6 +72,59 @@ func (m *AsanaModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *AsanaModule) HealthProbe() (string, map[string]any) {
+	return "get_me", nil
+}
+
+// PreviewDelete describes the project delete_project would remove
+// Implements modules.DeletePreviewer interface
+func (m *AsanaModule) PreviewDelete(ctx context.Context, toolName string, params map[string]any) (any, error) {
+	if toolName != "delete_project" {
+		return nil, fmt.Errorf("no preview for tool: %s", toolName)
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectGID, _ := params["project_gid"].(string)
+	project, err := c.GetProject(ctx, gen.GetProjectParams{ProjectGid: projectGID})
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := c.ListTasksByProject(ctx, gen.ListTasksByProjectParams{ProjectGid: projectGID, OptFields: gen.NewOptString("name")})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(tasks.Data))
+	for _, t := range tasks.Data {
+		names = append(names, t.Name.Value)
+	}
+	return map[string]any{
+		"project_gid": projectGID,
+		"name":        project.Data.Value.Name.Value,
+		"task_count":  len(names),
+		"tasks":       modules.Preview(names),
+	}, nil
+}
+
+// snapshotTools maps update tools to the get tool that fetches what they change, for _return_diff.
+var snapshotTools = map[string]toolHandler{
+	"update_task":    getTask,
+	"complete_task":  getTask,
+	"update_project": getProject,
+}
+
+// SnapshotForUpdate returns the task or project an update tool is about to change.
+func (m *AsanaModule) SnapshotForUpdate(ctx context.Context, toolName string, params map[string]any) (string, error) {
+	get, ok := snapshotTools[toolName]
+	if !ok {
+		return "", modules.ErrDiffUnsupported
+	}
+	return get(ctx, params)
+}
+
 // Resources returns all available resources (none for Asana)
 func (m *AsanaModule) Resources() []modules.Resource {
 	return nil
@@ -103,6 +158,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 // =============================================================================
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "asana", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -112,6 +168,55 @@ func newOgenClient(ctx context.Context) (*gen.Client, error) {
 
 var toJSON = modules.ToJSON
 
+// setNilString applies an update-tool param to a nullable field: null clears it,
+// omitting it (or "") leaves it unchanged.
+func setNilString(field *gen.OptNilString, params map[string]any, key string) {
+	switch v, op := modules.StringUpdate(params, key); op {
+	case modules.FieldSet:
+		field.SetTo(v)
+	case modules.FieldCleared:
+		field.SetToNull()
+	}
+}
+
+// setDate applies an optional date param on create, normalized with
+// modules.NormalizeDate or modules.NormalizeDateTime in the timezone param.
+func setDate(field *gen.OptString, params map[string]any, key string, normalize func(string, *time.Location) (string, error)) error {
+	v, ok := params[key].(string)
+	if !ok || v == "" {
+		return nil
+	}
+	loc, err := modules.LocationParam(params)
+	if err != nil {
+		return err
+	}
+	d, err := normalize(v, loc)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	field.SetTo(d)
+	return nil
+}
+
+// setNilDate is setNilString for date params.
+func setNilDate(field *gen.OptNilString, params map[string]any, key string, normalize func(string, *time.Location) (string, error)) error {
+	switch v, op := modules.StringUpdate(params, key); op {
+	case modules.FieldSet:
+		loc, err := modules.LocationParam(params)
+		if err != nil {
+			return err
+		}
+		d, err := normalize(v, loc)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		field.SetTo(d)
+	case modules.FieldCleared:
+		field.SetToNull()
+	}
+	return nil
+}
+
 var toStringSlice = modules.ToStringSlice
 
 // =============================================================================
@@ -133,6 +238,38 @@ var toolDefinitions = []modules.Tool{
 			Properties: map[string]modules.Property{},
 		},
 	},
+	{
+		ID:   "asana:get_user",
+		Name: "get_user",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a user's information by GID.",
+			"ja-JP": "GIDを指定してユーザーの情報を取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"user_gid": {Type: "string", Description: "User GID"},
+			},
+			Required: []string{"user_gid"},
+		},
+	},
+	{
+		ID:   "asana:list_users",
+		Name: "list_users",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List users in a workspace. Use this to resolve a name to the user GID required by assignee parameters.",
+			"ja-JP": "ワークスペース内のユーザーを一覧表示します。担当者パラメータに必要なユーザーGIDを名前から調べる際に使用します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"workspace_gid": {Type: "string", Description: "Workspace GID"},
+			},
+			Required: []string{"workspace_gid"},
+		},
+	},
 	// Workspaces
 	{
 		ID:   "asana:list_workspaces",
@@ -215,7 +352,8 @@ var toolDefinitions = []modules.Tool{
 				"notes":         {Type: "string", Description: "Project description"},
 				"color":         {Type: "string", Description: "Project color (dark-pink, dark-green, dark-blue, dark-red, dark-teal, dark-brown, dark-orange, dark-purple, dark-warm-gray, light-pink, light-green, light-blue, light-red, light-teal, light-brown, light-orange, light-purple, light-warm-gray, none)"},
 				"default_view":  {Type: "string", Description: "Default view: list, board, calendar, timeline"},
-				"due_on":        {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
+				"due_on":        {Type: "string", Description: "Due date (YYYY-MM-DD, or relative like 'tomorrow' or 'in 3 days')"},
+				"timezone":      modules.TimezoneProperty,
 			},
 			Required: []string{"name"},
 		},
@@ -224,8 +362,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "asana:update_project",
 		Name: "update_project",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing project.",
-			"ja-JP": "既存のプロジェクトを更新します。",
+			"en-US": "Update an existing project. Omitted fields are left unchanged; pass null to clear a field (e.g. due_on, notes).",
+			"ja-JP": "既存のプロジェクトを更新します。省略したフィールドは変更されません。フィールドをクリアするにはnullを指定します（例: due_on, notes）。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
@@ -236,7 +374,8 @@ var toolDefinitions = []modules.Tool{
 				"notes":        {Type: "string", Description: "New project description"},
 				"color":        {Type: "string", Description: "Project color"},
 				"default_view": {Type: "string", Description: "Default view"},
-				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
+				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD, or relative like 'tomorrow' or 'in 3 days')"},
+				"timezone":     modules.TimezoneProperty,
 				"archived":     {Type: "boolean", Description: "Archive status"},
 			},
 			Required: []string{"project_gid"},
@@ -246,10 +385,10 @@ var toolDefinitions = []modules.Tool{
 		ID:   "asana:delete_project",
 		Name: "delete_project",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Delete a project.",
-			"ja-JP": "プロジェクトを削除します。",
+			"en-US": "Delete a project. The first call returns the project and its task count plus a confirmation_token; call again with the token to delete.",
+			"ja-JP": "プロジェクトを削除します。最初の呼び出しはプロジェクトとタスク数、およびconfirmation_tokenを返します。トークンを付けて再度呼び出すと削除されます。",
 		},
-		Annotations: modules.AnnotateDelete,
+		Annotations: modules.AnnotateConfirmedDelete,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
@@ -258,6 +397,59 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"project_gid"},
 		},
 	},
+	// Portfolios
+	{
+		ID:   "asana:list_portfolios",
+		Name: "list_portfolios",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List portfolios owned by a user in a workspace.",
+			"ja-JP": "ワークスペース内でユーザーが所有するポートフォリオを一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"workspace_gid": {Type: "string", Description: "Workspace GID"},
+				"owner":         {Type: "string", Description: "Owner user GID or email. Default: me"},
+			},
+			Required: []string{"workspace_gid"},
+		},
+	},
+	{
+		ID:   "asana:get_portfolio",
+		Name: "get_portfolio",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a portfolio and the projects it contains.",
+			"ja-JP": "ポートフォリオとそれに含まれるプロジェクトを取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"portfolio_gid": {Type: "string", Description: "Portfolio GID"},
+			},
+			Required: []string{"portfolio_gid"},
+		},
+	},
+	{
+		ID:   "asana:create_portfolio",
+		Name: "create_portfolio",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a new portfolio in a workspace.",
+			"ja-JP": "ワークスペースに新しいポートフォリオを作成します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"workspace_gid": {Type: "string", Description: "Workspace GID (required)"},
+				"name":          {Type: "string", Description: "Portfolio name (required)"},
+				"color":         {Type: "string", Description: "Portfolio color (same values as project colors)"},
+				"public":        {Type: "boolean", Description: "Make the portfolio visible to the whole workspace. Default: false"},
+			},
+			Required: []string{"workspace_gid", "name"},
+		},
+	},
 	// Sections
 	{
 		ID:   "asana:list_sections",
@@ -304,11 +496,12 @@ var toolDefinitions = []modules.Tool{
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"project_gid":   {Type: "string", Description: "Project GID"},
-				"section_gid":   {Type: "string", Description: "Section GID"},
-				"assignee_gid":  {Type: "string", Description: "Assignee user GID"},
-				"workspace_gid": {Type: "string", Description: "Workspace GID (required when using assignee)"},
-				"completed":     {Type: "boolean", Description: "Filter by completion status"},
+				"project_gid":    {Type: "string", Description: "Project GID"},
+				"section_gid":    {Type: "string", Description: "Section GID"},
+				"assignee_gid":   {Type: "string", Description: "Assignee user GID"},
+				"workspace_gid":  {Type: "string", Description: "Workspace GID (required when using assignee)"},
+				"completed":      {Type: "boolean", Description: "Filter by completion status"},
+				"modified_since": {Type: "string", Description: "Only return tasks modified after this time (ISO 8601, e.g. 2024-01-01T00:00:00Z)"},
 			},
 		},
 	},
@@ -346,9 +539,10 @@ var toolDefinitions = []modules.Tool{
 				"parent_gid":    {Type: "string", Description: "Parent task GID for subtasks"},
 				"notes":         {Type: "string", Description: "Task description (plain text)"},
 				"html_notes":    {Type: "string", Description: "Task description (HTML)"},
-				"due_on":        {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
-				"due_at":        {Type: "string", Description: "Due datetime (ISO 8601 format)"},
-				"start_on":      {Type: "string", Description: "Start date (YYYY-MM-DD format)"},
+				"due_on":        {Type: "string", Description: "Due date (YYYY-MM-DD, or relative like 'tomorrow' or 'in 3 days')"},
+				"due_at":        {Type: "string", Description: "Due datetime (ISO 8601 format, or relative like 'in 2 hours')"},
+				"timezone":      modules.TimezoneProperty,
+				"start_on":      {Type: "string", Description: "Start date (YYYY-MM-DD, or relative like 'next monday')"},
 				"assignee_gid":  {Type: "string", Description: "Assignee user GID"},
 				"tags":          {Type: "array", Description: "Array of tag GIDs"},
 			},
@@ -359,8 +553,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "asana:update_task",
 		Name: "update_task",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing task.",
-			"ja-JP": "既存のタスクを更新します。",
+			"en-US": "Update an existing task. Omitted fields are left unchanged; pass null to clear a field (e.g. due_on, assignee_gid).",
+			"ja-JP": "既存のタスクを更新します。省略したフィールドは変更されません。フィールドをクリアするにはnullを指定します（例: due_on, assignee_gid）。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
@@ -370,9 +564,10 @@ var toolDefinitions = []modules.Tool{
 				"name":         {Type: "string", Description: "New task name"},
 				"notes":        {Type: "string", Description: "Task description (plain text)"},
 				"html_notes":   {Type: "string", Description: "Task description (HTML)"},
-				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
-				"due_at":       {Type: "string", Description: "Due datetime (ISO 8601 format)"},
-				"start_on":     {Type: "string", Description: "Start date (YYYY-MM-DD format)"},
+				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD, or relative like 'tomorrow' or 'in 3 days')"},
+				"due_at":       {Type: "string", Description: "Due datetime (ISO 8601 format, or relative like 'in 2 hours')"},
+				"timezone":     modules.TimezoneProperty,
+				"start_on":     {Type: "string", Description: "Start date (YYYY-MM-DD, or relative like 'next monday')"},
 				"completed":    {Type: "boolean", Description: "Completion status"},
 				"assignee_gid": {Type: "string", Description: "Assignee user GID"},
 			},
@@ -411,6 +606,43 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"task_gid"},
 		},
 	},
+	{
+		ID:   "asana:add_task_to_project",
+		Name: "add_task_to_project",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add an existing task to a project (tasks can belong to multiple projects). Optionally place it in a section or before/after another task. Also moves a task within a project it already belongs to.",
+			"ja-JP": "既存のタスクをプロジェクトに追加します（タスクは複数のプロジェクトに所属可能）。セクションや他タスクの前後を指定して配置することもできます。所属済みのプロジェクト内での移動にも使えます。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"task_gid":      {Type: "string", Description: "Task GID"},
+				"project_gid":   {Type: "string", Description: "Project GID"},
+				"section_gid":   {Type: "string", Description: "Section GID in the project to place the task in"},
+				"insert_before": {Type: "string", Description: "Task GID to insert this task before"},
+				"insert_after":  {Type: "string", Description: "Task GID to insert this task after"},
+			},
+			Required: []string{"task_gid", "project_gid"},
+		},
+	},
+	{
+		ID:   "asana:remove_task_from_project",
+		Name: "remove_task_from_project",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Remove a task from a project. The task itself is not deleted.",
+			"ja-JP": "タスクをプロジェクトから外します。タスク自体は削除されません。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"task_gid":    {Type: "string", Description: "Task GID"},
+				"project_gid": {Type: "string", Description: "Project GID"},
+			},
+			Required: []string{"task_gid", "project_gid"},
+		},
+	},
 	// Subtasks
 	{
 		ID:   "asana:list_subtasks",
@@ -442,7 +674,8 @@ var toolDefinitions = []modules.Tool{
 				"parent_gid":   {Type: "string", Description: "Parent task GID (required)"},
 				"name":         {Type: "string", Description: "Subtask name (required)"},
 				"notes":        {Type: "string", Description: "Subtask description"},
-				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
+				"due_on":       {Type: "string", Description: "Due date (YYYY-MM-DD, or relative like 'tomorrow' or 'in 3 days')"},
+				"timezone":     modules.TimezoneProperty,
 				"assignee_gid": {Type: "string", Description: "Assignee user GID"},
 			},
 			Required: []string{"parent_gid", "name"},
@@ -453,14 +686,15 @@ var toolDefinitions = []modules.Tool{
 		ID:   "asana:list_stories",
 		Name: "list_stories",
 		Descriptions: modules.LocalizedText{
-			"en-US": "List stories (comments and activity) on a task.",
-			"ja-JP": "タスクのストーリー（コメントとアクティビティ）を一覧表示します。",
+			"en-US": "List stories on a task: the comment thread in chronological order, followed by system activity (assignments, moves, completions).",
+			"ja-JP": "タスクのストーリーを一覧表示します。コメントを時系列のスレッドとして表示し、続けてシステムアクティビティ（担当者変更、移動、完了など）を表示します。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"task_gid": {Type: "string", Description: "Task GID"},
+				"task_gid":      {Type: "string", Description: "Task GID"},
+				"comments_only": {Type: "boolean", Description: "Return only human comments, without system activity. Default: false"},
 			},
 			Required: []string{"task_gid"},
 		},
@@ -482,6 +716,24 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"task_gid", "text"},
 		},
 	},
+	// Events
+	{
+		ID:   "asana:get_events",
+		Name: "get_events",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get changes to a project or task since the last call (Asana Events API). Call without sync to get a starting sync token (no events); then pass the returned sync token each time to receive only the events since. Tokens expire after about 24 hours of disuse.",
+			"ja-JP": "前回の呼び出し以降のプロジェクトまたはタスクの変更を取得します（Asana Events API）。syncなしで呼び出すと開始用のsyncトークン（イベントなし）が返ります。以降は返されたsyncトークンを毎回渡すと、その後のイベントのみを受け取れます。トークンは約24時間使われないと失効します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"resource_gid": {Type: "string", Description: "GID of the project or task to watch"},
+				"sync":         {Type: "string", Description: "Sync token from the previous get_events call. Omit on the first call"},
+			},
+			Required: []string{"resource_gid"},
+		},
+	},
 	// Tags
 	{
 		ID:   "asana:list_tags",
@@ -553,7 +805,9 @@ type toolHandler func(ctx context.Context, params map[string]any) (string, error
 
 var toolHandlers = map[string]toolHandler{
 	// User
-	"get_me": getMe,
+	"get_me":     getMe,
+	"get_user":   getUser,
+	"list_users": listUsers,
 	// Workspaces
 	"list_workspaces": listWorkspaces,
 	"get_workspace":   getWorkspace,
@@ -563,22 +817,30 @@ var toolHandlers = map[string]toolHandler{
 	"create_project":  createProject,
 	"update_project":  updateProject,
 	"delete_project":  deleteProject,
+	// Portfolios
+	"list_portfolios":  listPortfolios,
+	"get_portfolio":    getPortfolio,
+	"create_portfolio": createPortfolio,
 	// Sections
 	"list_sections":   listSections,
 	"create_section":  createSection,
 	// Tasks
-	"list_tasks":    listTasks,
-	"get_task":      getTask,
-	"create_task":   createTask,
-	"update_task":   updateTask,
-	"complete_task": completeTask,
-	"delete_task":   deleteTask,
+	"list_tasks":               listTasks,
+	"get_task":                 getTask,
+	"create_task":              createTask,
+	"update_task":              updateTask,
+	"complete_task":            completeTask,
+	"delete_task":              deleteTask,
+	"add_task_to_project":      addTaskToProject,
+	"remove_task_from_project": removeTaskFromProject,
 	// Subtasks
 	"list_subtasks":   listSubtasks,
 	"create_subtask":  createSubtask,
 	// Stories
 	"list_stories": listStories,
 	"add_comment":  addComment,
+	// Events
+	"get_events": getEvents,
 	// Tags
 	"list_tags":   listTags,
 	"create_tag":  createTag,
@@ -602,6 +864,35 @@ func getMe(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res.Data)
 }
 
+func getUser(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	userGID, _ := params["user_gid"].(string)
+	res, err := c.GetUser(ctx, gen.GetUserParams{UserGid: userGID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res.Data)
+}
+
+func listUsers(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	workspaceGID, _ := params["workspace_gid"].(string)
+	res, err := c.ListUsersByWorkspace(ctx, gen.ListUsersByWorkspaceParams{
+		WorkspaceGid: workspaceGID,
+		OptFields:    gen.NewOptString("name,email"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res.Data)
+}
+
 // =============================================================================
 // Workspaces
 // =============================================================================
@@ -706,8 +997,8 @@ func createProject(ctx context.Context, params map[string]any) (string, error) {
 	if defaultView, ok := params["default_view"].(string); ok {
 		reqData.DefaultView.SetTo(defaultView)
 	}
-	if dueOn, ok := params["due_on"].(string); ok {
-		reqData.DueOn.SetTo(dueOn)
+	if err := setDate(&reqData.DueOn, params, "due_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
 	res, err := c.CreateProject(ctx, &gen.CreateProjectRequest{Data: reqData})
 	if err != nil {
@@ -723,20 +1014,18 @@ func updateProject(ctx context.Context, params map[string]any) (string, error) {
 	}
 	projectGID, _ := params["project_gid"].(string)
 	reqData := gen.UpdateProjectRequestData{}
-	if name, ok := params["name"].(string); ok && name != "" {
+	if name, op := modules.StringUpdate(params, "name"); op == modules.FieldSet {
 		reqData.Name.SetTo(name)
 	}
-	if notes, ok := params["notes"].(string); ok {
+	if notes, op := modules.StringUpdate(params, "notes"); op != modules.FieldUnchanged {
 		reqData.Notes.SetTo(notes)
 	}
-	if color, ok := params["color"].(string); ok {
-		reqData.Color.SetTo(color)
-	}
-	if defaultView, ok := params["default_view"].(string); ok {
+	setNilString(&reqData.Color, params, "color")
+	if defaultView, op := modules.StringUpdate(params, "default_view"); op == modules.FieldSet {
 		reqData.DefaultView.SetTo(defaultView)
 	}
-	if dueOn, ok := params["due_on"].(string); ok {
-		reqData.DueOn.SetTo(dueOn)
+	if err := setNilDate(&reqData.DueOn, params, "due_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
 	if archived, ok := params["archived"].(bool); ok {
 		reqData.Archived.SetTo(archived)
@@ -761,6 +1050,75 @@ func deleteProject(ctx context.Context, params map[string]any) (string, error) {
 	return `{"success":true,"message":"Project deleted"}`, nil
 }
 
+// =============================================================================
+// Portfolios
+// =============================================================================
+
+func listPortfolios(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	workspaceGID, _ := params["workspace_gid"].(string)
+	owner, _ := params["owner"].(string)
+	if owner == "" {
+		owner = "me"
+	}
+	res, err := c.ListPortfolios(ctx, gen.ListPortfoliosParams{
+		Workspace: workspaceGID,
+		Owner:     owner,
+		OptFields: gen.NewOptString("name,color,public,owner.name"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res.Data)
+}
+
+func getPortfolio(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	portfolioGID, _ := params["portfolio_gid"].(string)
+	res, err := c.GetPortfolio(ctx, gen.GetPortfolioParams{PortfolioGid: portfolioGID})
+	if err != nil {
+		return "", err
+	}
+	items, err := c.GetPortfolioItems(ctx, gen.GetPortfolioItemsParams{
+		PortfolioGid: portfolioGID,
+		OptFields:    gen.NewOptString("name,archived,color,due_on"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"portfolio": res.Data,
+		"items":     items.Data,
+	})
+}
+
+func createPortfolio(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	workspaceGID, _ := params["workspace_gid"].(string)
+	name, _ := params["name"].(string)
+	reqData := gen.CreatePortfolioRequestData{Name: name, Workspace: workspaceGID}
+	if color, ok := params["color"].(string); ok && color != "" {
+		reqData.Color.SetTo(color)
+	}
+	if public, ok := params["public"].(bool); ok {
+		reqData.Public.SetTo(public)
+	}
+	res, err := c.CreatePortfolio(ctx, &gen.CreatePortfolioRequest{Data: reqData})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res.Data)
+}
+
 // =============================================================================
 // Sections
 // =============================================================================
@@ -814,12 +1172,18 @@ func listTasks(ctx context.Context, params map[string]any) (string, error) {
 		completedSince = gen.NewOptString("2000-01-01T00:00:00Z")
 	}
 
-	optFields := gen.NewOptString("name,completed,due_on,due_at,assignee.name,notes")
+	var modifiedSince gen.OptString
+	if ms, ok := params["modified_since"].(string); ok && ms != "" {
+		modifiedSince = gen.NewOptString(ms)
+	}
+
+	optFields := gen.NewOptString("name,completed,due_on,due_at,assignee.name,notes,modified_at")
 
 	if hasSection && sectionGID != "" {
 		res, err := c.ListTasksBySection(ctx, gen.ListTasksBySectionParams{
 			SectionGid:     sectionGID,
 			CompletedSince: completedSince,
+			ModifiedSince:  modifiedSince,
 			OptFields:      optFields,
 		})
 		if err != nil {
@@ -832,6 +1196,7 @@ func listTasks(ctx context.Context, params map[string]any) (string, error) {
 		res, err := c.ListTasksByProject(ctx, gen.ListTasksByProjectParams{
 			ProjectGid:     projectGID,
 			CompletedSince: completedSince,
+			ModifiedSince:  modifiedSince,
 			OptFields:      optFields,
 		})
 		if err != nil {
@@ -845,6 +1210,7 @@ func listTasks(ctx context.Context, params map[string]any) (string, error) {
 			Assignee:       assigneeGID,
 			Workspace:      workspaceGID,
 			CompletedSince: completedSince,
+			ModifiedSince:  modifiedSince,
 			OptFields:      optFields,
 		})
 		if err != nil {
@@ -890,14 +1256,14 @@ func createTask(ctx context.Context, params map[string]any) (string, error) {
 	if htmlNotes, ok := params["html_notes"].(string); ok {
 		reqData.HTMLNotes.SetTo(htmlNotes)
 	}
-	if dueOn, ok := params["due_on"].(string); ok {
-		reqData.DueOn.SetTo(dueOn)
+	if err := setDate(&reqData.DueOn, params, "due_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
-	if dueAt, ok := params["due_at"].(string); ok {
-		reqData.DueAt.SetTo(dueAt)
+	if err := setDate(&reqData.DueAt, params, "due_at", modules.NormalizeDateTime); err != nil {
+		return "", err
 	}
-	if startOn, ok := params["start_on"].(string); ok {
-		reqData.StartOn.SetTo(startOn)
+	if err := setDate(&reqData.StartOn, params, "start_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
 	if assigneeGID, ok := params["assignee_gid"].(string); ok {
 		reqData.Assignee.SetTo(assigneeGID)
@@ -936,30 +1302,31 @@ func updateTask(ctx context.Context, params map[string]any) (string, error) {
 	}
 	taskGID, _ := params["task_gid"].(string)
 	reqData := gen.UpdateTaskRequestData{}
-	if name, ok := params["name"].(string); ok && name != "" {
+	if name, op := modules.StringUpdate(params, "name"); op == modules.FieldSet {
 		reqData.Name.SetTo(name)
 	}
-	if notes, ok := params["notes"].(string); ok {
+	if notes, op := modules.StringUpdate(params, "notes"); op != modules.FieldUnchanged {
 		reqData.Notes.SetTo(notes)
 	}
-	if htmlNotes, ok := params["html_notes"].(string); ok {
+	if htmlNotes, op := modules.StringUpdate(params, "html_notes"); op != modules.FieldUnchanged {
+		if op == modules.FieldCleared {
+			htmlNotes = "<body></body>"
+		}
 		reqData.HTMLNotes.SetTo(htmlNotes)
 	}
-	if dueOn, ok := params["due_on"].(string); ok {
-		reqData.DueOn.SetTo(dueOn)
+	if err := setNilDate(&reqData.DueOn, params, "due_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
-	if dueAt, ok := params["due_at"].(string); ok {
-		reqData.DueAt.SetTo(dueAt)
+	if err := setNilDate(&reqData.DueAt, params, "due_at", modules.NormalizeDateTime); err != nil {
+		return "", err
 	}
-	if startOn, ok := params["start_on"].(string); ok {
-		reqData.StartOn.SetTo(startOn)
+	if err := setNilDate(&reqData.StartOn, params, "start_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
 	if completed, ok := params["completed"].(bool); ok {
 		reqData.Completed.SetTo(completed)
 	}
-	if assigneeGID, ok := params["assignee_gid"].(string); ok {
-		reqData.Assignee.SetTo(assigneeGID)
-	}
+	setNilString(&reqData.Assignee, params, "assignee_gid")
 	res, err := c.UpdateTask(ctx, &gen.UpdateTaskRequest{Data: reqData}, gen.UpdateTaskParams{TaskGid: taskGID})
 	if err != nil {
 		return "", err
@@ -995,6 +1362,48 @@ func deleteTask(ctx context.Context, params map[string]any) (string, error) {
 	return `{"success":true,"message":"Task deleted"}`, nil
 }
 
+func addTaskToProject(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	taskGID, _ := params["task_gid"].(string)
+	projectGID, _ := params["project_gid"].(string)
+	reqData := gen.AddTaskProjectRequestData{Project: projectGID}
+	if sectionGID, ok := params["section_gid"].(string); ok && sectionGID != "" {
+		reqData.Section.SetTo(sectionGID)
+	}
+	if before, ok := params["insert_before"].(string); ok && before != "" {
+		reqData.InsertBefore.SetTo(before)
+	}
+	if after, ok := params["insert_after"].(string); ok && after != "" {
+		reqData.InsertAfter.SetTo(after)
+	}
+	if reqData.InsertBefore.Set && reqData.InsertAfter.Set {
+		return "", fmt.Errorf("specify at most one of insert_before or insert_after")
+	}
+	_, err = c.AddTaskProject(ctx, &gen.AddTaskProjectRequest{Data: reqData}, gen.AddTaskProjectParams{TaskGid: taskGID})
+	if err != nil {
+		return "", err
+	}
+	return `{"success":true,"message":"Task added to project"}`, nil
+}
+
+func removeTaskFromProject(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	taskGID, _ := params["task_gid"].(string)
+	projectGID, _ := params["project_gid"].(string)
+	reqData := gen.RemoveTaskProjectRequestData{Project: projectGID}
+	_, err = c.RemoveTaskProject(ctx, &gen.RemoveTaskProjectRequest{Data: reqData}, gen.RemoveTaskProjectParams{TaskGid: taskGID})
+	if err != nil {
+		return "", err
+	}
+	return `{"success":true,"message":"Task removed from project"}`, nil
+}
+
 // =============================================================================
 // Subtasks
 // =============================================================================
@@ -1028,8 +1437,8 @@ func createSubtask(ctx context.Context, params map[string]any) (string, error) {
 	if notes, ok := params["notes"].(string); ok {
 		reqData.Notes.SetTo(notes)
 	}
-	if dueOn, ok := params["due_on"].(string); ok {
-		reqData.DueOn.SetTo(dueOn)
+	if err := setDate(&reqData.DueOn, params, "due_on", modules.NormalizeDate); err != nil {
+		return "", err
 	}
 	if assigneeGID, ok := params["assignee_gid"].(string); ok {
 		reqData.Assignee.SetTo(assigneeGID)
@@ -1055,6 +1464,15 @@ func listStories(ctx context.Context, params map[string]any) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if commentsOnly, _ := params["comments_only"].(bool); commentsOnly {
+		comments := []gen.Story{}
+		for _, s := range res.Data {
+			if s.Type.Value == "comment" {
+				comments = append(comments, s)
+			}
+		}
+		return toJSON(comments)
+	}
 	return toJSON(res.Data)
 }
 
@@ -1074,6 +1492,54 @@ func addComment(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res.Data)
 }
 
+// =============================================================================
+// Events
+// =============================================================================
+
+// getEvents returns {events, sync, has_more}. Asana answers a missing or
+// expired sync token with 412 and a fresh token, which is returned with no
+// events: the new token marks "now", so events before it are not replayed.
+func getEvents(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	resourceGID, _ := params["resource_gid"].(string)
+	p := gen.GetEventsParams{Resource: resourceGID}
+	sync, _ := params["sync"].(string)
+	if sync != "" {
+		p.Sync.SetTo(sync)
+	}
+	res, err := c.GetEvents(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	switch r := res.(type) {
+	case *gen.EventListResponse:
+		events := r.Data
+		if events == nil {
+			events = []gen.Event{}
+		}
+		return toJSON(map[string]any{
+			"events":   events,
+			"sync":     r.Sync.Value,
+			"has_more": r.HasMore.Value,
+		})
+	case *gen.SyncTokenError:
+		message := "Initial sync token. Pass it as sync on the next call to receive events from now on."
+		if sync != "" {
+			message = "The sync token expired; events since it are lost. Continue with this new token, and re-read the resource if you need its current state."
+		}
+		return toJSON(map[string]any{
+			"events":   []gen.Event{},
+			"sync":     r.Sync.Value,
+			"has_more": false,
+			"message":  message,
+		})
+	}
+	return "", fmt.Errorf("unexpected response type %T", res)
+}
+
 // =============================================================================
 // Tags
 // =============================================================================
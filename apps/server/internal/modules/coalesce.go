@@ -0,0 +1,71 @@
+package modules
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"golang.org/x/sync/singleflight"
+
+	"mcpist/server/internal/middleware"
+)
+
+// reads collapses concurrent identical read-only tool calls from the same user
+// (e.g. batch tasks or parallel runs fetching the same repo) into one upstream call.
+var reads singleflight.Group
+
+// isReadOnly reports whether a tool is annotated read-only. Only reads are
+// coalesced: running a write once for several callers would drop writes.
+func isReadOnly(m Module, toolName string) bool {
+	t, ok := findTool(m.Tools(), toolName)
+	return ok && t.Annotations != nil && t.Annotations.ReadOnlyHint != nil && *t.Annotations.ReadOnlyHint
+}
+
+// coalesceKey identifies a call by user, module, tool and a hash of its params.
+// ok is false when there is no user to scope the key to, and for metered calls
+// (_include_meta), which run on their own so their upstream counts are accurate.
+func coalesceKey(ctx context.Context, moduleName, toolName string, params map[string]any) (string, bool) {
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil || callMeterFrom(ctx) != nil {
+		return "", false
+	}
+	// json.Marshal sorts map keys, so equal params hash equally
+	b, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	return authCtx.UserID + "\x00" + moduleName + "\x00" + toolName + "\x00" + hex.EncodeToString(sum[:]), true
+}
+
+// coalesced runs execute, sharing its result with identical concurrent callers.
+// Each caller gets its own copy, since callers rewrite the content for output.
+// A caller stops waiting when its own ctx is cancelled, and if the shared call
+// was cancelled by another caller disconnecting, it runs execute itself.
+func coalesced(ctx context.Context, key string, execute func() *ToolCallResult) *ToolCallResult {
+	ch := reads.DoChan(key, func() (any, error) {
+		res := execute()
+		// Only the first caller's execute runs, so ctx here is that caller's
+		return res, ctx.Err()
+	})
+	var r singleflight.Result
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: "request cancelled: " + ctx.Err().Error()}},
+			IsError: true,
+		}
+	}
+	res := r.Val.(*ToolCallResult)
+	if !r.Shared {
+		return res
+	}
+	if r.Err != nil && ctx.Err() == nil {
+		return execute()
+	}
+	out := *res
+	out.Content = append([]ContentBlock(nil), res.Content...)
+	return &out
+}
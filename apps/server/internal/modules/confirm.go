@@ -0,0 +1,134 @@
+package modules
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfirmationParam carries the token that confirms a call to a tool annotated
+// AnnotateConfirmedDelete.
+const ConfirmationParam = "confirmation_token"
+
+// confirmationTTL is how long a confirmation token stays valid.
+const confirmationTTL = 5 * time.Minute
+
+// confirmationSecret signs confirmation tokens. CONFIRMATION_TOKEN_SECRET must
+// be set when several instances serve the same users; otherwise a random
+// per-process secret is used and tokens only verify on the instance that issued them.
+var confirmationSecret = loadConfirmationSecret()
+
+func loadConfirmationSecret() []byte {
+	if s := os.Getenv("CONFIRMATION_TOKEN_SECRET"); s != "" {
+		return []byte(s)
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate confirmation secret: %v", err))
+	}
+	return b
+}
+
+// TakeConfirmationToken removes the confirmation_token parameter from params and returns it.
+func TakeConfirmationToken(params map[string]any) string {
+	v, ok := params[ConfirmationParam]
+	if !ok {
+		return ""
+	}
+	delete(params, ConfirmationParam)
+	s, _ := v.(string)
+	return s
+}
+
+// RequiresConfirmation reports whether a tool is annotated AnnotateConfirmedDelete,
+// i.e. must be called twice: once for a preview and once with the token it returned.
+func RequiresConfirmation(moduleName, toolName string) bool {
+	m, ok := registry[moduleName]
+	if !ok {
+		return false
+	}
+	t, ok := findTool(m.Tools(), toolName)
+	return ok && t.Annotations != nil && t.Annotations.confirm
+}
+
+// ConfirmationPreview is returned instead of running a tool that requires confirmation.
+type ConfirmationPreview struct {
+	ConfirmationRequired bool   `json:"confirmation_required"`
+	Summary              any    `json:"summary"`
+	ConfirmationToken    string `json:"confirmation_token"`
+	ExpiresAt            string `json:"expires_at"`
+	Message              string `json:"message"`
+}
+
+// PreviewConfirmation summarizes what the call would delete (via the module's
+// DeletePreviewer, if any) and issues a token bound to the user, tool and params.
+func PreviewConfirmation(ctx context.Context, userID, moduleName, toolName string, params map[string]any) (*ConfirmationPreview, error) {
+	var summary any = map[string]any{"module": moduleName, "tool": toolName, "params": params}
+	if p, ok := registry[moduleName].(DeletePreviewer); ok {
+		s, err := p.PreviewDelete(ctx, toolName, params)
+		if err != nil {
+			return nil, WithUpstreamStatus(err)
+		}
+		summary = s
+	}
+	expires := time.Now().Add(confirmationTTL)
+	return &ConfirmationPreview{
+		ConfirmationRequired: true,
+		Summary:              summary,
+		ConfirmationToken:    confirmationToken(userID, moduleName, toolName, params, expires),
+		ExpiresAt:            expires.UTC().Format(time.RFC3339),
+		Message: fmt.Sprintf("%s:%s permanently deletes data and was not run. Review the summary, then call it again with the same params plus %q: %q within %s.",
+			moduleName, toolName, ConfirmationParam, "<confirmation_token>", confirmationTTL),
+	}, nil
+}
+
+// VerifyConfirmation checks a token from PreviewConfirmation against the call it confirms.
+func VerifyConfirmation(token, userID, moduleName, toolName string, params map[string]any) error {
+	expiry, sig, ok := strings.Cut(token, ".")
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if !ok || err != nil {
+		return fmt.Errorf("invalid %s", ConfirmationParam)
+	}
+	expires := time.Unix(unix, 0)
+	if !hmac.Equal([]byte(sig), []byte(confirmationSignature(userID, moduleName, toolName, params, expires))) {
+		return fmt.Errorf("invalid %s: it was issued for a different call (params must be unchanged)", ConfirmationParam)
+	}
+	if time.Now().After(expires) {
+		return fmt.Errorf("%s expired; call %s:%s without it to get a new one", ConfirmationParam, moduleName, toolName)
+	}
+	return nil
+}
+
+func confirmationToken(userID, moduleName, toolName string, params map[string]any, expires time.Time) string {
+	return strconv.FormatInt(expires.Unix(), 10) + "." + confirmationSignature(userID, moduleName, toolName, params, expires)
+}
+
+// confirmationSignature is the HMAC of the user, tool, params (json.Marshal
+// sorts map keys, so equal params sign equally) and expiry.
+func confirmationSignature(userID, moduleName, toolName string, params map[string]any, expires time.Time) string {
+	b, _ := json.Marshal(params)
+	mac := hmac.New(sha256.New, confirmationSecret)
+	fmt.Fprintf(mac, "%s\x00%s\x00%s\x00%d\x00", userID, moduleName, toolName, expires.Unix())
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// previewItems is how many names Preview keeps.
+const previewItems = 20
+
+// Preview shortens a list of names for a delete preview, noting how many were left out.
+func Preview(names []string) []string {
+	if len(names) <= previewItems {
+		return names
+	}
+	out := append([]string(nil), names[:previewItems]...)
+	return append(out, fmt.Sprintf("... and %d more", len(names)-previewItems))
+}
@@ -3,6 +3,7 @@ package confluence
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -17,10 +18,14 @@ func formatCompact(toolName, jsonStr string) string {
 		return spacesToCSV(jsonStr)
 	case "get_pages":
 		return pagesToCSV(jsonStr)
+	case "get_page_children":
+		return childPagesToCSV(jsonStr)
 	case "search":
 		return searchToCSV(jsonStr)
 	case "get_page_labels":
 		return labelsToCSV(jsonStr)
+	case "list_page_versions":
+		return versionsToCSV(jsonStr)
 	case "get_page_comments":
 		return commentsToCompact(jsonStr)
 	// Read: single items → MD
@@ -28,6 +33,8 @@ func formatCompact(toolName, jsonStr string) string {
 		return spaceToCompact(jsonStr)
 	case "get_page":
 		return pageToCompact(jsonStr)
+	case "get_page_markdown":
+		return pageMarkdownToCompact(jsonStr)
 	// Write
 	case "create_page", "update_page":
 		return pickKeys(jsonStr, "id", "title", "status", "spaceId")
@@ -37,6 +44,8 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "id")
 	case "add_page_label":
 		return jsonStr // label response is already minimal
+	case "add_attachment":
+		return pickKeys(jsonStr, "id", "title", "fileSize", "downloadUrl")
 	default:
 		return jsonStr
 	}
@@ -141,6 +150,40 @@ func pagesToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// childPagesToCSV: id,title,position
+func childPagesToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	results, ok := wrapper["results"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(results) == 0 {
+		return "# 0 child pages"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,title,position\n")
+	for _, raw := range results {
+		p, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		position := ""
+		if v, ok := p["childPosition"].(float64); ok {
+			position = fmt.Sprintf("%d", int(v))
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n",
+			csvEscape(str(p, "id")),
+			csvEscape(str(p, "title")),
+			position,
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // pageToCompact: single page detail with body
 func pageToCompact(jsonStr string) string {
 	var p map[string]any
@@ -176,6 +219,24 @@ func pageToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// pageMarkdownToCompact: title heading followed by the converted Markdown
+func pageMarkdownToCompact(jsonStr string) string {
+	var p map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &p); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(p, "title")))
+	sb.WriteString(fmt.Sprintf("- **ID**: %s\n", str(p, "id")))
+	if num, ok := p["version"].(float64); ok {
+		sb.WriteString(fmt.Sprintf("- **Version**: %d\n", int(num)))
+	}
+	if md := str(p, "markdown"); md != "" {
+		sb.WriteString("\n" + md)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
 // searchToCSV: id,title,type,spaceId
 func searchToCSV(jsonStr string) string {
 	var wrapper map[string]any
@@ -242,6 +303,47 @@ func labelsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// versionsToCSV: number,createdAt,authorId,minorEdit,message, then the next cursor if any
+func versionsToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	results, ok := wrapper["results"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(results) == 0 {
+		return "# 0 versions"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nnumber,createdAt,authorId,minorEdit,message\n")
+	for _, raw := range results {
+		v, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		number, _ := v["number"].(float64)
+		minorEdit, _ := v["minorEdit"].(bool)
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%t,%s\n",
+			int(number),
+			str(v, "createdAt"),
+			str(v, "authorId"),
+			minorEdit,
+			csvEscape(str(v, "message")),
+		))
+	}
+	sb.WriteString("```")
+	if links, ok := wrapper["_links"].(map[string]any); ok {
+		if next, err := url.Parse(str(links, "next")); err == nil {
+			if cursor := next.Query().Get("cursor"); cursor != "" {
+				sb.WriteString("\ncursor=" + cursor)
+			}
+		}
+	}
+	return sb.String()
+}
+
 // commentsToCompact: comments list in MD
 func commentsToCompact(jsonStr string) string {
 	var wrapper map[string]any
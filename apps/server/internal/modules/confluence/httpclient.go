@@ -0,0 +1,106 @@
+package confluence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/modules"
+)
+
+// =============================================================================
+// Module-local HTTP helpers for endpoints that cannot be modeled by ogen:
+//   - add_attachment (V1 multipart/form-data upload with X-Atlassian-Token)
+// =============================================================================
+
+// doAddAttachment uploads a file via POST /wiki/rest/api/content/{id}/child/attachment.
+func doAddAttachment(ctx context.Context, creds *broker.Credentials, pageID, filename, comment string, content []byte) (string, error) {
+	modules.LogTrace(ctx, "confluence", "upstream_call", map[string]any{"path": "/wiki/rest/api/content/{id}/child/attachment"})
+	serverURL, err := serverURLFor(creds)
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	if comment != "" {
+		if err := w.WriteField("comment", comment); err != nil {
+			return "", fmt.Errorf("failed to build request: %w", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/wiki/rest/api/content/%s/child/attachment", serverURL, url.PathEscape(pageID))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	if creds.AuthType == broker.AuthTypeBasic {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	// Confluence rejects multipart uploads without this XSRF bypass header
+	req.Header.Set("X-Atlassian-Token", "no-check")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        fmt.Errorf("upload failed: %s", msg),
+		}
+	}
+
+	var created struct {
+		Results []struct {
+			ID         string `json:"id"`
+			Title      string `json:"title"`
+			Extensions struct {
+				FileSize  int64  `json:"fileSize"`
+				MediaType string `json:"mediaType"`
+			} `json:"extensions"`
+			Links struct {
+				Download string `json:"download"`
+			} `json:"_links"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(created.Results) == 0 {
+		return "", fmt.Errorf("upload returned no attachment")
+	}
+	a := created.Results[0]
+	return toJSON(map[string]any{
+		"id":          a.ID,
+		"title":       a.Title,
+		"fileSize":    a.Extensions.FileSize,
+		"mediaType":   a.Extensions.MediaType,
+		"downloadUrl": a.Links.Download,
+	})
+}
@@ -2,6 +2,8 @@ package confluence
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
@@ -61,6 +63,7 @@ func (m *ConfluenceModule) ExecuteTool(ctx context.Context, name string, params
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -70,6 +73,12 @@ func (m *ConfluenceModule) ToCompact(toolName string, jsonResult string) string
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *ConfluenceModule) HealthProbe() (string, map[string]any) {
+	return "list_spaces", nil
+}
+
 // Resources returns all available resources (none for Confluence)
 func (m *ConfluenceModule) Resources() []modules.Resource {
 	return nil
@@ -97,27 +106,50 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "confluence", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
 	}
+	serverURL, err := serverURLFor(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	switch creds.AuthType {
+	case broker.AuthTypeBasic:
+		return confluenceapi.NewBasicClient(serverURL, creds.Username, creds.Password)
+	default:
+		// OAuth 2.0
+		return confluenceapi.NewBearerClient(serverURL, creds.AccessToken)
+	}
+}
 
+// serverURLFor returns the site base URL: the configured base_url, else by auth
+// type the site domain for Basic auth, the Atlassian cloud gateway for OAuth 2.0.
+// base_url must serve the Cloud API (/wiki/api/v2), e.g. a proxy in front of a
+// Cloud site; Data Center has no v2 API and is not supported.
+func serverURLFor(creds *broker.Credentials) (string, error) {
+	base, err := creds.BaseURL("")
+	if err != nil {
+		return "", err
+	}
+	if base != "" {
+		return base, nil
+	}
 	switch creds.AuthType {
 	case broker.AuthTypeBasic:
 		domain, _ := creds.Metadata["domain"].(string)
 		if domain == "" {
-			return nil, fmt.Errorf("confluence domain not configured")
+			return "", fmt.Errorf("confluence domain not configured")
 		}
-		serverURL := fmt.Sprintf("https://%s", domain)
-		return confluenceapi.NewBasicClient(serverURL, creds.Username, creds.Password)
+		return fmt.Sprintf("https://%s", domain), nil
 	default:
-		// OAuth 2.0
 		cloudID, _ := creds.Metadata["cloud_id"].(string)
 		if cloudID == "" {
-			return nil, fmt.Errorf("confluence cloud_id not configured")
+			return "", fmt.Errorf("confluence cloud_id not configured")
 		}
-		serverURL := fmt.Sprintf("https://api.atlassian.com/ex/confluence/%s", cloudID)
-		return confluenceapi.NewBearerClient(serverURL, creds.AccessToken)
+		return fmt.Sprintf("https://api.atlassian.com/ex/confluence/%s", cloudID), nil
 	}
 }
 
@@ -178,6 +210,24 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"space_id"},
 		},
 	},
+	{
+		ID:   "confluence:get_page_children",
+		Name: "get_page_children",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List the direct child pages of a Confluence page, for navigating the page tree.",
+			"ja-JP": "Confluenceページの直下の子ページを一覧表示します。ページツリーの探索に使用します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"page_id": {Type: "string", Description: "Parent page ID"},
+				"limit":   {Type: "number", Description: "Maximum results to return. Default: 25"},
+				"cursor":  {Type: "string", Description: "Pagination cursor for next page"},
+			},
+			Required: []string{"page_id"},
+		},
+	},
 	{
 		ID:   "confluence:get_page",
 		Name: "get_page",
@@ -195,6 +245,22 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"page_id"},
 		},
 	},
+	{
+		ID:   "confluence:get_page_markdown",
+		Name: "get_page_markdown",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a Confluence page's content converted from storage format to Markdown (headings, lists, tables, links, code and panel macros).",
+			"ja-JP": "Confluenceページのコンテンツをストレージ形式からMarkdown（見出し、リスト、表、リンク、コード・パネルマクロ）に変換して取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"page_id": {Type: "string", Description: "Page ID"},
+			},
+			Required: []string{"page_id"},
+		},
+	},
 	{
 		ID:   "confluence:create_page",
 		Name: "create_page",
@@ -322,8 +388,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "confluence:add_page_label",
 		Name: "add_page_label",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Add a label to a Confluence page.",
-			"ja-JP": "Confluenceページにラベルを追加します。",
+			"en-US": "Add one or more labels to a Confluence page.",
+			"ja-JP": "Confluenceページに1つ以上のラベルを追加します。",
 		},
 		Annotations: modules.AnnotateCreate,
 		InputSchema: modules.InputSchema{
@@ -331,8 +397,47 @@ var toolDefinitions = []modules.Tool{
 			Properties: map[string]modules.Property{
 				"page_id": {Type: "string", Description: "Page ID"},
 				"label":   {Type: "string", Description: "Label name"},
+				"labels":  {Type: "array", Description: "Label names, to add several at once", Items: &modules.Property{Type: "string"}},
+			},
+			Required: []string{"page_id"},
+		},
+	},
+	{
+		ID:   "confluence:list_page_versions",
+		Name: "list_page_versions",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List the version history of a Confluence page: version number, author, date and change message.",
+			"ja-JP": "Confluenceページのバージョン履歴（バージョン番号、作成者、日時、変更メッセージ）を一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"page_id": {Type: "string", Description: "Page ID"},
+				"sort":    {Type: "string", Description: "'-modified-date' (newest first, default) or 'modified-date'"},
+				"limit":   {Type: "number", Description: "Maximum results to return. Default: 25"},
+				"cursor":  {Type: "string", Description: "Pagination cursor for next page"},
+			},
+			Required: []string{"page_id"},
+		},
+	},
+	{
+		ID:   "confluence:add_attachment",
+		Name: "add_attachment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Attach a file to a Confluence page. Content is passed base64-encoded.",
+			"ja-JP": "Confluenceページにファイルを添付します。内容はBase64エンコードで渡します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"page_id":        {Type: "string", Description: "Page ID"},
+				"filename":       {Type: "string", Description: "File name shown in Confluence (e.g., 'diagram.png')"},
+				"content_base64": {Type: "string", Description: "File content, base64-encoded"},
+				"comment":        {Type: "string", Description: "Optional attachment comment"},
 			},
-			Required: []string{"page_id", "label"},
+			Required: []string{"page_id", "filename", "content_base64"},
 		},
 	},
 }
@@ -344,18 +449,22 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"list_spaces":       listSpaces,
-	"get_space":         getSpace,
-	"get_pages":         getPages,
-	"get_page":          getPage,
-	"create_page":       createPage,
-	"update_page":       updatePage,
-	"delete_page":       deletePage,
-	"search":            search,
-	"get_page_comments": getPageComments,
-	"add_page_comment":  addPageComment,
-	"get_page_labels":   getPageLabels,
-	"add_page_label":    addPageLabel,
+	"list_spaces":        listSpaces,
+	"get_space":          getSpace,
+	"get_pages":          getPages,
+	"get_page_children":  getPageChildren,
+	"get_page":           getPage,
+	"get_page_markdown":  getPageMarkdown,
+	"create_page":        createPage,
+	"update_page":        updatePage,
+	"delete_page":        deletePage,
+	"search":             search,
+	"get_page_comments":  getPageComments,
+	"add_page_comment":   addPageComment,
+	"get_page_labels":    getPageLabels,
+	"add_page_label":     addPageLabel,
+	"list_page_versions": listPageVersions,
+	"add_attachment":     addAttachment,
 }
 
 // =============================================================================
@@ -428,6 +537,26 @@ func getPages(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func getPageChildren(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	pageID, _ := params["page_id"].(string)
+	p := gen.GetChildPagesParams{ID: pageID}
+	if l, ok := params["limit"].(float64); ok {
+		p.Limit.SetTo(int(l))
+	}
+	if cursor, ok := params["cursor"].(string); ok && cursor != "" {
+		p.Cursor.SetTo(cursor)
+	}
+	res, err := c.GetChildPages(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func getPage(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -447,6 +576,51 @@ func getPage(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// pageMarkdown is the get_page_markdown result
+type pageMarkdown struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Version  int    `json:"version,omitempty"`
+	Markdown string `json:"markdown"`
+}
+
+func getPageMarkdown(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	pageID, _ := params["page_id"].(string)
+	p := gen.GetPageParams{PageId: pageID}
+	p.BodyFormat.SetTo("storage")
+	res, err := c.GetPage(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	var body struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	}
+	if len(res.Body) > 0 {
+		if err := json.Unmarshal(res.Body, &body); err != nil {
+			return "", fmt.Errorf("failed to parse page body: %w", err)
+		}
+	}
+	var version struct {
+		Number int `json:"number"`
+	}
+	if len(res.Version) > 0 {
+		json.Unmarshal(res.Version, &version)
+	}
+	return toJSON(pageMarkdown{
+		ID:       res.ID.Value,
+		Title:    res.Title.Value,
+		Version:  version.Number,
+		Markdown: StorageToMarkdown(body.Storage.Value),
+	})
+}
+
 func createPage(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -612,12 +786,70 @@ func addPageLabel(ctx context.Context, params map[string]any) (string, error) {
 		return "", err
 	}
 	pageID, _ := params["page_id"].(string)
-	label, _ := params["label"].(string)
 
-	req := gen.AddLabelRequestArray{{Name: label}}
+	var req gen.AddLabelRequestArray
+	if label, ok := params["label"].(string); ok && label != "" {
+		req = append(req, gen.AddLabelRequest{Name: label})
+	}
+	if labels, ok := params["labels"].([]any); ok {
+		for _, label := range modules.ToStringSlice(labels) {
+			req = append(req, gen.AddLabelRequest{Name: label})
+		}
+	}
+	if len(req) == 0 {
+		return "", fmt.Errorf("label or labels is required")
+	}
 	res, err := c.AddPageLabel(ctx, req, gen.AddPageLabelParams{PageId: pageID})
 	if err != nil {
 		return "", err
 	}
 	return toJSON(res)
 }
+
+// =============================================================================
+// Versions
+// =============================================================================
+
+func listPageVersions(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	pageID, _ := params["page_id"].(string)
+	p := gen.GetPageVersionsParams{ID: pageID}
+	if sort, ok := params["sort"].(string); ok && sort != "" {
+		p.Sort.SetTo(sort)
+	}
+	if l, ok := params["limit"].(float64); ok {
+		p.Limit.SetTo(int(l))
+	}
+	if cursor, ok := params["cursor"].(string); ok && cursor != "" {
+		p.Cursor.SetTo(cursor)
+	}
+	res, err := c.GetPageVersions(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+// =============================================================================
+// Attachments
+// =============================================================================
+
+func addAttachment(ctx context.Context, params map[string]any) (string, error) {
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+	pageID, _ := params["page_id"].(string)
+	filename, _ := params["filename"].(string)
+	encoded, _ := params["content_base64"].(string)
+	comment, _ := params["comment"].(string)
+
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("content_base64 is not valid base64: %w", err)
+	}
+	return doAddAttachment(ctx, creds, pageID, filename, comment, content)
+}
@@ -0,0 +1,440 @@
+package confluence
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// =============================================================================
+// Markdown Converter for Confluence storage format
+// Converts storage-format XHTML (HTML plus ac:/ri: macros) to readable Markdown
+// =============================================================================
+
+var (
+	// selfClosingTag matches self-closing ac:/ri: elements. The HTML parser
+	// ignores "/>" on unknown elements, which would nest the following siblings.
+	selfClosingTag = regexp.MustCompile(`<((?:ac|ri):[a-zA-Z-]+)([^<>]*?)\s*/>`)
+	whitespace     = regexp.MustCompile(`[ \t\r\n]+`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+)
+
+// StorageToMarkdown converts a Confluence storage-format body to Markdown.
+// Unparseable input is returned unchanged.
+func StorageToMarkdown(storage string) string {
+	storage = selfClosingTag.ReplaceAllString(storage, "<$1$2></$1>")
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(storage), context)
+	if err != nil {
+		return storage
+	}
+
+	var sb strings.Builder
+	for _, n := range nodes {
+		sb.WriteString(renderBlock(n, 0))
+	}
+
+	lines := strings.Split(sb.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	md := blankLines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(md)
+}
+
+// renderBlocks renders all children of n as block content
+func renderBlocks(n *html.Node, depth int) string {
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(renderBlock(c, depth))
+	}
+	return sb.String()
+}
+
+// renderBlock renders a node at block level; inline nodes fall through to renderInline
+func renderBlock(n *html.Node, depth int) string {
+	if n.Type != html.ElementNode {
+		return renderInline(n)
+	}
+
+	switch n.Data {
+	// Headings
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(n.Data[1:])
+		return fmt.Sprintf("\n\n%s %s\n\n", strings.Repeat("#", level), strings.TrimSpace(renderInlineChildren(n)))
+
+	// Paragraph
+	case "p":
+		return "\n\n" + strings.TrimSpace(renderInlineChildren(n)) + "\n\n"
+
+	// Lists
+	case "ul", "ol":
+		return "\n\n" + renderList(n, n.Data == "ol", depth) + "\n\n"
+	case "ac:task-list":
+		return "\n\n" + renderTaskList(n, depth) + "\n\n"
+
+	// Table
+	case "table":
+		return "\n\n" + renderTable(n) + "\n\n"
+
+	// Code
+	case "pre":
+		return fmt.Sprintf("\n\n```\n%s\n```\n\n", strings.Trim(textContent(n), "\n"))
+
+	// Quote
+	case "blockquote":
+		return "\n\n" + quoteLines(renderBlocks(n, depth)) + "\n\n"
+
+	// Divider
+	case "hr":
+		return "\n\n---\n\n"
+
+	// Macros
+	case "ac:structured-macro", "ac:macro":
+		return renderMacro(n, depth)
+
+	// Containers
+	case "div", "section", "tbody", "thead", "ac:layout", "ac:layout-section", "ac:layout-cell", "ac:rich-text-body":
+		return renderBlocks(n, depth)
+	}
+	return renderInline(n)
+}
+
+// renderInlineChildren renders all children of n as inline content
+func renderInlineChildren(n *html.Node) string {
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(renderInline(c))
+	}
+	return sb.String()
+}
+
+// renderInline renders a node as inline Markdown
+func renderInline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return whitespace.ReplaceAllString(n.Data, " ")
+	case html.CommentNode:
+		return cdataText(n)
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch n.Data {
+	case "strong", "b":
+		return wrapInline(renderInlineChildren(n), "**")
+	case "em", "i":
+		return wrapInline(renderInlineChildren(n), "*")
+	case "del", "s":
+		return wrapInline(renderInlineChildren(n), "~~")
+	case "code":
+		return wrapInline(textContent(n), "`")
+	case "br":
+		return "\n"
+	case "a":
+		text := strings.TrimSpace(renderInlineChildren(n))
+		href := attr(n, "href")
+		if href == "" {
+			return text
+		}
+		if text == "" {
+			text = href
+		}
+		return fmt.Sprintf("[%s](%s)", text, href)
+	case "time":
+		return attr(n, "datetime")
+
+	// Confluence links: pages, attachments, users
+	case "ac:link":
+		return renderLink(n)
+	case "ac:image":
+		return renderImage(n)
+	case "ac:emoticon":
+		if fallback := attr(n, "ac:emoji-fallback"); fallback != "" {
+			return fallback
+		}
+		return ":" + attr(n, "ac:name") + ":"
+	case "ac:structured-macro", "ac:macro":
+		// Inline macros (e.g. status lozenges) show their title
+		if title := macroParam(n, "title"); title != "" {
+			return "[" + title + "]"
+		}
+		if body := findChild(n, "ac:rich-text-body"); body != nil {
+			return renderInlineChildren(body)
+		}
+		return ""
+	case "ac:parameter", "ac:placeholder", "ac:task-id":
+		return ""
+
+	// Block elements inside inline context (e.g. a list item's paragraph)
+	case "p", "div":
+		return renderInlineChildren(n) + "\n"
+	}
+	return renderInlineChildren(n)
+}
+
+// renderLink renders an ac:link: its link body if present, otherwise the target's name
+func renderLink(n *html.Node) string {
+	for _, body := range []string{"ac:plain-text-link-body", "ac:link-body"} {
+		if b := findDescendant(n, body); b != nil {
+			if text := strings.TrimSpace(renderInlineChildren(b)); text != "" {
+				return text
+			}
+		}
+	}
+	if page := findDescendant(n, "ri:page"); page != nil {
+		return "[[" + attr(page, "ri:content-title") + "]]"
+	}
+	if a := findDescendant(n, "ri:attachment"); a != nil {
+		return "[" + attr(a, "ri:filename") + "]"
+	}
+	if u := findDescendant(n, "ri:user"); u != nil {
+		return "@" + attr(u, "ri:account-id")
+	}
+	if anchor := attr(n, "ac:anchor"); anchor != "" {
+		return "#" + anchor
+	}
+	return ""
+}
+
+// renderImage renders an ac:image as a Markdown image
+func renderImage(n *html.Node) string {
+	alt := attr(n, "ac:alt")
+	if u := findDescendant(n, "ri:url"); u != nil {
+		return fmt.Sprintf("![%s](%s)", alt, attr(u, "ri:value"))
+	}
+	if a := findDescendant(n, "ri:attachment"); a != nil {
+		filename := attr(a, "ri:filename")
+		if alt == "" {
+			alt = filename
+		}
+		return fmt.Sprintf("![%s](%s)", alt, filename)
+	}
+	return ""
+}
+
+// renderList renders ul/ol items, indenting nested lists by two spaces per level
+func renderList(n *html.Node, ordered bool, depth int) string {
+	indent := strings.Repeat("  ", depth)
+	var items []string
+	num := 0
+	for li := n.FirstChild; li != nil; li = li.NextSibling {
+		if li.Type != html.ElementNode || li.Data != "li" {
+			continue
+		}
+		num++
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", num)
+		}
+
+		var text strings.Builder
+		var nested []string
+		for c := li.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && (c.Data == "ul" || c.Data == "ol") {
+				nested = append(nested, renderList(c, c.Data == "ol", depth+1))
+				continue
+			}
+			text.WriteString(renderInline(c))
+		}
+		item := indent + marker + strings.TrimSpace(strings.ReplaceAll(text.String(), "\n", " "))
+		for _, sub := range nested {
+			item += "\n" + sub
+		}
+		items = append(items, item)
+	}
+	return strings.Join(items, "\n")
+}
+
+// renderTaskList renders ac:task-list as Markdown checkboxes
+func renderTaskList(n *html.Node, depth int) string {
+	indent := strings.Repeat("  ", depth)
+	var items []string
+	for task := n.FirstChild; task != nil; task = task.NextSibling {
+		if task.Type != html.ElementNode || task.Data != "ac:task" {
+			continue
+		}
+		checkbox := "[ ]"
+		if status := findChild(task, "ac:task-status"); status != nil && strings.TrimSpace(textContent(status)) == "complete" {
+			checkbox = "[x]"
+		}
+		body := ""
+		if b := findChild(task, "ac:task-body"); b != nil {
+			body = strings.TrimSpace(strings.ReplaceAll(renderInlineChildren(b), "\n", " "))
+		}
+		items = append(items, fmt.Sprintf("%s- %s %s", indent, checkbox, body))
+	}
+	return strings.Join(items, "\n")
+}
+
+// renderTable renders a table as a Markdown table; the first row is the header
+func renderTable(n *html.Node) string {
+	var rows [][]string
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			if c.Data != "tr" {
+				walk(c)
+				continue
+			}
+			var cells []string
+			for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.Type == html.ElementNode && (cell.Data == "th" || cell.Data == "td") {
+					text := strings.TrimSpace(renderInlineChildren(cell))
+					text = strings.ReplaceAll(text, "\n", "<br>")
+					cells = append(cells, strings.ReplaceAll(text, "|", "\\|"))
+				}
+			}
+			rows = append(rows, cells)
+		}
+	}
+	walk(n)
+	if len(rows) == 0 {
+		return ""
+	}
+
+	cols := 0
+	for _, r := range rows {
+		cols = max(cols, len(r))
+	}
+	var sb strings.Builder
+	for i, r := range rows {
+		for len(r) < cols {
+			r = append(r, "")
+		}
+		sb.WriteString("| " + strings.Join(r, " | ") + " |\n")
+		if i == 0 {
+			sb.WriteString("|" + strings.Repeat(" --- |", cols) + "\n")
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// renderMacro renders block-level ac:structured-macro elements
+func renderMacro(n *html.Node, depth int) string {
+	name := attr(n, "ac:name")
+	switch name {
+	case "code", "noformat":
+		body := ""
+		if b := findChild(n, "ac:plain-text-body"); b != nil {
+			body = strings.Trim(textContent(b), "\n")
+		}
+		return fmt.Sprintf("\n\n```%s\n%s\n```\n\n", macroParam(n, "language"), body)
+	case "info", "note", "tip", "warning", "panel", "expand":
+		var sb strings.Builder
+		label := strings.ToUpper(name[:1]) + name[1:]
+		if title := macroParam(n, "title"); title != "" {
+			label += ": " + title
+		}
+		sb.WriteString("**" + label + "**\n\n")
+		if b := findChild(n, "ac:rich-text-body"); b != nil {
+			sb.WriteString(strings.TrimSpace(renderBlocks(b, depth)))
+		}
+		return "\n\n" + quoteLines(sb.String()) + "\n\n"
+	case "toc", "children", "anchor", "pagetree":
+		return ""
+	}
+	if b := findChild(n, "ac:rich-text-body"); b != nil {
+		return renderBlocks(b, depth)
+	}
+	if b := findChild(n, "ac:plain-text-body"); b != nil {
+		return fmt.Sprintf("\n\n```\n%s\n```\n\n", strings.Trim(textContent(b), "\n"))
+	}
+	return renderInline(n)
+}
+
+// quoteLines prefixes every line with "> "
+func quoteLines(s string) string {
+	lines := strings.Split(strings.TrimSpace(blankLines.ReplaceAllString(s, "\n\n")), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapInline wraps text in a Markdown marker, keeping surrounding spaces outside
+func wrapInline(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	lead := text[:len(text)-len(strings.TrimLeft(text, " "))]
+	trail := text[len(strings.TrimRight(text, " ")):]
+	return lead + marker + trimmed + marker + trail
+}
+
+// textContent returns the raw text of n and its descendants, including CDATA sections
+func textContent(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return n.Data
+	case html.CommentNode:
+		return cdataText(n)
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == "br" {
+			sb.WriteString("\n")
+			continue
+		}
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
+
+// cdataText returns the content of a CDATA section, which the HTML parser
+// reports as a comment node ("[CDATA[...]]"). Real comments yield "".
+func cdataText(n *html.Node) string {
+	if !strings.HasPrefix(n.Data, "[CDATA[") {
+		return ""
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(n.Data, "[CDATA["), "]]")
+}
+
+// macroParam returns the value of a macro's ac:parameter with the given name
+func macroParam(n *html.Node, name string) string {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == "ac:parameter" && attr(c, "ac:name") == name {
+			return strings.TrimSpace(textContent(c))
+		}
+	}
+	return ""
+}
+
+func findChild(n *html.Node, name string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findDescendant(n *html.Node, name string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == name {
+			return c
+		}
+		if found := findDescendant(c, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
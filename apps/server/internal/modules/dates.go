@@ -0,0 +1,184 @@
+package modules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateInput is a date or date-time parameter parsed by ParseDateInput.
+type DateInput struct {
+	Time    time.Time
+	HasTime bool // false for date-only input such as "2024-01-15" or "tomorrow"
+	HasZone bool // false when the input had no UTC offset, e.g. "2024-01-15T09:00"
+}
+
+// now is the clock relative dates are computed from; tests replace it.
+var now = time.Now
+
+// absoluteLayouts lists the absolute formats ParseDateInput accepts, most specific first.
+var absoluteLayouts = []struct {
+	layout           string
+	hasTime, hasZone bool
+}{
+	{time.RFC3339Nano, true, true},
+	{"2006-01-02T15:04Z07:00", true, true},
+	{"2006-01-02 15:04:05Z07:00", true, true},
+	{"2006-01-02T15:04:05", true, false},
+	{"2006-01-02T15:04", true, false},
+	{"2006-01-02 15:04:05", true, false},
+	{"2006-01-02 15:04", true, false},
+	{"2006/01/02 15:04", true, false},
+	{"2006-01-02", false, false},
+	{"2006/01/02", false, false},
+	{"20060102", false, false},
+}
+
+var (
+	relativeOffset = regexp.MustCompile(`^(?:in\s+)?(\d+)\s+(minute|hour|day|week|month|year)s?(\s+ago)?$`)
+	weekdays       = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+	}
+)
+
+// ParseDateInput parses a date or date-time parameter. It accepts RFC3339,
+// ISO 8601 dates and date-times with or without an offset, epoch seconds or
+// milliseconds, and relative strings: "now", "today", "tomorrow", "yesterday",
+// "in 3 days", "2 weeks ago", "next week", "friday", "next monday".
+// Inputs without an offset, and relative dates, are taken in loc (UTC when nil).
+func ParseDateInput(s string, loc *time.Location) (DateInput, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	s = strings.TrimSpace(s)
+	for _, l := range absoluteLayouts {
+		if t, err := time.ParseInLocation(l.layout, s, loc); err == nil {
+			return DateInput{Time: t, HasTime: l.hasTime, HasZone: l.hasZone}, nil
+		}
+	}
+	if len(s) >= 9 && strings.Trim(s, "0123456789") == "" {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err == nil {
+			if len(s) >= 12 {
+				return DateInput{Time: time.UnixMilli(n).In(loc), HasTime: true, HasZone: true}, nil
+			}
+			return DateInput{Time: time.Unix(n, 0).In(loc), HasTime: true, HasZone: true}, nil
+		}
+	}
+	if d, ok := parseRelative(strings.ToLower(s), now().In(loc)); ok {
+		return d, nil
+	}
+	return DateInput{}, fmt.Errorf("invalid date %q: use YYYY-MM-DD, RFC3339 (e.g. 2024-01-15T09:00:00Z), or a relative date like \"tomorrow\" or \"in 3 days\"", s)
+}
+
+// parseRelative resolves relative date strings against t.
+func parseRelative(s string, t time.Time) (DateInput, bool) {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	date := func(d time.Time) (DateInput, bool) { return DateInput{Time: d}, true }
+
+	switch s {
+	case "now":
+		return DateInput{Time: t, HasTime: true, HasZone: true}, true
+	case "today":
+		return date(today)
+	case "tomorrow":
+		return date(today.AddDate(0, 0, 1))
+	case "yesterday":
+		return date(today.AddDate(0, 0, -1))
+	case "next week":
+		return date(today.AddDate(0, 0, 7))
+	case "next month":
+		return date(today.AddDate(0, 1, 0))
+	}
+
+	if wd, ok := weekdays[strings.TrimPrefix(s, "next ")]; ok {
+		days := (int(wd) - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return date(today.AddDate(0, 0, days))
+	}
+
+	m := relativeOffset.FindStringSubmatch(s)
+	if m == nil || (strings.HasPrefix(s, "in") == (m[3] != "")) {
+		return DateInput{}, false
+	}
+	n, _ := strconv.Atoi(m[1])
+	if m[3] != "" {
+		n = -n
+	}
+	switch m[2] {
+	case "minute":
+		return DateInput{Time: t.Add(time.Duration(n) * time.Minute), HasTime: true, HasZone: true}, true
+	case "hour":
+		return DateInput{Time: t.Add(time.Duration(n) * time.Hour), HasTime: true, HasZone: true}, true
+	case "day":
+		return date(today.AddDate(0, 0, n))
+	case "week":
+		return date(today.AddDate(0, 0, 7*n))
+	case "month":
+		return date(today.AddDate(0, n, 0))
+	default:
+		return date(today.AddDate(n, 0, 0))
+	}
+}
+
+// Date returns the calendar date as YYYY-MM-DD.
+func (d DateInput) Date() string { return d.Time.Format("2006-01-02") }
+
+// RFC3339 returns the instant as an RFC3339 timestamp in UTC.
+// Date-only input becomes midnight at the start of that date.
+func (d DateInput) RFC3339() string { return d.Time.UTC().Format(time.RFC3339) }
+
+// LocalDateTime returns the date-time without an offset when the input had
+// none, for APIs that take a separate time zone field; RFC3339 otherwise.
+func (d DateInput) LocalDateTime() string {
+	if d.HasZone {
+		return d.Time.Format(time.RFC3339)
+	}
+	return d.Time.Format("2006-01-02T15:04:05")
+}
+
+// TimezoneProperty is the schema of the timezone param read by LocationParam.
+var TimezoneProperty = Property{Type: "string", Description: "IANA time zone (e.g. 'Asia/Tokyo') that relative dates like \"today\" and date-times without an offset are taken in. Relative dates default to UTC; date-times without an offset require it."}
+
+// LocationParam loads the IANA time zone in the timezone param, or returns
+// nil when it is not set.
+func LocationParam(params map[string]any) (*time.Location, error) {
+	name, _ := params["timezone"].(string)
+	if name == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("timezone: unknown time zone %q (use an IANA name such as Asia/Tokyo)", name)
+	}
+	return loc, nil
+}
+
+// NormalizeDate parses a date parameter (see ParseDateInput) and returns it as
+// YYYY-MM-DD. Relative dates are resolved in loc (UTC when nil).
+func NormalizeDate(s string, loc *time.Location) (string, error) {
+	d, err := ParseDateInput(s, loc)
+	if err != nil {
+		return "", err
+	}
+	return d.Date(), nil
+}
+
+// NormalizeDateTime parses a date-time parameter (see ParseDateInput) and
+// returns it as an RFC3339 timestamp in UTC. Input without an offset is taken
+// in loc; with no loc it is rejected rather than assumed to be UTC.
+func NormalizeDateTime(s string, loc *time.Location) (string, error) {
+	d, err := ParseDateInput(s, loc)
+	if err != nil {
+		return "", err
+	}
+	if d.HasTime && !d.HasZone && loc == nil {
+		return "", fmt.Errorf("%q has no UTC offset: add one (e.g. %s+09:00) or set timezone", s, strings.TrimSpace(s))
+	}
+	return d.RFC3339(), nil
+}
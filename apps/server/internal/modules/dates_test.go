@@ -0,0 +1,113 @@
+package modules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateInput(t *testing.T) {
+	old := now
+	now = func() time.Time { return time.Date(2024, 1, 17, 10, 30, 0, 0, time.UTC) } // a Wednesday
+	defer func() { now = old }()
+
+	tests := []struct {
+		in      string
+		date    string
+		rfc3339 string
+		local   string
+		hasTime bool
+		hasZone bool
+	}{
+		{"2024-01-15", "2024-01-15", "2024-01-15T00:00:00Z", "2024-01-15T00:00:00", false, false},
+		{"2024/01/15", "2024-01-15", "2024-01-15T00:00:00Z", "2024-01-15T00:00:00", false, false},
+		{"2024-01-15T09:00:00+09:00", "2024-01-15", "2024-01-15T00:00:00Z", "2024-01-15T09:00:00+09:00", true, true},
+		{"2024-01-15T09:00:00.123Z", "2024-01-15", "2024-01-15T09:00:00Z", "2024-01-15T09:00:00Z", true, true},
+		{"2024-01-15T09:00", "2024-01-15", "2024-01-15T09:00:00Z", "2024-01-15T09:00:00", true, false},
+		{"2024-01-15 09:00:30", "2024-01-15", "2024-01-15T09:00:30Z", "2024-01-15T09:00:30", true, false},
+		{"1705309200000", "2024-01-15", "2024-01-15T09:00:00Z", "2024-01-15T09:00:00Z", true, true},
+		{"1705309200", "2024-01-15", "2024-01-15T09:00:00Z", "2024-01-15T09:00:00Z", true, true},
+		{"now", "2024-01-17", "2024-01-17T10:30:00Z", "2024-01-17T10:30:00Z", true, true},
+		{" Tomorrow ", "2024-01-18", "2024-01-18T00:00:00Z", "2024-01-18T00:00:00", false, false},
+		{"yesterday", "2024-01-16", "2024-01-16T00:00:00Z", "2024-01-16T00:00:00", false, false},
+		{"in 3 days", "2024-01-20", "2024-01-20T00:00:00Z", "2024-01-20T00:00:00", false, false},
+		{"2 weeks ago", "2024-01-03", "2024-01-03T00:00:00Z", "2024-01-03T00:00:00", false, false},
+		{"in 1 month", "2024-02-17", "2024-02-17T00:00:00Z", "2024-02-17T00:00:00", false, false},
+		{"in 2 hours", "2024-01-17", "2024-01-17T12:30:00Z", "2024-01-17T12:30:00Z", true, true},
+		{"friday", "2024-01-19", "2024-01-19T00:00:00Z", "2024-01-19T00:00:00", false, false},
+		{"next wednesday", "2024-01-24", "2024-01-24T00:00:00Z", "2024-01-24T00:00:00", false, false},
+	}
+	for _, tt := range tests {
+		d, err := ParseDateInput(tt.in, nil)
+		if err != nil {
+			t.Errorf("ParseDateInput(%q) error: %v", tt.in, err)
+			continue
+		}
+		if d.Date() != tt.date || d.RFC3339() != tt.rfc3339 || d.LocalDateTime() != tt.local {
+			t.Errorf("ParseDateInput(%q) = %s / %s / %s, want %s / %s / %s",
+				tt.in, d.Date(), d.RFC3339(), d.LocalDateTime(), tt.date, tt.rfc3339, tt.local)
+		}
+		if d.HasTime != tt.hasTime || d.HasZone != tt.hasZone {
+			t.Errorf("ParseDateInput(%q) HasTime=%v HasZone=%v, want %v %v", tt.in, d.HasTime, d.HasZone, tt.hasTime, tt.hasZone)
+		}
+	}
+
+	for _, in := range []string{"", "soon", "3 days", "in 3 days ago", "2024-13-01", "15/01/2024"} {
+		if _, err := ParseDateInput(in, nil); err == nil {
+			t.Errorf("ParseDateInput(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestParseDateInputLocation(t *testing.T) {
+	old := now
+	now = func() time.Time { return time.Date(2024, 1, 17, 20, 0, 0, 0, time.UTC) }
+	defer func() { now = old }()
+
+	tokyo := time.FixedZone("JST", 9*60*60)
+	d, err := ParseDateInput("tomorrow", tokyo)
+	if err != nil || d.Date() != "2024-01-19" {
+		t.Errorf("tomorrow in JST = %v, %v, want 2024-01-19", d.Date(), err)
+	}
+	d, err = ParseDateInput("2024-01-15T09:00", tokyo)
+	if err != nil || d.RFC3339() != "2024-01-15T00:00:00Z" || d.LocalDateTime() != "2024-01-15T09:00:00" {
+		t.Errorf("naive time in JST = %s / %s, %v", d.RFC3339(), d.LocalDateTime(), err)
+	}
+}
+
+func TestNormalizeDate(t *testing.T) {
+	if got, err := NormalizeDate("2024-01-15T23:00:00Z", nil); err != nil || got != "2024-01-15" {
+		t.Errorf("NormalizeDate = %q, %v", got, err)
+	}
+	if got, err := NormalizeDateTime("2024-01-15T09:00:00+09:00", nil); err != nil || got != "2024-01-15T00:00:00Z" {
+		t.Errorf("NormalizeDateTime = %q, %v", got, err)
+	}
+	if _, err := NormalizeDate("someday", nil); err == nil {
+		t.Error("NormalizeDate(someday) succeeded, want error")
+	}
+}
+
+func TestNormalizeDateLocation(t *testing.T) {
+	old := now
+	now = func() time.Time { return time.Date(2024, 1, 17, 20, 0, 0, 0, time.UTC) }
+	defer func() { now = old }()
+
+	loc, err := LocationParam(map[string]any{"timezone": "Asia/Tokyo"})
+	if err != nil {
+		t.Fatalf("LocationParam: %v", err)
+	}
+	if got, err := NormalizeDate("today", loc); err != nil || got != "2024-01-18" {
+		t.Errorf("today in Asia/Tokyo = %q, %v, want 2024-01-18", got, err)
+	}
+	if got, err := NormalizeDateTime("2024-01-15T09:00", loc); err != nil || got != "2024-01-15T00:00:00Z" {
+		t.Errorf("naive time in Asia/Tokyo = %q, %v, want 2024-01-15T00:00:00Z", got, err)
+	}
+	if _, err := NormalizeDateTime("2024-01-15T09:00", nil); err == nil {
+		t.Error("naive time without a location succeeded, want error")
+	}
+	if _, err := LocationParam(map[string]any{"timezone": "Mars/Olympus"}); err == nil {
+		t.Error("LocationParam(Mars/Olympus) succeeded, want error")
+	}
+	if loc, err := LocationParam(map[string]any{}); loc != nil || err != nil {
+		t.Errorf("LocationParam without timezone = %v, %v, want nil, nil", loc, err)
+	}
+}
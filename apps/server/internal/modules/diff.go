@@ -0,0 +1,99 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ReturnDiffParam asks an update tool to also return what it changed, as a
+// field-level diff of the resource before and after.
+const ReturnDiffParam = "_return_diff"
+
+// ErrDiffUnsupported is returned by UpdateSnapshotter for tools it cannot snapshot.
+var ErrDiffUnsupported = errors.New("_return_diff is not supported for this tool")
+
+// TakeReturnDiff removes the _return_diff meta-parameter from params and
+// reports whether it was set to true.
+func TakeReturnDiff(params map[string]any) bool {
+	v, ok := params[ReturnDiffParam]
+	if !ok {
+		return false
+	}
+	delete(params, ReturnDiffParam)
+	b, _ := v.(bool)
+	return b
+}
+
+// FieldChange is one changed field of a _return_diff diff.
+type FieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Snapshot fetches the resource an update tool is about to change, via the
+// module's UpdateSnapshotter, decoded for DiffFields.
+func Snapshot(ctx context.Context, moduleName, toolName string, params map[string]any) (any, error) {
+	s, ok := registry[moduleName].(UpdateSnapshotter)
+	if !ok {
+		return nil, fmt.Errorf("%s:%s: %w", moduleName, toolName, ErrDiffUnsupported)
+	}
+	raw, err := s.SnapshotForUpdate(ctx, toolName, params)
+	if errors.Is(err, ErrDiffUnsupported) {
+		return nil, fmt.Errorf("%s:%s: %w", moduleName, toolName, err)
+	}
+	if err != nil {
+		return nil, WithUpstreamStatus(err)
+	}
+	var v any
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+	return v, nil
+}
+
+// DiffFields compares two snapshots and returns the fields that differ, keyed by
+// dotted path (e.g. "assignee.name", "panels.2.title"). Objects and equal-length
+// arrays are compared element by element; anything else is compared whole.
+func DiffFields(before, after any) map[string]FieldChange {
+	changes := map[string]FieldChange{}
+	diffValue("", before, after, changes)
+	return changes
+}
+
+func diffValue(path string, before, after any, changes map[string]FieldChange) {
+	switch b := before.(type) {
+	case map[string]any:
+		if a, ok := after.(map[string]any); ok {
+			for k, bv := range b {
+				diffValue(joinPath(path, k), bv, a[k], changes)
+			}
+			for k, av := range a {
+				if _, ok := b[k]; !ok {
+					diffValue(joinPath(path, k), nil, av, changes)
+				}
+			}
+			return
+		}
+	case []any:
+		if a, ok := after.([]any); ok && len(a) == len(b) {
+			for i := range b {
+				diffValue(joinPath(path, strconv.Itoa(i)), b[i], a[i], changes)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(before, after) {
+		changes[path] = FieldChange{Before: before, After: after}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -22,6 +22,8 @@ func formatCompact(toolName, jsonStr string) string {
 		return revisionsCSV(jsonStr)
 	case "get_current_account":
 		return accountCompact(jsonStr)
+	case "delete_batch":
+		return deleteBatchCSV(jsonStr)
 	case "create_shared_link":
 		return pickKeys(jsonStr, "url", "name", "path_lower")
 	default:
@@ -80,6 +82,38 @@ func entriesCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// deleteBatchCSV formats delete_batch results → CSV with path, status, error.
+func deleteBatchCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	entries, ok := data["entries"].([]any)
+	if !ok {
+		return jsonStr
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```csv\npath,status,error\n")
+	for _, e := range entries {
+		em, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		errText := ""
+		if f, ok := em["error"].(map[string]any); ok {
+			errText = str(f, ".tag")
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n",
+			csvEscape(str(em, "path")),
+			str(em, "status"),
+			errText,
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // searchCSV formats search_v2 responses.
 // Extracts matches[].metadata.metadata → CSV with name, path_display, .tag.
 func searchCSV(jsonStr string) string {
@@ -4,17 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"time"
+
+	"mcpist/server/internal/modules"
 )
 
 var httpClient = &http.Client{Timeout: 30 * time.Second}
 
 // doPost sends a JSON-RPC POST request to the Dropbox API and returns the raw response body.
 func doPost(ctx context.Context, path string, body any) (string, error) {
+	modules.LogTrace(ctx, "dropbox", "upstream_call", map[string]any{"path": path})
 	var reqBody io.Reader
 	if body != nil {
 		b, err := json.Marshal(body)
@@ -47,7 +51,11 @@ func doPost(ctx context.Context, path string, body any) (string, error) {
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New(string(respBody)),
+		}
 	}
 
 	return string(respBody), nil
@@ -80,7 +88,11 @@ func doContentDownload(ctx context.Context, path string, apiArg any) (string, er
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("download failed (status %d): %s", resp.StatusCode, string(body))
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        fmt.Errorf("download failed: %s", body),
+		}
 	}
 
 	// Read content (limit to 1MB)
@@ -139,7 +151,11 @@ func doContentUpload(ctx context.Context, path string, apiArg any, content strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("upload failed (status %d): %s", resp.StatusCode, string(respBody))
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        fmt.Errorf("upload failed: %s", respBody),
+		}
 	}
 	return string(respBody), nil
 }
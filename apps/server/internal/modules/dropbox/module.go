@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"mcpist/server/internal/broker"
 	"mcpist/server/internal/middleware"
@@ -43,6 +44,7 @@ func (m *DropboxModule) ExecuteTool(ctx context.Context, name string, params map
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -51,6 +53,12 @@ func (m *DropboxModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *DropboxModule) HealthProbe() (string, map[string]any) {
+	return "get_current_account", nil
+}
+
 func (m *DropboxModule) Resources() []modules.Resource { return nil }
 func (m *DropboxModule) ReadResource(ctx context.Context, uri string) (string, error) {
 	return "", fmt.Errorf("resources not supported")
@@ -112,8 +120,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "dropbox:list_folder",
 		Name: "list_folder",
 		Descriptions: modules.LocalizedText{
-			"en-US": "List contents of a folder in Dropbox. Returns files and sub-folders.",
-			"ja-JP": "Dropbox内のフォルダの内容を一覧表示します。ファイルとサブフォルダを返します。",
+			"en-US": "List contents of a folder in Dropbox. Returns files and sub-folders, following continuation cursors until about limit entries are collected. If has_more is true, pass the returned cursor to list_folder_continue to resume.",
+			"ja-JP": "Dropbox内のフォルダの内容を一覧表示します。ファイルとサブフォルダを返し、約limit件に達するまで継続カーソルを自動で辿ります。has_moreがtrueの場合、返されたcursorをlist_folder_continueに渡すと続きから取得できます。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
@@ -123,7 +131,7 @@ var toolDefinitions = []modules.Tool{
 				"recursive":          {Type: "boolean", Description: "List contents recursively (default: false)"},
 				"include_deleted":    {Type: "boolean", Description: "Include deleted entries (default: false)"},
 				"include_media_info": {Type: "boolean", Description: "Include media info for photos/videos (default: false)"},
-				"limit":              {Type: "number", Description: "Maximum number of results (1-2000)"},
+				"limit":              {Type: "number", Description: "Approximate maximum number of entries to collect across pages (default: 500, max: 10000)"},
 			},
 		},
 	},
@@ -139,6 +147,7 @@ var toolDefinitions = []modules.Tool{
 			Type: "object",
 			Properties: map[string]modules.Property{
 				"cursor": {Type: "string", Description: "Cursor from a previous list_folder or list_folder_continue response"},
+				"limit":  {Type: "number", Description: "Approximate maximum number of entries to collect across pages (default: 500, max: 10000)"},
 			},
 			Required: []string{"cursor"},
 		},
@@ -165,19 +174,19 @@ var toolDefinitions = []modules.Tool{
 		ID:   "dropbox:search_files",
 		Name: "search_files",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Search for files and folders by name or content.",
-			"ja-JP": "名前またはコンテンツでファイルとフォルダを検索します。",
+			"en-US": "Search for files and folders by name or content. If has_more is true, call again with the returned cursor to get the next page.",
+			"ja-JP": "名前またはコンテンツでファイルとフォルダを検索します。has_moreがtrueの場合、返されたcursorを指定して再度呼び出すと次のページを取得できます。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"query":           {Type: "string", Description: "Search query string"},
+				"query":           {Type: "string", Description: "Search query string (ignored when cursor is given)"},
+				"cursor":          {Type: "string", Description: "Cursor from a previous search_files response to fetch the next page"},
 				"path":            {Type: "string", Description: "Scope search to this path"},
 				"max_results":     {Type: "number", Description: "Maximum results (1-1000, default: 100)"},
 				"file_categories": {Type: "array", Description: "Filter by category: image, document, pdf, spreadsheet, presentation, audio, video, folder", Items: &modules.Property{Type: "string"}},
 			},
-			Required: []string{"query"},
 		},
 	},
 	{
@@ -304,6 +313,22 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"path"},
 		},
 	},
+	{
+		ID:   "dropbox:delete_batch",
+		Name: "delete_batch",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Delete multiple files or folders in one job (moves to trash). Waits for the batch to finish and reports the result for each path.",
+			"ja-JP": "複数のファイルまたはフォルダを1つのジョブで削除します（ゴミ箱に移動）。バッチの完了を待ち、パスごとの結果を返します。",
+		},
+		Annotations: modules.AnnotateDelete,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"paths": {Type: "array", Description: "Paths of files or folders to delete (max 1000)", Items: &modules.Property{Type: "string"}},
+			},
+			Required: []string{"paths"},
+		},
+	},
 	{
 		ID:   "dropbox:create_shared_link",
 		Name: "create_shared_link",
@@ -361,6 +386,7 @@ var toolHandlers = map[string]toolHandler{
 	"copy_file":          copyFile,
 	"move_file":          moveFile,
 	"delete_file":        deleteFile,
+	"delete_batch":       deleteBatch,
 	"create_shared_link": createSharedLink,
 	"list_revisions":     listRevisions,
 }
@@ -390,11 +416,10 @@ func listFolder(ctx context.Context, params map[string]any) (string, error) {
 	if v, ok := params["include_media_info"].(bool); ok {
 		body["include_media_info"] = v
 	}
-	if v, ok := params["limit"].(float64); ok {
-		body["limit"] = int(v)
-	}
+	limit := listLimit(params)
+	body["limit"] = min(limit, maxListPageSize)
 
-	return doPost(ctx, "/files/list_folder", body)
+	return collectFolderPages(ctx, "/files/list_folder", body, limit)
 }
 
 func listFolderContinue(ctx context.Context, params map[string]any) (string, error) {
@@ -402,7 +427,61 @@ func listFolderContinue(ctx context.Context, params map[string]any) (string, err
 	if cursor == "" {
 		return "", fmt.Errorf("cursor is required")
 	}
-	return doPost(ctx, "/files/list_folder/continue", map[string]any{"cursor": cursor})
+	return collectFolderPages(ctx, "/files/list_folder/continue", map[string]any{"cursor": cursor}, listLimit(params))
+}
+
+const (
+	defaultListLimit = 500
+	maxListLimit     = 10000
+	maxListPageSize  = 2000 // Dropbox API per-request limit
+)
+
+func listLimit(params map[string]any) int {
+	limit := defaultListLimit
+	if v, ok := params["limit"].(float64); ok && v > 0 {
+		limit = int(v)
+	}
+	return min(limit, maxListLimit)
+}
+
+// folderPage is one list_folder / list_folder/continue response
+type folderPage struct {
+	Entries []json.RawMessage `json:"entries"`
+	Cursor  string            `json:"cursor"`
+	HasMore bool              `json:"has_more"`
+}
+
+// collectFolderPages requests the first page at path, then follows
+// list_folder/continue cursors until at least limit entries are collected.
+// Whole pages are kept so the returned cursor resumes exactly after the last entry.
+func collectFolderPages(ctx context.Context, path string, body any, limit int) (string, error) {
+	var result folderPage
+	for {
+		raw, err := doPost(ctx, path, body)
+		if err != nil {
+			return "", err
+		}
+		var page folderPage
+		if err := json.Unmarshal([]byte(raw), &page); err != nil {
+			return "", fmt.Errorf("failed to decode response: %w", err)
+		}
+		result.Entries = append(result.Entries, page.Entries...)
+		result.Cursor = page.Cursor
+		result.HasMore = page.HasMore
+		if !page.HasMore || len(result.Entries) >= limit {
+			break
+		}
+		path = "/files/list_folder/continue"
+		body = map[string]any{"cursor": page.Cursor}
+	}
+	if result.Entries == nil {
+		result.Entries = []json.RawMessage{}
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode response: %w", err)
+	}
+	return string(b), nil
 }
 
 func getMetadata(ctx context.Context, params map[string]any) (string, error) {
@@ -423,9 +502,12 @@ func getMetadata(ctx context.Context, params map[string]any) (string, error) {
 }
 
 func searchFiles(ctx context.Context, params map[string]any) (string, error) {
+	if cursor, ok := params["cursor"].(string); ok && cursor != "" {
+		return doPost(ctx, "/files/search/continue_v2", map[string]any{"cursor": cursor})
+	}
 	query, _ := params["query"].(string)
 	if query == "" {
-		return "", fmt.Errorf("query is required")
+		return "", fmt.Errorf("query or cursor is required")
 	}
 
 	body := map[string]any{"query": query}
@@ -555,6 +637,86 @@ func deleteFile(ctx context.Context, params map[string]any) (string, error) {
 	return doPost(ctx, "/files/delete_v2", map[string]any{"path": path})
 }
 
+const (
+	maxDeleteBatch    = 1000
+	batchPollInterval = 500 * time.Millisecond
+)
+
+// batchJobStatus is the response of files/delete_batch and files/delete_batch/check
+type batchJobStatus struct {
+	Tag        string `json:".tag"`
+	AsyncJobID string `json:"async_job_id"`
+	Entries    []struct {
+		Tag     string          `json:".tag"`
+		Failure json.RawMessage `json:"failure"`
+	} `json:"entries"`
+}
+
+func deleteBatch(ctx context.Context, params map[string]any) (string, error) {
+	rawPaths, _ := params["paths"].([]any)
+	paths := modules.ToStringSlice(rawPaths)
+	if len(paths) == 0 {
+		return "", fmt.Errorf("paths is required")
+	}
+	if len(paths) > maxDeleteBatch {
+		return "", fmt.Errorf("too many paths: %d (max %d)", len(paths), maxDeleteBatch)
+	}
+
+	entries := make([]map[string]string, 0, len(paths))
+	for _, p := range paths {
+		entries = append(entries, map[string]string{"path": p})
+	}
+	raw, err := doPost(ctx, "/files/delete_batch", map[string]any{"entries": entries})
+	if err != nil {
+		return "", err
+	}
+
+	var status batchJobStatus
+	for {
+		if err := json.Unmarshal([]byte(raw), &status); err != nil {
+			return "", fmt.Errorf("failed to decode response: %w", err)
+		}
+		switch status.Tag {
+		case "complete":
+			return deleteBatchResult(paths, status)
+		case "failed":
+			return "", fmt.Errorf("delete batch failed: %s", raw)
+		case "async_job_id", "in_progress":
+		default:
+			return "", fmt.Errorf("unexpected delete batch status: %s", status.Tag)
+		}
+
+		jobID := status.AsyncJobID
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("delete batch %s did not finish: %w", jobID, ctx.Err())
+		case <-time.After(batchPollInterval):
+		}
+		raw, err = doPost(ctx, "/files/delete_batch/check", map[string]any{"async_job_id": jobID})
+		if err != nil {
+			return "", err
+		}
+		// in_progress responses omit the job ID; keep polling the same job
+		status = batchJobStatus{AsyncJobID: jobID}
+	}
+}
+
+// deleteBatchResult pairs each input path with its entry in a completed batch
+func deleteBatchResult(paths []string, status batchJobStatus) (string, error) {
+	results := make([]map[string]any, 0, len(paths))
+	for i, p := range paths {
+		r := map[string]any{"path": p}
+		if i < len(status.Entries) {
+			r["status"] = status.Entries[i].Tag
+			if len(status.Entries[i].Failure) > 0 {
+				r["error"] = status.Entries[i].Failure
+			}
+		}
+		results = append(results, r)
+	}
+	return toJSON(map[string]any{"entries": results})
+}
+
 func createSharedLink(ctx context.Context, params map[string]any) (string, error) {
 	path, _ := params["path"].(string)
 	if path == "" {
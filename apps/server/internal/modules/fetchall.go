@@ -0,0 +1,189 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+)
+
+// FetchAllParam asks a list tool to follow its upstream pagination until the
+// last page or the item cap, and return every item as one result. true uses
+// the default cap; a number sets the cap for the call.
+const FetchAllParam = "_fetch_all"
+
+const (
+	// defaultFetchAllItems is the item cap when FETCH_ALL_MAX_ITEMS is unset
+	defaultFetchAllItems = 500
+	// maxFetchAllItems bounds a cap given per call
+	maxFetchAllItems = 5000
+)
+
+// ErrFetchAllUnsupported is returned by FetchAll for tools that don't declare their pagination.
+var ErrFetchAllUnsupported = errors.New("_fetch_all is not supported for this tool")
+
+// Pagination describes how a list tool pages, so FetchAll can follow it
+// without knowing the service. Cursor-based tools set NextCursor and
+// CursorParam; page-numbered tools set PageParam and PageSizeParam.
+type Pagination struct {
+	Items         string // Result field holding the page's items; "" when the result is a top-level array
+	NextCursor    string // Result field holding the next page's cursor; empty or absent on the last page
+	HasMore       string // Result field flagging more pages, cleared in the merged result (optional)
+	CursorParam   string // Param the cursor is passed back in
+	PageParam     string // Param holding the 1-based page number
+	PageSizeParam string // Param holding the page size; a short page ends page-numbered paging
+	MaxPageSize   int    // Largest page the upstream serves; used when the caller didn't set PageSizeParam, and caps it otherwise
+	MaxItems      int    // Most items the upstream serves across all pages, e.g. GitHub search (optional)
+}
+
+// FetchAllInfo is the _fetch_all object appended to a merged result.
+type FetchAllInfo struct {
+	Pages     int  `json:"pages"`
+	Items     int  `json:"items"`
+	Truncated bool `json:"_truncated"` // The cap was hit before the last page
+}
+
+// defaultFetchAllLimit returns the item cap from FETCH_ALL_MAX_ITEMS, or
+// defaultFetchAllItems when it is unset or invalid.
+func defaultFetchAllLimit() int {
+	n, err := strconv.Atoi(os.Getenv("FETCH_ALL_MAX_ITEMS"))
+	if err != nil || n <= 0 {
+		return defaultFetchAllItems
+	}
+	return n
+}
+
+// TakeFetchAll removes the _fetch_all meta-parameter from params and returns
+// the item cap to fetch up to, or 0 when auto-pagination was not requested.
+func TakeFetchAll(params map[string]any) int {
+	v, ok := params[FetchAllParam]
+	if !ok {
+		return 0
+	}
+	delete(params, FetchAllParam)
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return defaultFetchAllLimit()
+		}
+	case float64:
+		if v > 0 {
+			return min(int(v), maxFetchAllItems)
+		}
+	}
+	return 0
+}
+
+// FetchAll runs a list tool page after page, as declared by the module's
+// Paginator, until the upstream runs out of pages or maxItems items (at most
+// the tool's MaxItems) have been collected. The merged result keeps the shape of a single page, with every
+// item and no next-page cursor. A failed page fails the whole call.
+func FetchAll(ctx context.Context, moduleName, toolName string, params map[string]any, maxItems int) (*ToolCallResult, *FetchAllInfo, error) {
+	pager, ok := registry[moduleName].(Paginator)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s:%s: %w", moduleName, toolName, ErrFetchAllUnsupported)
+	}
+	p, ok := pager.Pagination(toolName)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s:%s: %w", moduleName, toolName, ErrFetchAllUnsupported)
+	}
+
+	params = maps.Clone(params)
+	// A larger page size than the upstream serves would make a full page look short
+	if n, set := params[p.PageSizeParam].(float64); p.PageSizeParam != "" && p.MaxPageSize > 0 && (!set || n > float64(p.MaxPageSize)) {
+		params[p.PageSizeParam] = float64(p.MaxPageSize)
+	}
+	pageSize, _ := params[p.PageSizeParam].(float64)
+	if p.MaxItems > 0 {
+		maxItems = min(maxItems, p.MaxItems)
+	}
+	page := 1
+	if n, ok := params[p.PageParam].(float64); ok && n > 1 {
+		page = int(n)
+	}
+
+	var first any
+	items := []any{}
+	info := &FetchAllInfo{}
+	seen := map[string]bool{}
+	for {
+		result, err := Run(ctx, moduleName, toolName, params)
+		if err != nil || result.IsError {
+			return result, nil, err
+		}
+		info.Pages++
+
+		var body any
+		if err := json.Unmarshal([]byte(result.Content[0].Text), &body); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse page %d: %w", info.Pages, err)
+		}
+		got, ok := pageItems(body, p.Items)
+		if !ok {
+			return nil, nil, fmt.Errorf("page %d of %s:%s has no item list", info.Pages, moduleName, toolName)
+		}
+		if first == nil {
+			first = body
+		}
+		items = append(items, got...)
+
+		more := false
+		if p.PageParam != "" {
+			more = len(got) > 0 && float64(len(got)) >= pageSize
+			page++
+			params[p.PageParam] = float64(page)
+		} else if obj, ok := body.(map[string]any); ok {
+			next, _ := obj[p.NextCursor].(string)
+			more = next != "" && !seen[next]
+			seen[next] = true
+			params[p.CursorParam] = next
+		}
+
+		if len(items) >= maxItems {
+			info.Truncated = len(items) > maxItems || more
+			items = items[:maxItems]
+			break
+		}
+		if !more {
+			break
+		}
+		if p.MaxItems > 0 && p.PageParam != "" && float64(page-1)*pageSize >= float64(p.MaxItems) {
+			// The upstream answers pages past MaxItems with an error
+			info.Truncated = true
+			break
+		}
+	}
+	info.Items = len(items)
+
+	merged := any(items)
+	if obj, ok := first.(map[string]any); ok && p.Items != "" {
+		obj[p.Items] = items
+		delete(obj, p.NextCursor)
+		if p.HasMore != "" {
+			obj[p.HasMore] = false
+		}
+		merged = obj
+	}
+	out, err := json.Marshal(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ToolCallResult{Content: []ContentBlock{{Type: "text", Text: string(out)}}}, info, nil
+}
+
+// pageItems returns the items of one page: the result itself when key is "",
+// otherwise the array in that field.
+func pageItems(body any, key string) ([]any, bool) {
+	if key == "" {
+		items, ok := body.([]any)
+		return items, ok || body == nil
+	}
+	obj, ok := body.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	items, ok := obj[key].([]any)
+	return items, ok || obj[key] == nil
+}
@@ -0,0 +1,75 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gen "mcpist/server/pkg/githubapi/gen"
+)
+
+// =============================================================================
+// Event Subscriptions (modules.EventSource)
+// =============================================================================
+
+// Subscribe creates a repository webhook delivering events to deliveryURL.
+// resource is "owner/repo"; events defaults to ["*"] (all events).
+func (m *GitHubModule) Subscribe(ctx context.Context, resource string, events []string, deliveryURL string) (string, error) {
+	owner, repo, err := splitRepoResource(resource)
+	if err != nil {
+		return "", err
+	}
+	if len(events) == 0 {
+		events = []string{"*"}
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	hook, err := c.ReposCreateWebhook(ctx, &gen.CreateWebhookRequest{
+		Name:   gen.NewOptString("web"),
+		Active: gen.NewOptBool(true),
+		Events: events,
+		Config: gen.WebhookConfig{
+			URL:         gen.NewOptString(deliveryURL),
+			ContentType: gen.NewOptString("json"),
+			InsecureSsl: gen.NewOptString("0"),
+		},
+	}, gen.ReposCreateWebhookParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(hook.ID, 10), nil
+}
+
+// Unsubscribe deletes the repository webhook created by Subscribe.
+func (m *GitHubModule) Unsubscribe(ctx context.Context, resource, hookID string) error {
+	owner, repo, err := splitRepoResource(resource)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.ParseInt(hookID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid hook ID: %s", hookID)
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return err
+	}
+	return c.ReposDeleteWebhook(ctx, gen.ReposDeleteWebhookParams{Owner: owner, Repo: repo, HookID: id})
+}
+
+// EventType returns the X-GitHub-Event header (e.g. "push", "issues").
+func (m *GitHubModule) EventType(header http.Header, body []byte) string {
+	return header.Get("X-GitHub-Event")
+}
+
+func splitRepoResource(resource string) (string, string, error) {
+	owner, repo, ok := strings.Cut(resource, "/")
+	if !ok || owner == "" || repo == "" {
+		return "", "", fmt.Errorf("resource must be owner/repo, got %q", resource)
+	}
+	return owner, repo, nil
+}
@@ -3,7 +3,9 @@ package github
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // =============================================================================
@@ -19,7 +21,11 @@ func formatCompact(toolName, jsonStr string) string {
 		return starredReposToCSV(jsonStr)
 	case "list_branches":
 		return branchesToCSV(jsonStr)
-	case "list_commits":
+	case "list_tags":
+		return tagsToCSV(jsonStr)
+	case "list_release_assets":
+		return releaseAssetsToCSV(jsonStr)
+	case "list_commits", "list_pr_commits":
 		return commitsToCSV(jsonStr)
 	case "list_issues":
 		return issuesToCSV(jsonStr)
@@ -27,14 +33,30 @@ func formatCompact(toolName, jsonStr string) string {
 		return prsToCSV(jsonStr)
 	case "list_pr_files":
 		return prFilesToCSV(jsonStr)
+	case "list_issue_comments":
+		return issueCommentsToCSV(jsonStr)
+	case "list_pr_comments":
+		return reviewCommentsToCSV(jsonStr)
+	case "list_labels":
+		return labelsToCSV(jsonStr)
 	case "list_workflows":
 		return workflowsToCSV(jsonStr)
 	case "list_workflow_runs":
 		return workflowRunsToCSV(jsonStr)
+	case "list_followers":
+		return followersToCSV(jsonStr)
 	case "list_orgs":
 		return orgsToCSV(jsonStr)
 	case "list_public_events":
 		return eventsToCSV(jsonStr)
+	case "list_gists":
+		return gistsToCSV(jsonStr)
+	case "list_notifications":
+		return notificationsToCSV(jsonStr)
+	case "list_contributors":
+		return contributorsToCSV(jsonStr)
+	case "list_collaborators":
+		return collaboratorsToCSV(jsonStr)
 	// Search → CSV
 	case "search_repos":
 		return searchReposToCSV(jsonStr)
@@ -42,6 +64,12 @@ func formatCompact(toolName, jsonStr string) string {
 		return searchCodeToCSV(jsonStr)
 	case "search_issues":
 		return searchIssuesToCSV(jsonStr)
+	case "find_issues":
+		return findIssuesToCSV(jsonStr)
+	case "search_users":
+		return searchUsersToCSV(jsonStr)
+	case "get_rate_limit":
+		return rateLimitToCSV(jsonStr)
 	// Read: single item → MD
 	case "get_user":
 		return userToCompact(jsonStr)
@@ -53,16 +81,34 @@ func formatCompact(toolName, jsonStr string) string {
 		return prToCompact(jsonStr)
 	case "get_file_content":
 		return fileContentToCompact(jsonStr)
+	case "get_release_by_tag":
+		return releaseToCompact(jsonStr)
+	case "get_languages":
+		return languagesToCSV(jsonStr)
+	case "get_topics", "replace_topics":
+		return topicsToCompact(jsonStr)
 	// Composite: already compacted in handler
 	case "describe_user", "describe_repo", "describe_pr":
 		return jsonStr
 	// Write
 	case "create_issue", "update_issue":
 		return pickKeys(jsonStr, "number", "html_url", "state")
-	case "add_issue_comment":
+	case "add_issue_comment", "update_issue_comment":
 		return pickKeys(jsonStr, "id", "html_url")
+	case "create_label":
+		return pickKeys(jsonStr, "id", "name", "color", "description")
 	case "create_pr":
 		return pickKeys(jsonStr, "number", "html_url", "state", "draft")
+	case "request_pr_reviewers":
+		return reviewRequestsToCompact(jsonStr)
+	case "create_gist", "update_gist":
+		return pickKeys(jsonStr, "id", "html_url", "public", "description")
+	case "create_branch":
+		return pickKeys(jsonStr, "branch", "sha", "from_ref")
+	case "delete_branch", "delete_issue_comment":
+		return pickKeys(jsonStr, "success", "message")
+	case "add_collaborator":
+		return pickKeys(jsonStr, "id", "permissions", "html_url", "success", "message")
 	default:
 		return jsonStr
 	}
@@ -211,7 +257,262 @@ func branchesToCSV(jsonStr string) string {
 	return sb.String()
 }
 
-// commitsToCSV: sha,author,date,message
+// tagsToCSV: name,commit_sha
+func tagsToCSV(jsonStr string) string {
+	var tags []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &tags); err != nil {
+		return jsonStr
+	}
+	if len(tags) == 0 {
+		return "# 0 tags"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nname,commit_sha\n")
+	for _, t := range tags {
+		sb.WriteString(fmt.Sprintf("%s,%s\n",
+			csvEscape(str(t, "name")),
+			str(t, "commit_sha"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// releaseAssetsToCSV: id,name,size,download_url
+func releaseAssetsToCSV(jsonStr string) string {
+	var assets []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &assets); err != nil {
+		return jsonStr
+	}
+	return assetsCSV(assets)
+}
+
+func assetsCSV(assets []map[string]any) string {
+	if len(assets) == 0 {
+		return "# 0 assets"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,size,download_url\n")
+	for _, a := range assets {
+		sb.WriteString(fmt.Sprintf("%d,%s,%d,%s\n",
+			intVal(a, "id"),
+			csvEscape(str(a, "name")),
+			intVal(a, "size"),
+			str(a, "download_url"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// releaseToCompact: release header + assets CSV
+func releaseToCompact(jsonStr string) string {
+	var release map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &release); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	title := str(release, "name")
+	if title == "" {
+		title = str(release, "tag_name")
+	}
+	sb.WriteString(fmt.Sprintf("# %s\n", title))
+	sb.WriteString(fmt.Sprintf("id: %d | tag: %s", intVal(release, "id"), str(release, "tag_name")))
+	if boolVal(release, "draft") {
+		sb.WriteString(" | draft")
+	}
+	if boolVal(release, "prerelease") {
+		sb.WriteString(" | prerelease")
+	}
+	sb.WriteString("\n")
+	if published := str(release, "published_at"); published != "" {
+		sb.WriteString(fmt.Sprintf("published: %s\n", published))
+	}
+	sb.WriteString(fmt.Sprintf("url: %s\n", str(release, "html_url")))
+
+	raw, _ := release["assets"].([]any)
+	assets := make([]map[string]any, 0, len(raw))
+	for _, a := range raw {
+		if m, ok := a.(map[string]any); ok {
+			assets = append(assets, m)
+		}
+	}
+	sb.WriteString(assetsCSV(assets))
+	return sb.String()
+}
+
+// labelsToCSV: name,color,description
+func labelsToCSV(jsonStr string) string {
+	var labels []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &labels); err != nil {
+		return jsonStr
+	}
+	if len(labels) == 0 {
+		return "# 0 labels"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nname,color,description\n")
+	for _, l := range labels {
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n",
+			csvEscape(str(l, "name")),
+			str(l, "color"),
+			csvEscape(str(l, "description")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// gistsToCSV: id,description,public,files,updated_at
+func gistsToCSV(jsonStr string) string {
+	var gists []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &gists); err != nil {
+		return jsonStr
+	}
+	if len(gists) == 0 {
+		return "# 0 gists"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,description,public,files,updated_at\n")
+	for _, g := range gists {
+		files, _ := g["files"].(map[string]any)
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		sb.WriteString(fmt.Sprintf("%s,%s,%v,%s,%s\n",
+			str(g, "id"),
+			csvEscape(str(g, "description")),
+			boolVal(g, "public"),
+			csvEscape(strings.Join(names, " ")),
+			str(g, "updated_at"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// notificationsToCSV: id,reason,type,repo,title,updated_at,unread
+func notificationsToCSV(jsonStr string) string {
+	var threads []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &threads); err != nil {
+		return jsonStr
+	}
+	if len(threads) == 0 {
+		return "# 0 notifications"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,reason,type,repo,title,updated_at,unread\n")
+	for _, t := range threads {
+		subject, _ := t["subject"].(map[string]any)
+		repo, _ := t["repository"].(map[string]any)
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s,%v\n",
+			str(t, "id"),
+			str(t, "reason"),
+			str(subject, "type"),
+			str(repo, "full_name"),
+			csvEscape(str(subject, "title")),
+			str(t, "updated_at"),
+			boolVal(t, "unread"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// topicsToCompact: comma-separated topic names
+func topicsToCompact(jsonStr string) string {
+	var data struct {
+		Names []string `json:"names"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	if len(data.Names) == 0 {
+		return "# 0 topics"
+	}
+	return "topics: " + strings.Join(data.Names, ", ")
+}
+
+// contributorsToCSV: login,contributions,type
+func contributorsToCSV(jsonStr string) string {
+	var contributors []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &contributors); err != nil {
+		return jsonStr
+	}
+	if len(contributors) == 0 {
+		return "# 0 contributors"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nlogin,contributions,type\n")
+	for _, c := range contributors {
+		sb.WriteString(fmt.Sprintf("%s,%d,%s\n",
+			str(c, "login"),
+			intVal(c, "contributions"),
+			str(c, "type"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// languagesToCSV: language,bytes,percent
+func languagesToCSV(jsonStr string) string {
+	var res struct {
+		TotalBytes int64 `json:"total_bytes"`
+		Languages  []struct {
+			Language string  `json:"language"`
+			Bytes    int64   `json:"bytes"`
+			Percent  float64 `json:"percent"`
+		} `json:"languages"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &res); err != nil {
+		return jsonStr
+	}
+	if len(res.Languages) == 0 {
+		return "# 0 languages"
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("```csv  # %d bytes\nlanguage,bytes,percent\n", res.TotalBytes))
+	for _, l := range res.Languages {
+		sb.WriteString(fmt.Sprintf("%s,%d,%.1f\n", csvEscape(l.Language), l.Bytes, l.Percent))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// collaboratorsToCSV: login,type,role_name,permissions
+// permissions lists the granted levels, e.g. "pull|triage|push".
+func collaboratorsToCSV(jsonStr string) string {
+	var collaborators []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &collaborators); err != nil {
+		return jsonStr
+	}
+	if len(collaborators) == 0 {
+		return "# 0 collaborators"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nlogin,type,role_name,permissions\n")
+	for _, c := range collaborators {
+		perms, _ := c["permissions"].(map[string]any)
+		var granted []string
+		for _, level := range []string{"pull", "triage", "push", "maintain", "admin"} {
+			if boolVal(perms, level) {
+				granted = append(granted, level)
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+			str(c, "login"),
+			str(c, "type"),
+			str(c, "role_name"),
+			strings.Join(granted, "|"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 func commitsToCSV(jsonStr string) string {
 	var commits []map[string]any
 	if err := json.Unmarshal([]byte(jsonStr), &commits); err != nil {
@@ -375,6 +676,12 @@ func prToCompact(jsonStr string) string {
 	if created := str(p, "created_at"); len(created) >= 10 {
 		sb.WriteString(fmt.Sprintf("- **Created**: %s\n", created[:10]))
 	}
+	if reviewers := fieldList(p["requested_reviewers"], "login"); len(reviewers) > 0 {
+		sb.WriteString(fmt.Sprintf("- **Requested Reviewers**: %s\n", strings.Join(reviewers, ", ")))
+	}
+	if teams := fieldList(p["requested_teams"], "slug"); len(teams) > 0 {
+		sb.WriteString(fmt.Sprintf("- **Requested Teams**: %s\n", strings.Join(teams, ", ")))
+	}
 	if body := str(p, "body"); body != "" {
 		if len(body) > 3000 {
 			body = body[:3000] + "...(truncated)"
@@ -384,6 +691,24 @@ func prToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// reviewRequestsToCompact: number, html_url and the pending reviewers after a review request
+func reviewRequestsToCompact(jsonStr string) string {
+	var p map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &p); err != nil {
+		return jsonStr
+	}
+	out, err := json.Marshal(map[string]any{
+		"number":              p["number"],
+		"html_url":            p["html_url"],
+		"requested_reviewers": fieldList(p["requested_reviewers"], "login"),
+		"requested_teams":     fieldList(p["requested_teams"], "slug"),
+	})
+	if err != nil {
+		return jsonStr
+	}
+	return string(out)
+}
+
 // prFilesToCSV: filename,status,additions,deletions
 func prFilesToCSV(jsonStr string) string {
 	var files []map[string]any
@@ -407,6 +732,109 @@ func prFilesToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// issueCommentsToCSV: id,author,created,reactions,body
+func issueCommentsToCSV(jsonStr string) string {
+	var comments []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &comments); err != nil {
+		return jsonStr
+	}
+	if len(comments) == 0 {
+		return "# 0 comments"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,author,created,reactions,body\n")
+	for _, c := range comments {
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s,%s\n",
+			intVal(c, "id"),
+			csvEscape(commentAuthor(c)),
+			dateOnly(str(c, "created_at")),
+			csvEscape(reactionsSummary(c)),
+			csvEscape(truncateBody(str(c, "body"))),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// reviewCommentsToCSV: id,path,line,author,created,reply_to,reactions,body
+func reviewCommentsToCSV(jsonStr string) string {
+	var comments []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &comments); err != nil {
+		return jsonStr
+	}
+	if len(comments) == 0 {
+		return "# 0 comments"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,path,line,author,created,reply_to,reactions,body\n")
+	for _, c := range comments {
+		// line is null on outdated comments; fall back to the line in the original diff
+		line := ""
+		if v, ok := c["line"].(float64); ok {
+			line = fmt.Sprintf("%d", int(v))
+		} else if v, ok := c["original_line"].(float64); ok {
+			line = fmt.Sprintf("%d (outdated)", int(v))
+		}
+		replyTo := ""
+		if v := intVal(c, "in_reply_to_id"); v != 0 {
+			replyTo = fmt.Sprintf("%d", v)
+		}
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s,%s,%s,%s,%s\n",
+			intVal(c, "id"),
+			csvEscape(str(c, "path")),
+			line,
+			csvEscape(commentAuthor(c)),
+			dateOnly(str(c, "created_at")),
+			replyTo,
+			csvEscape(reactionsSummary(c)),
+			csvEscape(truncateBody(str(c, "body"))),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// reactionOrder lists reaction keys in the order GitHub displays them.
+var reactionOrder = []string{"+1", "-1", "laugh", "hooray", "confused", "heart", "rocket", "eyes"}
+
+// reactionsSummary renders non-zero reaction counts, e.g. "+1:3 heart:1".
+func reactionsSummary(comment map[string]any) string {
+	r, ok := comment["reactions"].(map[string]any)
+	if !ok || intVal(r, "total_count") == 0 {
+		return ""
+	}
+	var parts []string
+	for _, k := range reactionOrder {
+		if n := intVal(r, k); n > 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d", k, n))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func commentAuthor(comment map[string]any) string {
+	if u, ok := comment["user"].(map[string]any); ok {
+		return str(u, "login")
+	}
+	return ""
+}
+
+func dateOnly(s string) string {
+	if len(s) >= 10 {
+		return s[:10]
+	}
+	return s
+}
+
+// truncateBody flattens a comment body to one line of at most 300 characters.
+func truncateBody(body string) string {
+	body = strings.Join(strings.Fields(body), " ")
+	if len(body) > 300 {
+		body = body[:300] + "..."
+	}
+	return body
+}
+
 // fileContentToCompact: file content
 func fileContentToCompact(jsonStr string) string {
 	var f map[string]any
@@ -499,6 +927,15 @@ func searchCodeToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// findIssuesToCSV: searchIssuesToCSV followed by the query that was run
+func findIssuesToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	return searchIssuesToCSV(jsonStr) + "\nquery: " + str(wrapper, "query")
+}
+
 // searchIssuesToCSV: number,title,state,repo,created
 func searchIssuesToCSV(jsonStr string) string {
 	var wrapper map[string]any
@@ -546,6 +983,37 @@ func searchIssuesToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// searchUsersToCSV: login,type,score
+func searchUsersToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	total := intVal(wrapper, "total_count")
+	items, ok := wrapper["items"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(items) == 0 {
+		return fmt.Sprintf("# 0/%d users", total)
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("```csv  # %d/%d users\nlogin,type,score\n", len(items), total))
+	for _, raw := range items {
+		u, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%.1f\n",
+			csvEscape(str(u, "login")),
+			str(u, "type"),
+			floatVal(u, "score"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // workflowsToCSV: id,name,state,path
 func workflowsToCSV(jsonStr string) string {
 	var wrapper map[string]any
@@ -614,6 +1082,57 @@ func workflowRunsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// followersToCSV: login,type
+func followersToCSV(jsonStr string) string {
+	var users []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &users); err != nil {
+		return jsonStr
+	}
+	if len(users) == 0 {
+		return "# 0 followers"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nlogin,type\n")
+	for _, u := range users {
+		sb.WriteString(fmt.Sprintf("%s,%s\n",
+			csvEscape(str(u, "login")),
+			str(u, "type"),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// rateLimitToCSV: resource,limit,remaining,used,reset
+func rateLimitToCSV(jsonStr string) string {
+	var overview map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &overview); err != nil {
+		return jsonStr
+	}
+	resources, ok := overview["resources"].(map[string]any)
+	if !ok {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nresource,limit,remaining,used,reset\n")
+	for _, name := range []string{"core", "search", "code_search", "graphql"} {
+		r, ok := resources[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		reset := time.Unix(int64(floatVal(r, "reset")), 0).UTC().Format(time.RFC3339)
+		sb.WriteString(fmt.Sprintf("%s,%d,%d,%d,%s\n",
+			name,
+			intVal(r, "limit"),
+			intVal(r, "remaining"),
+			intVal(r, "used"),
+			reset,
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // orgsToCSV: login,description
 func orgsToCSV(jsonStr string) string {
 	var orgs []map[string]any
@@ -713,6 +1232,20 @@ func labelsStr(obj map[string]any) string {
 	return strings.Join(names, ";")
 }
 
+// fieldList collects a string field from each object in a JSON array (e.g. user logins).
+func fieldList(v any, key string) []string {
+	items, _ := v.([]any)
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			if s := str(m, key); s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
 func csvEscape(s string) string {
 	if s == "" {
 		return ""
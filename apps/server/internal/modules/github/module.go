@@ -4,9 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"sync"
+	"time"
 
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -62,6 +68,7 @@ func (m *GitHubModule) ExecuteTool(ctx context.Context, name string, params map[
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -71,6 +78,66 @@ func (m *GitHubModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GitHubModule) HealthProbe() (string, map[string]any) {
+	return "get_rate_limit", nil
+}
+
+// snapshotTools maps update tools to the get tool that fetches what they change, for _return_diff.
+var snapshotTools = map[string]toolHandler{
+	"update_issue":   getIssue,
+	"replace_topics": getTopics,
+}
+
+// SnapshotForUpdate returns the issue or topics an update tool is about to change.
+func (m *GitHubModule) SnapshotForUpdate(ctx context.Context, toolName string, params map[string]any) (string, error) {
+	get, ok := snapshotTools[toolName]
+	if !ok {
+		return "", modules.ErrDiffUnsupported
+	}
+	return get(ctx, params)
+}
+
+// listPagination is how GitHub list endpoints page: top-level arrays by page number.
+var listPagination = modules.Pagination{PageParam: "page", PageSizeParam: "per_page", MaxPageSize: 100}
+
+// searchPagination is how the search endpoints page: the same, with the items in
+// "items". Search serves only the first 1000 results of a query.
+var searchPagination = modules.Pagination{Items: "items", PageParam: "page", PageSizeParam: "per_page", MaxPageSize: 100, MaxItems: 1000}
+
+// paginatedTools maps list tools to their pagination, for _fetch_all.
+var paginatedTools = map[string]modules.Pagination{
+	"list_followers":      listPagination,
+	"list_repos":          listPagination,
+	"list_starred_repos":  listPagination,
+	"list_tags":           listPagination,
+	"list_release_assets": listPagination,
+	"list_commits":        listPagination,
+	"list_contributors":   listPagination,
+	"list_issues":         listPagination,
+	"list_issue_comments": listPagination,
+	"list_labels":         listPagination,
+	"list_prs":            listPagination,
+	"list_pr_commits":     listPagination,
+	"list_pr_comments":    listPagination,
+	"list_public_events":  listPagination,
+	"list_gists":          listPagination,
+	"list_notifications":  listPagination,
+	"list_collaborators":  listPagination,
+	"search_repos":        searchPagination,
+	"search_code":         searchPagination,
+	"search_issues":       searchPagination,
+	"find_issues":         searchPagination,
+	"search_users":        searchPagination,
+}
+
+// Pagination returns how a list tool pages.
+func (m *GitHubModule) Pagination(toolName string) (modules.Pagination, bool) {
+	p, ok := paginatedTools[toolName]
+	return p, ok
+}
+
 // Resources returns all available resources (none for GitHub)
 func (m *GitHubModule) Resources() []modules.Resource {
 	return nil
@@ -119,6 +186,37 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"username"},
 		},
 	},
+	{
+		ID:   "github:list_followers",
+		Name: "list_followers",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List followers of a user.",
+			"ja-JP": "ユーザーのフォロワーを一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"username": {Type: "string", Description: "GitHub username"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"username"},
+		},
+	},
+	{
+		ID:   "github:get_rate_limit",
+		Name: "get_rate_limit",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the authenticated user's API rate-limit status (core, search, graphql) with remaining calls and reset times. Check this before launching many search calls.",
+			"ja-JP": "認証ユーザーのAPIレート制限の状況（core、search、graphql）を残り回数とリセット時刻付きで取得します。多数の検索呼び出しを行う前に確認してください。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type:       "object",
+			Properties: map[string]modules.Property{},
+		},
+	},
 	// Repositories
 	{
 		ID:   "github:list_repos",
@@ -176,6 +274,7 @@ var toolDefinitions = []modules.Tool{
 			},
 			Required: []string{"owner", "repo"},
 		},
+		OutputSchema: repositorySchema,
 	},
 	{
 		ID:   "github:list_branches",
@@ -195,6 +294,100 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo"},
 		},
 	},
+	{
+		ID:   "github:create_branch",
+		Name: "create_branch",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a branch from an existing branch, tag or commit SHA (default: the repository's default branch). Use before create_pr to open a PR from a new head branch.",
+			"ja-JP": "既存のブランチ、タグ、またはコミットSHA（デフォルト: リポジトリのデフォルトブランチ）からブランチを作成します。新しいheadブランチからPRを作成する前にcreate_prと組み合わせて使います。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":    {Type: "string", Description: "Repository owner"},
+				"repo":     {Type: "string", Description: "Repository name"},
+				"branch":   {Type: "string", Description: "Name of the new branch (e.g., 'feature/login')"},
+				"from_ref": {Type: "string", Description: "Branch, tag or commit SHA to branch from. Default: the default branch"},
+			},
+			Required: []string{"owner", "repo", "branch"},
+		},
+	},
+	{
+		ID:   "github:delete_branch",
+		Name: "delete_branch",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Delete a branch. Commits that are not reachable from another branch or tag can no longer be reached by name.",
+			"ja-JP": "ブランチを削除します。他のブランチやタグから到達できないコミットは名前で参照できなくなります。",
+		},
+		Annotations: modules.AnnotateDelete,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":  {Type: "string", Description: "Repository owner"},
+				"repo":   {Type: "string", Description: "Repository name"},
+				"branch": {Type: "string", Description: "Branch name to delete"},
+			},
+			Required: []string{"owner", "repo", "branch"},
+		},
+	},
+	{
+		ID:   "github:list_tags",
+		Name: "list_tags",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List tags in a repository with the commit SHA each tag points to.",
+			"ja-JP": "リポジトリ内のタグを、各タグが指すコミットSHAとともに一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":    {Type: "string", Description: "Repository owner"},
+				"repo":     {Type: "string", Description: "Repository name"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30, max: 100"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:get_release_by_tag",
+		Name: "get_release_by_tag",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a release by its tag name, including its assets (name, size, download URL).",
+			"ja-JP": "タグ名でリリースを取得します（アセットの名前、サイズ、ダウンロードURLを含む）。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner": {Type: "string", Description: "Repository owner"},
+				"repo":  {Type: "string", Description: "Repository name"},
+				"tag":   {Type: "string", Description: "Tag name (e.g., v1.2.0)"},
+			},
+			Required: []string{"owner", "repo", "tag"},
+		},
+	},
+	{
+		ID:   "github:list_release_assets",
+		Name: "list_release_assets",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List the assets (build artifacts) attached to a release.",
+			"ja-JP": "リリースに添付されたアセット（ビルド成果物）を一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":      {Type: "string", Description: "Repository owner"},
+				"repo":       {Type: "string", Description: "Repository name"},
+				"release_id": {Type: "number", Description: "Release ID (see get_release_by_tag)"},
+				"per_page":   {Type: "number", Description: "Results per page. Default: 30, max: 100"},
+				"page":       {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo", "release_id"},
+		},
+	},
 	{
 		ID:   "github:list_commits",
 		Name: "list_commits",
@@ -234,6 +427,78 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo", "path"},
 		},
 	},
+	{
+		ID:   "github:get_languages",
+		Name: "get_languages",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the language breakdown of a repository: bytes of code and share per language, largest first.",
+			"ja-JP": "リポジトリの言語構成（言語ごとのコードのバイト数と割合）を多い順に取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner": {Type: "string", Description: "Repository owner"},
+				"repo":  {Type: "string", Description: "Repository name"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:list_contributors",
+		Name: "list_contributors",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List contributors to a repository with their commit counts, most active first.",
+			"ja-JP": "リポジトリのコントリビューターをコミット数とともに、活動の多い順に一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":    {Type: "string", Description: "Repository owner"},
+				"repo":     {Type: "string", Description: "Repository name"},
+				"anon":     {Type: "boolean", Description: "Include anonymous contributors (commits without a GitHub account). Default: false"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30, Max: 100"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:get_topics",
+		Name: "get_topics",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the topics of a repository.",
+			"ja-JP": "リポジトリのトピックを取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner": {Type: "string", Description: "Repository owner"},
+				"repo":  {Type: "string", Description: "Repository name"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:replace_topics",
+		Name: "replace_topics",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Replace all topics of a repository. Topics not in names are removed; pass an empty array to clear all topics. Names are lowercased.",
+			"ja-JP": "リポジトリのトピックをすべて置き換えます。namesに含まれないトピックは削除されます。空配列ですべてのトピックを削除します。名前は小文字に変換されます。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner": {Type: "string", Description: "Repository owner"},
+				"repo":  {Type: "string", Description: "Repository name"},
+				"names": {Type: "array", Description: "Complete list of topics (lowercase letters, numbers and hyphens, max 50 characters each)", Items: &modules.Property{Type: "string"}},
+			},
+			Required: []string{"owner", "repo", "names"},
+		},
+	},
 	// Issues
 	{
 		ID:   "github:list_issues",
@@ -254,6 +519,7 @@ var toolDefinitions = []modules.Tool{
 			},
 			Required: []string{"owner", "repo"},
 		},
+		OutputSchema: arrayOf(issueSchema),
 	},
 	{
 		ID:   "github:get_issue",
@@ -272,6 +538,7 @@ var toolDefinitions = []modules.Tool{
 			},
 			Required: []string{"owner", "repo", "issue_number"},
 		},
+		OutputSchema: issueSchema,
 	},
 	{
 		ID:   "github:create_issue",
@@ -298,8 +565,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "github:update_issue",
 		Name: "update_issue",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing issue.",
-			"ja-JP": "既存のIssueを更新します。",
+			"en-US": "Update an existing issue. Omitted fields are left unchanged; pass null to clear body, labels or assignees.",
+			"ja-JP": "既存のIssueを更新します。省略したフィールドは変更されません。body、labels、assigneesをクリアするにはnullを指定します。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
@@ -317,6 +584,26 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo", "issue_number"},
 		},
 	},
+	{
+		ID:   "github:list_issue_comments",
+		Name: "list_issue_comments",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List conversation comments on an issue or pull request, with a reactions summary per comment.",
+			"ja-JP": "Issueまたはプルリクエストのコメントをリアクションのサマリーと共に一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":        {Type: "string", Description: "Repository owner"},
+				"repo":         {Type: "string", Description: "Repository name"},
+				"issue_number": {Type: "number", Description: "Issue or PR number"},
+				"per_page":     {Type: "number", Description: "Results per page. Default: 30"},
+				"page":         {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo", "issue_number"},
+		},
+	},
 	{
 		ID:   "github:add_issue_comment",
 		Name: "add_issue_comment",
@@ -336,6 +623,119 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo", "issue_number", "body"},
 		},
 	},
+	{
+		ID:   "github:update_issue_comment",
+		Name: "update_issue_comment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Replace the body of an issue or pull request comment. Use to keep a status comment up to date instead of posting a new one.",
+			"ja-JP": "IssueまたはプルリクエストのコメントのBodyを置き換えます。新しいコメントを投稿する代わりにステータスコメントを最新に保つために使います。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":      {Type: "string", Description: "Repository owner"},
+				"repo":       {Type: "string", Description: "Repository name"},
+				"comment_id": {Type: "number", Description: "Comment ID (the id field from list_issue_comments or add_issue_comment)"},
+				"body":       {Type: "string", Description: "New comment body"},
+			},
+			Required: []string{"owner", "repo", "comment_id", "body"},
+		},
+	},
+	{
+		ID:   "github:delete_issue_comment",
+		Name: "delete_issue_comment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Delete an issue or pull request comment.",
+			"ja-JP": "Issueまたはプルリクエストのコメントを削除します。",
+		},
+		Annotations: modules.AnnotateDelete,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":      {Type: "string", Description: "Repository owner"},
+				"repo":       {Type: "string", Description: "Repository name"},
+				"comment_id": {Type: "number", Description: "Comment ID"},
+			},
+			Required: []string{"owner", "repo", "comment_id"},
+		},
+	},
+	{
+		ID:   "github:lock_issue",
+		Name: "lock_issue",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Lock the conversation on an issue or pull request so only collaborators can comment.",
+			"ja-JP": "IssueまたはプルリクエストのConversationをロックし、コラボレーターのみがコメントできるようにします。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":        {Type: "string", Description: "Repository owner"},
+				"repo":         {Type: "string", Description: "Repository name"},
+				"issue_number": {Type: "number", Description: "Issue or PR number"},
+				"lock_reason":  {Type: "string", Description: "Reason shown on the timeline: off-topic, too heated, resolved, spam (optional)"},
+			},
+			Required: []string{"owner", "repo", "issue_number"},
+		},
+	},
+	{
+		ID:   "github:unlock_issue",
+		Name: "unlock_issue",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Unlock the conversation on an issue or pull request.",
+			"ja-JP": "IssueまたはプルリクエストのConversationのロックを解除します。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":        {Type: "string", Description: "Repository owner"},
+				"repo":         {Type: "string", Description: "Repository name"},
+				"issue_number": {Type: "number", Description: "Issue or PR number"},
+			},
+			Required: []string{"owner", "repo", "issue_number"},
+		},
+	},
+	{
+		ID:   "github:list_labels",
+		Name: "list_labels",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List labels defined in a repository.",
+			"ja-JP": "リポジトリに定義されているラベルを一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":    {Type: "string", Description: "Repository owner"},
+				"repo":     {Type: "string", Description: "Repository name"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30, max: 100"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:create_label",
+		Name: "create_label",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a label in a repository.",
+			"ja-JP": "リポジトリにラベルを作成します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":       {Type: "string", Description: "Repository owner"},
+				"repo":        {Type: "string", Description: "Repository name"},
+				"name":        {Type: "string", Description: "Label name"},
+				"color":       {Type: "string", Description: "Hex color code without the leading # (e.g., f29513)"},
+				"description": {Type: "string", Description: "Short description of the label"},
+			},
+			Required: []string{"owner", "repo", "name"},
+		},
+	},
 	// Pull Requests
 	{
 		ID:   "github:list_prs",
@@ -356,6 +756,7 @@ var toolDefinitions = []modules.Tool{
 			},
 			Required: []string{"owner", "repo"},
 		},
+		OutputSchema: arrayOf(pullRequestSchema),
 	},
 	{
 		ID:   "github:get_pr",
@@ -374,6 +775,7 @@ var toolDefinitions = []modules.Tool{
 			},
 			Required: []string{"owner", "repo", "pr_number"},
 		},
+		OutputSchema: pullRequestSchema,
 	},
 	{
 		ID:   "github:create_pr",
@@ -397,6 +799,26 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo", "title", "head", "base"},
 		},
 	},
+	{
+		ID:   "github:request_pr_reviewers",
+		Name: "request_pr_reviewers",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Request reviews on a pull request from users and/or teams.",
+			"ja-JP": "プルリクエストのレビューをユーザーやチームに依頼します。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":          {Type: "string", Description: "Repository owner"},
+				"repo":           {Type: "string", Description: "Repository name"},
+				"pr_number":      {Type: "number", Description: "PR number"},
+				"reviewers":      {Type: "array", Description: "User logins to request reviews from", Items: &modules.Property{Type: "string"}},
+				"team_reviewers": {Type: "array", Description: "Team slugs to request reviews from (organization repositories only)", Items: &modules.Property{Type: "string"}},
+			},
+			Required: []string{"owner", "repo", "pr_number"},
+		},
+	},
 	{
 		ID:   "github:list_pr_files",
 		Name: "list_pr_files",
@@ -416,31 +838,71 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"owner", "repo", "pr_number"},
 		},
 	},
-	// Search
 	{
-		ID:   "github:search_repos",
-		Name: "search_repos",
+		ID:   "github:list_pr_commits",
+		Name: "list_pr_commits",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Search for repositories.",
-			"ja-JP": "リポジトリを検索します。",
+			"en-US": "List the commits of a pull request in order (sha, author, date, message), e.g. to write a changelog or review a PR commit by commit. Returns at most 250 commits.",
+			"ja-JP": "プルリクエストのコミットを順に一覧表示します（SHA、作成者、日時、メッセージ）。変更履歴の作成やコミット単位でのレビューに使用します。最大250件まで返します。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"query":    {Type: "string", Description: "Search query"},
-				"sort":     {Type: "string", Description: "Sort by (stars, forks, help-wanted-issues, updated)"},
-				"per_page": {Type: "number", Description: "Results per page. Default: 30"},
-				"page":     {Type: "number", Description: "Page number. Default: 1"},
+				"owner":     {Type: "string", Description: "Repository owner"},
+				"repo":      {Type: "string", Description: "Repository name"},
+				"pr_number": {Type: "number", Description: "PR number"},
+				"per_page":  {Type: "number", Description: "Results per page. Default: 30"},
+				"page":      {Type: "number", Description: "Page number. Default: 1"},
 			},
-			Required: []string{"query"},
+			Required: []string{"owner", "repo", "pr_number"},
 		},
 	},
 	{
-		ID:   "github:search_code",
-		Name: "search_code",
+		ID:   "github:list_pr_comments",
+		Name: "list_pr_comments",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Search for code across repositories.",
+			"en-US": "List review comments on a pull request's diff (file path, line, author, body), with a reactions summary per comment. For conversation comments use list_issue_comments.",
+			"ja-JP": "プルリクエストの差分に対するレビューコメント（ファイルパス、行、作成者、本文）をリアクションのサマリーと共に一覧表示します。会話コメントには list_issue_comments を使用します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":     {Type: "string", Description: "Repository owner"},
+				"repo":      {Type: "string", Description: "Repository name"},
+				"pr_number": {Type: "number", Description: "PR number"},
+				"per_page":  {Type: "number", Description: "Results per page. Default: 30"},
+				"page":      {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo", "pr_number"},
+		},
+	},
+	// Search
+	{
+		ID:   "github:search_repos",
+		Name: "search_repos",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Search for repositories.",
+			"ja-JP": "リポジトリを検索します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"query":    {Type: "string", Description: "Search query"},
+				"sort":     {Type: "string", Description: "Sort by (stars, forks, help-wanted-issues, updated)"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"query"},
+		},
+	},
+	{
+		ID:   "github:search_code",
+		Name: "search_code",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Search for code across repositories.",
 			"ja-JP": "リポジトリ全体でコードを検索します。",
 		},
 		Annotations: modules.AnnotateReadOnly,
@@ -473,6 +935,54 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"query"},
 		},
 	},
+	{
+		ID:   "github:find_issues",
+		Name: "find_issues",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Find issues or pull requests in one repository with structured filters. The search query is built for you, so no GitHub search syntax is needed.",
+			"ja-JP": "構造化されたフィルタで1つのリポジトリ内のIssueまたはプルリクエストを検索します。検索クエリは自動で組み立てられるため、GitHubの検索構文は不要です。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":     {Type: "string", Description: "Repository owner"},
+				"repo":      {Type: "string", Description: "Repository name"},
+				"text":      {Type: "string", Description: "Words to match in the title or body (optional)"},
+				"type":      {Type: "string", Description: "issue, pr or all. Default: issue"},
+				"state":     {Type: "string", Description: "open, closed or all. Default: open"},
+				"labels":    {Type: "array", Description: "Labels that must all be present", Items: &modules.Property{Type: "string"}},
+				"assignee":  {Type: "string", Description: "Assignee login, or 'none' for unassigned"},
+				"author":    {Type: "string", Description: "Author login"},
+				"milestone": {Type: "string", Description: "Milestone title, or 'none' for no milestone"},
+				"since":     {Type: "string", Description: "Only items updated at or after this date/time (e.g. '2024-01-01', '7 days ago')"},
+				"sort":      {Type: "string", Description: "Sort by (created, updated, comments, reactions). Default: best match"},
+				"direction": {Type: "string", Description: "Sort direction (asc, desc). Default: desc"},
+				"per_page":  {Type: "number", Description: "Results per page. Default: 30"},
+				"page":      {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:search_users",
+		Name: "search_users",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Search for users and organizations.",
+			"ja-JP": "ユーザーと組織を検索します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"query":    {Type: "string", Description: "Search query (e.g., 'tom in:login type:user', 'location:tokyo language:go')"},
+				"sort":     {Type: "string", Description: "Sort by (followers, repositories, joined)"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"query"},
+		},
+	},
 	// Actions
 	{
 		ID:   "github:list_workflows",
@@ -548,6 +1058,137 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"username"},
 		},
 	},
+	// Gists
+	{
+		ID:   "github:list_gists",
+		Name: "list_gists",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List gists for a user. Omit username to list the authenticated user's gists (including secret ones).",
+			"ja-JP": "ユーザーのGistを一覧表示します。usernameを省略すると認証ユーザーのGist（シークレットを含む）を一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"username": {Type: "string", Description: "GitHub username. Default: authenticated user"},
+				"per_page": {Type: "number", Description: "Results per page. Default: 30, max: 100"},
+				"page":     {Type: "number", Description: "Page number. Default: 1"},
+			},
+		},
+	},
+	{
+		ID:   "github:create_gist",
+		Name: "create_gist",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a gist. files maps each file name to its content, e.g. {\"hello.py\": \"print('hi')\"}.",
+			"ja-JP": "Gistを作成します。filesはファイル名から内容へのマップです（例: {\"hello.py\": \"print('hi')\"}）。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"files":       {Type: "object", Description: "Map of file name to file content"},
+				"description": {Type: "string", Description: "Gist description"},
+				"public":      {Type: "boolean", Description: "Create a public gist. Default: false (secret)"},
+			},
+			Required: []string{"files"},
+		},
+	},
+	{
+		ID:   "github:update_gist",
+		Name: "update_gist",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Update a gist's description or files. files maps file name to new content; set a file to null to delete it. Files not listed are left unchanged.",
+			"ja-JP": "Gistの説明またはファイルを更新します。filesはファイル名から新しい内容へのマップで、nullを指定するとそのファイルを削除します。指定しないファイルは変更されません。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"gist_id":     {Type: "string", Description: "Gist ID"},
+				"files":       {Type: "object", Description: "Map of file name to new content (null deletes the file)"},
+				"description": {Type: "string", Description: "New gist description"},
+			},
+			Required: []string{"gist_id"},
+		},
+	},
+	// Notifications
+	{
+		ID:   "github:list_notifications",
+		Name: "list_notifications",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List notifications for the authenticated user. By default only unread notifications are returned.",
+			"ja-JP": "認証ユーザーの通知を一覧表示します。デフォルトでは未読の通知のみを返します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"all":           {Type: "boolean", Description: "Include notifications already marked as read. Default: false"},
+				"participating": {Type: "boolean", Description: "Only notifications where the user is directly participating or mentioned. Default: false"},
+				"since":         {Type: "string", Description: "Only notifications updated after this time (ISO 8601, e.g. 2024-01-01T00:00:00Z)"},
+				"per_page":      {Type: "number", Description: "Results per page. Default: 50, max: 50"},
+				"page":          {Type: "number", Description: "Page number. Default: 1"},
+			},
+		},
+	},
+	{
+		ID:   "github:mark_notification_read",
+		Name: "mark_notification_read",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Mark a notification thread as read.",
+			"ja-JP": "通知スレッドを既読にします。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"thread_id": {Type: "string", Description: "Notification thread ID (id from list_notifications)"},
+			},
+			Required: []string{"thread_id"},
+		},
+	},
+	// Collaborators
+	{
+		ID:   "github:list_collaborators",
+		Name: "list_collaborators",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List collaborators of a repository with their permissions (pull, triage, push, maintain, admin).",
+			"ja-JP": "リポジトリのコラボレーターと権限（pull、triage、push、maintain、admin）を一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":       {Type: "string", Description: "Repository owner"},
+				"repo":        {Type: "string", Description: "Repository name"},
+				"affiliation": {Type: "string", Description: "Filter by affiliation: outside, direct, all. Default: all"},
+				"permission":  {Type: "string", Description: "Only collaborators with this permission: pull, triage, push, maintain, admin"},
+				"per_page":    {Type: "number", Description: "Results per page. Default: 30, max: 100"},
+				"page":        {Type: "number", Description: "Page number. Default: 1"},
+			},
+			Required: []string{"owner", "repo"},
+		},
+	},
+	{
+		ID:   "github:add_collaborator",
+		Name: "add_collaborator",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a user as a repository collaborator. Sends an invitation unless the user already has access (e.g. as an organization member); calling it for an existing collaborator updates their permission.",
+			"ja-JP": "ユーザーをリポジトリのコラボレーターに追加します。既にアクセス権を持つ場合（組織メンバーなど）を除き招待が送信されます。既存のコラボレーターに対して呼び出すと権限が更新されます。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"owner":      {Type: "string", Description: "Repository owner"},
+				"repo":       {Type: "string", Description: "Repository name"},
+				"username":   {Type: "string", Description: "GitHub username to add"},
+				"permission": {Type: "string", Description: "Permission to grant: pull, triage, push, maintain, admin, or a custom role name. Default: push"},
+			},
+			Required: []string{"owner", "repo", "username"},
+		},
+	},
 	// Composite
 	{
 		ID:   "github:describe_user",
@@ -610,28 +1251,58 @@ type toolHandler func(ctx context.Context, params map[string]any) (string, error
 
 var toolHandlers = map[string]toolHandler{
 	"get_user":            getUser,
+	"list_followers":      listFollowers,
+	"get_rate_limit":      getRateLimit,
 	"list_repos":          listRepos,
 	"list_starred_repos":  listStarredRepos,
 	"get_repo":            getRepo,
 	"list_branches":       listBranches,
+	"create_branch":       createBranch,
+	"delete_branch":       deleteBranch,
+	"list_tags":           listTags,
+	"get_release_by_tag":  getReleaseByTag,
+	"list_release_assets": listReleaseAssets,
 	"list_commits":        listCommits,
 	"get_file_content":    getFileContent,
+	"get_languages":       getLanguages,
+	"list_contributors":   listContributors,
+	"get_topics":          getTopics,
+	"replace_topics":      replaceTopics,
 	"list_issues":         listIssues,
 	"get_issue":           getIssue,
 	"create_issue":        createIssue,
 	"update_issue":        updateIssue,
+	"list_issue_comments": listIssueComments,
 	"add_issue_comment":   addIssueComment,
+	"update_issue_comment": updateIssueComment,
+	"delete_issue_comment": deleteIssueComment,
+	"lock_issue":          lockIssue,
+	"unlock_issue":        unlockIssue,
+	"list_labels":         listLabels,
+	"create_label":        createLabel,
 	"list_prs":            listPRs,
 	"get_pr":              getPR,
 	"create_pr":           createPR,
+	"request_pr_reviewers": requestPRReviewers,
 	"list_pr_files":       listPRFiles,
+	"list_pr_commits":     listPRCommits,
+	"list_pr_comments":    listPRComments,
 	"search_repos":        searchRepos,
 	"search_code":         searchCode,
 	"search_issues":       searchIssues,
+	"find_issues":         findIssues,
+	"search_users":        searchUsers,
 	"list_workflows":      listWorkflows,
 	"list_workflow_runs":  listWorkflowRuns,
 	"list_orgs":           listOrgs,
 	"list_public_events":  listPublicEvents,
+	"list_gists":          listGists,
+	"create_gist":         createGist,
+	"update_gist":         updateGist,
+	"list_notifications":  listNotifications,
+	"mark_notification_read": markNotificationRead,
+	"list_collaborators":  listCollaborators,
+	"add_collaborator":    addCollaborator,
 	"describe_user":       describeUser,
 	"describe_repo":       describeRepo,
 	"describe_pr":         describePR,
@@ -642,17 +1313,28 @@ var toolHandlers = map[string]toolHandler{
 // =============================================================================
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "github", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
 	}
-	return githubapi.NewClient(creds.AccessToken)
+	serverURL, err := creds.BaseURL(githubapi.DefaultServerURL)
+	if err != nil {
+		return nil, err
+	}
+	return githubapi.NewClientWithURL(serverURL, creds.AccessToken)
 }
 
 var toJSON = modules.ToJSON
 
 var toStringSlice = modules.ToStringSlice
 
+// pageSize returns per_page for a list call: the caller's value, else the
+// deployment default, else GitHub's default of 30 (max 100).
+func pageSize(params map[string]any) int {
+	return modules.PageSize(params, "per_page", 30, 100)
+}
+
 // =============================================================================
 // User
 // =============================================================================
@@ -670,6 +1352,36 @@ func getUser(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func listFollowers(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	username, _ := params["username"].(string)
+	p := gen.UsersListFollowersForUserParams{Username: username}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.UsersListFollowersForUser(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func getRateLimit(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.RateLimitGet(ctx)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func listOrgs(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -677,9 +1389,7 @@ func listOrgs(ctx context.Context, params map[string]any) (string, error) {
 	}
 	username, _ := params["username"].(string)
 	p := gen.OrgsListForUserParams{Username: username}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	res, err := c.OrgsListForUser(ctx, p)
 	if err != nil {
 		return "", err
@@ -694,9 +1404,7 @@ func listPublicEvents(ctx context.Context, params map[string]any) (string, error
 	}
 	username, _ := params["username"].(string)
 	p := gen.ActivityListPublicEventsForUserParams{Username: username}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -724,9 +1432,7 @@ func listRepos(ctx context.Context, params map[string]any) (string, error) {
 	if sort, ok := params["sort"].(string); ok && sort != "" {
 		p.Sort.SetTo(gen.ReposListForUserSort(sort))
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -750,9 +1456,7 @@ func listStarredRepos(ctx context.Context, params map[string]any) (string, error
 	if dir, ok := params["direction"].(string); ok && dir != "" {
 		p.Direction.SetTo(gen.ActivityListReposStarredByUserDirection(dir))
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -787,9 +1491,7 @@ func listBranches(ctx context.Context, params map[string]any) (string, error) {
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
 	p := gen.ReposListBranchesParams{Owner: owner, Repo: repo}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	res, err := c.ReposListBranches(ctx, p)
 	if err != nil {
 		return "", err
@@ -797,6 +1499,180 @@ func listBranches(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func createBranch(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	branch, _ := params["branch"].(string)
+	branch = strings.TrimPrefix(branch, "refs/heads/")
+	fromRef, _ := params["from_ref"].(string)
+	if fromRef == "" {
+		r, err := c.ReposGet(ctx, gen.ReposGetParams{Owner: owner, Repo: repo})
+		if err != nil {
+			return "", err
+		}
+		fromRef = r.DefaultBranch.Value
+	}
+	sha, err := resolveRef(ctx, c, owner, repo, fromRef)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.GitCreateRef(ctx, &gen.CreateRefRequest{Ref: "refs/heads/" + branch, Sha: sha}, gen.GitCreateRefParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"branch":   branch,
+		"ref":      res.Ref,
+		"sha":      res.Object.Sha,
+		"from_ref": fromRef,
+	})
+}
+
+// resolveRef returns the commit SHA of ref: a full SHA is used as-is,
+// otherwise ref is looked up as a branch and then as a tag.
+func resolveRef(ctx context.Context, c *gen.Client, owner, repo, ref string) (string, error) {
+	if commitSHA.MatchString(ref) {
+		return ref, nil
+	}
+	name := strings.TrimPrefix(ref, "refs/")
+	candidates := []string{"heads/" + name, "tags/" + name}
+	if strings.HasPrefix(name, "heads/") || strings.HasPrefix(name, "tags/") {
+		candidates = []string{name}
+	}
+	for _, candidate := range candidates {
+		res, err := c.GitGetRef(ctx, gen.GitGetRefParams{Owner: owner, Repo: repo, Ref: candidate})
+		if err == nil {
+			return res.Object.Sha, nil
+		}
+		var upstream *modules.UpstreamError
+		if !errors.As(modules.WithUpstreamStatus(err), &upstream) || upstream.StatusCode != http.StatusNotFound {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("ref %q not found in %s/%s: not a branch, tag or full commit SHA", ref, owner, repo)
+}
+
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+func deleteBranch(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	branch, _ := params["branch"].(string)
+	branch = strings.TrimPrefix(branch, "refs/heads/")
+	if err := c.GitDeleteRef(ctx, gen.GitDeleteRefParams{Owner: owner, Repo: repo, Ref: "heads/" + branch}); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"success": true,
+		"message": fmt.Sprintf("Branch %s deleted", branch),
+	})
+}
+
+func listTags(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	p := gen.ReposListTagsParams{Owner: owner, Repo: repo}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.ReposListTags(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	type tag struct {
+		Name      string `json:"name"`
+		CommitSHA string `json:"commit_sha"`
+	}
+	tags := make([]tag, 0, len(res))
+	for _, t := range res {
+		tags = append(tags, tag{Name: t.Name, CommitSHA: t.Commit.Sha})
+	}
+	return toJSON(tags)
+}
+
+// releaseAsset is the trimmed asset shape returned by the release tools.
+type releaseAsset struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type,omitempty"`
+	DownloadURL string `json:"download_url"`
+}
+
+func toReleaseAssets(assets []gen.ReleaseAsset) []releaseAsset {
+	out := make([]releaseAsset, 0, len(assets))
+	for _, a := range assets {
+		out = append(out, releaseAsset{
+			ID:          a.ID,
+			Name:        a.Name,
+			Size:        a.Size,
+			ContentType: a.ContentType.Value,
+			DownloadURL: a.BrowserDownloadURL.String(),
+		})
+	}
+	return out
+}
+
+func getReleaseByTag(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	tag, _ := params["tag"].(string)
+	res, err := c.ReposGetReleaseByTag(ctx, gen.ReposGetReleaseByTagParams{Owner: owner, Repo: repo, Tag: tag})
+	if err != nil {
+		return "", err
+	}
+	release := map[string]any{
+		"id":         res.ID,
+		"tag_name":   res.TagName,
+		"name":       res.Name.Value,
+		"html_url":   res.HTMLURL.String(),
+		"draft":      res.Draft.Value,
+		"prerelease": res.Prerelease.Value,
+		"assets":     toReleaseAssets(res.Assets),
+	}
+	if res.PublishedAt.Set && !res.PublishedAt.Null {
+		release["published_at"] = res.PublishedAt.Value
+	}
+	return toJSON(release)
+}
+
+func listReleaseAssets(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	releaseID, _ := params["release_id"].(float64)
+	p := gen.ReposListReleaseAssetsParams{Owner: owner, Repo: repo, ReleaseID: int64(releaseID)}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.ReposListReleaseAssets(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(toReleaseAssets(res))
+}
+
 func listCommits(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -808,9 +1684,7 @@ func listCommits(ctx context.Context, params map[string]any) (string, error) {
 	if sha, ok := params["sha"].(string); ok && sha != "" {
 		p.Sha.SetTo(sha)
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -850,6 +1724,103 @@ func getFileContent(ctx context.Context, params map[string]any) (string, error)
 	return toJSON(res)
 }
 
+func getLanguages(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	res, err := c.ReposListLanguages(ctx, gen.ReposListLanguagesParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	var bytesByLanguage map[string]int64
+	if err := json.Unmarshal(res, &bytesByLanguage); err != nil {
+		return "", fmt.Errorf("failed to decode languages: %w", err)
+	}
+
+	var total int64
+	for _, n := range bytesByLanguage {
+		total += n
+	}
+	languages := make([]map[string]any, 0, len(bytesByLanguage))
+	for name, n := range bytesByLanguage {
+		percent := 0.0
+		if total > 0 {
+			percent = math.Round(float64(n)*1000/float64(total)) / 10
+		}
+		languages = append(languages, map[string]any{"language": name, "bytes": n, "percent": percent})
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i]["bytes"].(int64) > languages[j]["bytes"].(int64)
+	})
+	return toJSON(map[string]any{"total_bytes": total, "languages": languages})
+}
+
+func listContributors(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	p := gen.ReposListContributorsParams{Owner: owner, Repo: repo}
+	if anon, ok := params["anon"].(bool); ok && anon {
+		p.Anon.SetTo("true")
+	}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.ReposListContributors(ctx, p)
+	if err != nil {
+		// GitHub answers 204 No Content for an empty repository
+		var upstream *modules.UpstreamError
+		if errors.As(modules.WithUpstreamStatus(err), &upstream) && upstream.StatusCode == http.StatusNoContent {
+			return "[]", nil
+		}
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func getTopics(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	res, err := c.ReposGetAllTopics(ctx, gen.ReposGetAllTopicsParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func replaceTopics(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	raw, _ := params["names"].([]any)
+	// GitHub rejects uppercase topics; a non-nil empty slice clears all topics
+	names := []string{}
+	for _, n := range toStringSlice(raw) {
+		if n = strings.ToLower(strings.TrimSpace(n)); n != "" {
+			names = append(names, n)
+		}
+	}
+	res, err := c.ReposReplaceAllTopics(ctx, &gen.ReplaceTopicsRequest{Names: names}, gen.ReposReplaceAllTopicsParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 // =============================================================================
 // Issues
 // =============================================================================
@@ -865,9 +1836,7 @@ func listIssues(ctx context.Context, params map[string]any) (string, error) {
 	if state, ok := params["state"].(string); ok && state != "" {
 		p.State.SetTo(gen.IssuesListForRepoState(state))
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -927,20 +1896,25 @@ func updateIssue(ctx context.Context, params map[string]any) (string, error) {
 	repo, _ := params["repo"].(string)
 	issueNumber, _ := params["issue_number"].(float64)
 	req := &gen.UpdateIssueRequest{}
-	if title, ok := params["title"].(string); ok {
+	if title, op := modules.StringUpdate(params, "title"); op == modules.FieldSet {
 		req.Title.SetTo(title)
 	}
-	if b, ok := params["body"].(string); ok {
+	if b, op := modules.StringUpdate(params, "body"); op != modules.FieldUnchanged {
 		req.Body.SetTo(b)
 	}
-	if state, ok := params["state"].(string); ok {
+	if state, op := modules.StringUpdate(params, "state"); op == modules.FieldSet {
 		req.State.SetTo(state)
 	}
+	// null clears labels/assignees: a non-nil empty slice is sent as []
 	if labels, ok := params["labels"].([]interface{}); ok {
 		req.Labels = toStringSlice(labels)
+	} else if modules.UpdateOf(params, "labels") == modules.FieldCleared {
+		req.Labels = []string{}
 	}
 	if assignees, ok := params["assignees"].([]interface{}); ok {
 		req.Assignees = toStringSlice(assignees)
+	} else if modules.UpdateOf(params, "assignees") == modules.FieldCleared {
+		req.Assignees = []string{}
 	}
 	res, err := c.IssuesUpdate(ctx, req, gen.IssuesUpdateParams{Owner: owner, Repo: repo, IssueNumber: int(issueNumber)})
 	if err != nil {
@@ -949,6 +1923,26 @@ func updateIssue(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func listIssueComments(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	issueNumber, _ := params["issue_number"].(float64)
+	p := gen.IssuesListCommentsParams{Owner: owner, Repo: repo, IssueNumber: int(issueNumber)}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.IssuesListComments(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func addIssueComment(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -965,35 +1959,216 @@ func addIssueComment(ctx context.Context, params map[string]any) (string, error)
 	return toJSON(res)
 }
 
-// =============================================================================
-// Pull Requests
-// =============================================================================
-
-func listPRs(ctx context.Context, params map[string]any) (string, error) {
+func updateIssueComment(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	commentID, _ := params["comment_id"].(float64)
+	body, _ := params["body"].(string)
+	res, err := c.IssuesUpdateComment(ctx, &gen.CreateCommentRequest{Body: body}, gen.IssuesUpdateCommentParams{Owner: owner, Repo: repo, CommentID: int64(commentID)})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func deleteIssueComment(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	commentID, _ := params["comment_id"].(float64)
+	if err := c.IssuesDeleteComment(ctx, gen.IssuesDeleteCommentParams{Owner: owner, Repo: repo, CommentID: int64(commentID)}); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"success": true,
+		"message": fmt.Sprintf("Comment %d deleted", int64(commentID)),
+	})
+}
+
+// lockReasons are the lock reasons GitHub accepts.
+var lockReasons = map[string]bool{"off-topic": true, "too heated": true, "resolved": true, "spam": true}
+
+func lockIssue(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	issueNumber, _ := params["issue_number"].(float64)
+	req := &gen.LockIssueRequest{}
+	if reason, ok := params["lock_reason"].(string); ok && reason != "" {
+		reason = strings.ReplaceAll(strings.ToLower(reason), "_", " ")
+		if !lockReasons[reason] {
+			return "", fmt.Errorf("invalid lock_reason %q: use off-topic, too heated, resolved, or spam", reason)
+		}
+		req.LockReason.SetTo(reason)
+	}
+	if err := c.IssuesLock(ctx, req, gen.IssuesLockParams{Owner: owner, Repo: repo, IssueNumber: int(issueNumber)}); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"success": true,
+		"message": fmt.Sprintf("Locked conversation on %s/%s#%d", owner, repo, int(issueNumber)),
+	})
+}
+
+func unlockIssue(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	issueNumber, _ := params["issue_number"].(float64)
+	if err := c.IssuesUnlock(ctx, gen.IssuesUnlockParams{Owner: owner, Repo: repo, IssueNumber: int(issueNumber)}); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"success": true,
+		"message": fmt.Sprintf("Unlocked conversation on %s/%s#%d", owner, repo, int(issueNumber)),
+	})
+}
+
+func listLabels(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	p := gen.IssuesListLabelsForRepoParams{Owner: owner, Repo: repo}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.IssuesListLabelsForRepo(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func createLabel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	name, _ := params["name"].(string)
+	req := &gen.CreateLabelRequest{Name: name}
+	if color, ok := params["color"].(string); ok && color != "" {
+		req.Color.SetTo(strings.TrimPrefix(color, "#"))
+	}
+	if d, ok := params["description"].(string); ok {
+		req.Description.SetTo(d)
+	}
+	res, err := c.IssuesCreateLabel(ctx, req, gen.IssuesCreateLabelParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+// =============================================================================
+// Pull Requests
+// =============================================================================
+
+func listPRs(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	p := gen.PullsListForRepoParams{Owner: owner, Repo: repo}
+	if state, ok := params["state"].(string); ok && state != "" {
+		p.State.SetTo(gen.PullsListForRepoState(state))
+	}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.PullsListForRepo(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func getPR(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	prNumber, _ := params["pr_number"].(float64)
+	res, err := c.PullsGet(ctx, gen.PullsGetParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func createPR(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	title, _ := params["title"].(string)
+	head, _ := params["head"].(string)
+	baseBranch, _ := params["base"].(string)
+	req := &gen.CreatePRRequest{Title: title, Head: head, Base: baseBranch}
+	if b, ok := params["body"].(string); ok {
+		req.Body.SetTo(b)
+	}
+	if draft, ok := params["draft"].(bool); ok {
+		req.Draft.SetTo(draft)
+	}
+	res, err := c.PullsCreate(ctx, req, gen.PullsCreateParams{Owner: owner, Repo: repo})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func requestPRReviewers(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
 	}
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
-	p := gen.PullsListForRepoParams{Owner: owner, Repo: repo}
-	if state, ok := params["state"].(string); ok && state != "" {
-		p.State.SetTo(gen.PullsListForRepoState(state))
+	prNumber, _ := params["pr_number"].(float64)
+	req := &gen.RequestReviewersRequest{}
+	if reviewers, ok := params["reviewers"].([]interface{}); ok {
+		req.Reviewers = toStringSlice(reviewers)
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
+	if teams, ok := params["team_reviewers"].([]interface{}); ok {
+		req.TeamReviewers = toStringSlice(teams)
 	}
-	if pg, ok := params["page"].(float64); ok {
-		p.Page.SetTo(int(pg))
+	if len(req.Reviewers) == 0 && len(req.TeamReviewers) == 0 {
+		return "", fmt.Errorf("at least one of reviewers or team_reviewers is required")
 	}
-	res, err := c.PullsListForRepo(ctx, p)
+	res, err := c.PullsRequestReviewers(ctx, req, gen.PullsRequestReviewersParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)})
 	if err != nil {
 		return "", err
 	}
 	return toJSON(res)
 }
 
-func getPR(ctx context.Context, params map[string]any) (string, error) {
+func listPRFiles(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
@@ -1001,38 +2176,36 @@ func getPR(ctx context.Context, params map[string]any) (string, error) {
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
 	prNumber, _ := params["pr_number"].(float64)
-	res, err := c.PullsGet(ctx, gen.PullsGetParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)})
+	p := gen.PullsListFilesParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)}
+	p.PerPage.SetTo(pageSize(params))
+	res, err := c.PullsListFiles(ctx, p)
 	if err != nil {
 		return "", err
 	}
 	return toJSON(res)
 }
 
-func createPR(ctx context.Context, params map[string]any) (string, error) {
+func listPRCommits(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
 	}
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
-	title, _ := params["title"].(string)
-	head, _ := params["head"].(string)
-	baseBranch, _ := params["base"].(string)
-	req := &gen.CreatePRRequest{Title: title, Head: head, Base: baseBranch}
-	if b, ok := params["body"].(string); ok {
-		req.Body.SetTo(b)
-	}
-	if draft, ok := params["draft"].(bool); ok {
-		req.Draft.SetTo(draft)
+	prNumber, _ := params["pr_number"].(float64)
+	p := gen.PullsListCommitsParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
 	}
-	res, err := c.PullsCreate(ctx, req, gen.PullsCreateParams{Owner: owner, Repo: repo})
+	res, err := c.PullsListCommits(ctx, p)
 	if err != nil {
 		return "", err
 	}
 	return toJSON(res)
 }
 
-func listPRFiles(ctx context.Context, params map[string]any) (string, error) {
+func listPRComments(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
@@ -1040,11 +2213,12 @@ func listPRFiles(ctx context.Context, params map[string]any) (string, error) {
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
 	prNumber, _ := params["pr_number"].(float64)
-	p := gen.PullsListFilesParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
+	p := gen.PullsListReviewCommentsParams{Owner: owner, Repo: repo, PullNumber: int(prNumber)}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
 	}
-	res, err := c.PullsListFiles(ctx, p)
+	res, err := c.PullsListReviewComments(ctx, p)
 	if err != nil {
 		return "", err
 	}
@@ -1065,9 +2239,7 @@ func searchRepos(ctx context.Context, params map[string]any) (string, error) {
 	if sort, ok := params["sort"].(string); ok && sort != "" {
 		p.Sort.SetTo(sort)
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -1085,9 +2257,7 @@ func searchCode(ctx context.Context, params map[string]any) (string, error) {
 	}
 	query, _ := params["query"].(string)
 	p := gen.SearchCodeParams{Q: query}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -1108,9 +2278,30 @@ func searchIssues(ctx context.Context, params map[string]any) (string, error) {
 	if sort, ok := params["sort"].(string); ok && sort != "" {
 		p.Sort.SetTo(sort)
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.SearchIssues(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+// findIssues builds a search_issues query scoped to one repository from
+// structured filters and returns the search result with the query it ran.
+func findIssues(ctx context.Context, params map[string]any) (string, error) {
+	query, err := issueSearchQuery(params)
+	if err != nil {
+		return "", err
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
 	}
+	p := gen.SearchIssuesParams{Q: query}
+	p.PerPage.SetTo(pageSize(params))
 	if pg, ok := params["page"].(float64); ok {
 		p.Page.SetTo(int(pg))
 	}
@@ -1118,6 +2309,116 @@ func searchIssues(ctx context.Context, params map[string]any) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	b, err := json.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+	var result map[string]any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return "", err
+	}
+	result["query"] = query
+	return toJSON(result)
+}
+
+// issueSearchQuery turns find_issues filters into GitHub search syntax. Sorting
+// goes into the query (sort:field-direction) so the direction is honoured too.
+func issueSearchQuery(params map[string]any) (string, error) {
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	terms := []string{"repo:" + owner + "/" + repo}
+
+	switch t, _ := params["type"].(string); t {
+	case "", "issue":
+		terms = append(terms, "is:issue")
+	case "pr":
+		terms = append(terms, "is:pr")
+	case "all":
+	default:
+		return "", fmt.Errorf("invalid type %q: use issue, pr or all", t)
+	}
+	switch state, _ := params["state"].(string); state {
+	case "", "open":
+		terms = append(terms, "is:open")
+	case "closed":
+		terms = append(terms, "is:closed")
+	case "all":
+	default:
+		return "", fmt.Errorf("invalid state %q: use open, closed or all", state)
+	}
+	if labels, ok := params["labels"].([]any); ok {
+		for _, label := range modules.ToStringSlice(labels) {
+			terms = append(terms, "label:"+searchValue(label))
+		}
+	}
+	if assignee, _ := params["assignee"].(string); assignee == "none" {
+		terms = append(terms, "no:assignee")
+	} else if assignee != "" {
+		terms = append(terms, "assignee:"+assignee)
+	}
+	if author, _ := params["author"].(string); author != "" {
+		terms = append(terms, "author:"+author)
+	}
+	if milestone, _ := params["milestone"].(string); milestone == "none" {
+		terms = append(terms, "no:milestone")
+	} else if milestone != "" {
+		terms = append(terms, "milestone:"+searchValue(milestone))
+	}
+	if since, _ := params["since"].(string); since != "" {
+		ts, err := modules.NormalizeDateTime(since, nil)
+		if err != nil {
+			return "", fmt.Errorf("invalid since: %w", err)
+		}
+		terms = append(terms, "updated:>="+ts)
+	}
+	if sortBy, _ := params["sort"].(string); sortBy != "" {
+		switch sortBy {
+		case "created", "updated", "comments", "reactions":
+		default:
+			return "", fmt.Errorf("invalid sort %q: use created, updated, comments or reactions", sortBy)
+		}
+		direction, _ := params["direction"].(string)
+		switch direction {
+		case "":
+			direction = "desc"
+		case "asc", "desc":
+		default:
+			return "", fmt.Errorf("invalid direction %q: use asc or desc", direction)
+		}
+		terms = append(terms, "sort:"+sortBy+"-"+direction)
+	}
+	if text, _ := params["text"].(string); text != "" {
+		terms = append(terms, text)
+	}
+	return strings.Join(terms, " "), nil
+}
+
+// searchValue quotes a qualifier value that contains spaces.
+func searchValue(v string) string {
+	if strings.ContainsAny(v, " \t") {
+		return `"` + strings.ReplaceAll(v, `"`, "") + `"`
+	}
+	return v
+}
+
+func searchUsers(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	query, _ := params["query"].(string)
+	p := gen.SearchUsersParams{Q: query}
+	if sort, ok := params["sort"].(string); ok && sort != "" {
+		p.Sort.SetTo(sort)
+	}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.SearchUsers(ctx, p)
+	if err != nil {
+		return "", err
+	}
 	return toJSON(res)
 }
 
@@ -1133,9 +2434,7 @@ func listWorkflows(ctx context.Context, params map[string]any) (string, error) {
 	owner, _ := params["owner"].(string)
 	repo, _ := params["repo"].(string)
 	p := gen.ActionsListWorkflowsParams{Owner: owner, Repo: repo}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	res, err := c.ActionsListWorkflows(ctx, p)
 	if err != nil {
 		return "", err
@@ -1157,9 +2456,7 @@ func listWorkflowRuns(ctx context.Context, params map[string]any) (string, error
 		if status, ok := params["status"].(string); ok && status != "" {
 			p.Status.SetTo(status)
 		}
-		if pp, ok := params["per_page"].(float64); ok {
-			p.PerPage.SetTo(int(pp))
-		}
+		p.PerPage.SetTo(pageSize(params))
 		res, err := c.ActionsListWorkflowRunsById(ctx, p)
 		if err != nil {
 			return "", err
@@ -1171,9 +2468,7 @@ func listWorkflowRuns(ctx context.Context, params map[string]any) (string, error
 	if status, ok := params["status"].(string); ok && status != "" {
 		p.Status.SetTo(status)
 	}
-	if pp, ok := params["per_page"].(float64); ok {
-		p.PerPage.SetTo(int(pp))
-	}
+	p.PerPage.SetTo(pageSize(params))
 	res, err := c.ActionsListWorkflowRuns(ctx, p)
 	if err != nil {
 		return "", err
@@ -1182,26 +2477,263 @@ func listWorkflowRuns(ctx context.Context, params map[string]any) (string, error
 }
 
 // =============================================================================
-// Composite: describe_user
+// Gists
 // =============================================================================
 
-func describeUser(ctx context.Context, params map[string]any) (string, error) {
+func listGists(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	var perPage, page gen.OptInt
+	perPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		page.SetTo(int(pg))
+	}
+	var res []gen.Gist
+	if username, ok := params["username"].(string); ok && username != "" {
+		res, err = c.GistsListForUser(ctx, gen.GistsListForUserParams{Username: username, PerPage: perPage, Page: page})
+	} else {
+		res, err = c.GistsList(ctx, gen.GistsListParams{PerPage: perPage, Page: page})
+	}
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func createGist(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	files, _ := params["files"].(map[string]any)
+	if len(files) == 0 {
+		return "", fmt.Errorf("files must contain at least one file")
+	}
+	raw, err := gistFiles(files)
+	if err != nil {
+		return "", err
+	}
+	req := &gen.CreateGistRequest{Files: raw}
+	if d, ok := params["description"].(string); ok {
+		req.Description.SetTo(d)
+	}
+	if pub, ok := params["public"].(bool); ok {
+		req.Public.SetTo(pub)
+	}
+	res, err := c.GistsCreate(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func updateGist(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	gistID, _ := params["gist_id"].(string)
+	req := &gen.UpdateGistRequest{}
+	if files, ok := params["files"].(map[string]any); ok && len(files) > 0 {
+		raw, err := gistFiles(files)
+		if err != nil {
+			return "", err
+		}
+		req.Files = raw
+	}
+	if d, ok := params["description"].(string); ok {
+		req.Description.SetTo(d)
+	}
+	if req.Files == nil && !req.Description.Set {
+		return "", fmt.Errorf("at least one of files or description is required")
+	}
+	res, err := c.GistsUpdate(ctx, req, gen.GistsUpdateParams{GistID: gistID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+// gistFiles converts {name: content} into the API's {name: {"content": content}} form.
+// A null value is passed through so update_gist can delete the file; object values
+// (e.g. {"filename": "new.py"} to rename) are passed as-is.
+func gistFiles(files map[string]any) ([]byte, error) {
+	out := make(map[string]any, len(files))
+	for name, v := range files {
+		switch fv := v.(type) {
+		case string:
+			out[name] = map[string]string{"content": fv}
+		case nil, map[string]any:
+			out[name] = fv
+		default:
+			return nil, fmt.Errorf("file %q: content must be a string", name)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// =============================================================================
+// Notifications
+// =============================================================================
+
+func listNotifications(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	p := gen.ActivityListNotificationsForAuthenticatedUserParams{}
+	if all, ok := params["all"].(bool); ok {
+		p.All.SetTo(all)
+	}
+	if participating, ok := params["participating"].(bool); ok {
+		p.Participating.SetTo(participating)
+	}
+	if since, ok := params["since"].(string); ok && since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return "", fmt.Errorf("invalid since (expected ISO 8601, e.g. 2024-01-01T00:00:00Z): %w", err)
+		}
+		p.Since.SetTo(t)
+	}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.ActivityListNotificationsForAuthenticatedUser(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func markNotificationRead(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	threadID, _ := params["thread_id"].(string)
+	id, err := strconv.ParseInt(threadID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid thread_id: %s", threadID)
+	}
+	if err := c.ActivityMarkThreadAsRead(ctx, gen.ActivityMarkThreadAsReadParams{ThreadID: id}); err != nil {
+		return "", err
+	}
+	return `{"success":true,"message":"Notification marked as read"}`, nil
+}
+
+// =============================================================================
+// Collaborators
+// =============================================================================
+
+func listCollaborators(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
+	p := gen.ReposListCollaboratorsParams{Owner: owner, Repo: repo}
+	if affiliation, ok := params["affiliation"].(string); ok && affiliation != "" {
+		p.Affiliation.SetTo(affiliation)
+	}
+	if permission, ok := params["permission"].(string); ok && permission != "" {
+		p.Permission.SetTo(permission)
+	}
+	p.PerPage.SetTo(pageSize(params))
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+	res, err := c.ReposListCollaborators(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func addCollaborator(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	owner, _ := params["owner"].(string)
+	repo, _ := params["repo"].(string)
 	username, _ := params["username"].(string)
+	req := &gen.AddCollaboratorRequest{}
+	if permission, ok := params["permission"].(string); ok && permission != "" {
+		req.Permission.SetTo(permission)
+	}
+	res, err := c.ReposAddCollaborator(ctx, req, gen.ReposAddCollaboratorParams{Owner: owner, Repo: repo, Username: username})
+	if err != nil {
+		return "", err
+	}
+	// 201 returns the invitation; 204 means the user already had access
+	if invitation, ok := res.(*gen.RepositoryInvitation); ok {
+		return toJSON(invitation)
+	}
+	return toJSON(map[string]any{
+		"success": true,
+		"message": fmt.Sprintf("%s already has access to %s/%s; no invitation was sent", username, owner, repo),
+	})
+}
+
+// =============================================================================
+// Composite helpers
+// =============================================================================
 
+// fanOutCall is one sub-call of a composite tool.
+type fanOutCall struct {
+	key    string
+	params map[string]any
+	fn     toolHandler
+}
+
+// fanOut runs calls concurrently and returns each result by key ("" when the
+// sub-call failed, so composites degrade to partial data). If ctx is cancelled
+// (client disconnected or tool timeout) it returns ctx.Err() without waiting;
+// the sub-calls share ctx, so their in-flight upstream requests are aborted too.
+// The channel is buffered so the abandoned goroutines never block on send.
+func fanOut(ctx context.Context, calls []fanOutCall) (map[string]string, error) {
 	type result struct {
 		key string
 		val string
 		err error
 	}
 
-	ch := make(chan result, 5)
-	var wg sync.WaitGroup
+	ch := make(chan result, len(calls))
+	for _, c := range calls {
+		go func(c fanOutCall) {
+			v, err := c.fn(ctx, c.params)
+			ch <- result{key: c.key, val: v, err: err}
+		}(c)
+	}
+
+	raw := make(map[string]string, len(calls))
+	for range calls {
+		select {
+		case r := <-ch:
+			if r.err == nil {
+				raw[r.key] = r.val
+			} else {
+				raw[r.key] = ""
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return raw, nil
+}
+
+// =============================================================================
+// Composite: describe_user
+// =============================================================================
+
+func describeUser(ctx context.Context, params map[string]any) (string, error) {
+	username, _ := params["username"].(string)
 
-	calls := []struct {
-		key    string
-		params map[string]any
-		fn     toolHandler
-	}{
+	calls := []fanOutCall{
 		{"profile", map[string]any{"username": username}, getUser},
 		{"repos", map[string]any{"username": username, "sort": "updated", "per_page": float64(10)}, listRepos},
 		{"starred", map[string]any{"username": username, "per_page": float64(10)}, listStarredRepos},
@@ -1209,27 +2741,9 @@ func describeUser(ctx context.Context, params map[string]any) (string, error) {
 		{"events", map[string]any{"username": username, "per_page": float64(10)}, listPublicEvents},
 	}
 
-	for _, c := range calls {
-		wg.Add(1)
-		go func(key string, fn toolHandler, p map[string]any) {
-			defer wg.Done()
-			v, err := fn(ctx, p)
-			ch <- result{key: key, val: v, err: err}
-		}(c.key, c.fn, c.params)
-	}
-
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	raw := make(map[string]string, 5)
-	for r := range ch {
-		if r.err != nil {
-			raw[r.key] = ""
-		} else {
-			raw[r.key] = r.val
-		}
+	raw, err := fanOut(ctx, calls)
+	if err != nil {
+		return "", err
 	}
 
 	out := map[string]any{}
@@ -1343,20 +2857,7 @@ func describeRepo(ctx context.Context, params map[string]any) (string, error) {
 	owner, _ := params["owner"].(string)
 	repoName, _ := params["repo"].(string)
 
-	type result struct {
-		key string
-		val string
-		err error
-	}
-
-	ch := make(chan result, 5)
-	var wg sync.WaitGroup
-
-	calls := []struct {
-		key    string
-		params map[string]any
-		fn     toolHandler
-	}{
+	calls := []fanOutCall{
 		{"repo", map[string]any{"owner": owner, "repo": repoName}, getRepo},
 		{"readme", map[string]any{"owner": owner, "repo": repoName, "path": "README.md"}, getFileContent},
 		{"branches", map[string]any{"owner": owner, "repo": repoName, "per_page": float64(10)}, listBranches},
@@ -1364,27 +2865,9 @@ func describeRepo(ctx context.Context, params map[string]any) (string, error) {
 		{"prs", map[string]any{"owner": owner, "repo": repoName, "state": "open", "per_page": float64(10)}, listPRs},
 	}
 
-	for _, c := range calls {
-		wg.Add(1)
-		go func(key string, fn toolHandler, p map[string]any) {
-			defer wg.Done()
-			v, err := fn(ctx, p)
-			ch <- result{key: key, val: v, err: err}
-		}(c.key, c.fn, c.params)
-	}
-
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	raw := make(map[string]string, 5)
-	for r := range ch {
-		if r.err != nil {
-			raw[r.key] = ""
-		} else {
-			raw[r.key] = r.val
-		}
+	raw, err := fanOut(ctx, calls)
+	if err != nil {
+		return "", err
 	}
 
 	out := map[string]any{
@@ -1512,45 +2995,14 @@ func describePR(ctx context.Context, params map[string]any) (string, error) {
 	repoName, _ := params["repo"].(string)
 	prNumber, _ := params["pr_number"].(float64)
 
-	type result struct {
-		key string
-		val string
-		err error
-	}
-
-	ch := make(chan result, 2)
-	var wg sync.WaitGroup
-
-	calls := []struct {
-		key    string
-		params map[string]any
-		fn     toolHandler
-	}{
+	calls := []fanOutCall{
 		{"pr", map[string]any{"owner": owner, "repo": repoName, "pr_number": prNumber}, getPR},
 		{"files", map[string]any{"owner": owner, "repo": repoName, "pr_number": prNumber, "per_page": float64(30)}, listPRFiles},
 	}
 
-	for _, c := range calls {
-		wg.Add(1)
-		go func(key string, fn toolHandler, p map[string]any) {
-			defer wg.Done()
-			v, err := fn(ctx, p)
-			ch <- result{key: key, val: v, err: err}
-		}(c.key, c.fn, c.params)
-	}
-
-	go func() {
-		wg.Wait()
-		close(ch)
-	}()
-
-	raw := make(map[string]string, 2)
-	for r := range ch {
-		if r.err != nil {
-			raw[r.key] = ""
-		} else {
-			raw[r.key] = r.val
-		}
+	raw, err := fanOut(ctx, calls)
+	if err != nil {
+		return "", err
 	}
 
 	out := map[string]any{
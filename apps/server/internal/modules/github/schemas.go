@@ -0,0 +1,109 @@
+package github
+
+import "mcpist/server/internal/modules"
+
+// =============================================================================
+// Output Schemas (shape of the _format: "json" result)
+// =============================================================================
+
+func field(typ, description string) *modules.OutputSchema {
+	return &modules.OutputSchema{Type: typ, Description: description}
+}
+
+func arrayOf(items *modules.OutputSchema) *modules.OutputSchema {
+	return &modules.OutputSchema{Type: "array", Items: items}
+}
+
+var userSchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"login": field("string", "Username"),
+		"id":    field("integer", "User ID"),
+	},
+	Required: []string{"login", "id"},
+}
+
+var labelSchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"id":          field("integer", "Label ID"),
+		"name":        field("string", "Label name"),
+		"color":       field("string", "Hex color without #"),
+		"description": field("string", "Label description"),
+	},
+}
+
+var issueSchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"id":         field("integer", "Issue ID"),
+		"number":     field("integer", "Issue number"),
+		"title":      field("string", "Title"),
+		"state":      field("string", "open or closed"),
+		"body":       field("string", "Body (Markdown)"),
+		"html_url":   field("string", "Web URL"),
+		"user":       userSchema,
+		"labels":     arrayOf(labelSchema),
+		"assignees":  arrayOf(userSchema),
+		"created_at": field("string", "RFC3339 timestamp"),
+		"updated_at": field("string", "RFC3339 timestamp"),
+		"closed_at":  field("string", "RFC3339 timestamp, absent while open"),
+	},
+	Required: []string{"id", "number", "title", "state", "html_url"},
+}
+
+var branchRefSchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"ref": field("string", "Branch name"),
+		"sha": field("string", "Commit SHA"),
+	},
+}
+
+var pullRequestSchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"id":                  field("integer", "Pull request ID"),
+		"number":              field("integer", "PR number"),
+		"title":               field("string", "Title"),
+		"state":               field("string", "open or closed"),
+		"body":                field("string", "Body (Markdown)"),
+		"html_url":            field("string", "Web URL"),
+		"user":                userSchema,
+		"draft":               field("boolean", "Draft PR"),
+		"merged":              field("boolean", "Merged (get_pr only)"),
+		"head":                branchRefSchema,
+		"base":                branchRefSchema,
+		"requested_reviewers": arrayOf(userSchema),
+		"created_at":          field("string", "RFC3339 timestamp"),
+		"updated_at":          field("string", "RFC3339 timestamp"),
+		"merged_at":           field("string", "RFC3339 timestamp, absent until merged"),
+	},
+	Required: []string{"id", "number", "title", "state", "html_url"},
+}
+
+var repositorySchema = &modules.OutputSchema{
+	Type: "object",
+	Properties: map[string]*modules.OutputSchema{
+		"id":                field("integer", "Repository ID"),
+		"name":              field("string", "Repository name"),
+		"full_name":         field("string", "owner/name"),
+		"description":       field("string", "Description"),
+		"private":           field("boolean", "Private repository"),
+		"html_url":          field("string", "Web URL"),
+		"owner":             userSchema,
+		"fork":              field("boolean", "Is a fork"),
+		"language":          field("string", "Primary language"),
+		"stargazers_count":  field("integer", "Stars"),
+		"forks_count":       field("integer", "Forks"),
+		"open_issues_count": field("integer", "Open issues and PRs"),
+		"default_branch":    field("string", "Default branch"),
+		"topics":            arrayOf(field("string", "")),
+		"archived":          field("boolean", "Archived"),
+		"visibility":        field("string", "public, private or internal"),
+		"pushed_at":         field("string", "RFC3339 timestamp"),
+		"created_at":        field("string", "RFC3339 timestamp"),
+		"updated_at":        field("string", "RFC3339 timestamp"),
+	},
+	Required: []string{"id", "name", "full_name", "private", "owner", "html_url"},
+}
@@ -50,6 +50,7 @@ func (m *GoogleAppsScriptModule) ExecuteTool(ctx context.Context, name string, p
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -58,6 +59,12 @@ func (m *GoogleAppsScriptModule) ToCompact(toolName string, jsonResult string) s
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleAppsScriptModule) HealthProbe() (string, map[string]any) {
+	return "list_projects", nil
+}
+
 // =============================================================================
 // Token and Client
 // =============================================================================
@@ -77,6 +84,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_apps_script", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -459,13 +467,7 @@ func listProjects(ctx context.Context, params map[string]any) (string, error) {
 		q += fmt.Sprintf(" and name contains '%s'", searchQuery)
 	}
 
-	pageSize := 20
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 20, 100)
 
 	resp, err := cli.ListFiles(ctx, driveGen.ListFilesParams{
 		Q:        driveGen.NewOptString(q),
@@ -16,8 +16,10 @@ func formatCompact(toolName, jsonStr string) string {
 		return calendarsCSV(jsonStr)
 	case "list_events":
 		return eventsCSV(jsonStr)
-	case "create_event", "update_event", "quick_add":
+	case "create_event", "update_event", "move_event", "quick_add":
 		return pickKeys(jsonStr, "id", "summary", "htmlLink")
+	case "create_events":
+		return createEventsCompact(jsonStr)
 	case "get_calendar":
 		return pickKeys(jsonStr, "id", "summary", "timeZone")
 	default:
@@ -25,7 +27,33 @@ func formatCompact(toolName, jsonStr string) string {
 	}
 }
 
-// calendarsCSV formats list_calendars response → CSV: id, summary, primary, accessRole.
+// createEventsCompact formats a create_events result → summary line + CSV: index, summary, id, error.
+func createEventsCompact(jsonStr string) string {
+	var data struct {
+		Created int              `json:"created"`
+		Failed  int              `json:"failed"`
+		Results []map[string]any `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %d created, %d failed\n", data.Created, data.Failed))
+	sb.WriteString("```csv\nindex,summary,id,error\n")
+	for _, r := range data.Results {
+		index, _ := r["index"].(float64)
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s\n",
+			int(index),
+			csvEscape(str(r, "summary")),
+			str(r, "id"),
+			csvEscape(str(r, "error")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// calendarsCSV formats list_calendars response → CSV: id, summary, primary, accessRole, backgroundColor.
 func calendarsCSV(jsonStr string) string {
 	var data map[string]any
 	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
@@ -36,7 +64,7 @@ func calendarsCSV(jsonStr string) string {
 		return "# 0 calendars"
 	}
 	var sb strings.Builder
-	sb.WriteString("```csv\nid,summary,primary,accessRole\n")
+	sb.WriteString("```csv\nid,summary,primary,accessRole,backgroundColor\n")
 	for _, item := range items {
 		m, ok := item.(map[string]any)
 		if !ok {
@@ -46,11 +74,12 @@ func calendarsCSV(jsonStr string) string {
 		if p, ok := m["primary"].(bool); ok && p {
 			primary = "true"
 		}
-		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s\n",
 			csvEscape(str(m, "id")),
 			csvEscape(str(m, "summary")),
 			primary,
 			str(m, "accessRole"),
+			str(m, "backgroundColor"),
 		))
 	}
 	sb.WriteString("```")
@@ -2,8 +2,10 @@ package google_calendar
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"mcpist/server/internal/broker"
@@ -46,6 +48,7 @@ func (m *GoogleCalendarModule) ExecuteTool(ctx context.Context, name string, par
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -54,6 +57,12 @@ func (m *GoogleCalendarModule) ToCompact(toolName string, jsonResult string) str
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleCalendarModule) HealthProbe() (string, map[string]any) {
+	return "list_calendars", nil
+}
+
 // =============================================================================
 // Token and Client
 // =============================================================================
@@ -73,6 +82,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_calendar", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -89,8 +99,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "google_calendar:list_calendars",
 		Name: "list_calendars",
 		Descriptions: modules.LocalizedText{
-			"en-US": "List all calendars accessible to the user.",
-			"ja-JP": "ユーザーがアクセス可能なすべてのカレンダーを一覧表示します。",
+			"en-US": "List all calendars accessible to the user (id, summary, primary, accessRole, backgroundColor). Use the returned id as calendar_id in event tools to reach non-primary calendars.",
+			"ja-JP": "ユーザーがアクセス可能なすべてのカレンダーを一覧表示します（ID、名前、プライマリ、アクセス権限、背景色）。返されたIDをイベント系ツールのcalendar_idに指定すると、プライマリ以外のカレンダーを操作できます。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
@@ -177,6 +187,28 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"calendar_id", "summary", "start_time", "end_time"},
 		},
 	},
+	{
+		ID:   "google_calendar:create_events",
+		Name: "create_events",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create several events in one calendar at once (up to 50), e.g. a series of distinct meetings. Events are created in parallel and each gets its own result, so one failure does not stop the others.",
+			"ja-JP": "1つのカレンダーに複数のイベント（最大50件）をまとめて作成します（例: 内容の異なる一連のミーティング）。イベントは並列に作成され、それぞれ個別の結果が返るため、1件の失敗が他に影響しません。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"calendar_id": {Type: "string", Description: "Calendar ID. Use 'primary' for the user's primary calendar."},
+				"events": {
+					Type:        "array",
+					Description: "Events to create. Each element takes the create_event fields: {summary, start_time, end_time, description, location, all_day, attendees, timezone}",
+					Items:       &modules.Property{Type: "object"},
+				},
+				"timezone": {Type: "string", Description: "Default timezone for events that don't set one (e.g., 'Asia/Tokyo'). Default: UTC"},
+			},
+			Required: []string{"calendar_id", "events"},
+		},
+	},
 	{
 		ID:   "google_calendar:update_event",
 		Name: "update_event",
@@ -217,6 +249,24 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"calendar_id", "event_id"},
 		},
 	},
+	{
+		ID:   "google_calendar:move_event",
+		Name: "move_event",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Move an event to another calendar. The event keeps its ID; only events owned by the source calendar can be moved.",
+			"ja-JP": "イベントを別のカレンダーに移動します。イベントIDは変わりません。移動元カレンダーが所有するイベントのみ移動できます。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"calendar_id":             {Type: "string", Description: "Source calendar ID"},
+				"event_id":                {Type: "string", Description: "Event ID"},
+				"destination_calendar_id": {Type: "string", Description: "Calendar ID to move the event to (see list_calendars)"},
+			},
+			Required: []string{"calendar_id", "event_id", "destination_calendar_id"},
+		},
+	},
 	{
 		ID:   "google_calendar:quick_add",
 		Name: "quick_add",
@@ -248,8 +298,10 @@ var toolHandlers = map[string]toolHandler{
 	"list_events":    listEvents,
 	"get_event":      getEvent,
 	"create_event":   createEvent,
+	"create_events":  createEvents,
 	"update_event":   updateEvent,
 	"delete_event":   deleteEvent,
+	"move_event":     moveEvent,
 	"quick_add":      quickAdd,
 }
 
@@ -300,10 +352,7 @@ func listEvents(ctx context.Context, params map[string]any) (string, error) {
 		timeMax = tm
 	}
 
-	maxResults := 50
-	if mr, ok := params["max_results"].(float64); ok {
-		maxResults = int(mr)
-	}
+	maxResults := modules.PageSize(params, "max_results", 50, 2500)
 
 	singleEvents := true
 	if se, ok := params["single_events"].(bool); ok {
@@ -379,13 +428,16 @@ func createEvent(ctx context.Context, params map[string]any) (string, error) {
 	}
 
 	allDay, _ := params["all_day"].(bool)
-	if allDay {
-		req.Start = gen.NewOptEventDateTime(gen.EventDateTime{Date: gen.NewOptNilString(startTime)})
-		req.End = gen.NewOptEventDateTime(gen.EventDateTime{Date: gen.NewOptNilString(endTime)})
-	} else {
-		req.Start = gen.NewOptEventDateTime(gen.EventDateTime{DateTime: gen.NewOptNilString(startTime), TimeZone: gen.NewOptNilString(timezone)})
-		req.End = gen.NewOptEventDateTime(gen.EventDateTime{DateTime: gen.NewOptNilString(endTime), TimeZone: gen.NewOptNilString(timezone)})
+	start, err := eventDateTime("start_time", startTime, allDay, timezone)
+	if err != nil {
+		return "", err
 	}
+	end, err := eventDateTime("end_time", endTime, allDay, timezone)
+	if err != nil {
+		return "", err
+	}
+	req.Start = gen.NewOptEventDateTime(start)
+	req.End = gen.NewOptEventDateTime(end)
 
 	if attendees, ok := params["attendees"].([]interface{}); ok && len(attendees) > 0 {
 		list := make([]gen.EventAttendee, 0, len(attendees))
@@ -408,6 +460,91 @@ func createEvent(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+const (
+	// maxBatchEvents caps the events created by one create_events call
+	maxBatchEvents = 50
+	// batchEventConcurrency bounds in-flight inserts to stay under Calendar rate limits
+	batchEventConcurrency = 5
+)
+
+// batchEventResult is the outcome of one event in create_events
+type batchEventResult struct {
+	Index    int    `json:"index"`
+	Summary  string `json:"summary"`
+	Created  bool   `json:"created"`
+	ID       string `json:"id,omitempty"`
+	HTMLLink string `json:"htmlLink,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func createEvents(ctx context.Context, params map[string]any) (string, error) {
+	calendarID, _ := params["calendar_id"].(string)
+	timezone, _ := params["timezone"].(string)
+	raw, _ := params["events"].([]any)
+	if len(raw) == 0 {
+		return "", fmt.Errorf("events must contain at least one {summary, start_time, end_time} object")
+	}
+	if len(raw) > maxBatchEvents {
+		return "", fmt.Errorf("at most %d events can be created per call, got %d", maxBatchEvents, len(raw))
+	}
+
+	results := make([]batchEventResult, len(raw))
+	sem := make(chan struct{}, batchEventConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range raw {
+		event, _ := item.(map[string]any)
+		results[i].Index = i
+		results[i].Summary, _ = event["summary"].(string)
+		if event == nil || results[i].Summary == "" || event["start_time"] == nil || event["end_time"] == nil {
+			results[i].Error = "summary, start_time and end_time are required"
+			continue
+		}
+		eventParams := make(map[string]any, len(event)+2)
+		for k, v := range event {
+			eventParams[k] = v
+		}
+		eventParams["calendar_id"] = calendarID
+		if _, ok := eventParams["timezone"]; !ok && timezone != "" {
+			eventParams["timezone"] = timezone
+		}
+		wg.Add(1)
+		go func(res *batchEventResult) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				res.Error = ctx.Err().Error()
+				return
+			}
+			defer func() { <-sem }()
+			out, err := createEvent(ctx, eventParams)
+			if err != nil {
+				res.Error = modules.WithUpstreamStatus(err).Error()
+				return
+			}
+			var created struct {
+				ID       string `json:"id"`
+				HTMLLink string `json:"htmlLink"`
+			}
+			json.Unmarshal([]byte(out), &created)
+			res.Created, res.ID, res.HTMLLink = true, created.ID, created.HTMLLink
+		}(&results[i])
+	}
+	wg.Wait()
+
+	created := 0
+	for _, r := range results {
+		if r.Created {
+			created++
+		}
+	}
+	return toJSON(map[string]any{
+		"created": created,
+		"failed":  len(results) - created,
+		"results": results,
+	})
+}
+
 func updateEvent(ctx context.Context, params map[string]any) (string, error) {
 	calendarID, _ := params["calendar_id"].(string)
 	eventID, _ := params["event_id"].(string)
@@ -444,20 +581,25 @@ func updateEvent(ctx context.Context, params map[string]any) (string, error) {
 		req.Location = gen.NewOptNilString(loc)
 	}
 
-	allDay, hasAllDay := params["all_day"].(bool)
+	// Naive times keep the event's existing time zone
+	timezone := ""
+	if start, ok := existing.Start.Get(); ok {
+		timezone, _ = start.TimeZone.Get()
+	}
+	allDay, _ := params["all_day"].(bool)
 	if startTime, ok := params["start_time"].(string); ok && startTime != "" {
-		if hasAllDay && allDay {
-			req.Start = gen.NewOptEventDateTime(gen.EventDateTime{Date: gen.NewOptNilString(startTime)})
-		} else {
-			req.Start = gen.NewOptEventDateTime(gen.EventDateTime{DateTime: gen.NewOptNilString(startTime)})
+		start, err := eventDateTime("start_time", startTime, allDay, timezone)
+		if err != nil {
+			return "", err
 		}
+		req.Start = gen.NewOptEventDateTime(start)
 	}
 	if endTime, ok := params["end_time"].(string); ok && endTime != "" {
-		if hasAllDay && allDay {
-			req.End = gen.NewOptEventDateTime(gen.EventDateTime{Date: gen.NewOptNilString(endTime)})
-		} else {
-			req.End = gen.NewOptEventDateTime(gen.EventDateTime{DateTime: gen.NewOptNilString(endTime)})
+		end, err := eventDateTime("end_time", endTime, allDay, timezone)
+		if err != nil {
+			return "", err
 		}
+		req.End = gen.NewOptEventDateTime(end)
 	}
 
 	res, err := c.UpdateEvent(ctx, req, gen.UpdateEventParams{CalendarId: calendarID, EventId: eventID})
@@ -467,6 +609,27 @@ func updateEvent(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// eventDateTime converts a start_time/end_time param to an EventDateTime.
+// Date-times without an offset, and relative dates, are taken in timezone;
+// with no timezone they are sent as UTC.
+func eventDateTime(key, value string, allDay bool, timezone string) (gen.EventDateTime, error) {
+	var loc *time.Location
+	if timezone != "" {
+		loc, _ = time.LoadLocation(timezone)
+	}
+	d, err := modules.ParseDateInput(value, loc)
+	if err != nil {
+		return gen.EventDateTime{}, fmt.Errorf("%s: %w", key, err)
+	}
+	if allDay {
+		return gen.EventDateTime{Date: gen.NewOptNilString(d.Date())}, nil
+	}
+	if timezone == "" {
+		return gen.EventDateTime{DateTime: gen.NewOptNilString(d.RFC3339())}, nil
+	}
+	return gen.EventDateTime{DateTime: gen.NewOptNilString(d.LocalDateTime()), TimeZone: gen.NewOptNilString(timezone)}, nil
+}
+
 func deleteEvent(ctx context.Context, params map[string]any) (string, error) {
 	calendarID, _ := params["calendar_id"].(string)
 	eventID, _ := params["event_id"].(string)
@@ -482,6 +645,22 @@ func deleteEvent(ctx context.Context, params map[string]any) (string, error) {
 	return `{"success":true,"message":"Event deleted"}`, nil
 }
 
+func moveEvent(ctx context.Context, params map[string]any) (string, error) {
+	calendarID, _ := params["calendar_id"].(string)
+	eventID, _ := params["event_id"].(string)
+	destination, _ := params["destination_calendar_id"].(string)
+
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.MoveEvent(ctx, gen.MoveEventParams{CalendarId: calendarID, EventId: eventID, Destination: destination})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func quickAdd(ctx context.Context, params map[string]any) (string, error) {
 	calendarID, _ := params["calendar_id"].(string)
 	text, _ := params["text"].(string)
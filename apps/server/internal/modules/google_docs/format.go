@@ -18,8 +18,8 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "documentId", "title")
 	case "append_text", "insert_text", "delete_range",
 		"apply_text_style", "apply_paragraph_style",
-		"insert_table", "insert_page_break", "insert_image":
-		return pickKeys(jsonStr, "documentId")
+		"insert_table", "insert_page_break", "insert_horizontal_rule", "insert_image":
+		return pickKeys(jsonStr, "documentId", "suggested", "comment_id", "message")
 	case "add_comment":
 		return pickKeys(jsonStr, "id", "content")
 	case "delete_comment", "resolve_comment":
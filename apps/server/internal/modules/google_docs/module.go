@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	"unicode/utf16"
 
 	"github.com/go-faster/jx"
 
@@ -51,6 +53,7 @@ func (m *GoogleDocsModule) ExecuteTool(ctx context.Context, name string, params
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -78,6 +81,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_docs", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -101,14 +105,16 @@ var toolDefinitions = []modules.Tool{
 	{ID: "google_docs:get_document", Name: "get_document", Descriptions: modules.LocalizedText{"en-US": "Get a Google Document's metadata and structure.", "ja-JP": "Google ドキュメントのメタデータと構造を取得します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}}, Required: []string{"document_id"}}},
 	{ID: "google_docs:read_document", Name: "read_document", Descriptions: modules.LocalizedText{"en-US": "Read a Google Document's content as plain text.", "ja-JP": "Google ドキュメントの内容をプレーンテキストとして読み取ります。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}}, Required: []string{"document_id"}}},
 	{ID: "google_docs:list_tabs", Name: "list_tabs", Descriptions: modules.LocalizedText{"en-US": "List all tabs in a multi-tab document.", "ja-JP": "マルチタブドキュメントの全タブを一覧表示します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}}, Required: []string{"document_id"}}},
+	{ID: "google_docs:read_tab", Name: "read_tab", Descriptions: modules.LocalizedText{"en-US": "Read the content of a single tab of a multi-tab document as plain text. Use list_tabs to find tab IDs.", "ja-JP": "マルチタブドキュメントの単一タブの内容をプレーンテキストとして読み取ります。タブIDはlist_tabsで確認できます。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "tab_id": {Type: "string", Description: "Tab ID"}}, Required: []string{"document_id", "tab_id"}}},
 	{ID: "google_docs:create_document", Name: "create_document", Descriptions: modules.LocalizedText{"en-US": "Create a new Google Document.", "ja-JP": "新しい Google ドキュメントを作成します。"}, Annotations: modules.AnnotateCreate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"title": {Type: "string", Description: "Document title"}}, Required: []string{"title"}}},
-	{ID: "google_docs:append_text", Name: "append_text", Descriptions: modules.LocalizedText{"en-US": "Append text to the end of a document.", "ja-JP": "ドキュメントの末尾にテキストを追加します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "text": {Type: "string", Description: "Text to append"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "text"}}},
-	{ID: "google_docs:insert_text", Name: "insert_text", Descriptions: modules.LocalizedText{"en-US": "Insert text at a specific position in the document.", "ja-JP": "ドキュメントの指定位置にテキストを挿入します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "text": {Type: "string", Description: "Text to insert"}, "index": {Type: "number", Description: "Position index (1-based). Use 1 for document start."}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "text", "index"}}},
-	{ID: "google_docs:delete_range", Name: "delete_range", Descriptions: modules.LocalizedText{"en-US": "Delete content from a specified range in the document.", "ja-JP": "ドキュメントの指定範囲のコンテンツを削除します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "start_index": {Type: "number", Description: "Start position index (1-based)"}, "end_index": {Type: "number", Description: "End position index (1-based, exclusive)"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "start_index", "end_index"}}},
+	{ID: "google_docs:append_text", Name: "append_text", Descriptions: modules.LocalizedText{"en-US": "Append text to the end of a document. With suggest: true, the addition is posted as a comment for human review instead.", "ja-JP": "ドキュメントの末尾にテキストを追加します。suggest: true の場合は直接編集せず、レビュー用のコメントとして提案します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "text": {Type: "string", Description: "Text to append"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}, "suggest": {Type: "boolean", Description: "Propose the edit as a comment for review instead of applying it (default: false)"}}, Required: []string{"document_id", "text"}}},
+	{ID: "google_docs:insert_text", Name: "insert_text", Descriptions: modules.LocalizedText{"en-US": "Insert text at a specific position in the document. With suggest: true, the insertion is posted as a comment for human review instead.", "ja-JP": "ドキュメントの指定位置にテキストを挿入します。suggest: true の場合は直接編集せず、レビュー用のコメントとして提案します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "text": {Type: "string", Description: "Text to insert"}, "index": {Type: "number", Description: "Position index (1-based). Use 1 for document start."}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}, "suggest": {Type: "boolean", Description: "Propose the edit as a comment for review instead of applying it (default: false)"}}, Required: []string{"document_id", "text", "index"}}},
+	{ID: "google_docs:delete_range", Name: "delete_range", Descriptions: modules.LocalizedText{"en-US": "Delete content from a specified range in the document. With suggest: true, the deletion is posted as a comment quoting the range for human review instead.", "ja-JP": "ドキュメントの指定範囲のコンテンツを削除します。suggest: true の場合は直接削除せず、対象範囲を引用したレビュー用のコメントとして提案します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "start_index": {Type: "number", Description: "Start position index (1-based)"}, "end_index": {Type: "number", Description: "End position index (1-based, exclusive)"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}, "suggest": {Type: "boolean", Description: "Propose the edit as a comment for review instead of applying it (default: false)"}}, Required: []string{"document_id", "start_index", "end_index"}}},
 	{ID: "google_docs:apply_text_style", Name: "apply_text_style", Descriptions: modules.LocalizedText{"en-US": "Apply text styling (bold, italic, underline, colors) to a range.", "ja-JP": "指定範囲にテキストスタイル（太字、斜体、下線、色）を適用します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "start_index": {Type: "number", Description: "Start position index (1-based)"}, "end_index": {Type: "number", Description: "End position index (1-based, exclusive)"}, "bold": {Type: "boolean", Description: "Apply bold"}, "italic": {Type: "boolean", Description: "Apply italic"}, "underline": {Type: "boolean", Description: "Apply underline"}, "strikethrough": {Type: "boolean", Description: "Apply strikethrough"}, "font_size": {Type: "number", Description: "Font size in points"}, "foreground_color": {Type: "string", Description: "Text color in hex format (e.g., '#FF0000')"}, "background_color": {Type: "string", Description: "Background color in hex format"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "start_index", "end_index"}}},
-	{ID: "google_docs:apply_paragraph_style", Name: "apply_paragraph_style", Descriptions: modules.LocalizedText{"en-US": "Apply paragraph styling (alignment, spacing, indentation) to a range.", "ja-JP": "指定範囲に段落スタイル（配置、行間、インデント）を適用します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "start_index": {Type: "number", Description: "Start position index (1-based)"}, "end_index": {Type: "number", Description: "End position index (1-based, exclusive)"}, "alignment": {Type: "string", Description: "Alignment: 'START', 'CENTER', 'END', 'JUSTIFIED'"}, "line_spacing": {Type: "number", Description: "Line spacing multiplier (e.g., 1.0, 1.5, 2.0)"}, "indent_start": {Type: "number", Description: "Start indentation in points"}, "indent_end": {Type: "number", Description: "End indentation in points"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "start_index", "end_index"}}},
+	{ID: "google_docs:apply_paragraph_style", Name: "apply_paragraph_style", Descriptions: modules.LocalizedText{"en-US": "Apply paragraph styling (named style such as headings, alignment, spacing, indentation) to a range.", "ja-JP": "指定範囲に段落スタイル（見出しなどの名前付きスタイル、配置、行間、インデント）を適用します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "start_index": {Type: "number", Description: "Start position index (1-based)"}, "end_index": {Type: "number", Description: "End position index (1-based, exclusive)"}, "named_style_type": {Type: "string", Description: "Named style: 'NORMAL_TEXT', 'TITLE', 'SUBTITLE', 'HEADING_1' ... 'HEADING_6'"}, "alignment": {Type: "string", Description: "Alignment: 'START', 'CENTER', 'END', 'JUSTIFIED'"}, "line_spacing": {Type: "number", Description: "Line spacing multiplier (e.g., 1.0, 1.5, 2.0)"}, "indent_start": {Type: "number", Description: "Start indentation in points"}, "indent_end": {Type: "number", Description: "End indentation in points"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "start_index", "end_index"}}},
 	{ID: "google_docs:insert_table", Name: "insert_table", Descriptions: modules.LocalizedText{"en-US": "Insert a table at a specific position in the document.", "ja-JP": "ドキュメントの指定位置にテーブルを挿入します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "rows": {Type: "number", Description: "Number of rows"}, "columns": {Type: "number", Description: "Number of columns"}, "index": {Type: "number", Description: "Position index (1-based) to insert the table"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "rows", "columns", "index"}}},
 	{ID: "google_docs:insert_page_break", Name: "insert_page_break", Descriptions: modules.LocalizedText{"en-US": "Insert a page break at a specific position.", "ja-JP": "指定位置に改ページを挿入します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "index": {Type: "number", Description: "Position index (1-based)"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "index"}}},
+	{ID: "google_docs:insert_horizontal_rule", Name: "insert_horizontal_rule", Descriptions: modules.LocalizedText{"en-US": "Insert a horizontal rule (an empty paragraph with a bottom border) at a specific position. The index should be at the start of a paragraph.", "ja-JP": "指定位置に水平線（下罫線付きの空段落）を挿入します。インデックスは段落の先頭を指定してください。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "index": {Type: "number", Description: "Position index (1-based)"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "index"}}},
 	{ID: "google_docs:insert_image", Name: "insert_image", Descriptions: modules.LocalizedText{"en-US": "Insert an image from a URL at a specific position.", "ja-JP": "URLから画像を指定位置に挿入します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "image_url": {Type: "string", Description: "Public URL of the image"}, "index": {Type: "number", Description: "Position index (1-based)"}, "width": {Type: "number", Description: "Image width in points (optional)"}, "height": {Type: "number", Description: "Image height in points (optional)"}, "tab_id": {Type: "string", Description: "Tab ID for multi-tab documents (optional)"}}, Required: []string{"document_id", "image_url", "index"}}},
 	{ID: "google_docs:list_comments", Name: "list_comments", Descriptions: modules.LocalizedText{"en-US": "List all comments on a document.", "ja-JP": "ドキュメントの全コメントを一覧表示します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "page_size": {Type: "number", Description: "Maximum number of comments (1-100). Default: 20"}, "page_token": {Type: "string", Description: "Token for pagination"}}, Required: []string{"document_id"}}},
 	{ID: "google_docs:get_comment", Name: "get_comment", Descriptions: modules.LocalizedText{"en-US": "Get a specific comment with its replies.", "ja-JP": "特定のコメントとその返信を取得します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"document_id": {Type: "string", Description: "Document ID"}, "comment_id": {Type: "string", Description: "Comment ID"}}, Required: []string{"document_id", "comment_id"}}},
@@ -125,24 +131,26 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"get_document":          getDocument,
-	"read_document":         readDocument,
-	"list_tabs":             listTabs,
-	"create_document":       createDocument,
-	"append_text":           appendText,
-	"insert_text":           insertText,
-	"delete_range":          deleteRange,
-	"apply_text_style":      applyTextStyle,
-	"apply_paragraph_style": applyParagraphStyle,
-	"insert_table":          insertTable,
-	"insert_page_break":     insertPageBreak,
-	"insert_image":          insertImage,
-	"list_comments":         listComments,
-	"get_comment":           getComment,
-	"add_comment":           addComment,
-	"reply_to_comment":      replyToComment,
-	"resolve_comment":       resolveComment,
-	"delete_comment":        deleteComment,
+	"get_document":           getDocument,
+	"read_document":          readDocument,
+	"list_tabs":              listTabs,
+	"read_tab":               readTab,
+	"create_document":        createDocument,
+	"append_text":            appendText,
+	"insert_text":            insertText,
+	"delete_range":           deleteRange,
+	"apply_text_style":       applyTextStyle,
+	"apply_paragraph_style":  applyParagraphStyle,
+	"insert_table":           insertTable,
+	"insert_page_break":      insertPageBreak,
+	"insert_horizontal_rule": insertHorizontalRule,
+	"insert_image":           insertImage,
+	"list_comments":          listComments,
+	"get_comment":            getComment,
+	"add_comment":            addComment,
+	"reply_to_comment":       replyToComment,
+	"resolve_comment":        resolveComment,
+	"delete_comment":         deleteComment,
 }
 
 // =============================================================================
@@ -272,12 +280,20 @@ func listTabs(ctx context.Context, params map[string]any) (string, error) {
 	}
 	documentID, _ := params["document_id"].(string)
 
-	doc, err := cli.GetDocument(ctx, gen.GetDocumentParams{DocumentId: documentID})
+	// Tabs are only populated when includeTabsContent is set
+	doc, err := cli.GetDocument(ctx, gen.GetDocumentParams{
+		DocumentId:         documentID,
+		IncludeTabsContent: gen.NewOptBool(true),
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to get document: %w", err)
 	}
 
-	if len(doc.Tabs) == 0 {
+	tabs, err := documentTabs(doc)
+	if err != nil {
+		return "", err
+	}
+	if len(tabs) == 0 {
 		result := map[string]interface{}{
 			"document_id": documentID,
 			"tabs":        []interface{}{},
@@ -287,9 +303,111 @@ func listTabs(ctx context.Context, params map[string]any) (string, error) {
 		return string(b), nil
 	}
 
-	tabsJSON, _ := json.Marshal(doc.Tabs)
-	result := fmt.Sprintf(`{"document_id":"%s","tabs":%s}`, documentID, string(tabsJSON))
-	return result, nil
+	// Return tab properties only (tabId, title, index, parentTabId, nestingLevel), flattening child tabs
+	var props []interface{}
+	var walk func(tabs []interface{})
+	walk = func(tabs []interface{}) {
+		for _, t := range tabs {
+			tab, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if p, ok := tab["tabProperties"].(map[string]interface{}); ok {
+				props = append(props, p)
+			}
+			if children, ok := tab["childTabs"].([]interface{}); ok {
+				walk(children)
+			}
+		}
+	}
+	walk(tabs)
+
+	result := map[string]interface{}{
+		"document_id": documentID,
+		"tabs":        props,
+	}
+	b, _ := json.Marshal(result)
+	return string(b), nil
+}
+
+func readTab(ctx context.Context, params map[string]any) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	documentID, _ := params["document_id"].(string)
+	tabID, _ := params["tab_id"].(string)
+
+	doc, err := cli.GetDocument(ctx, gen.GetDocumentParams{
+		DocumentId:         documentID,
+		IncludeTabsContent: gen.NewOptBool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get document: %w", err)
+	}
+
+	tabs, err := documentTabs(doc)
+	if err != nil {
+		return "", err
+	}
+	tab := findTab(tabs, tabID)
+	if tab == nil {
+		return "", fmt.Errorf("tab not found: %s (use list_tabs to find tab IDs)", tabID)
+	}
+
+	title := ""
+	if p, ok := tab["tabProperties"].(map[string]interface{}); ok {
+		title, _ = p["title"].(string)
+	}
+	// documentTab has the same body structure as a single-tab document
+	text := ""
+	if documentTab, ok := tab["documentTab"].(map[string]interface{}); ok {
+		text = extractTextFromDocument(documentTab)
+	}
+
+	result := map[string]interface{}{
+		"document_id": documentID,
+		"tab_id":      tabID,
+		"title":       title,
+		"content":     text,
+	}
+	b, _ := json.Marshal(result)
+	return string(b), nil
+}
+
+// documentTabs decodes the document's tabs into generic maps.
+func documentTabs(doc *gen.Document) ([]interface{}, error) {
+	if len(doc.Tabs) == 0 {
+		return nil, nil
+	}
+	tabsJSON, err := json.Marshal(doc.Tabs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize tabs: %w", err)
+	}
+	var tabs []interface{}
+	if err := json.Unmarshal(tabsJSON, &tabs); err != nil {
+		return nil, fmt.Errorf("failed to parse tabs: %w", err)
+	}
+	return tabs, nil
+}
+
+// findTab returns the tab with the given ID, searching child tabs recursively.
+func findTab(tabs []interface{}, tabID string) map[string]interface{} {
+	for _, t := range tabs {
+		tab, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if p, ok := tab["tabProperties"].(map[string]interface{}); ok && p["tabId"] == tabID {
+			return tab
+		}
+		if children, ok := tab["childTabs"].([]interface{}); ok {
+			if found := findTab(children, tabID); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
 }
 
 // =============================================================================
@@ -347,6 +465,10 @@ func appendText(ctx context.Context, params map[string]any) (string, error) {
 	documentID, _ := params["document_id"].(string)
 	text, _ := params["text"].(string)
 
+	if suggest, _ := params["suggest"].(bool); suggest {
+		return suggestEdit(ctx, documentID, "Suggested addition at the end of the document:\n"+text, "")
+	}
+
 	cli, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
@@ -385,6 +507,10 @@ func insertText(ctx context.Context, params map[string]any) (string, error) {
 	text, _ := params["text"].(string)
 	index := int(params["index"].(float64))
 
+	if suggest, _ := params["suggest"].(bool); suggest {
+		return suggestEdit(ctx, documentID, fmt.Sprintf("Suggested insertion at index %d:\n%s", index, text), "")
+	}
+
 	location := map[string]interface{}{"index": index}
 	if tabID, ok := params["tab_id"].(string); ok && tabID != "" {
 		location["tabId"] = tabID
@@ -400,6 +526,15 @@ func deleteRange(ctx context.Context, params map[string]any) (string, error) {
 	startIndex := int(params["start_index"].(float64))
 	endIndex := int(params["end_index"].(float64))
 
+	if suggest, _ := params["suggest"].(bool); suggest {
+		tabID, _ := params["tab_id"].(string)
+		quoted, err := rangeText(ctx, documentID, tabID, startIndex, endIndex)
+		if err != nil {
+			return "", err
+		}
+		return suggestEdit(ctx, documentID, fmt.Sprintf("Suggested deletion of range %d-%d:\n%s", startIndex, endIndex, quoted), quoted)
+	}
+
 	rangeSpec := map[string]interface{}{"startIndex": startIndex, "endIndex": endIndex}
 	if tabID, ok := params["tab_id"].(string); ok && tabID != "" {
 		rangeSpec["tabId"] = tabID
@@ -410,6 +545,115 @@ func deleteRange(ctx context.Context, params map[string]any) (string, error) {
 	})
 }
 
+// =============================================================================
+// Suggestions
+// =============================================================================
+
+// suggestEdit posts a proposed edit as a comment instead of applying it. The Docs
+// API can read tracked suggestions (suggestionsViewMode) but batchUpdate cannot
+// create them, so a comment is the closest reviewable form.
+func suggestEdit(ctx context.Context, documentID, content, quotedText string) (string, error) {
+	resp, err := addComment(ctx, map[string]any{
+		"document_id": documentID,
+		"content":     content,
+		"quoted_text": quotedText,
+	})
+	if err != nil {
+		return "", err
+	}
+	var comment map[string]interface{}
+	if err := json.Unmarshal([]byte(resp), &comment); err != nil {
+		return "", fmt.Errorf("failed to parse comment: %w", err)
+	}
+	result := map[string]interface{}{
+		"documentId": documentID,
+		"suggested":  true,
+		"comment_id": comment["id"],
+		"message":    "The document was not changed. The edit was posted as a comment for review; apply it without suggest once approved.",
+	}
+	b, _ := json.Marshal(result)
+	return string(b), nil
+}
+
+// rangeText returns the text between two document indexes (UTF-16 offsets),
+// read from the given tab or the document body.
+func rangeText(ctx context.Context, documentID, tabID string, startIndex, endIndex int) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	doc, err := cli.GetDocument(ctx, gen.GetDocumentParams{
+		DocumentId:         documentID,
+		IncludeTabsContent: gen.NewOptBool(tabID != ""),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get document: %w", err)
+	}
+
+	var root interface{}
+	if tabID != "" {
+		tabs, err := documentTabs(doc)
+		if err != nil {
+			return "", err
+		}
+		tab := findTab(tabs, tabID)
+		if tab == nil {
+			return "", fmt.Errorf("tab not found: %s (use list_tabs to find tab IDs)", tabID)
+		}
+		root = tab["documentTab"]
+	} else {
+		docJSON, err := toJSON(doc)
+		if err != nil {
+			return "", fmt.Errorf("failed to serialize document: %w", err)
+		}
+		var docMap map[string]interface{}
+		if err := json.Unmarshal([]byte(docJSON), &docMap); err != nil {
+			return "", fmt.Errorf("failed to parse document: %w", err)
+		}
+		root = docMap["body"]
+	}
+
+	var runs []textRun
+	collectTextRuns(root, &runs)
+	sort.Slice(runs, func(i, j int) bool { return runs[i].start < runs[j].start })
+
+	var text strings.Builder
+	for _, r := range runs {
+		units := utf16.Encode([]rune(r.content))
+		from, to := max(startIndex-r.start, 0), min(endIndex-r.start, len(units))
+		if from < to {
+			text.WriteString(string(utf16.Decode(units[from:to])))
+		}
+	}
+	return text.String(), nil
+}
+
+// textRun is a run of text starting at a document index.
+type textRun struct {
+	start   int
+	content string
+}
+
+// collectTextRuns gathers every paragraph element with a textRun, including those nested in tables.
+func collectTextRuns(node interface{}, runs *[]textRun) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if run, ok := v["textRun"].(map[string]interface{}); ok {
+			content, _ := run["content"].(string)
+			start, _ := v["startIndex"].(float64)
+			*runs = append(*runs, textRun{start: int(start), content: content})
+			return
+		}
+		for _, child := range v {
+			collectTextRuns(child, runs)
+		}
+	case []interface{}:
+		for _, child := range v {
+			collectTextRuns(child, runs)
+		}
+	}
+}
+
 // =============================================================================
 // Formatting
 // =============================================================================
@@ -473,6 +717,13 @@ func applyParagraphStyle(ctx context.Context, params map[string]any) (string, er
 	paragraphStyle := map[string]interface{}{}
 	fields := []string{}
 
+	if namedStyle, ok := params["named_style_type"].(string); ok && namedStyle != "" {
+		if !validNamedStyles[namedStyle] {
+			return "", fmt.Errorf("invalid named_style_type: %s", namedStyle)
+		}
+		paragraphStyle["namedStyleType"] = namedStyle
+		fields = append(fields, "namedStyleType")
+	}
 	if alignment, ok := params["alignment"].(string); ok && alignment != "" {
 		paragraphStyle["alignment"] = alignment
 		fields = append(fields, "alignment")
@@ -504,6 +755,13 @@ func applyParagraphStyle(ctx context.Context, params map[string]any) (string, er
 	})
 }
 
+// validNamedStyles lists the paragraph named style types accepted by the Docs API.
+var validNamedStyles = map[string]bool{
+	"NORMAL_TEXT": true, "TITLE": true, "SUBTITLE": true,
+	"HEADING_1": true, "HEADING_2": true, "HEADING_3": true,
+	"HEADING_4": true, "HEADING_5": true, "HEADING_6": true,
+}
+
 func parseColor(hex string) map[string]interface{} {
 	hex = strings.TrimPrefix(hex, "#")
 	if len(hex) != 6 {
@@ -571,6 +829,32 @@ func insertPageBreak(ctx context.Context, params map[string]any) (string, error)
 	})
 }
 
+// insertHorizontalRule emulates a horizontal rule, which the Docs API cannot insert directly:
+// it inserts an empty paragraph and gives it a solid bottom border.
+func insertHorizontalRule(ctx context.Context, params map[string]any) (string, error) {
+	documentID, _ := params["document_id"].(string)
+	index := int(params["index"].(float64))
+
+	location := map[string]interface{}{"index": index}
+	rangeSpec := map[string]interface{}{"startIndex": index, "endIndex": index + 1}
+	if tabID, ok := params["tab_id"].(string); ok && tabID != "" {
+		location["tabId"] = tabID
+		rangeSpec["tabId"] = tabID
+	}
+
+	border := map[string]interface{}{
+		"color":     parseColor("#BBBBBB"),
+		"width":     map[string]interface{}{"magnitude": 1, "unit": "PT"},
+		"padding":   map[string]interface{}{"magnitude": 1, "unit": "PT"},
+		"dashStyle": "SOLID",
+	}
+
+	return batchUpdate(ctx, documentID, []map[string]interface{}{
+		{"insertText": map[string]interface{}{"location": location, "text": "\n"}},
+		{"updateParagraphStyle": map[string]interface{}{"range": rangeSpec, "paragraphStyle": map[string]interface{}{"borderBottom": border}, "fields": "borderBottom"}},
+	})
+}
+
 func insertImage(ctx context.Context, params map[string]any) (string, error) {
 	documentID, _ := params["document_id"].(string)
 	imageURL, _ := params["image_url"].(string)
@@ -612,13 +896,7 @@ func listComments(ctx context.Context, params map[string]any) (string, error) {
 		Fields: driveGen.NewOptString("comments(id,content,author,createdTime,modifiedTime,resolved,replies)"),
 	}
 
-	pageSize := 20
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 20, 100)
 	p.PageSize = driveGen.NewOptInt(pageSize)
 
 	if pt, ok := params["page_token"].(string); ok && pt != "" {
@@ -3,6 +3,7 @@ package google_drive
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -18,6 +19,10 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "id", "name", "mimeType", "size", "webViewLink")
 	case "create_folder", "copy_file":
 		return pickKeys(jsonStr, "id", "name", "webViewLink")
+	case "copy_folder":
+		return folderCopyCSV(jsonStr)
+	case "star_file":
+		return pickKeys(jsonStr, "id", "name", "starred")
 	case "list_permissions":
 		return permissionsCSV(jsonStr)
 	case "list_comments":
@@ -177,6 +182,40 @@ func drivesCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// folderCopyCSV formats a copy_folder result → summary line + CSV: source_id, new_id.
+func folderCopyCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Copied to %s (%s): %v folders, %v files",
+		str(data, "id"), str(data, "name"), data["folders"], data["files"]))
+	if timedOut, _ := data["timed_out"].(bool); timedOut {
+		sb.WriteString(" (timed out: time limit reached)\n# " + str(data, "message"))
+	} else if truncated, _ := data["truncated"].(bool); truncated {
+		sb.WriteString(" (truncated: depth or item limit reached)")
+	}
+	sb.WriteString("\n")
+	if errs, ok := data["errors"].([]any); ok {
+		for _, e := range errs {
+			sb.WriteString(fmt.Sprintf("# error: %v\n", e))
+		}
+	}
+	mapping, _ := data["mapping"].(map[string]any)
+	ids := make([]string, 0, len(mapping))
+	for id := range mapping {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	sb.WriteString("```csv\nsource_id,new_id\n")
+	for _, id := range ids {
+		sb.WriteString(fmt.Sprintf("%s,%v\n", id, mapping[id]))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // pickKeys extracts only the specified keys from a JSON object.
 func pickKeys(jsonStr string, keys ...string) string {
 	var data map[string]any
@@ -3,11 +3,15 @@ package google_drive
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
+
+	"mcpist/server/internal/modules"
 )
 
 // =============================================================================
@@ -61,7 +65,11 @@ func doUploadFile(ctx context.Context, token string, name, content, mimeType, pa
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("upload failed: status %d", resp.StatusCode)
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New("upload failed"),
+		}
 	}
 
 	var result map[string]any
@@ -108,7 +116,11 @@ func doUpdateFileContent(ctx context.Context, token, fileID, content string) (st
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("update failed: status %d", resp.StatusCode)
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New("update failed"),
+		}
 	}
 
 	var result map[string]any
@@ -162,7 +174,11 @@ func doReadFile(ctx context.Context, token, fileID string) (string, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("read failed: status %d", resp.StatusCode)
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New("read failed"),
+		}
 	}
 
 	data, err := io.ReadAll(io.LimitReader(resp.Body, maxReadSize+1))
@@ -197,7 +213,11 @@ func doExportFile(ctx context.Context, token, fileID, mimeType string) (string,
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("export failed: status %d", resp.StatusCode)
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New("export failed"),
+		}
 	}
 
 	data, err := io.ReadAll(io.LimitReader(resp.Body, maxReadSize+1))
@@ -231,7 +251,11 @@ func doEmptyTrash(ctx context.Context, token string) (string, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("empty trash failed: status %d", resp.StatusCode)
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New("empty trash failed"),
+		}
 	}
 
 	return `{"success":true,"message":"Trash emptied successfully"}`, nil
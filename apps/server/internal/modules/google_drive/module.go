@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"mcpist/server/internal/broker"
 	"mcpist/server/internal/middleware"
@@ -20,7 +21,10 @@ const (
 var toJSON = modules.ToJSON
 
 // Standard file fields to request via ogen fields param
-const ogenFileFields = "id,name,mimeType,size,createdTime,modifiedTime,parents,webViewLink,iconLink,trashed"
+const ogenFileFields = "id,name,mimeType,size,createdTime,modifiedTime,viewedByMeTime,parents,webViewLink,iconLink,trashed,starred"
+
+// Sort order applied by the "recent" flag of list_files/search_files
+const recentOrderBy = "viewedByMeTime desc"
 
 // GoogleDriveModule implements the Module interface for Google Drive API
 type GoogleDriveModule struct{}
@@ -49,6 +53,7 @@ func (m *GoogleDriveModule) ExecuteTool(ctx context.Context, name string, params
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -57,6 +62,68 @@ func (m *GoogleDriveModule) ToCompact(toolName string, jsonResult string) string
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleDriveModule) HealthProbe() (string, map[string]any) {
+	return "get_about", nil
+}
+
+// paginatedTools maps list tools to their pagination, for _fetch_all.
+var paginatedTools = map[string]modules.Pagination{
+	"list_files":         {Items: "files", NextCursor: "nextPageToken", CursorParam: "page_token", PageSizeParam: "page_size", MaxPageSize: 1000},
+	"list_comments":      {Items: "comments", NextCursor: "nextPageToken", CursorParam: "page_token", PageSizeParam: "page_size", MaxPageSize: 100},
+	"list_revisions":     {Items: "revisions", NextCursor: "nextPageToken", CursorParam: "page_token", PageSizeParam: "page_size", MaxPageSize: 1000},
+	"list_shared_drives": {Items: "drives", NextCursor: "nextPageToken", CursorParam: "page_token", PageSizeParam: "page_size", MaxPageSize: 100},
+}
+
+// Pagination returns how a list tool pages
+// Implements modules.Paginator interface
+func (m *GoogleDriveModule) Pagination(toolName string) (modules.Pagination, bool) {
+	p, ok := paginatedTools[toolName]
+	return p, ok
+}
+
+// PreviewDelete lists what empty_trash would remove
+// Implements modules.DeletePreviewer interface
+func (m *GoogleDriveModule) PreviewDelete(ctx context.Context, toolName string, params map[string]any) (any, error) {
+	if toolName != "empty_trash" {
+		return nil, fmt.Errorf("no preview for tool: %s", toolName)
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := gen.ListFilesParams{
+		Q:        gen.NewOptString("trashed=true"),
+		PageSize: gen.NewOptInt(1000),
+		Fields:   gen.NewOptString("nextPageToken,files(name)"),
+	}
+	var names []string
+	more := false
+	for page := 0; page < 5; page++ {
+		res, err := c.ListFiles(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range res.Files {
+			names = append(names, f.Name.Value)
+		}
+		more = res.NextPageToken.Value != ""
+		if !more {
+			break
+		}
+		p.PageToken = gen.NewOptString(res.NextPageToken.Value)
+	}
+	count := any(len(names))
+	if more {
+		count = fmt.Sprintf("%d+", len(names))
+	}
+	return map[string]any{
+		"file_count": count,
+		"files":      modules.Preview(names),
+	}, nil
+}
+
 // =============================================================================
 // Token and Client
 // =============================================================================
@@ -76,6 +143,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_drive", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -113,6 +181,8 @@ var toolDefinitions = []modules.Tool{
 				"page_token":      {Type: "string", Description: "Token for pagination"},
 				"order_by":        {Type: "string", Description: "Sort order (e.g., 'name', 'modifiedTime desc', 'folder,name')"},
 				"include_trashed": {Type: "boolean", Description: "Include trashed files. Default: false"},
+				"starred":         {Type: "boolean", Description: "Only return starred files. Default: false"},
+				"recent":          {Type: "boolean", Description: "Sort by last viewed by me, most recent first (viewedByMeTime desc). Ignored when order_by is set. Default: false"},
 			},
 		},
 	},
@@ -147,6 +217,8 @@ var toolDefinitions = []modules.Tool{
 				"full_text": {Type: "string", Description: "Full-text search in file content"},
 				"mime_type": {Type: "string", Description: "Filter by MIME type (e.g., 'application/pdf', 'application/vnd.google-apps.document')"},
 				"page_size": {Type: "number", Description: "Maximum number of results (1-1000). Default: 100"},
+				"starred":   {Type: "boolean", Description: "Only return starred files. Default: false"},
+				"recent":    {Type: "boolean", Description: "Sort by last viewed by me, most recent first (viewedByMeTime desc). Default: false"},
 			},
 		},
 	},
@@ -201,6 +273,26 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"file_id"},
 		},
 	},
+	{
+		ID:   "google_drive:copy_folder",
+		Name: "copy_folder",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Copy a folder recursively: recreates its subfolders and copies every file into a new folder. Returns a mapping of source IDs to new IDs. Stops at max_depth levels and max_items items (reported as truncated), or before the tool time limit (reported as timed_out; do not re-run, as that creates a second copy).",
+			"ja-JP": "フォルダを再帰的にコピーします。サブフォルダを再作成し、すべてのファイルを新しいフォルダにコピーします。元ID→新IDの対応表を返します。max_depth階層・max_items件、または実行時間の上限で打ち切ります（truncated / timed_outで通知。再実行すると2つ目のコピーが作成されるため再実行しないでください）。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"source_folder_id": {Type: "string", Description: "Folder ID to copy"},
+				"dest_parent_id":   {Type: "string", Description: "Parent folder ID for the copy. Use 'root' for the root folder."},
+				"new_name":         {Type: "string", Description: "Name for the new folder (default: 'Copy of <name>')"},
+				"max_depth":        {Type: "number", Description: "Maximum subfolder depth to copy (default: 10, max: 20)"},
+				"max_items":        {Type: "number", Description: "Maximum number of files and folders to create (default: 50, max: 200). Larger folders may hit the time limit (reported as timed_out)"},
+			},
+			Required: []string{"source_folder_id", "dest_parent_id"},
+		},
+	},
 	{
 		ID:   "google_drive:move_file",
 		Name: "move_file",
@@ -236,6 +328,23 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"file_id", "new_name"},
 		},
 	},
+	{
+		ID:   "google_drive:star_file",
+		Name: "star_file",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Star or unstar a file or folder.",
+			"ja-JP": "ファイルまたはフォルダにスターを付ける、または外します。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"file_id": {Type: "string", Description: "File or folder ID"},
+				"starred": {Type: "boolean", Description: "true to star, false to unstar. Default: true"},
+			},
+			Required: []string{"file_id"},
+		},
+	},
 	{
 		ID:   "google_drive:delete_file",
 		Name: "delete_file",
@@ -428,10 +537,10 @@ var toolDefinitions = []modules.Tool{
 		ID:   "google_drive:empty_trash",
 		Name: "empty_trash",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Permanently delete all files in trash.",
-			"ja-JP": "ゴミ箱内のすべてのファイルを完全に削除します。",
+			"en-US": "Permanently delete all files in trash. The first call returns the files that would be deleted and a confirmation_token; call again with the token to empty the trash.",
+			"ja-JP": "ゴミ箱内のすべてのファイルを完全に削除します。最初の呼び出しは削除対象のファイルとconfirmation_tokenを返します。トークンを付けて再度呼び出すとゴミ箱が空になります。",
 		},
-		Annotations: modules.AnnotateDelete,
+		Annotations: modules.AnnotateConfirmedDelete,
 		InputSchema: modules.InputSchema{
 			Type:       "object",
 			Properties: map[string]modules.Property{},
@@ -457,15 +566,15 @@ var toolDefinitions = []modules.Tool{
 		ID:   "google_drive:export_file",
 		Name: "export_file",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Export a Google Workspace file (Docs, Sheets, Slides) to a specific format.",
-			"ja-JP": "Google Workspaceファイル（Docs、Sheets、Slides）を特定の形式でエクスポートします。",
+			"en-US": "Export a Google Workspace file (Docs, Sheets, Slides) to a specific format. Google Docs can be exported directly to Markdown.",
+			"ja-JP": "Google Workspaceファイル（Docs、Sheets、Slides）を特定の形式でエクスポートします。Google DocsはMarkdownに直接エクスポートできます。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
 				"file_id":   {Type: "string", Description: "File ID to export"},
-				"mime_type": {Type: "string", Description: "Export format. Docs: 'application/pdf', 'text/plain', 'application/vnd.openxmlformats-officedocument.wordprocessingml.document'. Sheets: 'application/pdf', 'text/csv', 'application/vnd.openxmlformats-officedocument.spreadsheetml.sheet'. Slides: 'application/pdf', 'application/vnd.openxmlformats-officedocument.presentationml.presentation'."},
+				"mime_type": {Type: "string", Description: "Export format. Docs: 'application/pdf', 'text/plain', 'text/markdown' (alias: 'markdown'), 'application/vnd.openxmlformats-officedocument.wordprocessingml.document'. Sheets: 'application/pdf', 'text/csv', 'application/vnd.openxmlformats-officedocument.spreadsheetml.sheet'. Slides: 'application/pdf', 'application/vnd.openxmlformats-officedocument.presentationml.presentation'."},
 			},
 			Required: []string{"file_id", "mime_type"},
 		},
@@ -485,8 +594,10 @@ var toolHandlers = map[string]toolHandler{
 	"read_file":           readFile,
 	"create_folder":       createFolder,
 	"copy_file":           copyFile,
+	"copy_folder":         copyFolder,
 	"move_file":           moveFile,
 	"rename_file":         renameFile,
+	"star_file":           starFile,
 	"delete_file":         deleteFile,
 	"get_about":           getAbout,
 	"upload_file":         uploadFile,
@@ -519,24 +630,23 @@ func listFiles(ctx context.Context, params map[string]any) (string, error) {
 	if !includeTrashed {
 		queryParts = append(queryParts, "trashed=false")
 	}
+	if starred, _ := params["starred"].(bool); starred {
+		queryParts = append(queryParts, "starred=true")
+	}
 
 	p := gen.ListFilesParams{
-		PageSize: gen.NewOptInt(100),
+		PageSize: gen.NewOptInt(modules.PageSize(params, "page_size", 100, 1000)),
 		Fields:   gen.NewOptString(fmt.Sprintf("nextPageToken,files(%s)", ogenFileFields)),
 	}
 	if len(queryParts) > 0 {
 		p.Q = gen.NewOptString(strings.Join(queryParts, " and "))
 	}
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		size := int(ps)
-		if size > 1000 {
-			size = 1000
-		}
-		p.PageSize = gen.NewOptInt(size)
-	}
 	if pt, ok := params["page_token"].(string); ok && pt != "" {
 		p.PageToken = gen.NewOptString(pt)
 	}
+	if recent, _ := params["recent"].(bool); recent {
+		p.OrderBy = gen.NewOptString(recentOrderBy)
+	}
 	if ob, ok := params["order_by"].(string); ok && ob != "" {
 		p.OrderBy = gen.NewOptString(ob)
 	}
@@ -580,20 +690,19 @@ func searchFiles(ctx context.Context, params map[string]any) (string, error) {
 		queryParts = append(queryParts, fmt.Sprintf("mimeType='%s'", mimeType))
 	}
 	queryParts = append(queryParts, "trashed=false")
+	if starred, _ := params["starred"].(bool); starred {
+		queryParts = append(queryParts, "starred=true")
+	}
 
 	p := gen.ListFilesParams{
-		PageSize: gen.NewOptInt(100),
+		PageSize: gen.NewOptInt(modules.PageSize(params, "page_size", 100, 1000)),
 		Fields:   gen.NewOptString(fmt.Sprintf("files(%s)", ogenFileFields)),
 	}
 	if len(queryParts) > 0 {
 		p.Q = gen.NewOptString(strings.Join(queryParts, " and "))
 	}
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		size := int(ps)
-		if size > 1000 {
-			size = 1000
-		}
-		p.PageSize = gen.NewOptInt(size)
+	if recent, _ := params["recent"].(bool); recent {
+		p.OrderBy = gen.NewOptString(recentOrderBy)
 	}
 
 	c, err := newOgenClient(ctx)
@@ -659,6 +768,190 @@ func copyFile(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+const (
+	folderMimeType = "application/vnd.google-apps.folder"
+
+	defaultCopyDepth = 10
+	maxCopyDepth     = 20
+	// Files are copied one at a time within the 30s tool timeout, at a few per second
+	defaultCopyItems = 50
+	maxCopyItems     = 200
+
+	// copyReserve is the time left before the deadline at which copy_folder
+	// stops starting new copies, so it can still return what it did
+	copyReserve = 3 * time.Second
+)
+
+// folderCopy tracks the progress of copy_folder.
+type folderCopy struct {
+	c         *gen.Client
+	maxDepth  int
+	maxItems  int
+	mapping   map[string]string // source ID → new ID
+	folders   int
+	files     int
+	truncated bool
+	timedOut  bool
+	errors    []string
+}
+
+// copyLimit reads a copy_folder limit param, clamped to 1..max.
+func copyLimit(params map[string]any, key string, def, max int) int {
+	v, ok := params[key].(float64)
+	if !ok || v < 1 {
+		return def
+	}
+	return min(int(v), max)
+}
+
+func copyFolder(ctx context.Context, params map[string]any) (string, error) {
+	sourceID, _ := params["source_folder_id"].(string)
+	destParentID, _ := params["dest_parent_id"].(string)
+
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	source, err := c.GetFile(ctx, gen.GetFileParams{FileId: sourceID, Fields: gen.NewOptString("id,name,mimeType")})
+	if err != nil {
+		return "", err
+	}
+	if source.MimeType.Value != folderMimeType {
+		return "", fmt.Errorf("%s is not a folder (mimeType: %s); use copy_file for files", sourceID, source.MimeType.Value)
+	}
+	name, _ := params["new_name"].(string)
+	if name == "" {
+		name = "Copy of " + source.Name.Value
+	}
+
+	fc := &folderCopy{
+		c:        c,
+		maxDepth: copyLimit(params, "max_depth", defaultCopyDepth, maxCopyDepth),
+		maxItems: copyLimit(params, "max_items", defaultCopyItems, maxCopyItems),
+		mapping:  make(map[string]string),
+	}
+	root, err := fc.createFolder(ctx, sourceID, name, destParentID)
+	if err != nil {
+		return "", err
+	}
+	fc.copyChildren(ctx, sourceID, root, 1)
+
+	result := map[string]any{
+		"id":        root,
+		"name":      name,
+		"folders":   fc.folders,
+		"files":     fc.files,
+		"mapping":   fc.mapping,
+		"truncated": fc.truncated,
+	}
+	if fc.timedOut {
+		result["timed_out"] = true
+		result["message"] = fmt.Sprintf("Stopped before the tool time limit; the copy in %s is incomplete. Copy the remaining files into it with copy_file instead of running copy_folder again, which would create a second copy.", root)
+	}
+	if len(fc.errors) > 0 {
+		result["errors"] = fc.errors
+	}
+	return toJSON(result)
+}
+
+// copyChildren copies the contents of sourceID into destID, recursing into subfolders.
+// Individual failures are collected in fc.errors so one bad file does not abort the copy.
+func (fc *folderCopy) copyChildren(ctx context.Context, sourceID, destID string, depth int) {
+	children, err := fc.listChildren(ctx, sourceID)
+	if err != nil {
+		if !fc.outOfTime(ctx) {
+			fc.errors = append(fc.errors, fmt.Sprintf("%s: list failed: %v", sourceID, err))
+		}
+		return
+	}
+	for _, child := range children {
+		if fc.outOfTime(ctx) {
+			return
+		}
+		if fc.folders+fc.files >= fc.maxItems {
+			fc.truncated = true
+			return
+		}
+		id := child.ID.Value
+		if child.MimeType.Value == folderMimeType {
+			if depth >= fc.maxDepth {
+				fc.truncated = true
+				continue
+			}
+			newID, err := fc.createFolder(ctx, id, child.Name.Value, destID)
+			if err != nil {
+				if fc.outOfTime(ctx) {
+					return
+				}
+				fc.errors = append(fc.errors, fmt.Sprintf("%s: %v", id, err))
+				continue
+			}
+			fc.copyChildren(ctx, id, newID, depth+1)
+			continue
+		}
+		res, err := fc.c.CopyFile(ctx, &gen.CopyRequest{
+			Name:    child.Name,
+			Parents: gen.NewOptNilStringArray([]string{destID}),
+		}, gen.CopyFileParams{FileId: id})
+		if err != nil {
+			if fc.outOfTime(ctx) {
+				return
+			}
+			fc.errors = append(fc.errors, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+		fc.mapping[id] = res.ID.Value
+		fc.files++
+	}
+}
+
+// outOfTime reports whether the tool deadline is too close to start another
+// copy, marking the result as timed out.
+func (fc *folderCopy) outOfTime(ctx context.Context) bool {
+	deadline, ok := ctx.Deadline()
+	if ctx.Err() != nil || (ok && time.Until(deadline) < copyReserve) {
+		fc.truncated = true
+		fc.timedOut = true
+		return true
+	}
+	return false
+}
+
+func (fc *folderCopy) createFolder(ctx context.Context, sourceID, name, parentID string) (string, error) {
+	res, err := fc.c.CreateFile(ctx, &gen.FileMetadata{
+		Name:     gen.NewOptNilString(name),
+		MimeType: gen.NewOptNilString(folderMimeType),
+		Parents:  gen.NewOptNilStringArray([]string{parentID}),
+	})
+	if err != nil {
+		return "", err
+	}
+	fc.mapping[sourceID] = res.ID.Value
+	fc.folders++
+	return res.ID.Value, nil
+}
+
+// listChildren returns every non-trashed item directly inside folderID.
+func (fc *folderCopy) listChildren(ctx context.Context, folderID string) ([]gen.File, error) {
+	var files []gen.File
+	p := gen.ListFilesParams{
+		Q:        gen.NewOptString(fmt.Sprintf("'%s' in parents and trashed=false", folderID)),
+		PageSize: gen.NewOptInt(1000),
+		Fields:   gen.NewOptString("nextPageToken,files(id,name,mimeType)"),
+	}
+	for {
+		res, err := fc.c.ListFiles(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, res.Files...)
+		if !res.NextPageToken.Set || res.NextPageToken.Value == "" {
+			return files, nil
+		}
+		p.PageToken = gen.NewOptString(res.NextPageToken.Value)
+	}
+}
+
 func moveFile(ctx context.Context, params map[string]any) (string, error) {
 	fileID, _ := params["file_id"].(string)
 	newParentID, _ := params["new_parent_id"].(string)
@@ -708,6 +1001,27 @@ func renameFile(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func starFile(ctx context.Context, params map[string]any) (string, error) {
+	fileID, _ := params["file_id"].(string)
+	starred := true
+	if v, ok := params["starred"].(bool); ok {
+		starred = v
+	}
+
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.UpdateFile(ctx,
+		&gen.FileMetadata{Starred: gen.NewOptNilBool(starred)},
+		gen.UpdateFileParams{FileId: fileID, Fields: gen.NewOptString(ogenFileFields)},
+	)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func deleteFile(ctx context.Context, params map[string]any) (string, error) {
 	fileID, _ := params["file_id"].(string)
 
@@ -870,7 +1184,7 @@ func listComments(ctx context.Context, params map[string]any) (string, error) {
 	p := gen.ListCommentsParams{
 		FileId:   fileID,
 		PageSize: gen.NewOptInt(20),
-		Fields:   gen.NewOptString("comments(id,content,author,createdTime,modifiedTime,resolved)"),
+		Fields:   gen.NewOptString("nextPageToken,comments(id,content,author,createdTime,modifiedTime,resolved)"),
 	}
 	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
 		size := int(ps)
@@ -924,15 +1238,8 @@ func listRevisions(ctx context.Context, params map[string]any) (string, error) {
 
 	p := gen.ListRevisionsParams{
 		FileId:   fileID,
-		PageSize: gen.NewOptInt(100),
-		Fields:   gen.NewOptString("revisions(id,mimeType,modifiedTime,keepForever,size)"),
-	}
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		size := int(ps)
-		if size > 1000 {
-			size = 1000
-		}
-		p.PageSize = gen.NewOptInt(size)
+		PageSize: gen.NewOptInt(modules.PageSize(params, "page_size", 100, 1000)),
+		Fields:   gen.NewOptString("nextPageToken,revisions(id,mimeType,modifiedTime,keepForever,size)"),
 	}
 	if pt, ok := params["page_token"].(string); ok && pt != "" {
 		p.PageToken = gen.NewOptString(pt)
@@ -967,14 +1274,7 @@ func emptyTrash(ctx context.Context, params map[string]any) (string, error) {
 
 func listSharedDrives(ctx context.Context, params map[string]any) (string, error) {
 	p := gen.ListDrivesParams{
-		PageSize: gen.NewOptInt(100),
-	}
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		size := int(ps)
-		if size > 100 {
-			size = 100
-		}
-		p.PageSize = gen.NewOptInt(size)
+		PageSize: gen.NewOptInt(modules.PageSize(params, "page_size", 100, 100)),
 	}
 	if pt, ok := params["page_token"].(string); ok && pt != "" {
 		p.PageToken = gen.NewOptString(pt)
@@ -1004,6 +1304,12 @@ func readFile(ctx context.Context, params map[string]any) (string, error) {
 	return doReadFile(ctx, token, fileID)
 }
 
+// exportMimeAliases maps shorthand export formats to their MIME types.
+var exportMimeAliases = map[string]string{
+	"markdown": "text/markdown",
+	"md":       "text/markdown",
+}
+
 func exportFile(ctx context.Context, params map[string]any) (string, error) {
 	token, err := getAccessToken(ctx)
 	if err != nil {
@@ -1011,5 +1317,8 @@ func exportFile(ctx context.Context, params map[string]any) (string, error) {
 	}
 	fileID, _ := params["file_id"].(string)
 	mimeType, _ := params["mime_type"].(string)
+	if alias, ok := exportMimeAliases[strings.ToLower(mimeType)]; ok {
+		mimeType = alias
+	}
 	return doExportFile(ctx, token, fileID, mimeType)
 }
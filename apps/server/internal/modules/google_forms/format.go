@@ -0,0 +1,238 @@
+package google_forms
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// =============================================================================
+// Compact formatters per tool — pure transformation: (toolName, JSON) → string
+// =============================================================================
+
+func formatCompact(toolName, jsonStr string) string {
+	switch toolName {
+	case "search_forms":
+		return formsCSV(jsonStr)
+	case "get_form", "create_form":
+		return formCompact(jsonStr)
+	case "add_question":
+		return createItemCompact(jsonStr)
+	case "list_responses":
+		return responsesCSV(jsonStr)
+	default:
+		return jsonStr
+	}
+}
+
+// formsCSV formats search_forms → CSV: id, name, modifiedTime.
+func formsCSV(jsonStr string) string {
+	var data struct {
+		Files []map[string]any `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	if len(data.Files) == 0 {
+		return "# 0 forms"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,modifiedTime\n")
+	for _, f := range data.Files {
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n", str(f, "id"), csvEscape(str(f, "name")), str(f, "modifiedTime")))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// formCompact formats a form → header lines + CSV: itemId, questionId, type, required, title.
+func formCompact(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	info, _ := data["info"].(map[string]any)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(info, "title")))
+	sb.WriteString(fmt.Sprintf("formId=%s\n", str(data, "formId")))
+	if uri := str(data, "responderUri"); uri != "" {
+		sb.WriteString(fmt.Sprintf("responderUri=%s\n", uri))
+	}
+	if desc := str(info, "description"); desc != "" {
+		sb.WriteString(fmt.Sprintf("description=%s\n", desc))
+	}
+
+	items, _ := data["items"].([]any)
+	if len(items) == 0 {
+		sb.WriteString("# 0 items")
+		return sb.String()
+	}
+	sb.WriteString("```csv\nitemId,questionId,type,required,title\n")
+	for _, it := range items {
+		m, ok := it.(map[string]any)
+		if !ok {
+			continue
+		}
+		questionID, qType, required := "", itemType(m), ""
+		if qi, ok := m["questionItem"].(map[string]any); ok {
+			if q, ok := qi["question"].(map[string]any); ok {
+				questionID = str(q, "questionId")
+				if r, _ := q["required"].(bool); r {
+					required = "true"
+				}
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s\n",
+			str(m, "itemId"),
+			questionID,
+			qType,
+			required,
+			csvEscape(str(m, "title")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// itemType returns the add_question type name of an item (or the item kind for
+// non-question items such as page breaks and images).
+func itemType(item map[string]any) string {
+	qi, ok := item["questionItem"].(map[string]any)
+	if !ok {
+		for _, kind := range []string{"questionGroupItem", "pageBreakItem", "textItem", "imageItem", "videoItem"} {
+			if _, ok := item[kind]; ok {
+				return strings.TrimSuffix(kind, "Item")
+			}
+		}
+		return ""
+	}
+	q, _ := qi["question"].(map[string]any)
+	switch {
+	case q["textQuestion"] != nil:
+		if tq, _ := q["textQuestion"].(map[string]any); tq["paragraph"] == true {
+			return "paragraph"
+		}
+		return "short_text"
+	case q["choiceQuestion"] != nil:
+		cq, _ := q["choiceQuestion"].(map[string]any)
+		for name, apiType := range choiceTypes {
+			if str(cq, "type") == apiType {
+				return name
+			}
+		}
+		return "choice"
+	case q["scaleQuestion"] != nil:
+		return "scale"
+	case q["dateQuestion"] != nil:
+		return "date"
+	case q["timeQuestion"] != nil:
+		return "time"
+	case q["fileUploadQuestion"] != nil:
+		return "file_upload"
+	case q["ratingQuestion"] != nil:
+		return "rating"
+	}
+	return "question"
+}
+
+// createItemCompact formats a batchUpdate createItem reply → itemId and questionId(s).
+func createItemCompact(jsonStr string) string {
+	var data struct {
+		Replies []struct {
+			CreateItem struct {
+				ItemID     string   `json:"itemId"`
+				QuestionID []string `json:"questionId"`
+			} `json:"createItem"`
+		} `json:"replies"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil || len(data.Replies) == 0 {
+		return jsonStr
+	}
+	ci := data.Replies[0].CreateItem
+	return fmt.Sprintf("itemId=%s\nquestionId=%s", ci.ItemID, strings.Join(ci.QuestionID, ","))
+}
+
+// responsesCSV formats response list → CSV: responseId, lastSubmittedTime, respondentEmail, answers.
+// answers is "questionId=value" pairs separated by "; " (multiple values joined with "|").
+func responsesCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	responses, ok := data["responses"].([]any)
+	if !ok || len(responses) == 0 {
+		return "# 0 responses"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nresponseId,lastSubmittedTime,respondentEmail,answers\n")
+	for _, r := range responses {
+		m, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+			str(m, "responseId"),
+			str(m, "lastSubmittedTime"),
+			str(m, "respondentEmail"),
+			csvEscape(answersSummary(m)),
+		))
+	}
+	sb.WriteString("```")
+
+	if token := str(data, "nextPageToken"); token != "" {
+		sb.WriteString(fmt.Sprintf("\nnextPageToken=%s", token))
+	}
+	return sb.String()
+}
+
+// answersSummary flattens a response's answers map, sorted by question ID.
+func answersSummary(response map[string]any) string {
+	answers, _ := response["answers"].(map[string]any)
+	ids := make([]string, 0, len(answers))
+	for id := range answers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		a, _ := answers[id].(map[string]any)
+		var values []string
+		if ta, ok := a["textAnswers"].(map[string]any); ok {
+			list, _ := ta["answers"].([]any)
+			for _, v := range list {
+				if vm, ok := v.(map[string]any); ok {
+					values = append(values, str(vm, "value"))
+				}
+			}
+		} else if fa, ok := a["fileUploadAnswers"].(map[string]any); ok {
+			list, _ := fa["answers"].([]any)
+			for _, v := range list {
+				if vm, ok := v.(map[string]any); ok {
+					values = append(values, str(vm, "fileName"))
+				}
+			}
+		}
+		parts = append(parts, id+"="+strings.Join(values, "|"))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func str(obj map[string]any, key string) string {
+	if v, ok := obj[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func csvEscape(s string) string {
+	if s == "" {
+		return ""
+	}
+	if strings.ContainsAny(s, ",\"\n\r") {
+		return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package google_forms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"mcpist/server/internal/modules"
+)
+
+const formsAPIBase = "https://forms.googleapis.com/v1"
+
+// driveFilesURL lists files through the Drive API; the Forms API has no way to find forms
+const driveFilesURL = "https://www.googleapis.com/drive/v3/files"
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// doRequest sends an authenticated request to the Google Forms API and returns the raw response body.
+func doRequest(ctx context.Context, method, path string, query url.Values, body any) (string, error) {
+	return doRequestURL(ctx, method, formsAPIBase+path, query, body)
+}
+
+// doRequestURL sends an authenticated request to endpoint and returns the raw response body.
+func doRequestURL(ctx context.Context, method, endpoint string, query url.Values, body any) (string, error) {
+	modules.LogTrace(ctx, "google_forms", "upstream_call", map[string]any{"path": endpoint})
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New(string(respBody)),
+		}
+	}
+
+	return string(respBody), nil
+}
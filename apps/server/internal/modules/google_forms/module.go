@@ -0,0 +1,376 @@
+package google_forms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/middleware"
+	"mcpist/server/internal/modules"
+)
+
+const (
+	googleFormsVersion = "v1"
+)
+
+var toJSON = modules.ToJSON
+
+// GoogleFormsModule implements the Module interface for Google Forms API
+type GoogleFormsModule struct{}
+
+func New() *GoogleFormsModule { return &GoogleFormsModule{} }
+
+var moduleDescriptions = modules.LocalizedText{
+	"en-US": "Google Forms API - Create forms, add questions, and read responses",
+	"ja-JP": "Google Forms API - フォームの作成、質問の追加、回答の取得",
+}
+
+func (m *GoogleFormsModule) Name() string                        { return "google_forms" }
+func (m *GoogleFormsModule) Descriptions() modules.LocalizedText { return moduleDescriptions }
+func (m *GoogleFormsModule) Description() string {
+	return moduleDescriptions["en-US"]
+}
+func (m *GoogleFormsModule) APIVersion() string            { return googleFormsVersion }
+func (m *GoogleFormsModule) Tools() []modules.Tool         { return toolDefinitions }
+func (m *GoogleFormsModule) Resources() []modules.Resource { return nil }
+func (m *GoogleFormsModule) ReadResource(ctx context.Context, uri string) (string, error) {
+	return "", fmt.Errorf("resources not supported")
+}
+
+func (m *GoogleFormsModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	handler, ok := toolHandlers[name]
+	if !ok {
+		return "", fmt.Errorf("unknown tool: %s", name)
+	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
+	return handler(ctx, params)
+}
+
+// ToCompact converts JSON result to compact format.
+func (m *GoogleFormsModule) ToCompact(toolName string, jsonResult string) string {
+	return formatCompact(toolName, jsonResult)
+}
+
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleFormsModule) HealthProbe() (string, map[string]any) {
+	return "search_forms", map[string]any{"page_size": float64(1)}
+}
+
+// =============================================================================
+// Token
+// =============================================================================
+
+func getCredentials(ctx context.Context) *broker.Credentials {
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil {
+		log.Printf("[google_forms] No auth context")
+		return nil
+	}
+	credentials, err := broker.GetTokenBroker().GetModuleToken(ctx, authCtx.UserID, "google_forms")
+	if err != nil {
+		log.Printf("[google_forms] GetModuleToken error: %v", err)
+		return nil
+	}
+	return credentials
+}
+
+// =============================================================================
+// Tool Definitions
+// =============================================================================
+
+var toolDefinitions = []modules.Tool{
+	{
+		ID:   "google_forms:get_form",
+		Name: "get_form",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a form's title, description, settings, and questions.",
+			"ja-JP": "フォームのタイトル、説明、設定、質問を取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"form_id": {Type: "string", Description: "Form ID"},
+			},
+			Required: []string{"form_id"},
+		},
+	},
+	{
+		ID:   "google_forms:search_forms",
+		Name: "search_forms",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Search for forms in Google Drive by name, to find a form_id.",
+			"ja-JP": "Google Drive内のフォームを名前で検索し、form_idを確認します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"query":     {Type: "string", Description: "Text the form name contains. If empty, lists recent forms."},
+				"page_size": {Type: "number", Description: "Maximum results (1-100). Default: 20"},
+			},
+		},
+	},
+	{
+		ID:   "google_forms:create_form",
+		Name: "create_form",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a new, empty form. Add questions with add_question.",
+			"ja-JP": "新しい空のフォームを作成します。質問はadd_questionで追加します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"title":          {Type: "string", Description: "Form title shown to respondents"},
+				"document_title": {Type: "string", Description: "File name in Google Drive. Default: same as title"},
+				"description":    {Type: "string", Description: "Form description shown below the title"},
+			},
+			Required: []string{"title"},
+		},
+	},
+	{
+		ID:   "google_forms:add_question",
+		Name: "add_question",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a question to a form. Appended at the end unless index is given.",
+			"ja-JP": "フォームに質問を追加します。indexを指定しない場合は末尾に追加されます。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"form_id":     {Type: "string", Description: "Form ID"},
+				"title":       {Type: "string", Description: "Question text"},
+				"type":        {Type: "string", Description: "Question type: short_text, paragraph, multiple_choice, checkbox, dropdown, scale, date, time. Default: short_text"},
+				"description": {Type: "string", Description: "Help text shown below the question"},
+				"required":    {Type: "boolean", Description: "Whether an answer is required. Default: false"},
+				"options":     {Type: "array", Description: "Choices for multiple_choice, checkbox, and dropdown", Items: &modules.Property{Type: "string"}},
+				"low":         {Type: "number", Description: "Lowest value for scale (0 or 1). Default: 1"},
+				"high":        {Type: "number", Description: "Highest value for scale (2-10). Default: 5"},
+				"low_label":   {Type: "string", Description: "Label for the lowest scale value"},
+				"high_label":  {Type: "string", Description: "Label for the highest scale value"},
+				"index":       {Type: "number", Description: "0-based position to insert the question at. Default: end of form"},
+			},
+			Required: []string{"form_id", "title"},
+		},
+	},
+	{
+		ID:   "google_forms:list_responses",
+		Name: "list_responses",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List submitted responses of a form. Answers are keyed by question ID (see get_form).",
+			"ja-JP": "フォームに送信された回答を一覧表示します。回答は質問ID（get_formで確認）をキーとします。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"form_id":    {Type: "string", Description: "Form ID"},
+				"page_size":  {Type: "number", Description: "Maximum number of responses to return (max 5000). Default: 5000"},
+				"page_token": {Type: "string", Description: "Token for pagination (nextPageToken from a previous call)"},
+				"filter":     {Type: "string", Description: "Filter by submission time, e.g. \"timestamp > 2024-01-01T00:00:00Z\""},
+			},
+			Required: []string{"form_id"},
+		},
+	},
+}
+
+// =============================================================================
+// Tool Handlers
+// =============================================================================
+
+type toolHandler func(ctx context.Context, params map[string]any) (string, error)
+
+var toolHandlers = map[string]toolHandler{
+	"search_forms":   searchForms,
+	"get_form":       getForm,
+	"create_form":    createForm,
+	"add_question":   addQuestion,
+	"list_responses": listResponses,
+}
+
+func formPath(params map[string]any) string {
+	formID, _ := params["form_id"].(string)
+	return "/forms/" + url.PathEscape(formID)
+}
+
+func searchForms(ctx context.Context, params map[string]any) (string, error) {
+	q := "mimeType='application/vnd.google-apps.form' and trashed=false"
+	if query, ok := params["query"].(string); ok && query != "" {
+		q += fmt.Sprintf(" and name contains '%s'", strings.ReplaceAll(query, "'", "\\'"))
+	}
+	query := url.Values{
+		"q":        {q},
+		"pageSize": {strconv.Itoa(modules.PageSize(params, "page_size", 20, 100))},
+		"orderBy":  {"modifiedTime desc"},
+		"fields":   {"files(id,name,modifiedTime,webViewLink)"},
+	}
+	return doRequestURL(ctx, "GET", driveFilesURL, query, nil)
+}
+
+func getForm(ctx context.Context, params map[string]any) (string, error) {
+	return doRequest(ctx, "GET", formPath(params), nil, nil)
+}
+
+func createForm(ctx context.Context, params map[string]any) (string, error) {
+	title, _ := params["title"].(string)
+	info := map[string]any{"title": title}
+	if v, ok := params["document_title"].(string); ok && v != "" {
+		info["documentTitle"] = v
+	}
+	res, err := doRequest(ctx, "POST", "/forms", nil, map[string]any{"info": info})
+	if err != nil {
+		return "", err
+	}
+
+	// forms.create only accepts the title; the description needs a batchUpdate
+	description, _ := params["description"].(string)
+	if description == "" {
+		return res, nil
+	}
+	var form struct {
+		FormID string `json:"formId"`
+	}
+	if err := json.Unmarshal([]byte(res), &form); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	update := map[string]any{
+		"includeFormInResponse": true,
+		"requests": []any{map[string]any{
+			"updateFormInfo": map[string]any{
+				"info":       map[string]any{"description": description},
+				"updateMask": "description",
+			},
+		}},
+	}
+	updated, err := doRequest(ctx, "POST", "/forms/"+url.PathEscape(form.FormID)+":batchUpdate", nil, update)
+	if err != nil {
+		return "", fmt.Errorf("form %s created, but setting the description failed: %w", form.FormID, err)
+	}
+	var out struct {
+		Form json.RawMessage `json:"form"`
+	}
+	if err := json.Unmarshal([]byte(updated), &out); err != nil || len(out.Form) == 0 {
+		return res, nil
+	}
+	return string(out.Form), nil
+}
+
+func addQuestion(ctx context.Context, params map[string]any) (string, error) {
+	question, err := buildQuestion(params)
+	if err != nil {
+		return "", err
+	}
+	item := map[string]any{
+		"questionItem": map[string]any{"question": question},
+	}
+	item["title"], _ = params["title"].(string)
+	if v, ok := params["description"].(string); ok && v != "" {
+		item["description"] = v
+	}
+
+	// createItem requires an explicit index; append by counting existing items
+	var index int
+	if v, ok := params["index"].(float64); ok {
+		index = int(v)
+	} else {
+		res, err := doRequest(ctx, "GET", formPath(params), nil, nil)
+		if err != nil {
+			return "", err
+		}
+		var form struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal([]byte(res), &form); err != nil {
+			return "", fmt.Errorf("failed to parse form: %w", err)
+		}
+		index = len(form.Items)
+	}
+
+	body := map[string]any{
+		"requests": []any{map[string]any{
+			"createItem": map[string]any{
+				"item":     item,
+				"location": map[string]any{"index": index},
+			},
+		}},
+	}
+	return doRequest(ctx, "POST", formPath(params)+":batchUpdate", nil, body)
+}
+
+// choiceTypes maps add_question choice types to Forms API ChoiceType values.
+var choiceTypes = map[string]string{
+	"multiple_choice": "RADIO",
+	"checkbox":        "CHECKBOX",
+	"dropdown":        "DROP_DOWN",
+}
+
+// buildQuestion converts add_question params to a Forms API Question.
+func buildQuestion(params map[string]any) (map[string]any, error) {
+	qType, _ := params["type"].(string)
+	if qType == "" {
+		qType = "short_text"
+	}
+	required, _ := params["required"].(bool)
+	question := map[string]any{"required": required}
+
+	switch qType {
+	case "short_text", "paragraph":
+		question["textQuestion"] = map[string]any{"paragraph": qType == "paragraph"}
+	case "multiple_choice", "checkbox", "dropdown":
+		raw, _ := params["options"].([]any)
+		var options []any
+		for _, o := range raw {
+			if s, ok := o.(string); ok && s != "" {
+				options = append(options, map[string]any{"value": s})
+			}
+		}
+		if len(options) == 0 {
+			return nil, fmt.Errorf("options is required for %s questions", qType)
+		}
+		question["choiceQuestion"] = map[string]any{"type": choiceTypes[qType], "options": options}
+	case "scale":
+		scale := map[string]any{"low": 1, "high": 5}
+		if v, ok := params["low"].(float64); ok {
+			scale["low"] = int(v)
+		}
+		if v, ok := params["high"].(float64); ok {
+			scale["high"] = int(v)
+		}
+		if v, ok := params["low_label"].(string); ok && v != "" {
+			scale["lowLabel"] = v
+		}
+		if v, ok := params["high_label"].(string); ok && v != "" {
+			scale["highLabel"] = v
+		}
+		question["scaleQuestion"] = scale
+	case "date":
+		question["dateQuestion"] = map[string]any{}
+	case "time":
+		question["timeQuestion"] = map[string]any{}
+	default:
+		return nil, fmt.Errorf("unsupported question type: %s", qType)
+	}
+	return question, nil
+}
+
+func listResponses(ctx context.Context, params map[string]any) (string, error) {
+	q := url.Values{}
+	if v, ok := params["page_size"].(float64); ok && v > 0 {
+		q.Set("pageSize", strconv.Itoa(min(int(v), 5000)))
+	}
+	if v, ok := params["page_token"].(string); ok && v != "" {
+		q.Set("pageToken", v)
+	}
+	if v, ok := params["filter"].(string); ok && v != "" {
+		q.Set("filter", v)
+	}
+	return doRequest(ctx, "GET", formPath(params)+"/responses", q, nil)
+}
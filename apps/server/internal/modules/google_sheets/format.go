@@ -16,6 +16,12 @@ func formatCompact(toolName, jsonStr string) string {
 		return spreadsheetsCSV(jsonStr)
 	case "list_sheets":
 		return sheetsCSV(jsonStr)
+	case "list_protected_ranges":
+		return protectedRangesCSV(jsonStr)
+	case "search_developer_metadata":
+		return developerMetadataCSV(jsonStr)
+	case "create_developer_metadata":
+		return createdDeveloperMetadataCompact(jsonStr)
 	case "create_spreadsheet":
 		return pickKeys(jsonStr, "spreadsheetId", "properties")
 	case "update_values":
@@ -100,6 +106,118 @@ func sheetsCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// protectedRangesCSV formats list_protected_ranges → CSV: protectedRangeId, sheetId, sheetTitle,
+// row/column indexes (empty = unbounded), description, warningOnly, editors.
+func protectedRangesCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	ranges, ok := data["protectedRanges"].([]any)
+	if !ok || len(ranges) == 0 {
+		return "# 0 protected ranges"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nprotectedRangeId,sheetId,sheetTitle,startRow,endRow,startColumn,endColumn,description,warningOnly,editors\n")
+	for _, item := range ranges {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		rng, _ := m["range"].(map[string]any)
+		warningOnly, _ := m["warningOnly"].(bool)
+		var editors []string
+		if e, ok := m["editors"].(map[string]any); ok {
+			for _, key := range []string{"users", "groups"} {
+				list, _ := e[key].([]any)
+				for _, v := range list {
+					if s, ok := v.(string); ok {
+						editors = append(editors, s)
+					}
+				}
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s,%t,%s\n",
+			intStr(m, "protectedRangeId"),
+			intStr(rng, "sheetId"),
+			csvEscape(str(m, "sheetTitle")),
+			intStr(rng, "startRowIndex"),
+			intStr(rng, "endRowIndex"),
+			intStr(rng, "startColumnIndex"),
+			intStr(rng, "endColumnIndex"),
+			csvEscape(str(m, "description")),
+			warningOnly,
+			csvEscape(strings.Join(editors, ";")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// developerMetadataCSV formats search_developer_metadata → CSV: metadataId, key, value,
+// locationType, sheetId, dimension, startIndex, endIndex, visibility.
+func developerMetadataCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	matches, ok := data["matchedDeveloperMetadata"].([]any)
+	if !ok || len(matches) == 0 {
+		return "# 0 developer metadata"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nmetadataId,key,value,locationType,sheetId,dimension,startIndex,endIndex,visibility\n")
+	for _, item := range matches {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		md, _ := m["developerMetadata"].(map[string]any)
+		sb.WriteString(developerMetadataRow(md))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// createdDeveloperMetadataCompact formats the created metadata from the batchUpdate reply as CSV.
+func createdDeveloperMetadataCompact(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	replies, _ := data["replies"].([]any)
+	for _, reply := range replies {
+		rm, _ := reply.(map[string]any)
+		created, ok := rm["createDeveloperMetadata"].(map[string]any)
+		if !ok {
+			continue
+		}
+		md, _ := created["developerMetadata"].(map[string]any)
+		return "```csv\nmetadataId,key,value,locationType,sheetId,dimension,startIndex,endIndex,visibility\n" + developerMetadataRow(md) + "```"
+	}
+	return jsonStr
+}
+
+func developerMetadataRow(md map[string]any) string {
+	loc, _ := md["location"].(map[string]any)
+	sheetID := intStr(loc, "sheetId")
+	rng, _ := loc["dimensionRange"].(map[string]any)
+	if rng != nil {
+		sheetID = intStr(rng, "sheetId")
+	}
+	return fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s,%s\n",
+		intStr(md, "metadataId"),
+		csvEscape(str(md, "metadataKey")),
+		csvEscape(str(md, "metadataValue")),
+		str(loc, "locationType"),
+		sheetID,
+		str(rng, "dimension"),
+		intStr(rng, "startIndex"),
+		intStr(rng, "endIndex"),
+		str(md, "visibility"),
+	)
+}
+
 // findReplaceCompact extracts occurrencesChanged and sheetsChanged from batchUpdate reply.
 func findReplaceCompact(jsonStr string) string {
 	var data map[string]any
@@ -163,6 +281,14 @@ func str(obj map[string]any, key string) string {
 	return ""
 }
 
+// intStr formats a numeric field as an integer, or "" when absent.
+func intStr(obj map[string]any, key string) string {
+	if v, ok := obj[key].(float64); ok {
+		return fmt.Sprintf("%d", int(v))
+	}
+	return ""
+}
+
 func csvEscape(s string) string {
 	if s == "" {
 		return ""
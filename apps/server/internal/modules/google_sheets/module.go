@@ -51,6 +51,7 @@ func (m *GoogleSheetsModule) ExecuteTool(ctx context.Context, name string, param
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -59,6 +60,12 @@ func (m *GoogleSheetsModule) ToCompact(toolName string, jsonResult string) strin
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleSheetsModule) HealthProbe() (string, map[string]any) {
+	return "search_spreadsheets", map[string]any{"page_size": float64(1)}
+}
+
 // =============================================================================
 // Token and Client
 // =============================================================================
@@ -78,6 +85,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_sheets", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -110,7 +118,9 @@ var toolDefinitions = []modules.Tool{
 	{ID: "google_sheets:duplicate_sheet", Name: "duplicate_sheet", Descriptions: modules.LocalizedText{"en-US": "Duplicate a sheet within the same spreadsheet.", "ja-JP": "同じスプレッドシート内でシートを複製します。"}, Annotations: modules.AnnotateCreate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "sheet_id": {Type: "number", Description: "Sheet ID to duplicate"}, "new_title": {Type: "string", Description: "Title for the new sheet"}, "insert_index": {Type: "number", Description: "Position to insert (0-based). Default: after source"}}, Required: []string{"spreadsheet_id", "sheet_id"}}},
 	{ID: "google_sheets:copy_sheet_to", Name: "copy_sheet_to", Descriptions: modules.LocalizedText{"en-US": "Copy a sheet to another spreadsheet.", "ja-JP": "シートを別のスプレッドシートにコピーします。"}, Annotations: modules.AnnotateCreate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"source_spreadsheet_id": {Type: "string", Description: "Source spreadsheet ID"}, "sheet_id": {Type: "number", Description: "Sheet ID to copy"}, "dest_spreadsheet_id": {Type: "string", Description: "Destination spreadsheet ID"}}, Required: []string{"source_spreadsheet_id", "sheet_id", "dest_spreadsheet_id"}}},
 	// Data Read
+	{ID: "google_sheets:resolve_range", Name: "resolve_range", Descriptions: modules.LocalizedText{"en-US": "Convert an A1 notation range (e.g., 'Sheet1!B2:D10') to the 0-based sheet_id/row/column indices used by index-based tools such as format_cells. End indices are exclusive; bounds left open in the A1 range are omitted.", "ja-JP": "A1表記の範囲（例: 'Sheet1!B2:D10'）を、format_cellsなどのインデックス指定ツールで使う0始まりのsheet_id・行・列インデックスに変換します。終了インデックスは排他的で、A1範囲で省略された境界は出力されません。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "range": {Type: "string", Description: "A1 notation range (e.g., 'Sheet1!A1:C10', 'A1:C10', 'Sheet1!A:C'). Without a sheet name the first sheet is used"}}, Required: []string{"spreadsheet_id", "range"}}},
 	{ID: "google_sheets:get_values", Name: "get_values", Descriptions: modules.LocalizedText{"en-US": "Get cell values from a range (e.g., 'Sheet1!A1:C10').", "ja-JP": "指定範囲のセル値を取得します（例: 'Sheet1!A1:C10'）。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "range": {Type: "string", Description: "A1 notation range (e.g., 'Sheet1!A1:C10', 'A1:C10')"}, "value_render": {Type: "string", Description: "How values should be rendered: 'FORMATTED_VALUE' (default), 'UNFORMATTED_VALUE', or 'FORMULA'"}, "date_time_render": {Type: "string", Description: "How dates should be rendered: 'SERIAL_NUMBER' or 'FORMATTED_STRING' (default)"}}, Required: []string{"spreadsheet_id", "range"}}},
+	{ID: "google_sheets:get_records", Name: "get_records", Descriptions: modules.LocalizedText{"en-US": "Read a range as records: the first row is the header and each following row becomes an object keyed by header (e.g., [{\"Name\": \"Alice\", \"Age\": \"30\"}]). Blank headers become column_N; fully empty rows are skipped.", "ja-JP": "範囲をレコードとして読み取ります。先頭行をヘッダーとし、以降の各行をヘッダーをキーとするオブジェクトに変換します（例: [{\"Name\": \"Alice\", \"Age\": \"30\"}]）。空のヘッダーはcolumn_Nになり、完全に空の行はスキップされます。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "range": {Type: "string", Description: "A1 notation range whose first row is the header (e.g., 'Sheet1', 'Sheet1!A1:F100')"}, "value_render": {Type: "string", Description: "How values should be rendered: 'FORMATTED_VALUE' (default), 'UNFORMATTED_VALUE', or 'FORMULA'"}, "date_time_render": {Type: "string", Description: "How dates should be rendered: 'SERIAL_NUMBER' or 'FORMATTED_STRING' (default)"}}, Required: []string{"spreadsheet_id", "range"}}},
 	{ID: "google_sheets:batch_get_values", Name: "batch_get_values", Descriptions: modules.LocalizedText{"en-US": "Get cell values from multiple ranges at once.", "ja-JP": "複数の範囲からセル値を一度に取得します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "ranges": {Type: "array", Description: "Array of A1 notation ranges"}, "value_render": {Type: "string", Description: "How values should be rendered"}, "date_time_render": {Type: "string", Description: "How dates should be rendered"}}, Required: []string{"spreadsheet_id", "ranges"}}},
 	{ID: "google_sheets:get_formulas", Name: "get_formulas", Descriptions: modules.LocalizedText{"en-US": "Get formulas from a range.", "ja-JP": "指定範囲の数式を取得します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "range": {Type: "string", Description: "A1 notation range"}}, Required: []string{"spreadsheet_id", "range"}}},
 	// Data Write
@@ -132,7 +142,12 @@ var toolDefinitions = []modules.Tool{
 	// Find & Replace
 	{ID: "google_sheets:find_replace", Name: "find_replace", Descriptions: modules.LocalizedText{"en-US": "Find and replace text in a spreadsheet.", "ja-JP": "スプレッドシート内のテキストを検索・置換します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "find": {Type: "string", Description: "Text to find"}, "replacement": {Type: "string", Description: "Replacement text"}, "match_case": {Type: "boolean", Description: "Match case. Default: false"}, "match_entire": {Type: "boolean", Description: "Match entire cell content. Default: false"}, "use_regex": {Type: "boolean", Description: "Use regular expressions. Default: false"}, "sheet_id": {Type: "number", Description: "Limit to specific sheet (optional)"}, "range": {Type: "string", Description: "Limit to specific range in A1 notation (optional)"}}, Required: []string{"spreadsheet_id", "find", "replacement"}}},
 	// Protection
+	{ID: "google_sheets:list_protected_ranges", Name: "list_protected_ranges", Descriptions: modules.LocalizedText{"en-US": "List protected ranges and sheets in a spreadsheet, with their IDs, ranges, and editors.", "ja-JP": "スプレッドシート内の保護された範囲とシートを、ID・範囲・編集者とともに一覧表示します。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "sheet_id": {Type: "number", Description: "Only list protections on this sheet (optional)"}}, Required: []string{"spreadsheet_id"}}},
 	{ID: "google_sheets:protect_range", Name: "protect_range", Descriptions: modules.LocalizedText{"en-US": "Protect a range or sheet from editing.", "ja-JP": "範囲またはシートを編集から保護します。"}, Annotations: modules.AnnotateUpdate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "sheet_id": {Type: "number", Description: "Sheet ID"}, "description": {Type: "string", Description: "Description of the protected range"}, "start_row": {Type: "number", Description: "Start row index (0-based). Omit to protect entire sheet"}, "end_row": {Type: "number", Description: "End row index (exclusive)"}, "start_column": {Type: "number", Description: "Start column index (0-based)"}, "end_column": {Type: "number", Description: "End column index (exclusive)"}, "warning_only": {Type: "boolean", Description: "Show warning instead of blocking. Default: false"}}, Required: []string{"spreadsheet_id", "sheet_id"}}},
+	{ID: "google_sheets:delete_protected_range", Name: "delete_protected_range", Descriptions: modules.LocalizedText{"en-US": "Remove protection from a range or sheet. Use list_protected_ranges to find the protected range ID.", "ja-JP": "範囲またはシートの保護を解除します。保護範囲IDはlist_protected_rangesで確認できます。"}, Annotations: modules.AnnotateDelete, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "protected_range_id": {Type: "number", Description: "Protected range ID"}}, Required: []string{"spreadsheet_id", "protected_range_id"}}},
+	// Developer Metadata
+	{ID: "google_sheets:create_developer_metadata", Name: "create_developer_metadata", Descriptions: modules.LocalizedText{"en-US": "Attach developer metadata (key/value) to a spreadsheet, sheet, or row/column span. Metadata on rows and columns moves with them when rows or columns are inserted or deleted, giving a stable reference; find it again with search_developer_metadata.", "ja-JP": "スプレッドシート、シート、または行・列の範囲にデベロッパーメタデータ（キー/値）を付与します。行・列に付与したメタデータは行・列の挿入や削除に追従するため、安定した参照として使えます。search_developer_metadataで検索できます。"}, Annotations: modules.AnnotateCreate, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "key": {Type: "string", Description: "Metadata key"}, "value": {Type: "string", Description: "Metadata value (optional)"}, "sheet_id": {Type: "number", Description: "Sheet ID to attach to. Omit to attach to the spreadsheet"}, "dimension": {Type: "string", Description: "'ROWS' or 'COLUMNS' to attach to a row/column span of the sheet (optional)"}, "start_index": {Type: "number", Description: "Start row/column index (0-based). Required with dimension"}, "end_index": {Type: "number", Description: "End row/column index (exclusive). Default: start_index + 1"}, "visibility": {Type: "string", Description: "'DOCUMENT' (default, visible to anyone with access) or 'PROJECT' (only this app)"}}, Required: []string{"spreadsheet_id", "key"}}},
+	{ID: "google_sheets:search_developer_metadata", Name: "search_developer_metadata", Descriptions: modules.LocalizedText{"en-US": "Search developer metadata by key, value, ID, or location. Returns each match with its current location, so rows and columns tagged with create_developer_metadata can be found after inserts or deletes.", "ja-JP": "キー、値、ID、または位置でデベロッパーメタデータを検索します。各結果に現在の位置が含まれるため、create_developer_metadataでタグ付けした行・列を挿入や削除の後でも特定できます。"}, Annotations: modules.AnnotateReadOnly, InputSchema: modules.InputSchema{Type: "object", Properties: map[string]modules.Property{"spreadsheet_id": {Type: "string", Description: "Spreadsheet ID"}, "key": {Type: "string", Description: "Metadata key"}, "value": {Type: "string", Description: "Metadata value"}, "metadata_id": {Type: "number", Description: "Metadata ID"}, "location_type": {Type: "string", Description: "Location type: 'SPREADSHEET', 'SHEET', 'ROW', or 'COLUMN'"}, "sheet_id": {Type: "number", Description: "Only metadata on or within this sheet"}, "dimension": {Type: "string", Description: "'ROWS' or 'COLUMNS' to limit to metadata intersecting a span of the sheet"}, "start_index": {Type: "number", Description: "Start row/column index (0-based). Required with dimension"}, "end_index": {Type: "number", Description: "End row/column index (exclusive). Default: start_index + 1"}}, Required: []string{"spreadsheet_id"}}},
 }
 
 // =============================================================================
@@ -142,33 +157,39 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"get_spreadsheet":     getSpreadsheet,
-	"create_spreadsheet":  createSpreadsheet,
-	"search_spreadsheets": searchSpreadsheets,
-	"list_sheets":         listSheets,
-	"create_sheet":        createSheet,
-	"delete_sheet":        deleteSheet,
-	"rename_sheet":        renameSheet,
-	"duplicate_sheet":     duplicateSheet,
-	"copy_sheet_to":       copySheetTo,
-	"get_values":          getValues,
-	"batch_get_values":    batchGetValues,
-	"get_formulas":        getFormulas,
-	"update_values":       updateValues,
-	"batch_update_values": batchUpdateValues,
-	"append_values":       appendValues,
-	"clear_values":        clearValues,
-	"insert_rows":         insertRows,
-	"delete_rows":         deleteRows,
-	"insert_columns":      insertColumns,
-	"delete_columns":      deleteColumns,
-	"format_cells":        formatCells,
-	"merge_cells":         mergeCells,
-	"unmerge_cells":       unmergeCells,
-	"set_borders":         setBorders,
-	"auto_resize":         autoResize,
-	"find_replace":        findReplace,
-	"protect_range":       protectRange,
+	"get_spreadsheet":           getSpreadsheet,
+	"create_spreadsheet":        createSpreadsheet,
+	"search_spreadsheets":       searchSpreadsheets,
+	"list_sheets":               listSheets,
+	"create_sheet":              createSheet,
+	"delete_sheet":              deleteSheet,
+	"rename_sheet":              renameSheet,
+	"duplicate_sheet":           duplicateSheet,
+	"copy_sheet_to":             copySheetTo,
+	"resolve_range":             resolveRange,
+	"get_values":                getValues,
+	"get_records":               getRecords,
+	"batch_get_values":          batchGetValues,
+	"get_formulas":              getFormulas,
+	"update_values":             updateValues,
+	"batch_update_values":       batchUpdateValues,
+	"append_values":             appendValues,
+	"clear_values":              clearValues,
+	"insert_rows":               insertRows,
+	"delete_rows":               deleteRows,
+	"insert_columns":            insertColumns,
+	"delete_columns":            deleteColumns,
+	"format_cells":              formatCells,
+	"merge_cells":               mergeCells,
+	"unmerge_cells":             unmergeCells,
+	"set_borders":               setBorders,
+	"auto_resize":               autoResize,
+	"find_replace":              findReplace,
+	"list_protected_ranges":     listProtectedRanges,
+	"protect_range":             protectRange,
+	"delete_protected_range":    deleteProtectedRange,
+	"create_developer_metadata": createDeveloperMetadata,
+	"search_developer_metadata": searchDeveloperMetadata,
 }
 
 // =============================================================================
@@ -236,13 +257,7 @@ func searchSpreadsheets(ctx context.Context, params map[string]any) (string, err
 		q = fmt.Sprintf("mimeType='application/vnd.google-apps.spreadsheet' and name contains '%s'", query)
 	}
 
-	pageSize := 20
-	if ps, ok := params["page_size"].(float64); ok && ps > 0 {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 20, 100)
 
 	resp, err := cli.ListFiles(ctx, driveGen.ListFilesParams{
 		Q:        driveGen.NewOptString(q),
@@ -380,6 +395,92 @@ func getValues(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(resp)
 }
 
+func getRecords(ctx context.Context, params map[string]any) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+	rangeStr, _ := params["range"].(string)
+
+	p := gen.GetValuesParams{
+		SpreadsheetId: spreadsheetID,
+		Range:         rangeStr,
+	}
+	if vr, ok := params["value_render"].(string); ok && vr != "" {
+		p.ValueRenderOption = gen.NewOptString(vr)
+	}
+	if dtr, ok := params["date_time_render"].(string); ok && dtr != "" {
+		p.DateTimeRenderOption = gen.NewOptString(dtr)
+	}
+
+	resp, err := cli.GetValues(ctx, p)
+	if err != nil {
+		return "", fmt.Errorf("failed to get values: %w", err)
+	}
+	headers, records := rowsToRecords(resp.Values.Value)
+	return toJSON(map[string]any{
+		"range":   resp.Range.Value,
+		"headers": headers,
+		"records": records,
+	})
+}
+
+// rowsToRecords keys each row after the first by the header row. Blank headers
+// become column_N (1-based), repeated ones get a _2, _3... suffix, and cells
+// beyond the header get column_N keys. Sheets trims trailing empty cells, so
+// short rows are padded with "". Fully empty rows are skipped.
+func rowsToRecords(rows [][]jx.Raw) ([]string, []map[string]any) {
+	records := []map[string]any{}
+	if len(rows) == 0 {
+		return []string{}, records
+	}
+	headers := make([]string, 0, len(rows[0]))
+	seen := map[string]int{}
+	for i, raw := range rows[0] {
+		h := strings.TrimSpace(fmt.Sprint(cellValue(raw)))
+		if h == "" {
+			h = fmt.Sprintf("column_%d", i+1)
+		}
+		if seen[h]++; seen[h] > 1 {
+			h = fmt.Sprintf("%s_%d", h, seen[h])
+		}
+		headers = append(headers, h)
+	}
+
+	for _, row := range rows[1:] {
+		record := make(map[string]any, len(headers))
+		empty := true
+		for i := 0; i < max(len(headers), len(row)); i++ {
+			var v any = ""
+			if i < len(row) {
+				v = cellValue(row[i])
+			}
+			if v != "" {
+				empty = false
+			}
+			key := fmt.Sprintf("column_%d", i+1)
+			if i < len(headers) {
+				key = headers[i]
+			}
+			record[key] = v
+		}
+		if !empty {
+			records = append(records, record)
+		}
+	}
+	return headers, records
+}
+
+// cellValue decodes a raw cell (string, number or boolean); null becomes "".
+func cellValue(raw jx.Raw) any {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil || v == nil {
+		return ""
+	}
+	return v
+}
+
 func batchGetValues(ctx context.Context, params map[string]any) (string, error) {
 	cli, err := newOgenClient(ctx)
 	if err != nil {
@@ -852,6 +953,49 @@ func findReplace(ctx context.Context, params map[string]any) (string, error) {
 // Protection
 // =============================================================================
 
+func listProtectedRanges(ctx context.Context, params map[string]any) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+
+	resp, err := cli.GetSpreadsheet(ctx, gen.GetSpreadsheetParams{
+		SpreadsheetId: spreadsheetID,
+		Fields:        gen.NewOptString("sheets(properties(sheetId,title),protectedRanges)"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list protected ranges: %w", err)
+	}
+
+	// Flatten per-sheet protections into one list tagged with the sheet they belong to
+	sheetFilter, filterBySheet := params["sheet_id"].(float64)
+	ranges := []map[string]any{}
+	if items, ok := resp.Sheets.Get(); ok {
+		for _, item := range items {
+			var props struct {
+				SheetID int    `json:"sheetId"`
+				Title   string `json:"title"`
+			}
+			if raw, ok := item["properties"]; ok {
+				_ = json.Unmarshal(raw, &props)
+			}
+			if filterBySheet && props.SheetID != int(sheetFilter) {
+				continue
+			}
+			var protected []map[string]any
+			if raw, ok := item["protectedRanges"]; !ok || json.Unmarshal(raw, &protected) != nil {
+				continue
+			}
+			for _, pr := range protected {
+				pr["sheetTitle"] = props.Title
+				ranges = append(ranges, pr)
+			}
+		}
+	}
+	return toJSON(map[string]any{"protectedRanges": ranges})
+}
+
 func protectRange(ctx context.Context, params map[string]any) (string, error) {
 	spreadsheetID, _ := params["spreadsheet_id"].(string)
 	sheetID, _ := params["sheet_id"].(float64)
@@ -884,6 +1028,337 @@ func protectRange(ctx context.Context, params map[string]any) (string, error) {
 	})
 }
 
+func deleteProtectedRange(ctx context.Context, params map[string]any) (string, error) {
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+	protectedRangeID, _ := params["protected_range_id"].(float64)
+
+	return sheetsBatchUpdate(ctx, spreadsheetID, []map[string]interface{}{
+		{"deleteProtectedRange": map[string]interface{}{"protectedRangeId": int(protectedRangeID)}},
+	})
+}
+
+// =============================================================================
+// Developer Metadata
+// =============================================================================
+
+func createDeveloperMetadata(ctx context.Context, params map[string]any) (string, error) {
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+	key, _ := params["key"].(string)
+
+	location, ok, err := developerMetadataLocation(params)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		location = &gen.DeveloperMetadataLocation{Spreadsheet: gen.NewOptBool(true)}
+	}
+	metadata := map[string]interface{}{
+		"metadataKey": key,
+		"location":    location,
+		"visibility":  "DOCUMENT",
+	}
+	if value, ok := params["value"].(string); ok {
+		metadata["metadataValue"] = value
+	}
+	if visibility, ok := params["visibility"].(string); ok && visibility != "" {
+		metadata["visibility"] = strings.ToUpper(visibility)
+	}
+
+	return sheetsBatchUpdate(ctx, spreadsheetID, []map[string]interface{}{
+		{"createDeveloperMetadata": map[string]interface{}{"developerMetadata": metadata}},
+	})
+}
+
+func searchDeveloperMetadata(ctx context.Context, params map[string]any) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+
+	lookup := gen.DeveloperMetadataLookup{}
+	if key, ok := params["key"].(string); ok && key != "" {
+		lookup.MetadataKey.SetTo(key)
+	}
+	if value, ok := params["value"].(string); ok && value != "" {
+		lookup.MetadataValue.SetTo(value)
+	}
+	if id, ok := params["metadata_id"].(float64); ok {
+		lookup.MetadataId.SetTo(int(id))
+	}
+	if locationType, ok := params["location_type"].(string); ok && locationType != "" {
+		lookup.LocationType.SetTo(strings.ToUpper(locationType))
+	}
+	location, ok, err := developerMetadataLocation(params)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		// Match metadata anywhere inside the location, e.g. every row anchor on a sheet
+		lookup.MetadataLocation.SetTo(*location)
+		lookup.LocationMatchingStrategy.SetTo("INTERSECTING_LOCATION")
+	}
+	if lookup == (gen.DeveloperMetadataLookup{}) {
+		return "", fmt.Errorf("at least one of key, value, metadata_id, location_type, or sheet_id is required")
+	}
+
+	resp, err := cli.SearchDeveloperMetadata(ctx,
+		&gen.SearchDeveloperMetadataRequest{DataFilters: []gen.DataFilter{{DeveloperMetadataLookup: gen.NewOptDeveloperMetadataLookup(lookup)}}},
+		gen.SearchDeveloperMetadataParams{SpreadsheetId: spreadsheetID},
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to search developer metadata: %w", err)
+	}
+	return toJSON(resp)
+}
+
+// developerMetadataLocation builds a metadata location from sheet_id, dimension,
+// start_index and end_index: a sheet, or a row/column span within it when dimension
+// is set. ok is false when no sheet_id is given.
+func developerMetadataLocation(params map[string]any) (*gen.DeveloperMetadataLocation, bool, error) {
+	sheetID, ok := params["sheet_id"].(float64)
+	if !ok {
+		return nil, false, nil
+	}
+	dimension, _ := params["dimension"].(string)
+	if dimension == "" {
+		return &gen.DeveloperMetadataLocation{SheetId: gen.NewOptInt(int(sheetID))}, true, nil
+	}
+	dimension = strings.ToUpper(dimension)
+	if dimension != "ROWS" && dimension != "COLUMNS" {
+		return nil, false, fmt.Errorf("invalid dimension %q: use ROWS or COLUMNS", dimension)
+	}
+	startIndex, ok := params["start_index"].(float64)
+	if !ok {
+		return nil, false, fmt.Errorf("start_index is required when dimension is set")
+	}
+	endIndex, ok := params["end_index"].(float64)
+	if !ok {
+		endIndex = startIndex + 1
+	}
+	if endIndex <= startIndex {
+		return nil, false, fmt.Errorf("end_index must be greater than start_index")
+	}
+	return &gen.DeveloperMetadataLocation{
+		DimensionRange: gen.NewOptDimensionRange(gen.DimensionRange{
+			SheetId:    gen.NewOptInt(int(sheetID)),
+			Dimension:  gen.NewOptString(dimension),
+			StartIndex: gen.NewOptInt(int(startIndex)),
+			EndIndex:   gen.NewOptInt(int(endIndex)),
+		}),
+	}, true, nil
+}
+
+// =============================================================================
+// Range Resolution
+// =============================================================================
+
+func resolveRange(ctx context.Context, params map[string]any) (string, error) {
+	cli, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	spreadsheetID, _ := params["spreadsheet_id"].(string)
+	rangeStr, _ := params["range"].(string)
+
+	ref, err := parseA1Range(rangeStr)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := cli.GetSpreadsheet(ctx, gen.GetSpreadsheetParams{
+		SpreadsheetId: spreadsheetID,
+		Fields:        gen.NewOptString("sheets.properties"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get spreadsheet: %w", err)
+	}
+
+	var sheetID *int
+	sheetTitle := ref.sheet
+	if items, ok := resp.Sheets.Get(); ok {
+		for _, item := range items {
+			var props struct {
+				SheetID int    `json:"sheetId"`
+				Title   string `json:"title"`
+				Index   int    `json:"index"`
+			}
+			raw, ok := item["properties"]
+			if !ok || json.Unmarshal(raw, &props) != nil {
+				continue
+			}
+			if (ref.sheet == "" && props.Index == 0) || (ref.sheet != "" && props.Title == ref.sheet) {
+				id := props.SheetID
+				sheetID = &id
+				sheetTitle = props.Title
+				break
+			}
+		}
+	}
+	if sheetID == nil {
+		if ref.sheet == "" {
+			return "", fmt.Errorf("spreadsheet has no sheets")
+		}
+		return "", fmt.Errorf("sheet not found: %s", ref.sheet)
+	}
+
+	result := map[string]any{
+		"sheet_id":    *sheetID,
+		"sheet_title": sheetTitle,
+	}
+	if ref.startRow != nil {
+		result["start_row"] = *ref.startRow
+	}
+	if ref.endRow != nil {
+		result["end_row"] = *ref.endRow
+	}
+	if ref.startColumn != nil {
+		result["start_column"] = *ref.startColumn
+	}
+	if ref.endColumn != nil {
+		result["end_column"] = *ref.endColumn
+	}
+	return toJSON(result)
+}
+
+// a1Range is a parsed A1 notation range. Row and column bounds are 0-based,
+// end bounds exclusive, and nil when the range leaves them open (e.g. "A:C").
+type a1Range struct {
+	sheet       string
+	startRow    *int
+	endRow      *int
+	startColumn *int
+	endColumn   *int
+}
+
+// parseA1Range parses ranges such as "Sheet1!A1:C10", "'My Sheet'!B:B", "2:5", "A1" or "Sheet1".
+func parseA1Range(s string) (*a1Range, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("range is empty")
+	}
+	ref := &a1Range{}
+
+	cells := s
+	if strings.HasPrefix(s, "'") {
+		// Quoted sheet name; '' escapes a single quote.
+		var name strings.Builder
+		i := 1
+		for ; i < len(s); i++ {
+			if s[i] == '\'' {
+				if i+1 < len(s) && s[i+1] == '\'' {
+					name.WriteByte('\'')
+					i++
+					continue
+				}
+				break
+			}
+			name.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("invalid range %q: unterminated sheet name", s)
+		}
+		ref.sheet = name.String()
+		rest := s[i+1:]
+		if rest == "" {
+			return ref, nil
+		}
+		if !strings.HasPrefix(rest, "!") {
+			return nil, fmt.Errorf("invalid range %q", s)
+		}
+		cells = rest[1:]
+	} else if idx := strings.LastIndex(s, "!"); idx >= 0 {
+		ref.sheet = s[:idx]
+		cells = s[idx+1:]
+	} else if _, _, ok := splitA1Cell(strings.SplitN(s, ":", 2)[0]); !ok {
+		// No cell reference at all: the whole string is a sheet name.
+		ref.sheet = s
+		return ref, nil
+	}
+	if cells == "" {
+		return ref, nil
+	}
+
+	parts := strings.SplitN(cells, ":", 2)
+	startCol, startRow, ok := splitA1Cell(parts[0])
+	if !ok {
+		return nil, fmt.Errorf("invalid range %q: bad cell reference %q", s, parts[0])
+	}
+	if startCol != nil {
+		ref.startColumn = startCol
+	}
+	if startRow != nil {
+		v := *startRow - 1
+		ref.startRow = &v
+	}
+
+	if len(parts) == 1 {
+		// Single cell (or a bare column/row) covers exactly one unit.
+		if startCol == nil || startRow == nil {
+			return nil, fmt.Errorf("invalid range %q: a single reference must be a cell like A1", s)
+		}
+		endCol, endRow := *startCol+1, *startRow
+		ref.endColumn, ref.endRow = &endCol, &endRow
+		return ref, nil
+	}
+
+	endCol, endRow, ok := splitA1Cell(parts[1])
+	if !ok {
+		return nil, fmt.Errorf("invalid range %q: bad cell reference %q", s, parts[1])
+	}
+	if endCol != nil {
+		v := *endCol + 1
+		ref.endColumn = &v
+	}
+	if endRow != nil {
+		ref.endRow = endRow
+	}
+	if ref.startColumn != nil && ref.endColumn != nil && *ref.endColumn <= *ref.startColumn {
+		return nil, fmt.Errorf("invalid range %q: end column precedes start column", s)
+	}
+	if ref.startRow != nil && ref.endRow != nil && *ref.endRow <= *ref.startRow {
+		return nil, fmt.Errorf("invalid range %q: end row precedes start row", s)
+	}
+	return ref, nil
+}
+
+// splitA1Cell splits a cell reference like "AB12" into a 0-based column index
+// and a 1-based row number. Either part may be absent ("AB", "12"); "$" anchors are ignored.
+func splitA1Cell(cell string) (col *int, row *int, ok bool) {
+	cell = strings.ToUpper(strings.ReplaceAll(cell, "$", ""))
+	if cell == "" {
+		return nil, nil, false
+	}
+	i := 0
+	c := 0
+	for i < len(cell) && cell[i] >= 'A' && cell[i] <= 'Z' {
+		c = c*26 + int(cell[i]-'A'+1)
+		i++
+	}
+	if i > 3 {
+		// Sheets columns stop at ZZZ; longer letter runs are not cell references.
+		return nil, nil, false
+	}
+	if i > 0 {
+		v := c - 1
+		col = &v
+	}
+	if i < len(cell) {
+		r := 0
+		for j := i; j < len(cell); j++ {
+			if cell[j] < '0' || cell[j] > '9' {
+				return nil, nil, false
+			}
+			r = r*10 + int(cell[j]-'0')
+		}
+		if r == 0 {
+			return nil, nil, false
+		}
+		row = &r
+	}
+	return col, row, true
+}
+
 // =============================================================================
 // Helper Functions
 // =============================================================================
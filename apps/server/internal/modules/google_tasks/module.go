@@ -2,7 +2,6 @@ package google_tasks
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -47,6 +46,7 @@ func (m *GoogleTasksModule) ExecuteTool(ctx context.Context, name string, params
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -55,6 +55,12 @@ func (m *GoogleTasksModule) ToCompact(toolName string, jsonResult string) string
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GoogleTasksModule) HealthProbe() (string, map[string]any) {
+	return "list_task_lists", nil
+}
+
 // =============================================================================
 // Token and Client
 // =============================================================================
@@ -74,6 +80,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "google_tasks", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -167,7 +174,8 @@ var toolDefinitions = []modules.Tool{
 				"task_list_id": {Type: "string", Description: "Task list ID. Use '@default' for the default task list."},
 				"title":        {Type: "string", Description: "Task title"},
 				"notes":        {Type: "string", Description: "Task notes/description"},
-				"due":          {Type: "string", Description: "Due date (RFC3339 format, e.g., '2024-01-15T00:00:00Z')"},
+				"due":          {Type: "string", Description: "Due date (YYYY-MM-DD or RFC3339, or relative like 'tomorrow'). Only the date is stored"},
+				"timezone":     modules.TimezoneProperty,
 				"parent":       {Type: "string", Description: "Parent task ID for creating subtasks"},
 			},
 			Required: []string{"task_list_id", "title"},
@@ -188,7 +196,8 @@ var toolDefinitions = []modules.Tool{
 				"task_id":      {Type: "string", Description: "Task ID"},
 				"title":        {Type: "string", Description: "New task title"},
 				"notes":        {Type: "string", Description: "New task notes"},
-				"due":          {Type: "string", Description: "New due date (RFC3339 format)"},
+				"due":          {Type: "string", Description: "New due date (YYYY-MM-DD or RFC3339, or relative like 'tomorrow')"},
+				"timezone":     modules.TimezoneProperty,
 				"status":       {Type: "string", Description: "Task status: 'needsAction' or 'completed'"},
 			},
 			Required: []string{"task_list_id", "task_id"},
@@ -233,8 +242,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "google_tasks:clear_completed",
 		Name: "clear_completed",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Delete all completed tasks from a task list permanently.",
-			"ja-JP": "タスクリストの完了済みタスクをすべて完全に削除します。",
+			"en-US": "Clear all completed tasks from a task list in one call. Cleared tasks are hidden and no longer returned by list_tasks unless show_hidden is true.",
+			"ja-JP": "タスクリストの完了済みタスクを一括でクリアします。クリアされたタスクは非表示になり、show_hiddenをtrueにしない限りlist_tasksで返されません。",
 		},
 		Annotations: modules.AnnotateDelete,
 		InputSchema: modules.InputSchema{
@@ -361,7 +370,11 @@ func createTask(ctx context.Context, params map[string]any) (string, error) {
 		req.Notes = gen.NewOptNilString(notes)
 	}
 	if due, ok := params["due"].(string); ok && due != "" {
-		req.Due = gen.NewOptNilString(due)
+		d, err := taskDue(due, params)
+		if err != nil {
+			return "", err
+		}
+		req.Due = gen.NewOptNilString(d)
 	}
 
 	p := gen.CreateTaskParams{TaskListId: taskListID}
@@ -380,6 +393,21 @@ func createTask(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// taskDue normalizes a due param, resolving relative dates in the timezone
+// param. Google Tasks keeps only the date part of due, so it is sent as
+// midnight UTC of the date the caller gave.
+func taskDue(due string, params map[string]any) (string, error) {
+	loc, err := modules.LocationParam(params)
+	if err != nil {
+		return "", err
+	}
+	d, err := modules.NormalizeDate(due, loc)
+	if err != nil {
+		return "", fmt.Errorf("due: %w", err)
+	}
+	return d + "T00:00:00.000Z", nil
+}
+
 func updateTask(ctx context.Context, params map[string]any) (string, error) {
 	taskListID, _ := params["task_list_id"].(string)
 	taskID, _ := params["task_id"].(string)
@@ -413,7 +441,11 @@ func updateTask(ctx context.Context, params map[string]any) (string, error) {
 		if due == "" {
 			req.Due = gen.OptNilString{}
 		} else {
-			req.Due = gen.NewOptNilString(due)
+			d, err := taskDue(due, params)
+			if err != nil {
+				return "", err
+			}
+			req.Due = gen.NewOptNilString(d)
 		}
 	}
 	if status, ok := params["status"].(string); ok && status != "" {
@@ -491,39 +523,9 @@ func clearCompleted(ctx context.Context, params map[string]any) (string, error)
 	if err != nil {
 		return "", err
 	}
-
-	// List all tasks including completed
-	res, err := c.ListTasks(ctx, gen.ListTasksParams{
-		TaskListId:    taskListID,
-		ShowCompleted: gen.NewOptBool(true),
-		ShowHidden:    gen.NewOptBool(false),
-	})
+	err = c.ClearCompletedTasks(ctx, gen.ClearCompletedTasksParams{TaskListId: taskListID})
 	if err != nil {
 		return "", err
 	}
-
-	// Delete each completed task
-	deletedCount := 0
-	for _, task := range res.Items {
-		if s, ok := task.Status.Get(); ok && s == "completed" {
-			id, ok := task.ID.Get()
-			if !ok {
-				continue
-			}
-			err := c.DeleteTask(ctx, gen.DeleteTaskParams{TaskListId: taskListID, TaskId: id})
-			if err != nil {
-				log.Printf("[google_tasks] Failed to delete task %s: %v", id, err)
-				continue
-			}
-			deletedCount++
-		}
-	}
-
-	result := map[string]any{
-		"success":       true,
-		"deleted_count": deletedCount,
-		"message":       fmt.Sprintf("Deleted %d completed tasks", deletedCount),
-	}
-	b, _ := json.Marshal(result)
-	return string(b), nil
+	return `{"success":true,"message":"Completed tasks cleared"}`, nil
 }
@@ -3,6 +3,7 @@ package grafana
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -21,8 +22,14 @@ func formatCompact(toolName, jsonStr string) string {
 		return alertsToCSV(jsonStr)
 	case "query_annotations":
 		return annotationsToCSV(jsonStr)
+	case "list_alert_instances":
+		return alertInstancesToCSV(jsonStr)
 	case "list_folders":
 		return foldersToCSV(jsonStr)
+	case "list_library_panels":
+		return libraryPanelsToCSV(jsonStr)
+	case "list_service_accounts":
+		return serviceAccountsToCSV(jsonStr)
 	// Read: single items → MD
 	case "get_dashboard":
 		return dashboardToCompact(jsonStr)
@@ -30,19 +37,25 @@ func formatCompact(toolName, jsonStr string) string {
 		return datasourceToCompact(jsonStr)
 	case "get_alert":
 		return alertToCompact(jsonStr)
+	case "get_alert_state":
+		return alertStateToCompact(jsonStr)
+	case "get_library_panel":
+		return libraryPanelToCompact(jsonStr)
 	// Write
-	case "create_update_dashboard":
+	case "create_update_dashboard", "update_panel":
 		return pickKeys(jsonStr, "id", "uid", "url", "status", "version")
 	case "delete_dashboard":
 		return pickKeys(jsonStr, "title", "message")
 	case "create_annotation":
 		return pickKeys(jsonStr, "id", "message")
-	case "delete_annotation":
+	case "update_annotation", "delete_annotation":
 		return pickKeys(jsonStr, "message")
 	case "create_folder":
 		return pickKeys(jsonStr, "id", "uid", "title")
 	case "delete_folder":
 		return pickKeys(jsonStr, "title", "message")
+	case "create_library_panel":
+		return libraryPanelToCompact(jsonStr)
 	case "create_alert_rule":
 		return pickKeys(jsonStr, "uid", "title", "folderUID", "ruleGroup")
 	// Contact Points
@@ -52,6 +65,9 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "uid", "name", "type")
 	case "delete_contact_point":
 		return pickKeys(jsonStr, "message")
+	// Service Accounts — the key is only shown once, so keep it
+	case "create_service_account_token":
+		return pickKeys(jsonStr, "id", "name", "key")
 	// Notification Policies — tree structure, keep as-is
 	case "get_notification_policy", "update_notification_policy":
 		return jsonStr
@@ -237,6 +253,87 @@ func alertsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// alertStateToCompact: rule state summary + active instances as CSV (state,activeAt,value,labels)
+func alertStateToCompact(jsonStr string) string {
+	var rule map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &rule); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(rule, "name")))
+	sb.WriteString(fmt.Sprintf("state: %s | health: %s\n", str(rule, "state"), str(rule, "health")))
+	sb.WriteString(fmt.Sprintf("lastEvaluation: %s\n", str(rule, "lastEvaluation")))
+	if activeAt := str(rule, "activeAt"); activeAt != "" && !strings.HasPrefix(activeAt, "0001-") {
+		sb.WriteString(fmt.Sprintf("activeAt: %s\n", activeAt))
+	}
+	if lastErr := str(rule, "lastError"); lastErr != "" {
+		sb.WriteString(fmt.Sprintf("lastError: %s\n", lastErr))
+	}
+
+	alerts, _ := rule["alerts"].([]any)
+	if len(alerts) == 0 {
+		sb.WriteString("# 0 instances")
+		return sb.String()
+	}
+	sb.WriteString("```csv\nstate,activeAt,value,labels\n")
+	for _, raw := range alerts {
+		a, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+			str(a, "state"),
+			str(a, "activeAt"),
+			csvEscape(str(a, "value")),
+			csvEscape(labelsString(a["labels"])),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// alertInstancesToCSV: state,rule,startsAt,labels
+func alertInstancesToCSV(jsonStr string) string {
+	var alerts []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &alerts); err != nil {
+		return jsonStr
+	}
+	if len(alerts) == 0 {
+		return "# 0 alert instances"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nstate,rule,startsAt,labels\n")
+	for _, a := range alerts {
+		status, _ := a["status"].(map[string]any)
+		labels, _ := a["labels"].(map[string]any)
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s\n",
+			str(status, "state"),
+			csvEscape(str(labels, "alertname")),
+			str(a, "startsAt"),
+			csvEscape(labelsString(labels)),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// labelsString renders a label map as "k=v; ..." sorted by key, skipping internal "__" labels.
+func labelsString(v any) string {
+	labels, _ := v.(map[string]any)
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		if !strings.HasPrefix(k, "__") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, labels[k]))
+	}
+	return strings.Join(parts, "; ")
+}
+
 // contactPointsToCSV: uid,name,type
 func contactPointsToCSV(jsonStr string) string {
 	var cps []map[string]any
@@ -340,6 +437,110 @@ func foldersToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// libraryPanelsToCSV: uid,name,type,folder,connectedDashboards
+func libraryPanelsToCSV(jsonStr string) string {
+	var wrapper map[string]map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	result, ok := wrapper["result"]
+	if !ok {
+		return jsonStr
+	}
+	elements, _ := result["elements"].([]any)
+	if len(elements) == 0 {
+		return "# 0 library panels"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nuid,name,type,folder,connectedDashboards\n")
+	for _, raw := range elements {
+		e, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		meta, _ := e["meta"].(map[string]any)
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%d\n",
+			csvEscape(str(e, "uid")),
+			csvEscape(str(e, "name")),
+			str(e, "type"),
+			csvEscape(str(meta, "folderName")),
+			intVal(meta, "connectedDashboards"),
+		))
+	}
+	sb.WriteString("```")
+	if total := intVal(result, "totalCount"); total > len(elements) {
+		sb.WriteString(fmt.Sprintf("\n%d of %d (page %d)", len(elements), total, intVal(result, "page")))
+	}
+	return sb.String()
+}
+
+// serviceAccountsToCSV: id,name,login,role,disabled,tokens
+func serviceAccountsToCSV(jsonStr string) string {
+	var result map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
+		return jsonStr
+	}
+	accounts, _ := result["serviceAccounts"].([]any)
+	if len(accounts) == 0 {
+		return "# 0 service accounts"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,login,role,disabled,tokens\n")
+	for _, raw := range accounts {
+		a, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		disabled, _ := a["isDisabled"].(bool)
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s,%t,%d\n",
+			intVal(a, "id"),
+			csvEscape(str(a, "name")),
+			csvEscape(str(a, "login")),
+			str(a, "role"),
+			disabled,
+			intVal(a, "tokens"),
+		))
+	}
+	sb.WriteString("```")
+	if total := intVal(result, "totalCount"); total > len(accounts) {
+		sb.WriteString(fmt.Sprintf("\n%d of %d (page %d)", len(accounts), total, intVal(result, "page")))
+	}
+	return sb.String()
+}
+
+// libraryPanelToCompact: single library panel with its model
+func libraryPanelToCompact(jsonStr string) string {
+	var wrapper map[string]map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	e, ok := wrapper["result"]
+	if !ok {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(e, "name")))
+	sb.WriteString(fmt.Sprintf("- **UID**: %s\n", str(e, "uid")))
+	if t := str(e, "type"); t != "" {
+		sb.WriteString(fmt.Sprintf("- **Type**: %s\n", t))
+	}
+	if meta, ok := e["meta"].(map[string]any); ok {
+		if folder := str(meta, "folderName"); folder != "" {
+			sb.WriteString(fmt.Sprintf("- **Folder**: %s\n", folder))
+		}
+		sb.WriteString(fmt.Sprintf("- **Connected Dashboards**: %d\n", intVal(meta, "connectedDashboards")))
+	}
+	if ver := intVal(e, "version"); ver > 0 {
+		sb.WriteString(fmt.Sprintf("- **Version**: %d\n", ver))
+	}
+	if model, ok := e["model"]; ok && model != nil {
+		if b, err := json.Marshal(model); err == nil {
+			sb.WriteString(fmt.Sprintf("\n```json\n%s\n```\n", b))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -60,6 +60,7 @@ func (m *GrafanaModule) ExecuteTool(ctx context.Context, name string, params map
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -69,6 +70,78 @@ func (m *GrafanaModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *GrafanaModule) HealthProbe() (string, map[string]any) {
+	return "list_datasources", nil
+}
+
+// PreviewDelete lists what delete_folder would remove
+// Implements modules.DeletePreviewer interface
+func (m *GrafanaModule) PreviewDelete(ctx context.Context, toolName string, params map[string]any) (any, error) {
+	if toolName != "delete_folder" {
+		return nil, fmt.Errorf("no preview for tool: %s", toolName)
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uid, _ := params["uid"].(string)
+	res, err := c.Search(ctx, gen.SearchParams{FolderUIDs: []string{uid}, Limit: gen.NewOptInt(5000)})
+	if err != nil {
+		return nil, err
+	}
+	var dashboards, folders []string
+	for _, r := range res {
+		if r.Type.Value == "dash-folder" {
+			folders = append(folders, r.Title.Value)
+		} else {
+			dashboards = append(dashboards, r.Title.Value)
+		}
+	}
+	return map[string]any{
+		"folder_uid":      uid,
+		"dashboard_count": len(dashboards),
+		"dashboards":      modules.Preview(dashboards),
+		"subfolders":      modules.Preview(folders),
+	}, nil
+}
+
+// SnapshotForUpdate returns the dashboard, notification policy tree or contact
+// point an update tool is about to change.
+func (m *GrafanaModule) SnapshotForUpdate(ctx context.Context, toolName string, params map[string]any) (string, error) {
+	switch toolName {
+	case "update_panel":
+		uid, _ := params["dashboard_uid"].(string)
+		return getDashboard(ctx, map[string]any{"uid": uid})
+	case "update_notification_policy":
+		return getNotificationPolicy(ctx, params)
+	case "update_contact_point":
+		return contactPointByUID(ctx, params)
+	default:
+		return "", modules.ErrDiffUnsupported
+	}
+}
+
+// contactPointByUID picks the contact point with params["uid"] out of list_contact_points.
+func contactPointByUID(ctx context.Context, params map[string]any) (string, error) {
+	uid, _ := params["uid"].(string)
+	list, err := listContactPoints(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	var points []map[string]any
+	if err := json.Unmarshal([]byte(list), &points); err != nil {
+		return "", fmt.Errorf("failed to parse contact points: %w", err)
+	}
+	for _, p := range points {
+		if p["uid"] == uid {
+			return toJSON(p)
+		}
+	}
+	return "", fmt.Errorf("contact point not found: %s", uid)
+}
+
 // Resources returns all available resources (none for Grafana)
 func (m *GrafanaModule) Resources() []modules.Resource {
 	return nil
@@ -96,16 +169,19 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "grafana", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
 	}
 
-	base, _ := creds.Metadata["base_url"].(string)
-	if base == "" {
+	serverURL, err := creds.BaseURL("")
+	if err != nil {
+		return nil, err
+	}
+	if serverURL == "" {
 		return nil, fmt.Errorf("grafana base_url not configured")
 	}
-	serverURL := strings.TrimRight(base, "/")
 
 	switch creds.AuthType {
 	case broker.AuthTypeBasic:
@@ -243,6 +319,41 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"uid"},
 		},
 	},
+	{
+		ID:   "grafana:get_alert_state",
+		Name: "get_alert_state",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the current evaluation state of an alert rule (firing, pending, or inactive), its health, last evaluation time, and active instances.",
+			"ja-JP": "アラートルールの現在の評価状態（firing、pending、inactive）、ヘルス、最終評価時刻、アクティブなインスタンスを取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"uid": {Type: "string", Description: "Alert rule UID"},
+			},
+			Required: []string{"uid"},
+		},
+	},
+	{
+		ID:   "grafana:list_alert_instances",
+		Name: "list_alert_instances",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List alert instances currently firing (or silenced/inhibited) in the Grafana Alertmanager.",
+			"ja-JP": "Grafana Alertmanagerで現在発火中（またはサイレンス・抑制中）のアラートインスタンスを一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"rule_uid":  {Type: "string", Description: "Only instances of this alert rule"},
+				"filter":    {Type: "array", Description: "Label matchers (e.g., [\"severity=critical\", \"team=~ops.*\"])", Items: &modules.Property{Type: "string"}},
+				"active":    {Type: "boolean", Description: "Include active instances (default: true)"},
+				"silenced":  {Type: "boolean", Description: "Include silenced instances (default: true)"},
+				"inhibited": {Type: "boolean", Description: "Include inhibited instances (default: true)"},
+			},
+		},
+	},
 	{
 		ID:   "grafana:query_annotations",
 		Name: "query_annotations",
@@ -280,6 +391,57 @@ var toolDefinitions = []modules.Tool{
 			},
 		},
 	},
+	{
+		ID:   "grafana:list_library_panels",
+		Name: "list_library_panels",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List library panels (reusable panels shared across dashboards).",
+			"ja-JP": "ライブラリパネル（複数のダッシュボードで共有される再利用可能なパネル）を一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"query":       {Type: "string", Description: "Search by panel name or description"},
+				"folder_uids": {Type: "array", Description: "List of folder UIDs to search within", Items: &modules.Property{Type: "string"}},
+				"limit":       {Type: "number", Description: "Maximum results per page (default: 100)"},
+				"page":        {Type: "number", Description: "Page number (default: 1)"},
+			},
+		},
+	},
+	{
+		ID:   "grafana:get_library_panel",
+		Name: "get_library_panel",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a library panel by its UID, including the panel JSON model.",
+			"ja-JP": "UIDでライブラリパネルを取得します（パネルのJSONモデルを含む）。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"uid": {Type: "string", Description: "Library panel UID"},
+			},
+			Required: []string{"uid"},
+		},
+	},
+	{
+		ID:   "grafana:list_service_accounts",
+		Name: "list_service_accounts",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List service accounts in the organization (requires admin permissions).",
+			"ja-JP": "組織内のサービスアカウントを一覧表示します（管理者権限が必要）。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"query": {Type: "string", Description: "Filter by name or login"},
+				"limit": {Type: "number", Description: "Maximum results per page (default: 1000)"},
+				"page":  {Type: "number", Description: "Page number (default: 1)"},
+			},
+		},
+	},
 	// =========================================================================
 	// Write Tools
 	// =========================================================================
@@ -302,6 +464,25 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"dashboard"},
 		},
 	},
+	{
+		ID:   "grafana:update_panel",
+		Name: "update_panel",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Update a single panel of a dashboard. Fields in panel are merged into the existing panel (nested objects are merged, arrays are replaced, null removes a field); other panels are left untouched.",
+			"ja-JP": "ダッシュボードの単一パネルを更新します。panelのフィールドは既存パネルにマージされます（ネストしたオブジェクトはマージ、配列は置換、nullでフィールドを削除）。他のパネルは変更されません。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"dashboard_uid": {Type: "string", Description: "Dashboard UID"},
+				"panel_id":      {Type: "number", Description: "ID of the panel to update (panels inside rows are included)"},
+				"panel":         {Type: "object", Description: "Partial panel JSON model to merge (e.g., {\"title\": \"New title\", \"targets\": [...]})"},
+				"message":       {Type: "string", Description: "Commit message for the change"},
+			},
+			Required: []string{"dashboard_uid", "panel_id", "panel"},
+		},
+	},
 	{
 		ID:   "grafana:delete_dashboard",
 		Name: "delete_dashboard",
@@ -339,6 +520,26 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"text"},
 		},
 	},
+	{
+		ID:   "grafana:update_annotation",
+		Name: "update_annotation",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Update an annotation's text, tags, or time range, keeping its ID. Fields that are omitted keep their current values.",
+			"ja-JP": "アノテーションのテキスト、タグ、時間範囲をIDを保ったまま更新します。省略したフィールドは現在の値のままです。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"annotation_id": {Type: "number", Description: "Annotation ID to update"},
+				"text":          {Type: "string", Description: "New annotation text"},
+				"tags":          {Type: "array", Description: "New tags (replaces existing tags; [] clears them)", Items: &modules.Property{Type: "string"}},
+				"time":          {Type: "number", Description: "New epoch timestamp in milliseconds for annotation start"},
+				"time_end":      {Type: "number", Description: "New epoch timestamp in milliseconds for annotation end (for region annotations)"},
+			},
+			Required: []string{"annotation_id"},
+		},
+	},
 	{
 		ID:   "grafana:delete_annotation",
 		Name: "delete_annotation",
@@ -376,10 +577,10 @@ var toolDefinitions = []modules.Tool{
 		ID:   "grafana:delete_folder",
 		Name: "delete_folder",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Delete a folder by its UID. This also deletes all dashboards within the folder.",
-			"ja-JP": "UIDでフォルダを削除します。フォルダ内のすべてのダッシュボードも削除されます。",
+			"en-US": "Delete a folder by its UID. This also deletes all dashboards within the folder. The first call returns the dashboards that would be deleted and a confirmation_token; call again with the token to delete.",
+			"ja-JP": "UIDでフォルダを削除します。フォルダ内のすべてのダッシュボードも削除されます。最初の呼び出しは削除対象のダッシュボードとconfirmation_tokenを返します。トークンを付けて再度呼び出すと削除されます。",
 		},
-		Annotations: modules.AnnotateDelete,
+		Annotations: modules.AnnotateConfirmedDelete,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
@@ -388,6 +589,25 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"uid"},
 		},
 	},
+	{
+		ID:   "grafana:create_library_panel",
+		Name: "create_library_panel",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a library panel from a panel JSON model so it can be reused across dashboards.",
+			"ja-JP": "パネルのJSONモデルからライブラリパネルを作成し、複数のダッシュボードで再利用できるようにします。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"name":       {Type: "string", Description: "Library panel name"},
+				"model":      {Type: "object", Description: "Panel JSON model (type, title, targets, fieldConfig, options, etc.)"},
+				"folder_uid": {Type: "string", Description: "UID of the folder to store the library panel in (default: General)"},
+				"uid":        {Type: "string", Description: "Optional custom UID for the library panel"},
+			},
+			Required: []string{"name", "model"},
+		},
+	},
 	{
 		ID:   "grafana:list_contact_points",
 		Name: "list_contact_points",
@@ -515,6 +735,24 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"title", "rule_group", "folder_uid", "condition", "data"},
 		},
 	},
+	{
+		ID:   "grafana:create_service_account_token",
+		Name: "create_service_account_token",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create an API token for a service account. The token key is only returned once, in this response.",
+			"ja-JP": "サービスアカウントのAPIトークンを作成します。トークンキーはこのレスポンスでのみ返されます。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"sa_id": {Type: "number", Description: "Service account ID (use list_service_accounts to find)"},
+				"name":  {Type: "string", Description: "Token name (must be unique within the service account)"},
+				"ttl":   {Type: "number", Description: "Token lifetime in seconds. Omit for a token that never expires (if the server allows it)"},
+			},
+			Required: []string{"sa_id", "name"},
+		},
+	},
 	{
 		ID:   "grafana:query_datasource",
 		Name: "query_datasource",
@@ -531,6 +769,7 @@ var toolDefinitions = []modules.Tool{
 				"from":           {Type: "string", Description: "Start time (epoch ms or relative e.g., 'now-1h')"},
 				"to":             {Type: "string", Description: "End time (epoch ms or relative e.g., 'now')"},
 				"max_lines":      {Type: "number", Description: "Maximum number of log lines to return (Loki only, default: 100)"},
+				"max_rows":       {Type: "number", Description: "Maximum rows per result frame (default: 1000, max: 10000). Frames cut at this size set truncated: true; narrow from/to to see the rest"},
 			},
 			Required: []string{"datasource_uid", "expr"},
 		},
@@ -543,29 +782,38 @@ var toolDefinitions = []modules.Tool{
 
 var toolHandlers = map[string]toolHandler{
 	// Read
-	"search":            search,
-	"get_dashboard":     getDashboard,
-	"list_datasources":  listDatasources,
-	"get_datasource":    getDatasource,
-	"list_alerts":       listAlerts,
-	"get_alert":         getAlert,
-	"query_annotations": queryAnnotations,
-	"list_folders":      listFolders,
+	"search":                search,
+	"get_dashboard":         getDashboard,
+	"list_datasources":      listDatasources,
+	"get_datasource":        getDatasource,
+	"list_alerts":           listAlerts,
+	"get_alert":             getAlert,
+	"get_alert_state":       getAlertState,
+	"list_alert_instances":  listAlertInstances,
+	"query_annotations":     queryAnnotations,
+	"list_folders":          listFolders,
+	"list_library_panels":   listLibraryPanels,
+	"get_library_panel":     getLibraryPanel,
+	"list_service_accounts": listServiceAccounts,
 	// Write
-	"create_update_dashboard": createUpdateDashboard,
-	"delete_dashboard":        deleteDashboard,
-	"create_annotation":       createAnnotation,
-	"delete_annotation":       deleteAnnotation,
-	"create_folder":           createFolder,
-	"delete_folder":           deleteFolder,
-	"create_alert_rule":          createAlertRule,
-	"list_contact_points":        listContactPoints,
-	"create_contact_point":       createContactPoint,
-	"update_contact_point":       updateContactPoint,
-	"delete_contact_point":       deleteContactPoint,
-	"get_notification_policy":    getNotificationPolicy,
-	"update_notification_policy": updateNotificationPolicy,
-	"query_datasource":           queryDatasource,
+	"create_update_dashboard":      createUpdateDashboard,
+	"update_panel":                 updatePanel,
+	"delete_dashboard":             deleteDashboard,
+	"create_annotation":            createAnnotation,
+	"update_annotation":            updateAnnotation,
+	"delete_annotation":            deleteAnnotation,
+	"create_folder":                createFolder,
+	"delete_folder":                deleteFolder,
+	"create_library_panel":         createLibraryPanel,
+	"create_alert_rule":            createAlertRule,
+	"list_contact_points":          listContactPoints,
+	"create_contact_point":         createContactPoint,
+	"update_contact_point":         updateContactPoint,
+	"delete_contact_point":         deleteContactPoint,
+	"get_notification_policy":      getNotificationPolicy,
+	"update_notification_policy":   updateNotificationPolicy,
+	"create_service_account_token": createServiceAccountToken,
+	"query_datasource":             queryDatasource,
 }
 
 // =============================================================================
@@ -676,6 +924,73 @@ func getAlert(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func getAlertState(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	uid, _ := params["uid"].(string)
+	res, err := c.ListRuleStatuses(ctx, gen.ListRuleStatusesParams{RuleUID: gen.NewOptString(uid)})
+	if err != nil {
+		return "", err
+	}
+	if rule := findRuleStatus(res, func(r gen.RuleStatus) bool { return r.UID.Value == uid }); rule != nil {
+		return toJSON(rule)
+	}
+
+	// Older Grafana versions omit the uid from rule status; match on the rule title instead
+	def, err := c.GetAlertRule(ctx, gen.GetAlertRuleParams{UID: uid})
+	if err != nil {
+		return "", err
+	}
+	if rule := findRuleStatus(res, func(r gen.RuleStatus) bool { return r.Name.Value == def.Title.Value }); rule != nil {
+		return toJSON(rule)
+	}
+	return "", fmt.Errorf("no evaluation state for alert rule %s (it may be paused or not yet evaluated)", uid)
+}
+
+// findRuleStatus returns the first rule across all groups that matches.
+func findRuleStatus(res *gen.RuleStatusResponse, match func(gen.RuleStatus) bool) *gen.RuleStatus {
+	for _, g := range res.Data.Value.Groups {
+		for i := range g.Rules {
+			if match(g.Rules[i]) {
+				return &g.Rules[i]
+			}
+		}
+	}
+	return nil
+}
+
+func listAlertInstances(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	p := gen.ListAlertInstancesParams{}
+	if filters, ok := params["filter"].([]interface{}); ok {
+		p.Filter = modules.ToStringSlice(filters)
+	}
+	if uid, ok := params["rule_uid"].(string); ok && uid != "" {
+		p.Filter = append(p.Filter, fmt.Sprintf("__alert_rule_uid__=%q", uid))
+	}
+	if v, ok := params["active"].(bool); ok {
+		p.Active.SetTo(v)
+	}
+	if v, ok := params["silenced"].(bool); ok {
+		p.Silenced.SetTo(v)
+	}
+	if v, ok := params["inhibited"].(bool); ok {
+		p.Inhibited.SetTo(v)
+	}
+
+	res, err := c.ListAlertInstances(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func queryAnnotations(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -737,6 +1052,82 @@ func listFolders(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// libraryPanelKind is the library element kind for panels (2 is variables).
+const libraryPanelKind = 1
+
+func listLibraryPanels(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	p := gen.ListLibraryElementsParams{}
+	p.Kind.SetTo(libraryPanelKind)
+	if q, ok := params["query"].(string); ok && q != "" {
+		p.SearchString.SetTo(q)
+	}
+	if uids, ok := params["folder_uids"].([]interface{}); ok {
+		var folders []string
+		for _, uid := range uids {
+			if us, ok := uid.(string); ok && us != "" {
+				folders = append(folders, us)
+			}
+		}
+		if len(folders) > 0 {
+			p.FolderFilterUIDs.SetTo(strings.Join(folders, ","))
+		}
+	}
+	if l, ok := params["limit"].(float64); ok {
+		p.PerPage.SetTo(int(l))
+	}
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+
+	res, err := c.ListLibraryElements(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func getLibraryPanel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	uid, _ := params["uid"].(string)
+	res, err := c.GetLibraryElementByUid(ctx, gen.GetLibraryElementByUidParams{UID: uid})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func listServiceAccounts(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	p := gen.SearchServiceAccountsParams{}
+	if q, ok := params["query"].(string); ok && q != "" {
+		p.Query.SetTo(q)
+	}
+	if l, ok := params["limit"].(float64); ok {
+		p.Perpage.SetTo(int(l))
+	}
+	if pg, ok := params["page"].(float64); ok {
+		p.Page.SetTo(int(pg))
+	}
+
+	res, err := c.SearchServiceAccounts(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 // =============================================================================
 // Write Handlers
 // =============================================================================
@@ -777,6 +1168,97 @@ func createUpdateDashboard(ctx context.Context, params map[string]any) (string,
 	return toJSON(res)
 }
 
+func updatePanel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	uid, _ := params["dashboard_uid"].(string)
+	panelID, _ := params["panel_id"].(float64)
+	patch, ok := params["panel"].(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("panel is required and must be an object")
+	}
+
+	current, err := c.GetDashboardByUid(ctx, gen.GetDashboardByUidParams{UID: uid})
+	if err != nil {
+		return "", err
+	}
+	var dashboard map[string]any
+	if err := json.Unmarshal(current.Dashboard, &dashboard); err != nil {
+		return "", fmt.Errorf("failed to parse dashboard: %w", err)
+	}
+
+	panel := findPanel(dashboard["panels"], int(panelID))
+	if panel == nil {
+		return "", fmt.Errorf("panel %d not found in dashboard %s", int(panelID), uid)
+	}
+	mergePanel(panel, patch)
+	// The id identifies the panel being edited; never let the patch change it
+	panel["id"] = panelID
+
+	dashRaw, err := toRaw(dashboard)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode dashboard: %w", err)
+	}
+	req := &gen.SaveDashboardRequest{
+		Dashboard: dashRaw,
+	}
+	req.Overwrite.SetTo(true)
+	if meta, ok := current.Meta.Get(); ok {
+		if folderUID, ok := meta.FolderUid.Get(); ok && folderUID != "" {
+			req.FolderUid.SetTo(folderUID)
+		}
+	}
+	if message, ok := params["message"].(string); ok && message != "" {
+		req.Message.SetTo(message)
+	}
+
+	res, err := c.CreateOrUpdateDashboard(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+// findPanel returns the panel with the given id, searching the panels of
+// collapsed rows as well as top-level panels.
+func findPanel(panels any, id int) map[string]any {
+	list, _ := panels.([]any)
+	for _, raw := range list {
+		p, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if pid, ok := p["id"].(float64); ok && int(pid) == id {
+			return p
+		}
+		if nested := findPanel(p["panels"], id); nested != nil {
+			return nested
+		}
+	}
+	return nil
+}
+
+// mergePanel merges patch into dst: nested objects are merged recursively,
+// other values (including arrays) replace the existing value, and null deletes the key.
+func mergePanel(dst, patch map[string]any) {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		if pv, ok := v.(map[string]any); ok {
+			if dv, ok := dst[k].(map[string]any); ok {
+				mergePanel(dv, pv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
 func deleteDashboard(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -827,6 +1309,44 @@ func createAnnotation(ctx context.Context, params map[string]any) (string, error
 	return toJSON(res)
 }
 
+func updateAnnotation(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	id, _ := params["annotation_id"].(float64)
+
+	// PUT replaces every field, so start from the current annotation and apply the changes
+	existing, err := c.GetAnnotation(ctx, gen.GetAnnotationParams{ID: int(id)})
+	if err != nil {
+		return "", err
+	}
+	req := &gen.UpdateAnnotationRequest{
+		Time:    existing.Time,
+		TimeEnd: existing.TimeEnd,
+		Text:    existing.Text.Or(""),
+		Tags:    existing.Tags,
+	}
+	if text, ok := params["text"].(string); ok {
+		req.Text = text
+	}
+	if tags, ok := params["tags"].([]interface{}); ok {
+		req.Tags = modules.ToStringSlice(tags)
+	}
+	if t, ok := params["time"].(float64); ok {
+		req.Time.SetTo(int64(t))
+	}
+	if te, ok := params["time_end"].(float64); ok {
+		req.TimeEnd.SetTo(int64(te))
+	}
+
+	res, err := c.UpdateAnnotation(ctx, req, gen.UpdateAnnotationParams{ID: int(id)})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func deleteAnnotation(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -874,6 +1394,41 @@ func deleteFolder(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+func createLibraryPanel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	model, ok := params["model"]
+	if !ok {
+		return "", fmt.Errorf("model is required")
+	}
+	modelRaw, err := toRaw(model)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode model: %w", err)
+	}
+
+	name, _ := params["name"].(string)
+	req := &gen.CreateLibraryElementRequest{
+		Name:  name,
+		Model: modelRaw,
+		Kind:  libraryPanelKind,
+	}
+	if folderUID, ok := params["folder_uid"].(string); ok && folderUID != "" {
+		req.FolderUid.SetTo(folderUID)
+	}
+	if uid, ok := params["uid"].(string); ok && uid != "" {
+		req.UID.SetTo(uid)
+	}
+
+	res, err := c.CreateLibraryElement(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func createAlertRule(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -933,6 +1488,26 @@ func createAlertRule(ctx context.Context, params map[string]any) (string, error)
 	return toJSON(res)
 }
 
+func createServiceAccountToken(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	saID, _ := params["sa_id"].(float64)
+	name, _ := params["name"].(string)
+	req := &gen.CreateServiceAccountTokenRequest{Name: name}
+	if ttl, ok := params["ttl"].(float64); ok && ttl > 0 {
+		req.SecondsToLive.SetTo(int64(ttl))
+	}
+
+	res, err := c.CreateServiceAccountToken(ctx, req, gen.CreateServiceAccountTokenParams{ServiceAccountId: int64(saID)})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func listContactPoints(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -1129,7 +1704,47 @@ func queryDatasource(ctx context.Context, params map[string]any) (string, error)
 	// res is jx.Raw (free-form), pretty-print it
 	var parsed any
 	if json.Unmarshal(res, &parsed) == nil {
+		maxRows := defaultMaxRows
+		if v, ok := params["max_rows"].(float64); ok && v >= 1 {
+			maxRows = min(int(v), maxMaxRows)
+		}
+		if out, ok := parsed.(map[string]any); ok && truncateFrames(out, maxRows) {
+			out["truncated"] = true
+			out["max_rows"] = maxRows
+		}
 		return toJSON(parsed)
 	}
 	return string(res), nil
 }
+
+const (
+	defaultMaxRows = 1000
+	maxMaxRows     = 10000
+)
+
+// truncateFrames caps every data frame in a /api/ds/query response
+// ({"results": {refId: {"frames": [{"data": {"values": [[col]...]}}]}}}) to
+// maxRows rows. Frames are column-oriented, so each column (and the parallel
+// nanos arrays) is cut. It reports whether any frame was cut.
+func truncateFrames(res map[string]any, maxRows int) bool {
+	results, _ := res["results"].(map[string]any)
+	truncated := false
+	for _, r := range results {
+		result, _ := r.(map[string]any)
+		frames, _ := result["frames"].([]any)
+		for _, f := range frames {
+			frame, _ := f.(map[string]any)
+			data, _ := frame["data"].(map[string]any)
+			for _, key := range []string{"values", "nanos"} {
+				cols, _ := data[key].([]any)
+				for i, c := range cols {
+					if col, ok := c.([]any); ok && len(col) > maxRows {
+						cols[i] = col[:maxRows]
+						truncated = true
+					}
+				}
+			}
+		}
+	}
+	return truncated
+}
@@ -0,0 +1,84 @@
+package modules
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// probeTimeout bounds each module's health probe so one slow upstream
+// does not hold up the whole report.
+const probeTimeout = 10 * time.Second
+
+// ProbeResult is the connectivity status of a single module.
+type ProbeResult struct {
+	Status     string `json:"status"` // "ok", "error" or "unsupported"
+	Tool       string `json:"tool,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ProbeModules runs each module's HealthProbe tool concurrently and reports
+// whether the call succeeded. ctx must carry the user's auth context so the
+// probes use that user's credentials. Modules without a probe are reported
+// as "unsupported"; unknown module names are skipped.
+func ProbeModules(ctx context.Context, moduleNames []string) map[string]ProbeResult {
+	results := make(map[string]ProbeResult, len(moduleNames))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, name := range moduleNames {
+		m, ok := registry[name]
+		if !ok {
+			continue
+		}
+		prober, ok := m.(HealthProber)
+		if !ok {
+			results[name] = ProbeResult{Status: "unsupported"}
+			continue
+		}
+
+		wg.Add(1)
+		go func(name string, prober HealthProber) {
+			defer wg.Done()
+			res := probeModule(ctx, name, prober)
+			mu.Lock()
+			results[name] = res
+			mu.Unlock()
+		}(name, prober)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// probeModule executes one module's probe tool through Run, so params are
+// validated and the call is logged like any other tool execution.
+func probeModule(ctx context.Context, moduleName string, prober HealthProber) ProbeResult {
+	tool, probeParams := prober.HealthProbe()
+	params := make(map[string]any, len(probeParams))
+	for k, v := range probeParams {
+		params[k] = v
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result, err := Run(ctx, moduleName, tool, params)
+	res := ProbeResult{Status: "ok", Tool: tool, DurationMs: time.Since(start).Milliseconds()}
+	switch {
+	case err != nil:
+		res.Status = "error"
+		res.Error = err.Error()
+	case result.IsError:
+		res.Status = "error"
+		var texts []string
+		for _, c := range result.Content {
+			texts = append(texts, c.Text)
+		}
+		res.Error = strings.Join(texts, "\n")
+	}
+	return res
+}
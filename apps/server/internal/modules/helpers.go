@@ -2,7 +2,14 @@ package modules
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ogen-go/ogen/validate"
 )
 
 // ToJSON marshals any value to a JSON string.
@@ -26,3 +33,116 @@ func ToStringSlice(v []interface{}) []string {
 	}
 	return out
 }
+
+// UpstreamError is an upstream API failure annotated with its HTTP status
+// and, when the upstream sent a Retry-After header, how long to back off.
+type UpstreamError struct {
+	StatusCode int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("upstream %d %s: %v", e.StatusCode, http.StatusText(e.StatusCode), e.Err)
+}
+
+func (e *UpstreamError) Unwrap() error { return e.Err }
+
+// WithUpstreamStatus extracts the HTTP status from an ogen unexpected-status error
+// and surfaces it in the message (e.g. "upstream 404 Not Found: ..."), so failed
+// tool calls say what the upstream API answered. Other errors are returned as-is.
+func WithUpstreamStatus(err error) error {
+	var statusErr *validate.UnexpectedStatusCodeError
+	if err == nil || !errors.As(err, &statusErr) {
+		return err
+	}
+	var upstream *UpstreamError
+	if errors.As(err, &upstream) {
+		return err
+	}
+	upstream = &UpstreamError{StatusCode: statusErr.StatusCode, Err: err}
+	if statusErr.Payload != nil {
+		upstream.RetryAfter = ParseRetryAfter(statusErr.Payload.Header.Get("Retry-After"), time.Now())
+	}
+	return upstream
+}
+
+// ParseRetryAfter reads a Retry-After header value, either delay-seconds or an
+// HTTP date, as a duration from now. It returns 0 when the value is absent or invalid.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(secs, 0)) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// FieldUpdate says how an update tool should treat one optional field.
+type FieldUpdate int
+
+const (
+	FieldUnchanged FieldUpdate = iota // key omitted (or ""): leave the field as is
+	FieldCleared                      // explicit JSON null: clear the field
+	FieldSet                          // any other value: set the field
+)
+
+// UpdateOf classifies params[key] for a partial update. Update tools share one
+// convention: omitting a field leaves it unchanged and null clears it.
+// Empty strings count as omitted, because LLM clients often fill parameters
+// they don't use with "" and would otherwise wipe fields they never meant to touch.
+func UpdateOf(params map[string]any, key string) FieldUpdate {
+	v, ok := params[key]
+	switch {
+	case !ok:
+		return FieldUnchanged
+	case v == nil:
+		return FieldCleared
+	case v == "":
+		return FieldUnchanged
+	}
+	return FieldSet
+}
+
+// StringUpdate returns the new value of a string field along with UpdateOf.
+// The value is "" for cleared fields, which is how most APIs clear text.
+func StringUpdate(params map[string]any, key string) (string, FieldUpdate) {
+	s, _ := params[key].(string)
+	return s, UpdateOf(params, key)
+}
+
+// DefaultPageSizeEnv names the environment variable that sets a deployment-wide
+// default page size for list tools, replacing each tool's built-in default.
+const DefaultPageSizeEnv = "MCPIST_DEFAULT_PER_PAGE"
+
+// defaultPageSize is read once at startup; 0 means tools keep their own defaults.
+var defaultPageSize = loadDefaultPageSize()
+
+func loadDefaultPageSize() int {
+	n, err := strconv.Atoi(os.Getenv(DefaultPageSizeEnv))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// PageSize returns the page size for a list call: params[key] when the caller
+// passed a positive number, otherwise the deployment default, otherwise def.
+// The result is capped at max when max > 0.
+func PageSize(params map[string]any, key string, def, max int) int {
+	size := def
+	if defaultPageSize > 0 {
+		size = defaultPageSize
+	}
+	if v, ok := params[key].(float64); ok && v > 0 {
+		size = int(v)
+	}
+	if max > 0 && size > max {
+		size = max
+	}
+	return size
+}
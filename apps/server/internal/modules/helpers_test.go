@@ -1,7 +1,14 @@
 package modules
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/ogen-go/ogen/validate"
 )
 
 func TestToJSON(t *testing.T) {
@@ -59,3 +66,117 @@ func TestToStringSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestWithUpstreamStatus(t *testing.T) {
+	if WithUpstreamStatus(nil) != nil {
+		t.Error("expected nil for nil error")
+	}
+
+	plain := errors.New("no credentials available")
+	if got := WithUpstreamStatus(plain); got != plain {
+		t.Errorf("expected non-status error unchanged, got %v", got)
+	}
+
+	ogenErr := fmt.Errorf("decode response: %w", validate.UnexpectedStatusCode(404))
+	got := WithUpstreamStatus(ogenErr)
+	if !strings.HasPrefix(got.Error(), "upstream 404 Not Found: ") {
+		t.Errorf("unexpected message: %q", got.Error())
+	}
+	var upstream *UpstreamError
+	if !errors.As(got, &upstream) || upstream.StatusCode != 404 {
+		t.Errorf("expected *UpstreamError with status 404, got %#v", got)
+	}
+	if !errors.Is(got, ogenErr) {
+		t.Error("expected the original error to stay in the chain")
+	}
+	if again := WithUpstreamStatus(got); again != got {
+		t.Error("expected an already annotated error to be returned as-is")
+	}
+
+	limited := validate.UnexpectedStatusCodeWithResponse(&http.Response{
+		StatusCode: 429,
+		Header:     http.Header{"Retry-After": []string{"30"}},
+	})
+	if !errors.As(WithUpstreamStatus(limited), &upstream) || upstream.RetryAfter != 30*time.Second {
+		t.Errorf("expected Retry-After of 30s to be kept, got %#v", upstream)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"-5", 0},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"soon", 0},
+	}
+	for _, tt := range tests {
+		if got := ParseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("ParseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestUpdateOf(t *testing.T) {
+	params := map[string]any{
+		"cleared": nil,
+		"empty":   "",
+		"text":    "hello",
+		"flag":    false,
+		"list":    []any{},
+	}
+	tests := []struct {
+		key  string
+		want FieldUpdate
+	}{
+		{"missing", FieldUnchanged},
+		{"cleared", FieldCleared},
+		{"empty", FieldUnchanged},
+		{"text", FieldSet},
+		{"flag", FieldSet},
+		{"list", FieldSet},
+	}
+	for _, tt := range tests {
+		if got := UpdateOf(params, tt.key); got != tt.want {
+			t.Errorf("UpdateOf(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+
+	if s, op := StringUpdate(params, "text"); s != "hello" || op != FieldSet {
+		t.Errorf("StringUpdate(text) = %q, %v", s, op)
+	}
+	if s, op := StringUpdate(params, "cleared"); s != "" || op != FieldCleared {
+		t.Errorf("StringUpdate(cleared) = %q, %v", s, op)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	params := map[string]any{"per_page": float64(5), "big": float64(500), "zero": float64(0)}
+	tests := []struct {
+		name       string
+		deployment int
+		key        string
+		want       int
+	}{
+		{"tool default", 0, "missing", 30},
+		{"deployment default", 10, "missing", 10},
+		{"explicit wins", 10, "per_page", 5},
+		{"zero ignored", 0, "zero", 30},
+		{"capped", 0, "big", 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := defaultPageSize
+			defaultPageSize = tt.deployment
+			defer func() { defaultPageSize = old }()
+			if got := PageSize(params, tt.key, 30, 100); got != tt.want {
+				t.Errorf("PageSize(%q) = %d, want %d", tt.key, got, tt.want)
+			}
+		})
+	}
+}
@@ -19,8 +19,14 @@ func formatCompact(toolName, jsonStr string) string {
 		return issuesToCSV(jsonStr)
 	case "get_transitions":
 		return transitionsToCSV(jsonStr)
-	case "get_comments":
+	case "list_comments":
 		return commentsToCompact(jsonStr)
+	case "list_attachments", "add_attachment":
+		return attachmentsToCSV(jsonStr)
+	case "list_boards":
+		return boardsToCSV(jsonStr)
+	case "list_sprints":
+		return sprintsToCSV(jsonStr)
 	// Read: single item → MD
 	case "get_myself":
 		return myselfToCompact(jsonStr)
@@ -31,12 +37,20 @@ func formatCompact(toolName, jsonStr string) string {
 	// Write: confirmation with key fields
 	case "create_issue":
 		return pickKeys(jsonStr, "id", "key", "self")
+	case "create_subtask":
+		return pickKeys(jsonStr, "id", "key", "self")
+	case "link_issues":
+		return pickKeys(jsonStr, "linked", "link_type", "inward_key", "outward_key")
 	case "update_issue":
 		return pickKeys(jsonStr, "updated", "issue_key")
+	case "bulk_update_issues":
+		return bulkUpdateToCompact(jsonStr)
 	case "transition_issue":
 		return pickKeys(jsonStr, "transitioned", "issue_key", "transition_id")
 	case "add_comment":
 		return pickKeys(jsonStr, "id", "self")
+	case "move_issues_to_sprint":
+		return pickKeys(jsonStr, "moved", "sprint_id", "issue_keys")
 	default:
 		return jsonStr
 	}
@@ -111,6 +125,77 @@ func projectsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// boardsToCSV: id,name,type,project
+func boardsToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	boards, ok := wrapper["values"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(boards) == 0 {
+		return "# 0 boards"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,type,project\n")
+	for _, raw := range boards {
+		b, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		location, _ := b["location"].(map[string]any)
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s\n",
+			intVal(b, "id"),
+			csvEscape(str(b, "name")),
+			str(b, "type"),
+			csvEscape(str(location, "projectKey")),
+		))
+	}
+	sb.WriteString("```")
+	if isLast, ok := wrapper["isLast"].(bool); ok && !isLast {
+		sb.WriteString(fmt.Sprintf("\nnext start_at=%d", intVal(wrapper, "startAt")+len(boards)))
+	}
+	return sb.String()
+}
+
+// sprintsToCSV: id,name,state,startDate,endDate,goal
+func sprintsToCSV(jsonStr string) string {
+	var wrapper map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &wrapper); err != nil {
+		return jsonStr
+	}
+	sprints, ok := wrapper["values"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(sprints) == 0 {
+		return "# 0 sprints"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,state,startDate,endDate,goal\n")
+	for _, raw := range sprints {
+		sp, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%d,%s,%s,%s,%s,%s\n",
+			intVal(sp, "id"),
+			csvEscape(str(sp, "name")),
+			str(sp, "state"),
+			str(sp, "startDate"),
+			str(sp, "endDate"),
+			csvEscape(str(sp, "goal")),
+		))
+	}
+	sb.WriteString("```")
+	if isLast, ok := wrapper["isLast"].(bool); ok && !isLast {
+		sb.WriteString(fmt.Sprintf("\nnext start_at=%d", intVal(wrapper, "startAt")+len(sprints)))
+	}
+	return sb.String()
+}
+
 // projectToCompact: single project detail
 func projectToCompact(jsonStr string) string {
 	var p map[string]any
@@ -129,6 +214,15 @@ func projectToCompact(jsonStr string) string {
 	if lead, ok := p["lead"].(map[string]any); ok {
 		sb.WriteString(fmt.Sprintf("- **Lead**: %s\n", str(lead, "displayName")))
 	}
+	if types, ok := p["issueTypes"].([]any); ok && len(types) > 0 {
+		names := make([]string, 0, len(types))
+		for _, t := range types {
+			if m, ok := t.(map[string]any); ok {
+				names = append(names, str(m, "name"))
+			}
+		}
+		sb.WriteString(fmt.Sprintf("- **Issue Types**: %s\n", strings.Join(names, ", ")))
+	}
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
@@ -291,11 +385,63 @@ func commentsToCompact(jsonStr string) string {
 			created = created[:16]
 		}
 		body := extractADFText(c["body"])
-		sb.WriteString(fmt.Sprintf("**%s** (%s):\n%s\n\n", author, created, body))
+		restricted := ""
+		if v, ok := c["visibility"].(map[string]any); ok {
+			restricted = fmt.Sprintf(" [%s: %s]", str(v, "type"), str(v, "value"))
+		}
+		sb.WriteString(fmt.Sprintf("**%s** (%s)%s:\n%s\n\n", author, created, restricted, body))
 	}
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// attachmentsToCSV: id,filename,size,url
+func attachmentsToCSV(jsonStr string) string {
+	var items []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &items); err != nil {
+		return jsonStr
+	}
+	if len(items) == 0 {
+		return "# 0 attachments"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,filename,size,url\n")
+	for _, a := range items {
+		sb.WriteString(fmt.Sprintf("%s,%s,%d,%s\n",
+			csvEscape(str(a, "id")),
+			csvEscape(str(a, "filename")),
+			intVal(a, "size"),
+			csvEscape(str(a, "url")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// bulkUpdateToCompact formats a bulk_update_issues result → summary line + CSV: issue_key, updated, error.
+func bulkUpdateToCompact(jsonStr string) string {
+	var data struct {
+		Updated int              `json:"updated"`
+		Failed  int              `json:"failed"`
+		Results []map[string]any `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %d updated, %d failed\n", data.Updated, data.Failed))
+	sb.WriteString("```csv\nissue_key,updated,error\n")
+	for _, r := range data.Results {
+		updated, _ := r["updated"].(bool)
+		sb.WriteString(fmt.Sprintf("%s,%t,%s\n",
+			csvEscape(str(r, "issue_key")),
+			updated,
+			csvEscape(str(r, "error")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -0,0 +1,150 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/modules"
+)
+
+// =============================================================================
+// Module-local HTTP helpers for endpoints that cannot be modeled by ogen:
+//   - add_attachment (multipart/form-data upload with X-Atlassian-Token)
+//   - boards & sprints (Agile API, served under /rest/agile/1.0 instead of /rest/api/3)
+// =============================================================================
+
+// doAddAttachment uploads a file to an issue via POST /issue/{key}/attachments.
+func doAddAttachment(ctx context.Context, creds *broker.Credentials, issueKey, filename string, content []byte) (string, error) {
+	modules.LogTrace(ctx, "jira", "upstream_call", map[string]any{"path": "/issue/{key}/attachments"})
+	serverURL, err := serverURLFor(creds)
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to build request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/issue/%s/attachments", serverURL, url.PathEscape(issueKey))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, &body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	if creds.AuthType == broker.AuthTypeBasic {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	// Jira rejects multipart uploads without this XSRF bypass header
+	req.Header.Set("X-Atlassian-Token", "no-check")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        fmt.Errorf("upload failed: %s", msg),
+		}
+	}
+
+	var created []struct {
+		ID       string `json:"id"`
+		Filename string `json:"filename"`
+		Size     int64  `json:"size"`
+		Content  string `json:"content"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	out := make([]attachment, 0, len(created))
+	for _, a := range created {
+		out = append(out, attachment{ID: a.ID, Filename: a.Filename, Size: a.Size, URL: a.Content})
+	}
+	return toJSON(out)
+}
+
+// doAgileRequest sends a JSON request to the Jira Agile API and returns the raw
+// response body ("" for 204 No Content).
+func doAgileRequest(ctx context.Context, method, path string, query url.Values, body any) (string, error) {
+	modules.LogTrace(ctx, "jira", "upstream_call", map[string]any{"path": jiraAgileAPIPath + path})
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+	baseURL, err := siteURLFor(creds, jiraAgileAPIPath)
+	if err != nil {
+		return "", err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	endpoint := baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	if creds.AuthType == broker.AuthTypeBasic {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New(string(respBody)),
+		}
+	}
+	return string(respBody), nil
+}
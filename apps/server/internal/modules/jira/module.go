@@ -2,9 +2,13 @@ package jira
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/go-faster/jx"
 
@@ -16,8 +20,9 @@ import (
 )
 
 const (
-	jiraAPIPath    = "/rest/api/3"
-	jiraAPIVersion = "3"
+	jiraAPIPath      = "/rest/api/3"
+	jiraAgileAPIPath = "/rest/agile/1.0"
+	jiraAPIVersion   = "3"
 )
 
 // JiraModule implements the Module interface for Jira API
@@ -65,6 +70,7 @@ func (m *JiraModule) ExecuteTool(ctx context.Context, name string, params map[st
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -74,6 +80,18 @@ func (m *JiraModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// Aliases maps renamed tools to their current names
+// Implements modules.ToolAliaser interface
+func (m *JiraModule) Aliases() map[string]string {
+	return map[string]string{"get_comments": "list_comments"}
+}
+
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *JiraModule) HealthProbe() (string, map[string]any) {
+	return "get_myself", nil
+}
+
 // Resources returns all available resources (none for Jira)
 func (m *JiraModule) Resources() []modules.Resource {
 	return nil
@@ -101,27 +119,55 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "jira", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
 	}
+	serverURL, err := serverURLFor(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	switch creds.AuthType {
+	case broker.AuthTypeBasic:
+		return jiraapi.NewBasicClient(serverURL, creds.Username, creds.Password)
+	default:
+		// OAuth 2.0
+		return jiraapi.NewBearerClient(serverURL, creds.AccessToken)
+	}
+}
+
+// serverURLFor returns the REST API base URL for the credentials' auth type.
+func serverURLFor(creds *broker.Credentials) (string, error) {
+	return siteURLFor(creds, jiraAPIPath)
+}
 
+// siteURLFor returns the base URL of the API at apiPath: the configured
+// base_url, else the site domain for Basic auth, the Atlassian cloud gateway
+// for OAuth 2.0. base_url must serve the Cloud REST API (v3, ADF bodies), e.g.
+// a proxy in front of a Cloud site; Data Center only serves v2 and is not supported.
+func siteURLFor(creds *broker.Credentials, apiPath string) (string, error) {
+	base, err := creds.BaseURL("")
+	if err != nil {
+		return "", err
+	}
+	if base != "" {
+		return base + apiPath, nil
+	}
 	switch creds.AuthType {
 	case broker.AuthTypeBasic:
 		domain, _ := creds.Metadata["domain"].(string)
 		if domain == "" {
-			return nil, fmt.Errorf("jira domain not configured")
+			return "", fmt.Errorf("jira domain not configured")
 		}
-		serverURL := fmt.Sprintf("https://%s%s", domain, jiraAPIPath)
-		return jiraapi.NewBasicClient(serverURL, creds.Username, creds.Password)
+		return fmt.Sprintf("https://%s%s", domain, apiPath), nil
 	default:
-		// OAuth 2.0
 		cloudID, _ := creds.Metadata["cloud_id"].(string)
 		if cloudID == "" {
-			return nil, fmt.Errorf("jira cloud_id not configured")
+			return "", fmt.Errorf("jira cloud_id not configured")
 		}
-		serverURL := fmt.Sprintf("https://api.atlassian.com/ex/jira/%s%s", cloudID, jiraAPIPath)
-		return jiraapi.NewBearerClient(serverURL, creds.AccessToken)
+		return fmt.Sprintf("https://api.atlassian.com/ex/jira/%s%s", cloudID, apiPath), nil
 	}
 }
 
@@ -245,12 +291,50 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"project_key", "issue_type", "summary"},
 		},
 	},
+	{
+		ID:   "jira:create_subtask",
+		Name: "create_subtask",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a subtask under an existing Jira issue. The project and the subtask issue type are taken from the parent issue's project.",
+			"ja-JP": "既存のJira課題の下にサブタスクを作成します。プロジェクトとサブタスクの課題タイプは親課題のプロジェクトから取得します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"parent_key":  {Type: "string", Description: "Parent issue key (e.g., 'PROJ-123')"},
+				"summary":     {Type: "string", Description: "Subtask summary/title"},
+				"description": {Type: "string", Description: "Subtask description"},
+				"issue_type":  {Type: "string", Description: "Subtask issue type name. Default: the project's subtask type (e.g., 'Subtask', 'Sub-task')"},
+				"fields":      {Type: "object", Description: "Additional fields in Jira REST format: assignee, priority, labels (e.g., {\"priority\": {\"name\": \"High\"}})"},
+			},
+			Required: []string{"parent_key", "summary"},
+		},
+	},
+	{
+		ID:   "jira:link_issues",
+		Name: "link_issues",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Link two Jira issues with a link type such as 'Blocks', 'Relates' or 'Duplicate'.",
+			"ja-JP": "「Blocks」「Relates」「Duplicate」などのリンクタイプで2つのJira課題をリンクします。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"inward_key":  {Type: "string", Description: "Issue key on the inward side of the link (e.g., the issue that 'is blocked by')"},
+				"outward_key": {Type: "string", Description: "Issue key on the outward side of the link (e.g., the issue that 'blocks')"},
+				"link_type":   {Type: "string", Description: "Link type name (e.g., 'Blocks', 'Relates', 'Duplicate', 'Cloners')"},
+			},
+			Required: []string{"inward_key", "outward_key", "link_type"},
+		},
+	},
 	{
 		ID:   "jira:update_issue",
 		Name: "update_issue",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing Jira issue.",
-			"ja-JP": "既存のJira課題を更新します。",
+			"en-US": "Update an existing Jira issue. Omitted fields are left unchanged; pass null to clear description, assignee_account_id or labels.",
+			"ja-JP": "既存のJira課題を更新します。省略したフィールドは変更されません。description、assignee_account_id、labelsをクリアするにはnullを指定します。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
@@ -266,6 +350,56 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"issue_key"},
 		},
 	},
+	{
+		ID:   "jira:bulk_update_issues",
+		Name: "bulk_update_issues",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Update many Jira issues in one call. Each update is {issue_key, fields}, where fields takes the same keys as update_issue (summary, description, assignee_account_id, priority, labels). Updates run concurrently; returns per-issue success or error, so one failure does not stop the rest.",
+			"ja-JP": "複数のJira課題を一度に更新します。各更新は{issue_key, fields}で、fieldsはupdate_issueと同じキー（summary、description、assignee_account_id、priority、labels）を受け付けます。並行実行され、課題ごとの成功/エラーを返すため、一部の失敗で残りが止まることはありません。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"updates": {Type: "array", Description: "Updates to apply (max 100), e.g. [{\"issue_key\": \"PROJ-1\", \"fields\": {\"labels\": [\"triaged\"]}}]", Items: &modules.Property{Type: "object"}},
+			},
+			Required: []string{"updates"},
+		},
+	},
+	{
+		ID:   "jira:list_attachments",
+		Name: "list_attachments",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List attachments on a Jira issue with their size and download URL.",
+			"ja-JP": "Jira課題の添付ファイルをサイズとダウンロードURL付きで一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"issue_key": {Type: "string", Description: "Issue key (e.g., 'PROJ-123')"},
+			},
+			Required: []string{"issue_key"},
+		},
+	},
+	{
+		ID:   "jira:add_attachment",
+		Name: "add_attachment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Attach a file (e.g. a log or screenshot) to a Jira issue. Content is passed base64-encoded.",
+			"ja-JP": "Jira課題にファイル（ログやスクリーンショットなど）を添付します。内容はBase64エンコードで渡します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"issue_key":      {Type: "string", Description: "Issue key (e.g., 'PROJ-123')"},
+				"filename":       {Type: "string", Description: "File name shown in Jira (e.g., 'error.log')"},
+				"content_base64": {Type: "string", Description: "File content, base64-encoded"},
+			},
+			Required: []string{"issue_key", "filename", "content_base64"},
+		},
+	},
 	{
 		ID:   "jira:get_transitions",
 		Name: "get_transitions",
@@ -301,11 +435,11 @@ var toolDefinitions = []modules.Tool{
 		},
 	},
 	{
-		ID:   "jira:get_comments",
-		Name: "get_comments",
+		ID:   "jira:list_comments",
+		Name: "list_comments",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Get comments on a Jira issue.",
-			"ja-JP": "Jira課題のコメントを取得します。",
+			"en-US": "List comments on a Jira issue with their author, creation time and body.",
+			"ja-JP": "Jira課題のコメントを作成者・作成日時・本文とともに一覧表示します。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
@@ -322,19 +456,74 @@ var toolDefinitions = []modules.Tool{
 		ID:   "jira:add_comment",
 		Name: "add_comment",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Add a comment to a Jira issue.",
-			"ja-JP": "Jira課題にコメントを追加します。",
+			"en-US": "Add a comment to a Jira issue, optionally visible only to a project role or group.",
+			"ja-JP": "Jira課題にコメントを追加します。プロジェクトロールまたはグループのみに公開範囲を限定することもできます。",
 		},
 		Annotations: modules.AnnotateCreate,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"issue_key": {Type: "string", Description: "Issue key (e.g., 'PROJ-123')"},
-				"body":      {Type: "string", Description: "Comment text"},
+				"issue_key":        {Type: "string", Description: "Issue key (e.g., 'PROJ-123')"},
+				"body":             {Type: "string", Description: "Comment text"},
+				"visibility_type":  {Type: "string", Description: "Restrict visibility to a 'role' or 'group' (optional, default: visible to all)"},
+				"visibility_value": {Type: "string", Description: "Project role name (e.g. 'Developers') or group name. Required with visibility_type"},
 			},
 			Required: []string{"issue_key", "body"},
 		},
 	},
+	{
+		ID:   "jira:list_boards",
+		Name: "list_boards",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List Jira Software boards (Scrum and Kanban), optionally for a single project.",
+			"ja-JP": "Jira Softwareのボード（スクラム・かんばん）を一覧表示します。プロジェクトで絞り込むこともできます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"project":     {Type: "string", Description: "Project key or ID to filter by (e.g., 'PROJ')"},
+				"start_at":    {Type: "number", Description: "Starting index for pagination. Default: 0"},
+				"max_results": {Type: "number", Description: "Maximum results to return. Default: 50"},
+			},
+		},
+	},
+	{
+		ID:   "jira:list_sprints",
+		Name: "list_sprints",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List sprints of a Scrum board. Use list_boards to find the board ID.",
+			"ja-JP": "スクラムボードのスプリントを一覧表示します。ボードIDはlist_boardsで確認できます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"board_id":    {Type: "number", Description: "Board ID"},
+				"state":       {Type: "string", Description: "Comma-separated sprint states to include: future, active, closed. Default: all"},
+				"start_at":    {Type: "number", Description: "Starting index for pagination. Default: 0"},
+				"max_results": {Type: "number", Description: "Maximum results to return. Default: 50"},
+			},
+			Required: []string{"board_id"},
+		},
+	},
+	{
+		ID:   "jira:move_issues_to_sprint",
+		Name: "move_issues_to_sprint",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Move issues into a sprint (max 50 per call). The sprint must be future or active.",
+			"ja-JP": "課題をスプリントに移動します（1回あたり最大50件）。スプリントは未来またはアクティブである必要があります。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"sprint_id":  {Type: "number", Description: "Sprint ID (get from list_sprints)"},
+				"issue_keys": {Type: "array", Description: "Issue keys to move (e.g., ['PROJ-1', 'PROJ-2'])", Items: &modules.Property{Type: "string"}},
+			},
+			Required: []string{"sprint_id", "issue_keys"},
+		},
+	},
 }
 
 // =============================================================================
@@ -344,17 +533,25 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"get_myself":       getMyself,
-	"list_projects":    listProjects,
-	"get_project":      getProject,
-	"search":           search,
-	"get_issue":        getIssue,
-	"create_issue":     createIssue,
-	"update_issue":     updateIssue,
-	"get_transitions":  getTransitions,
-	"transition_issue": transitionIssue,
-	"get_comments":     getComments,
-	"add_comment":      addComment,
+	"get_myself":            getMyself,
+	"list_projects":         listProjects,
+	"get_project":           getProject,
+	"search":                search,
+	"get_issue":             getIssue,
+	"create_issue":          createIssue,
+	"create_subtask":        createSubtask,
+	"link_issues":           linkIssues,
+	"update_issue":          updateIssue,
+	"bulk_update_issues":    bulkUpdateIssues,
+	"list_attachments":      listAttachments,
+	"add_attachment":        addAttachment,
+	"get_transitions":       getTransitions,
+	"transition_issue":      transitionIssue,
+	"list_comments":         listComments,
+	"add_comment":           addComment,
+	"list_boards":           listBoards,
+	"list_sprints":          listSprints,
+	"move_issues_to_sprint": moveIssuesToSprint,
 }
 
 // =============================================================================
@@ -514,6 +711,95 @@ func createIssue(ctx context.Context, params map[string]any) (string, error) {
 	return toJSON(res)
 }
 
+// subtaskFields are the keys accepted in create_subtask's fields param
+var subtaskFields = map[string]bool{"assignee": true, "priority": true, "labels": true}
+
+func createSubtask(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	parentKey, _ := params["parent_key"].(string)
+	summary, _ := params["summary"].(string)
+
+	i := strings.LastIndex(parentKey, "-")
+	if i <= 0 {
+		return "", fmt.Errorf("invalid parent_key: %s", parentKey)
+	}
+	projectKey := parentKey[:i]
+
+	extra, _ := params["fields"].(map[string]any)
+	for k := range extra {
+		if !subtaskFields[k] {
+			return "", fmt.Errorf("unsupported field %q (supported: assignee, priority, labels)", k)
+		}
+	}
+
+	issueType, _ := params["issue_type"].(string)
+	if issueType == "" {
+		project, err := c.GetProject(ctx, gen.GetProjectParams{ProjectIdOrKey: projectKey})
+		if err != nil {
+			return "", err
+		}
+		for _, t := range project.IssueTypes {
+			if t.Subtask.Value {
+				issueType = t.Name.Value
+				break
+			}
+		}
+		if issueType == "" {
+			return "", fmt.Errorf("project %s has no subtask issue type", projectKey)
+		}
+	}
+
+	body := make(map[string]any, len(extra)+5)
+	for k, v := range extra {
+		body[k] = v
+	}
+	body["project"] = map[string]string{"key": projectKey}
+	body["issuetype"] = map[string]string{"name": issueType}
+	body["parent"] = map[string]string{"key": parentKey}
+	body["summary"] = summary
+	if description, ok := params["description"].(string); ok && description != "" {
+		body["description"] = adfDocument(description)
+	}
+
+	var fields gen.IssueFields
+	raw, err := toRaw(body)
+	if err != nil {
+		return "", err
+	}
+	if err := fields.UnmarshalJSON(raw); err != nil {
+		return "", fmt.Errorf("invalid fields: %w", err)
+	}
+
+	res, err := c.CreateIssue(ctx, &gen.CreateIssueRequest{Fields: fields})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func linkIssues(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	inwardKey, _ := params["inward_key"].(string)
+	outwardKey, _ := params["outward_key"].(string)
+	linkType, _ := params["link_type"].(string)
+
+	req := gen.LinkIssuesRequest{}
+	req.Type, _ = toRaw(map[string]string{"name": linkType})
+	req.InwardIssue, _ = toRaw(map[string]string{"key": inwardKey})
+	req.OutwardIssue, _ = toRaw(map[string]string{"key": outwardKey})
+
+	if err := c.LinkIssues(ctx, &req); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{"linked": true, "link_type": linkType, "inward_key": inwardKey, "outward_key": outwardKey})
+}
+
 func updateIssue(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -521,17 +807,33 @@ func updateIssue(ctx context.Context, params map[string]any) (string, error) {
 	}
 	issueKey, _ := params["issue_key"].(string)
 
+	err = c.UpdateIssue(ctx, &gen.UpdateIssueRequest{Fields: gen.OptIssueFields{Value: issueFieldsUpdate(params), Set: true}}, gen.UpdateIssueParams{IssueIdOrKey: issueKey})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`{"updated":true,"issue_key":"%s"}`, issueKey), nil
+}
+
+// issueFieldsUpdate builds the fields of an issue update from update_issue params.
+// Omitted fields are left unchanged; null clears description, assignee and labels.
+func issueFieldsUpdate(params map[string]any) gen.IssueFields {
 	fields := gen.IssueFields{}
-	if summary, ok := params["summary"].(string); ok {
+	if summary, op := modules.StringUpdate(params, "summary"); op == modules.FieldSet {
 		fields.Summary.SetTo(summary)
 	}
-	if description, ok := params["description"].(string); ok {
+	switch description, op := modules.StringUpdate(params, "description"); op {
+	case modules.FieldSet:
 		fields.Description, _ = toRaw(adfDocument(description))
+	case modules.FieldCleared:
+		fields.Description, _ = toRaw(nil)
 	}
-	if assigneeID, ok := params["assignee_account_id"].(string); ok {
+	switch assigneeID, op := modules.StringUpdate(params, "assignee_account_id"); op {
+	case modules.FieldSet:
 		fields.Assignee, _ = toRaw(map[string]string{"accountId": assigneeID})
+	case modules.FieldCleared:
+		fields.Assignee, _ = toRaw(nil)
 	}
-	if priority, ok := params["priority"].(string); ok {
+	if priority, op := modules.StringUpdate(params, "priority"); op == modules.FieldSet {
 		fields.Priority, _ = toRaw(map[string]string{"name": priority})
 	}
 	if labels, ok := params["labels"].([]interface{}); ok {
@@ -542,13 +844,143 @@ func updateIssue(ctx context.Context, params map[string]any) (string, error) {
 			}
 		}
 		fields.Labels = labelStrs
+	} else if modules.UpdateOf(params, "labels") == modules.FieldCleared {
+		fields.Labels = []string{}
 	}
+	return fields
+}
+
+const (
+	// maxBulkUpdates caps the issues updated by one bulk_update_issues call
+	maxBulkUpdates = 100
+	// bulkUpdateConcurrency bounds in-flight updates to stay under Jira rate limits
+	bulkUpdateConcurrency = 5
+)
 
-	err = c.UpdateIssue(ctx, &gen.UpdateIssueRequest{Fields: gen.OptIssueFields{Value: fields, Set: true}}, gen.UpdateIssueParams{IssueIdOrKey: issueKey})
+// bulkUpdateResult is the outcome of one update in bulk_update_issues
+type bulkUpdateResult struct {
+	IssueKey string `json:"issue_key"`
+	Updated  bool   `json:"updated"`
+	Error    string `json:"error,omitempty"`
+}
+
+func bulkUpdateIssues(ctx context.Context, params map[string]any) (string, error) {
+	raw, _ := params["updates"].([]any)
+	if len(raw) == 0 {
+		return "", fmt.Errorf("updates must contain at least one {issue_key, fields} object")
+	}
+	if len(raw) > maxBulkUpdates {
+		return "", fmt.Errorf("at most %d issues can be updated per call, got %d", maxBulkUpdates, len(raw))
+	}
+	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(`{"updated":true,"issue_key":"%s"}`, issueKey), nil
+
+	results := make([]bulkUpdateResult, len(raw))
+	sem := make(chan struct{}, bulkUpdateConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range raw {
+		update, _ := item.(map[string]any)
+		issueKey, _ := update["issue_key"].(string)
+		fields, _ := update["fields"].(map[string]any)
+		results[i].IssueKey = issueKey
+		if issueKey == "" || len(fields) == 0 {
+			results[i].Error = "issue_key and a non-empty fields object are required"
+			continue
+		}
+		wg.Add(1)
+		go func(res *bulkUpdateResult) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				res.Error = ctx.Err().Error()
+				return
+			}
+			defer func() { <-sem }()
+			req := &gen.UpdateIssueRequest{Fields: gen.OptIssueFields{Value: issueFieldsUpdate(fields), Set: true}}
+			if err := c.UpdateIssue(ctx, req, gen.UpdateIssueParams{IssueIdOrKey: res.IssueKey}); err != nil {
+				res.Error = modules.WithUpstreamStatus(err).Error()
+				return
+			}
+			res.Updated = true
+		}(&results[i])
+	}
+	wg.Wait()
+
+	updated := 0
+	for _, r := range results {
+		if r.Updated {
+			updated++
+		}
+	}
+	return toJSON(map[string]any{
+		"updated": updated,
+		"failed":  len(results) - updated,
+		"results": results,
+	})
+}
+
+// =============================================================================
+// Attachments
+// =============================================================================
+
+// attachment is the compact attachment shape returned by list/add_attachment
+type attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	URL      string `json:"url"`
+}
+
+func listAttachments(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	issueKey, _ := params["issue_key"].(string)
+	p := gen.GetIssueParams{IssueIdOrKey: issueKey}
+	p.Fields.SetTo("attachment")
+	res, err := c.GetIssue(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	var fields struct {
+		Attachment []struct {
+			ID       string `json:"id"`
+			Filename string `json:"filename"`
+			Size     int64  `json:"size"`
+			Content  string `json:"content"`
+		} `json:"attachment"`
+	}
+	if len(res.Fields) > 0 {
+		if err := json.Unmarshal(res.Fields, &fields); err != nil {
+			return "", fmt.Errorf("failed to parse attachments: %w", err)
+		}
+	}
+	out := make([]attachment, 0, len(fields.Attachment))
+	for _, a := range fields.Attachment {
+		out = append(out, attachment{ID: a.ID, Filename: a.Filename, Size: a.Size, URL: a.Content})
+	}
+	return toJSON(out)
+}
+
+func addAttachment(ctx context.Context, params map[string]any) (string, error) {
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+	issueKey, _ := params["issue_key"].(string)
+	filename, _ := params["filename"].(string)
+	encoded, _ := params["content_base64"].(string)
+
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("content_base64 is not valid base64: %w", err)
+	}
+	return doAddAttachment(ctx, creds, issueKey, filename, content)
 }
 
 // =============================================================================
@@ -600,7 +1032,7 @@ func transitionIssue(ctx context.Context, params map[string]any) (string, error)
 // Comments
 // =============================================================================
 
-func getComments(ctx context.Context, params map[string]any) (string, error) {
+func listComments(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
 		return "", err
@@ -629,13 +1061,77 @@ func addComment(ctx context.Context, params map[string]any) (string, error) {
 	body, _ := params["body"].(string)
 
 	adfBody, _ := toRaw(adfDocument(body))
-	res, err := c.AddComment(ctx, &gen.AddCommentRequest{Body: adfBody}, gen.AddCommentParams{IssueIdOrKey: issueKey})
+	req := &gen.AddCommentRequest{Body: adfBody}
+	if visibilityType, _ := params["visibility_type"].(string); visibilityType != "" {
+		visibilityValue, _ := params["visibility_value"].(string)
+		if visibilityType != "role" && visibilityType != "group" {
+			return "", fmt.Errorf("invalid visibility_type %q: use role or group", visibilityType)
+		}
+		if visibilityValue == "" {
+			return "", fmt.Errorf("visibility_value is required with visibility_type")
+		}
+		req.Visibility, _ = toRaw(map[string]string{"type": visibilityType, "value": visibilityValue})
+	}
+	res, err := c.AddComment(ctx, req, gen.AddCommentParams{IssueIdOrKey: issueKey})
 	if err != nil {
 		return "", err
 	}
 	return toJSON(res)
 }
 
+// =============================================================================
+// Boards & Sprints (Agile API)
+// =============================================================================
+
+// maxSprintIssues is the Agile API limit on issues moved per request
+const maxSprintIssues = 50
+
+func listBoards(ctx context.Context, params map[string]any) (string, error) {
+	q := url.Values{}
+	if project, ok := params["project"].(string); ok && project != "" {
+		q.Set("projectKeyOrId", project)
+	}
+	if sa, ok := params["start_at"].(float64); ok {
+		q.Set("startAt", strconv.Itoa(int(sa)))
+	}
+	q.Set("maxResults", strconv.Itoa(modules.PageSize(params, "max_results", 50, 50)))
+	return doAgileRequest(ctx, "GET", "/board", q, nil)
+}
+
+func listSprints(ctx context.Context, params map[string]any) (string, error) {
+	boardID, _ := params["board_id"].(float64)
+	q := url.Values{}
+	if state, ok := params["state"].(string); ok && state != "" {
+		q.Set("state", state)
+	}
+	if sa, ok := params["start_at"].(float64); ok {
+		q.Set("startAt", strconv.Itoa(int(sa)))
+	}
+	q.Set("maxResults", strconv.Itoa(modules.PageSize(params, "max_results", 50, 50)))
+	return doAgileRequest(ctx, "GET", fmt.Sprintf("/board/%d/sprint", int64(boardID)), q, nil)
+}
+
+func moveIssuesToSprint(ctx context.Context, params map[string]any) (string, error) {
+	sprintID, _ := params["sprint_id"].(float64)
+	raw, _ := params["issue_keys"].([]any)
+	issueKeys := modules.ToStringSlice(raw)
+	if len(issueKeys) == 0 {
+		return "", fmt.Errorf("issue_keys must contain at least one issue key")
+	}
+	if len(issueKeys) > maxSprintIssues {
+		return "", fmt.Errorf("at most %d issues can be moved per call, got %d", maxSprintIssues, len(issueKeys))
+	}
+	body := map[string]any{"issues": issueKeys}
+	if _, err := doAgileRequest(ctx, "POST", fmt.Sprintf("/sprint/%d/issue", int64(sprintID)), nil, body); err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{
+		"moved":      true,
+		"sprint_id":  int64(sprintID),
+		"issue_keys": issueKeys,
+	})
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -0,0 +1,101 @@
+package modules
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// IncludeMetaParam is the meta-parameter that asks for call metrics (_meta) to be
+// appended to a tool result.
+const IncludeMetaParam = "_include_meta"
+
+// TakeIncludeMeta removes the _include_meta meta-parameter from params and
+// reports whether it was set to true.
+func TakeIncludeMeta(params map[string]any) bool {
+	v, ok := params[IncludeMetaParam]
+	if !ok {
+		return false
+	}
+	delete(params, IncludeMetaParam)
+	b, _ := v.(bool)
+	return b
+}
+
+// CallMeter counts the upstream HTTP traffic of one tool call, including every
+// request a composite tool fans out to. It is carried in the request context.
+type CallMeter struct {
+	start         time.Time
+	upstreamCalls atomic.Int64
+	bytesIn       atomic.Int64
+}
+
+// CallMeta is the _meta object appended to a tool result with _include_meta.
+type CallMeta struct {
+	UpstreamCalls int64 `json:"upstream_calls"` // HTTP requests sent to the service
+	BytesIn       int64 `json:"bytes_in"`       // Response bytes received from the service
+	BytesOut      int   `json:"bytes_out"`      // Result bytes returned to the client
+	DurationMs    int64 `json:"duration_ms"`
+}
+
+type callMeterKey struct{}
+
+// WithCallMeter starts a meter for the tool call run with the returned context.
+func WithCallMeter(ctx context.Context) (context.Context, *CallMeter) {
+	m := &CallMeter{start: time.Now()}
+	return context.WithValue(ctx, callMeterKey{}, m), m
+}
+
+func callMeterFrom(ctx context.Context) *CallMeter {
+	m, _ := ctx.Value(callMeterKey{}).(*CallMeter)
+	return m
+}
+
+// Meta returns the metrics collected so far, with bytesOut as the size of the result.
+func (m *CallMeter) Meta(bytesOut int) CallMeta {
+	return CallMeta{
+		UpstreamCalls: m.upstreamCalls.Load(),
+		BytesIn:       m.bytesIn.Load(),
+		BytesOut:      bytesOut,
+		DurationMs:    time.Since(m.start).Milliseconds(),
+	}
+}
+
+// MeteredTransport wraps base so that requests whose context carries a
+// CallMeter are counted. Installed as http.DefaultTransport, it covers the
+// ogen clients and the modules' own HTTP clients alike.
+func MeteredTransport(base http.RoundTripper) http.RoundTripper {
+	return &meteredTransport{base: base}
+}
+
+type meteredTransport struct {
+	base http.RoundTripper
+}
+
+func (t *meteredTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	m := callMeterFrom(req.Context())
+	if m == nil {
+		return t.base.RoundTrip(req)
+	}
+	m.upstreamCalls.Add(1)
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = &countingBody{ReadCloser: resp.Body, n: &m.bytesIn}
+	return resp, nil
+}
+
+// countingBody adds the bytes read from a response body to n.
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
@@ -20,6 +20,10 @@ func formatCompact(toolName, jsonStr string) string {
 		return pickKeys(jsonStr, "id", "displayName")
 	case "create_task", "update_task", "complete_task":
 		return pickKeys(jsonStr, "id", "title", "status")
+	case "add_checklist_item":
+		return pickKeys(jsonStr, "id", "displayName", "isChecked")
+	case "add_linked_resource":
+		return pickKeys(jsonStr, "id", "displayName", "webUrl")
 	case "delete_list", "delete_task":
 		return pickKeys(jsonStr, "success", "message")
 	default:
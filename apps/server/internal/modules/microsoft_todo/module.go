@@ -46,6 +46,7 @@ func (m *MicrosoftTodoModule) ExecuteTool(ctx context.Context, name string, para
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -55,6 +56,12 @@ func (m *MicrosoftTodoModule) ToCompact(toolName string, jsonResult string) stri
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *MicrosoftTodoModule) HealthProbe() (string, map[string]any) {
+	return "list_lists", nil
+}
+
 // =============================================================================
 // Token and Headers
 // =============================================================================
@@ -78,6 +85,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 // =============================================================================
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "microsoft_todo", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -283,6 +291,45 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"list_id", "task_id"},
 		},
 	},
+	{
+		ID:   "microsoft_todo:add_checklist_item",
+		Name: "add_checklist_item",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a checklist item (subtask) to a task.",
+			"ja-JP": "タスクにチェックリスト項目（サブタスク）を追加します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"list_id":      {Type: "string", Description: "The ID of the task list"},
+				"task_id":      {Type: "string", Description: "The ID of the task"},
+				"display_name": {Type: "string", Description: "The checklist item text"},
+				"is_checked":   {Type: "boolean", Description: "Create the item already checked. Default: false"},
+			},
+			Required: []string{"list_id", "task_id", "display_name"},
+		},
+	},
+	{
+		ID:   "microsoft_todo:add_linked_resource",
+		Name: "add_linked_resource",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Attach a link to its source (e.g. an email, issue, or document URL) to a task.",
+			"ja-JP": "タスクに元となるリソース（メール、Issue、ドキュメントのURLなど）へのリンクを追加します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"list_id":          {Type: "string", Description: "The ID of the task list"},
+				"task_id":          {Type: "string", Description: "The ID of the task"},
+				"web_url":          {Type: "string", Description: "URL of the linked resource"},
+				"display_name":     {Type: "string", Description: "Title shown for the link"},
+				"application_name": {Type: "string", Description: "Name of the source application. Default: mcpist"},
+			},
+			Required: []string{"list_id", "task_id", "web_url"},
+		},
+	},
 }
 
 // =============================================================================
@@ -292,17 +339,19 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"list_lists":    listLists,
-	"get_list":      getList,
-	"create_list":   createList,
-	"update_list":   updateList,
-	"delete_list":   deleteList,
-	"list_tasks":    listTasks,
-	"get_task":      getTask,
-	"create_task":   createTask,
-	"update_task":   updateTask,
-	"complete_task": completeTask,
-	"delete_task":   deleteTask,
+	"list_lists":          listLists,
+	"get_list":            getList,
+	"create_list":         createList,
+	"update_list":         updateList,
+	"delete_list":         deleteList,
+	"list_tasks":          listTasks,
+	"get_task":            getTask,
+	"create_task":         createTask,
+	"update_task":         updateTask,
+	"complete_task":       completeTask,
+	"delete_task":         deleteTask,
+	"add_checklist_item":  addChecklistItem,
+	"add_linked_resource": addLinkedResource,
 }
 
 // =============================================================================
@@ -521,3 +570,49 @@ func deleteTask(ctx context.Context, params map[string]any) (string, error) {
 	}
 	return `{"success":true,"message":"Task deleted"}`, nil
 }
+
+// =============================================================================
+// Checklist Items / Linked Resources
+// =============================================================================
+
+func addChecklistItem(ctx context.Context, params map[string]any) (string, error) {
+	listID, _ := params["list_id"].(string)
+	taskID, _ := params["task_id"].(string)
+	displayName, _ := params["display_name"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	req := &gen.CreateChecklistItemReq{DisplayName: displayName}
+	if checked, ok := params["is_checked"].(bool); ok {
+		req.IsChecked.SetTo(checked)
+	}
+	res, err := c.CreateChecklistItem(ctx, req, gen.CreateChecklistItemParams{ListId: listID, TaskId: taskID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func addLinkedResource(ctx context.Context, params map[string]any) (string, error) {
+	listID, _ := params["list_id"].(string)
+	taskID, _ := params["task_id"].(string)
+	webURL, _ := params["web_url"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	// Graph rejects linked resources without an applicationName
+	req := &gen.CreateLinkedResourceReq{WebUrl: webURL, ApplicationName: "mcpist"}
+	if appName, ok := params["application_name"].(string); ok && appName != "" {
+		req.ApplicationName = appName
+	}
+	if displayName, ok := params["display_name"].(string); ok && displayName != "" {
+		req.DisplayName.SetTo(displayName)
+	}
+	res, err := c.CreateLinkedResource(ctx, req, gen.CreateLinkedResourceParams{ListId: listID, TaskId: taskID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
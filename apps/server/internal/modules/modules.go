@@ -3,14 +3,21 @@ package modules
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/observability"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // =============================================================================
@@ -89,8 +96,9 @@ func availableModuleNames(enabledModules []string) []string {
 // =============================================================================
 
 // DynamicMetaTools returns meta tools with dynamic module lists based on user's enabled modules.
-// If enabledModules is nil, all modules are listed.
-func DynamicMetaTools(enabledModules []string) []Tool {
+// If enabledModules is nil, all modules are listed. run and batch carry annotations
+// aggregated from the enabled tools (see dispatchAnnotations).
+func DynamicMetaTools(enabledModules []string, enabledTools map[string][]string) []Tool {
 	available := availableModuleNames(enabledModules)
 	moduleList := strings.Join(available, ", ")
 
@@ -114,10 +122,16 @@ func DynamicMetaTools(enabledModules []string) []Tool {
 
 [Usage]
 1. get_module_schema(module) to check available tools and parameters
+   (or describe_tool(module, tool) to confirm the parameters of one known tool)
 2. run(module, tool, params) to execute
 
 [Response Format]
-Results are returned in compact format (CSV/MD) by default. For full JSON response, add format: "json" to params.`, moduleDesc)
+Results are returned in compact format (CSV/MD, via each module's compact converter) by default. Add _format: "json" to params for the full JSON response, or _format: "compact" to request the compact form explicitly.
+To cap the result size, add _max_bytes: N to params. Larger results fall back to compact format, then are truncated with "_truncated": true.
+Add _include_meta: true to params to append {"_meta": {upstream_calls, bytes_in, bytes_out, duration_ms}}: upstream requests made, bytes received from the service, result bytes returned, and elapsed time.
+For list tools that support it, add _fetch_all: true to params to follow the pagination until the last page and return every item at once (up to 500 items by default; _fetch_all: N sets another cap). {"_fetch_all": {pages, items, _truncated}} is appended; _truncated is true when the cap was hit first.
+For update tools that support it, add _return_diff: true to params to append {"_diff": {field: {before, after}}} listing exactly what changed.
+Errors from the service carry _meta.upstream_status; when it is rate limiting, _meta.retry_after gives the seconds to wait before retrying.`, moduleDesc)
 	batchDesc := `Execute multiple tools in batch (JSONL format, with dependency and parallel execution support).
 
 [Fields]
@@ -129,7 +143,9 @@ Results are returned in compact format (CSV/MD) by default. For full JSON respon
 - output: If true, includes result in response (default: compact format)
 
 [Response Format]
-Tasks with output: true return compact format (CSV/MD) by default. For full JSON response, add format: "json" to params.
+Tasks with output: true return compact format (CSV/MD) by default. Add _format: "json" (or "compact") to params to choose the output shape, as in run.
+_max_bytes: N in params caps a task's output the same way as in run.
+results and errors are keyed by task id in input order.
 
 [Variable References] Access via JSONPath: ${id.results[index].field}
 
@@ -146,12 +162,17 @@ Tasks with output: true return compact format (CSV/MD) by default. For full JSON
 
 [Execution Rules]
 - No after -> parallel execution via goroutines
+- max_parallel: Maximum number of tasks running at once (1 = serial, default: unlimited)
 - With after -> executes after dependent tasks complete
+- Variable reference to another task -> treated as after (runs once that task completes)
 - Circular dependency -> error
 - Dependent task failure -> dependents are skipped`
+	describeToolDesc := "Get the full input schema, annotations, example params and a short summary for exactly one tool. Lighter than get_module_schema when the tool name is already known."
 	batchCommandsDesc := "Commands in JSONL format"
+	batchMaxParallelDesc := "Maximum number of tasks executed concurrently (1 = serial, default: unlimited)"
+	dispatch := dispatchAnnotations(available, enabledTools)
 
-	return []Tool{
+	tools := []Tool{
 		{
 			Name:        "get_module_schema",
 			Description: getSchemaDesc,
@@ -166,6 +187,26 @@ Tasks with output: true return compact format (CSV/MD) by default. For full JSON
 				},
 				Required: []string{"module"},
 			},
+			Annotations: AnnotateReadOnly,
+		},
+		{
+			Name:        "describe_tool",
+			Description: describeToolDesc,
+			InputSchema: InputSchema{
+				Type: "object",
+				Properties: map[string]Property{
+					"module": {
+						Type:        "string",
+						Description: fmt.Sprintf("Module name. Available: %s", moduleList),
+					},
+					"tool": {
+						Type:        "string",
+						Description: "Tool name",
+					},
+				},
+				Required: []string{"module", "tool"},
+			},
+			Annotations: AnnotateReadOnly,
 		},
 		{
 			Name:        "run",
@@ -188,6 +229,7 @@ Tasks with output: true return compact format (CSV/MD) by default. For full JSON
 				},
 				Required: []string{"module", "tool"},
 			},
+			Annotations: dispatch,
 		},
 		{
 			Name:        "batch",
@@ -199,9 +241,112 @@ Tasks with output: true return compact format (CSV/MD) by default. For full JSON
 						Type:        "string",
 						Description: batchCommandsDesc,
 					},
+					"max_parallel": {
+						Type:        "integer",
+						Description: batchMaxParallelDesc,
+					},
 				},
 				Required: []string{"commands"},
 			},
+			Annotations: dispatch,
+		},
+	}
+
+	// subscribe_events is listed only when an available module can push events
+	var eventModules []string
+	for _, name := range available {
+		if _, ok := registry[name].(EventSource); ok {
+			eventModules = append(eventModules, name)
+		}
+	}
+	if len(eventModules) > 0 {
+		tools = append(tools, subscribeEventsTool(eventModules))
+	}
+	return tools
+}
+
+// dispatchAnnotations combines the annotations of every enabled tool into hints
+// for run and batch, which can execute any of them: read-only and idempotent only
+// if every tool is, destructive if any tool is. A tool without annotations counts
+// as the MCP defaults (not read-only, destructive, not idempotent).
+func dispatchAnnotations(available []string, enabledTools map[string][]string) *ToolAnnotations {
+	readOnly, destructive, idempotent := true, false, true
+	for _, name := range available {
+		for _, t := range filterTools(name, registry[name].Tools(), enabledTools) {
+			a := t.Annotations
+			if a == nil {
+				a = &ToolAnnotations{}
+			}
+			if a.ReadOnlyHint == nil || !*a.ReadOnlyHint {
+				readOnly = false
+				if a.DestructiveHint == nil || *a.DestructiveHint {
+					destructive = true
+				}
+				if a.IdempotentHint == nil || !*a.IdempotentHint {
+					idempotent = false
+				}
+			}
+		}
+	}
+	if readOnly {
+		return AnnotateReadOnly
+	}
+	return &ToolAnnotations{
+		ReadOnlyHint:    boolPtr(false),
+		DestructiveHint: boolPtr(destructive),
+		IdempotentHint:  boolPtr(idempotent),
+		OpenWorldHint:   boolPtr(false),
+	}
+}
+
+// subscribeEventsTool builds the subscribe_events meta-tool for the given event-capable modules.
+func subscribeEventsTool(eventModules []string) Tool {
+	desc := `Register a webhook so that events from a module's service are forwarded to your callback URL.
+
+[Actions]
+- subscribe (default): module, resource, callback_url (https), events (optional, default all)
+  resource: github "owner/repo"; trello board, list or card ID
+  events: github event names (e.g. ["push", "issues"]); trello action types (e.g. ["createCard"])
+- list: show your subscriptions
+- unsubscribe: subscription_id
+
+[Delivery]
+Each event is POSTed to callback_url as {subscription_id, module, resource, event, received_at, payload}.
+The X-Mcpist-Signature header is "sha256=" + hex HMAC-SHA256 of the body, keyed with the secret returned once by subscribe.`
+
+	return Tool{
+		Name:        "subscribe_events",
+		Description: desc,
+		Annotations: AnnotateDestructive,
+		InputSchema: InputSchema{
+			Type: "object",
+			Properties: map[string]Property{
+				"action": {
+					Type:        "string",
+					Description: "subscribe, list or unsubscribe (default: subscribe)",
+				},
+				"module": {
+					Type:        "string",
+					Description: fmt.Sprintf("Module name. Supported: %s", strings.Join(eventModules, ", ")),
+				},
+				"resource": {
+					Type:        "string",
+					Description: "Resource to watch (module-specific)",
+				},
+				"events": {
+					Type:        "array",
+					Description: "Event names to forward (default: all)",
+					Items:       &Property{Type: "string"},
+				},
+				"callback_url": {
+					Type:        "string",
+					Description: "https URL that receives forwarded events",
+				},
+				"subscription_id": {
+					Type:        "string",
+					Description: "Subscription ID (for unsubscribe)",
+				},
+			},
 		},
 	}
 }
@@ -320,6 +465,163 @@ func GetModuleSchemas(moduleNames []string, enabledModules []string, enabledTool
 	}, nil
 }
 
+// ToolDescription is the describe_tool response for a single tool
+type ToolDescription struct {
+	Module      string           `json:"module"`
+	Tool        string           `json:"tool"`
+	Summary     string           `json:"summary"`
+	Description string           `json:"description"`
+	Annotations *ToolAnnotations `json:"annotations,omitempty"`
+	InputSchema InputSchema      `json:"inputSchema"`
+	Output      *OutputSchema    `json:"outputSchema,omitempty"`
+	Example     map[string]any   `json:"example"`
+	Deprecated  string           `json:"deprecated,omitempty"`
+}
+
+// DescribeTool returns the schema of one tool with an example run call and a readable summary.
+// Tools that are not enabled for the user are reported as unknown, as in GetModuleSchemas.
+func DescribeTool(moduleName, toolName string, enabledTools map[string][]string) (*ToolCallResult, error) {
+	m, ok := registry[moduleName]
+	if !ok {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: fmt.Sprintf("Unknown module: %s", moduleName)}},
+			IsError: true,
+		}, nil
+	}
+
+	var deprecated string
+	if current, aliased := ResolveToolAlias(moduleName, toolName); aliased {
+		deprecated = DeprecationNotice(moduleName, toolName, current)
+		toolName = current
+	}
+
+	tools := filterTools(moduleName, m.Tools(), enabledTools)
+	if len(tools) == 0 {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: fmt.Sprintf("Unknown module: %s", moduleName)}},
+			IsError: true,
+		}, nil
+	}
+	var tool *Tool
+	names := make([]string, 0, len(tools))
+	for i := range tools {
+		names = append(names, tools[i].Name)
+		if tools[i].Name == toolName {
+			tool = &tools[i]
+		}
+	}
+	if tool == nil {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: fmt.Sprintf("Unknown tool: %s:%s. Available: %s", moduleName, toolName, strings.Join(names, ", "))}},
+			IsError: true,
+		}, nil
+	}
+
+	description := tool.Descriptions["en-US"]
+	if description == "" {
+		description = tool.Description
+	}
+	desc := ToolDescription{
+		Module:      moduleName,
+		Tool:        tool.Name,
+		Summary:     toolSummary(moduleName, tool, description),
+		Description: description,
+		Annotations: tool.Annotations,
+		InputSchema: tool.InputSchema,
+		Output:      tool.OutputSchema,
+		Example: map[string]any{
+			"module": moduleName,
+			"tool":   tool.Name,
+			"params": exampleParams(tool.InputSchema),
+		},
+		Deprecated: deprecated,
+	}
+
+	jsonBytes, err := json.MarshalIndent(desc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &ToolCallResult{
+		Content: []ContentBlock{{Type: "text", Text: string(jsonBytes)}},
+	}, nil
+}
+
+// toolSummary renders a one-paragraph description: behavior, then required and optional params.
+func toolSummary(moduleName string, tool *Tool, description string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s:%s (%s). %s", moduleName, tool.Name, annotationSummary(tool.Annotations), description))
+
+	var required, optional []string
+	for _, name := range sortedPropertyNames(tool.InputSchema) {
+		param := fmt.Sprintf("%s (%s)", name, tool.InputSchema.Properties[name].Type)
+		if containsString(tool.InputSchema.Required, name) {
+			required = append(required, param)
+		} else {
+			optional = append(optional, param)
+		}
+	}
+	if len(required) > 0 {
+		sb.WriteString(" Required: " + strings.Join(required, ", ") + ".")
+	}
+	if len(optional) > 0 {
+		sb.WriteString(" Optional: " + strings.Join(optional, ", ") + ".")
+	}
+	return sb.String()
+}
+
+func annotationSummary(a *ToolAnnotations) string {
+	switch {
+	case a == nil:
+		return "no behavior hints"
+	case a.ReadOnlyHint != nil && *a.ReadOnlyHint:
+		return "read-only"
+	case a.DestructiveHint != nil && *a.DestructiveHint:
+		return "destructive write"
+	case a.IdempotentHint != nil && *a.IdempotentHint:
+		return "idempotent write"
+	default:
+		return "write, not idempotent"
+	}
+}
+
+// exampleParams builds placeholder values for the required params of a schema.
+func exampleParams(schema InputSchema) map[string]any {
+	params := make(map[string]any)
+	for _, name := range schema.Required {
+		if prop, ok := schema.Properties[name]; ok {
+			params[name] = exampleValue(name, prop)
+		}
+	}
+	return params
+}
+
+func exampleValue(name string, prop Property) any {
+	switch prop.Type {
+	case "number", "integer":
+		return 1
+	case "boolean":
+		return true
+	case "array":
+		if prop.Items != nil {
+			return []any{exampleValue(name, *prop.Items)}
+		}
+		return []any{}
+	case "object":
+		return map[string]any{}
+	default:
+		return "<" + name + ">"
+	}
+}
+
+func sortedPropertyNames(schema InputSchema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // =============================================================================
 // Tool Execution
 // =============================================================================
@@ -340,22 +642,37 @@ func Run(ctx context.Context, moduleName, toolName string, params map[string]int
 	}
 
 	// Validate params against tool's InputSchema
-	if tool, found := findTool(m.Tools(), toolName); found {
-		validated, err := ValidateParams(tool.InputSchema, params)
-		if err != nil {
-			return &ToolCallResult{
-				Content: []ContentBlock{{Type: "text", Text: err.Error()}},
-				IsError: true,
-			}, nil
+	validated, err := ValidateToolParams(moduleName, toolName, params)
+	if err != nil {
+		return &ToolCallResult{
+			Content: []ContentBlock{{Type: "text", Text: "validation error: " + err.Error()}},
+			IsError: true,
+		}, nil
+	}
+	params = validated
+
+	if isReadOnly(m, toolName) {
+		if key, ok := coalesceKey(ctx, moduleName, toolName, params); ok {
+			return coalesced(ctx, key, func() *ToolCallResult {
+				return execute(ctx, m, moduleName, toolName, params, start)
+			}), nil
 		}
-		params = validated
 	}
+	return execute(ctx, m, moduleName, toolName, params, start), nil
+}
 
+// execute calls the module with the tool timeout, tracing and call logging.
+func execute(ctx context.Context, m Module, moduleName, toolName string, params map[string]interface{}, start time.Time) *ToolCallResult {
 	// Apply timeout to prevent external API calls from hanging indefinitely
 	ctx, cancel := context.WithTimeout(ctx, toolTimeout)
 	defer cancel()
 
+	traceID := middleware.GetTraceID(ctx)
+	ctx, span := observability.StartToolSpan(ctx, moduleName, toolName, traceID)
+	defer span.End()
+
 	result, err := m.ExecuteTool(ctx, toolName, params)
+	err = WithUpstreamStatus(err)
 	durationMs := time.Since(start).Milliseconds()
 	requestID := middleware.GetRequestID(ctx)
 	authCtx := middleware.GetAuthContext(ctx)
@@ -366,20 +683,104 @@ func Run(ctx context.Context, moduleName, toolName string, params map[string]int
 
 	if err != nil {
 		errMsg := err.Error()
-		if ctx.Err() == context.DeadlineExceeded {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
 			errMsg = fmt.Sprintf("Request to %s timed out after %s. The external service did not respond in time.", moduleName, toolTimeout)
+		case context.Canceled:
+			errMsg = fmt.Sprintf("Request to %s was cancelled because the client disconnected.", moduleName)
 		}
-		observability.LogToolCall(requestID, userID, moduleName, toolName, durationMs, "error", errMsg)
+		var meta map[string]any
+		var upstream *UpstreamError
+		if errors.As(err, &upstream) {
+			span.SetAttributes(attribute.Int("mcpist.upstream_status", upstream.StatusCode))
+			meta = map[string]any{"upstream_status": upstream.StatusCode}
+			if upstream.RetryAfter > 0 {
+				secs := int(math.Ceil(upstream.RetryAfter.Seconds()))
+				errMsg += fmt.Sprintf(" Retry after %d seconds.", secs)
+				meta["retry_after"] = secs
+			}
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, errMsg)
+		observability.LogToolCall(requestID, traceID, userID, moduleName, toolName, durationMs, "error", errMsg)
 		return &ToolCallResult{
 			Content: []ContentBlock{{Type: "text", Text: errMsg}},
 			IsError: true,
-		}, nil
+			Meta:    meta,
+		}
 	}
 
-	observability.LogToolCall(requestID, userID, moduleName, toolName, durationMs, "success", "")
+	observability.LogToolCall(requestID, traceID, userID, moduleName, toolName, durationMs, "success", "")
 	return &ToolCallResult{
 		Content: []ContentBlock{{Type: "text", Text: result}},
-	}, nil
+	}
+}
+
+// LogTrace records a step of the current MCP call under its trace ID.
+// Module handlers call it on dispatch and newOgenClient calls it per upstream client,
+// so composite tools that fan out (e.g. describe_repo) show their full call tree.
+// The step is also added as an event on the current OpenTelemetry span.
+func LogTrace(ctx context.Context, moduleName, event string, details map[string]any) {
+	observability.LogTrace(middleware.GetTraceID(ctx), middleware.GetRequestID(ctx), moduleName, event, details)
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		attrs := []attribute.KeyValue{attribute.String("mcpist.module", moduleName)}
+		for k, v := range details {
+			attrs = append(attrs, attribute.String(k, fmt.Sprint(v)))
+		}
+		span.AddEvent(event, trace.WithAttributes(attrs...))
+	}
+}
+
+// ResolveToolAlias maps a deprecated tool name to its current name.
+// It returns the name unchanged and false when toolName is not an alias.
+func ResolveToolAlias(moduleName, toolName string) (string, bool) {
+	m, ok := registry[moduleName]
+	if !ok {
+		return toolName, false
+	}
+	aliaser, ok := m.(ToolAliaser)
+	if !ok {
+		return toolName, false
+	}
+	current, ok := aliaser.Aliases()[toolName]
+	if !ok || current == toolName {
+		return toolName, false
+	}
+	return current, true
+}
+
+// ToolAnnotation classifies a tool by its annotation preset for the audit trail:
+// read_only, create, update, delete, destructive, or unknown when the tool
+// (or its annotations) cannot be found. Aliases are resolved.
+func ToolAnnotation(moduleName, toolName string) string {
+	m, ok := registry[moduleName]
+	if !ok {
+		return "unknown"
+	}
+	toolName, _ = ResolveToolAlias(moduleName, toolName)
+	tool, ok := findTool(m.Tools(), toolName)
+	if !ok || tool.Annotations == nil {
+		return "unknown"
+	}
+	a := tool.Annotations
+	switch {
+	case a.ReadOnlyHint != nil && *a.ReadOnlyHint:
+		return "read_only"
+	case a.DestructiveHint != nil && *a.DestructiveHint:
+		if a.IdempotentHint != nil && *a.IdempotentHint {
+			return "delete"
+		}
+		return "destructive"
+	case a.IdempotentHint != nil && *a.IdempotentHint:
+		return "update"
+	default:
+		return "create"
+	}
+}
+
+// DeprecationNotice is returned alongside the result of a call made under a deprecated tool name.
+func DeprecationNotice(moduleName, oldName, newName string) string {
+	return fmt.Sprintf("deprecated: %s:%s has been renamed to %s:%s. The old name still works but will be removed in a future release.", moduleName, oldName, moduleName, newName)
 }
 
 // ApplyCompact converts a JSON result to compact format (CSV/MD) for a given module and tool.
@@ -411,17 +812,70 @@ type BatchCommand struct {
 
 // BatchResponse represents the batch execution response
 type BatchResponse struct {
-	Results map[string]string `json:"results"`          // ID -> result (for output:true tasks)
-	Errors  map[string]string `json:"errors,omitempty"` // ID -> error message
+	Results  *orderedStrings `json:"results"`            // ID -> result (for output:true tasks)
+	Errors   *orderedStrings `json:"errors,omitempty"`   // ID -> error message
+	Warnings *orderedStrings `json:"warnings,omitempty"` // ID -> deprecation notice for aliased tool names
+}
+
+// orderedStrings is a string map that marshals its keys in insertion order,
+// so batch results come back in the same order as the input commands
+type orderedStrings struct {
+	keys   []string
+	values map[string]string
+}
+
+func newOrderedStrings() *orderedStrings {
+	return &orderedStrings{values: make(map[string]string)}
+}
+
+// Set adds or replaces a key, keeping the position of its first insertion
+func (o *orderedStrings) Set(key, value string) {
+	if _, exists := o.values[key]; !exists {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// Len returns the number of keys
+func (o *orderedStrings) Len() int {
+	return len(o.keys)
+}
+
+// MarshalJSON encodes the map as a JSON object with keys in insertion order
+func (o *orderedStrings) MarshalJSON() ([]byte, error) {
+	var buf strings.Builder
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return []byte(buf.String()), nil
 }
 
 // taskState holds execution state for a task
 type taskState struct {
-	cmd     BatchCommand
-	result  string
-	err     error
-	done    chan struct{}
-	skipped bool
+	cmd      BatchCommand
+	result   string
+	err      error
+	done     chan struct{}
+	skipped  bool
+	maxBytes int          // result size cap from _max_bytes or the server default
+	format   OutputFormat // output shape from _format
+	notice   string // deprecation notice when the command used a tool alias
+	duration time.Duration
 }
 
 // SuccessfulTask represents a successfully executed task for credit tracking
@@ -431,16 +885,41 @@ type SuccessfulTask struct {
 	Tool   string
 }
 
+// TaskOutcome is the outcome of one batch task, for the tool call audit trail
+type TaskOutcome struct {
+	TaskID   string
+	Module   string
+	Tool     string
+	Status   string // success, error or skipped
+	Duration time.Duration
+}
+
 // BatchResult contains the tool call result and success count for credit consumption
 type BatchResult struct {
 	Result          *ToolCallResult
 	SuccessCount    int
 	SuccessfulTasks []SuccessfulTask // Individual task info for per-tool credit tracking
+	Tasks           []TaskOutcome    // Every task that was run or skipped, in command order
+}
+
+// checkBatch rejects meta-parameters that only work in a single run call.
+// The confirmation token of a batch task is checked before the batch starts.
+func (p MetaParams) checkBatch() error {
+	switch {
+	case p.FetchAll > 0:
+		return fmt.Errorf("%s is not supported in batch; use run for this task", FetchAllParam)
+	case p.IncludeMeta:
+		return fmt.Errorf("%s is not supported in batch; use run for this task", IncludeMetaParam)
+	case p.ReturnDiff:
+		return fmt.Errorf("%s is not supported in batch; use run for this task", ReturnDiffParam)
+	}
+	return nil
 }
 
-// Batch executes multiple tools from JSONL input with DAG-based parallel execution
+// Batch executes multiple tools from JSONL input with DAG-based parallel execution.
+// maxParallel bounds how many tasks run at once; 0 or less means no limit.
 // Returns the result and the count of successful tool executions for credit consumption
-func Batch(ctx context.Context, commands string) (*BatchResult, error) {
+func Batch(ctx context.Context, commands string, maxParallel int) (*BatchResult, error) {
 	// Parse commands
 	lines := strings.Split(strings.TrimSpace(commands), "\n")
 	tasks := make(map[string]*taskState)
@@ -483,13 +962,47 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 			}, nil
 		}
 
+		var notice string
+		if current, aliased := ResolveToolAlias(cmd.Module, cmd.Tool); aliased {
+			notice = DeprecationNotice(cmd.Module, cmd.Tool, current)
+			cmd.Tool = current
+		}
+
+		meta, err := TakeMetaParams(cmd.Params)
+		if err == nil {
+			err = meta.checkBatch()
+		}
+		if err != nil {
+			return &BatchResult{
+				Result: &ToolCallResult{
+					Content: []ContentBlock{{Type: "text", Text: fmt.Sprintf("%s: %v", cmd.ID, err)}},
+					IsError: true,
+				},
+				SuccessCount: 0,
+			}, nil
+		}
+
 		tasks[cmd.ID] = &taskState{
-			cmd:  cmd,
-			done: make(chan struct{}),
+			cmd:      cmd,
+			notice:   notice,
+			done:     make(chan struct{}),
+			maxBytes: meta.MaxBytes,
+			format:   meta.Format,
 		}
 		order = append(order, cmd.ID)
 	}
 
+	// Variable references to other tasks are implicit dependencies
+	for _, id := range order {
+		state := tasks[id]
+		for _, ref := range referencedTasks(state.cmd.Params) {
+			if _, exists := tasks[ref]; !exists || ref == id || containsString(state.cmd.After, ref) {
+				continue
+			}
+			state.cmd.After = append(state.cmd.After, ref)
+		}
+	}
+
 	// Validate dependencies exist
 	for _, state := range tasks {
 		for _, dep := range state.cmd.After {
@@ -516,7 +1029,11 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 		}, nil
 	}
 
-	// Execute tasks with goroutines
+	// Execute tasks with goroutines, bounded by the semaphore
+	if maxParallel <= 0 || maxParallel > len(order) {
+		maxParallel = len(order)
+	}
+	sem := make(chan struct{}, maxParallel)
 	var wg sync.WaitGroup
 	resultStore := &sync.Map{} // Store results for variable substitution
 
@@ -524,7 +1041,7 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 		wg.Add(1)
 		go func(taskID string) {
 			defer wg.Done()
-			executeTask(ctx, taskID, tasks, resultStore)
+			executeTask(ctx, taskID, tasks, resultStore, sem)
 		}(id)
 	}
 
@@ -532,18 +1049,26 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 
 	// Build response and count successful executions
 	response := BatchResponse{
-		Results: make(map[string]string),
-		Errors:  make(map[string]string),
+		Results:  newOrderedStrings(),
+		Errors:   newOrderedStrings(),
+		Warnings: newOrderedStrings(),
 	}
 	successCount := 0
 	var successfulTasks []SuccessfulTask
+	outcomes := make([]TaskOutcome, 0, len(order))
 
 	for _, id := range order {
 		state := tasks[id]
+		outcome := TaskOutcome{TaskID: id, Module: state.cmd.Module, Tool: state.cmd.Tool, Status: "success", Duration: state.duration}
+		if state.notice != "" {
+			response.Warnings.Set(id, state.notice)
+		}
 		if state.err != nil {
-			response.Errors[id] = state.err.Error()
+			response.Errors.Set(id, state.err.Error())
+			outcome.Status = "error"
 		} else if state.skipped {
-			response.Errors[id] = "skipped due to dependency failure"
+			response.Errors.Set(id, "skipped due to dependency failure")
+			outcome.Status = "skipped"
 		} else {
 			// Successful execution
 			successCount++
@@ -553,24 +1078,23 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 				Tool:   state.cmd.Tool,
 			})
 			if state.cmd.Output {
-				// output: true -> apply compact unless params.format == "json"
-				f, _ := state.cmd.Params["format"].(string)
-				if f == "json" {
-					response.Results[id] = state.result
-				} else {
-					response.Results[id] = ApplyCompact(state.cmd.Module, state.cmd.Tool, state.result)
-				}
+				// output: true -> apply compact unless _format is "json"
+				response.Results.Set(id, FormatResult(state.cmd.Module, state.cmd.Tool, state.result, state.format, state.maxBytes))
 			}
 		}
+		outcomes = append(outcomes, outcome)
 	}
 
 	// Clean up empty maps
-	if len(response.Errors) == 0 {
+	if response.Errors.Len() == 0 {
 		response.Errors = nil
 	}
-	if len(response.Results) == 0 {
+	if response.Results.Len() == 0 {
 		response.Results = nil
 	}
+	if response.Warnings.Len() == 0 {
+		response.Warnings = nil
+	}
 
 	// Return JSON format with success count
 	jsonBytes, _ := json.Marshal(response)
@@ -581,6 +1105,7 @@ func Batch(ctx context.Context, commands string) (*BatchResult, error) {
 		},
 		SuccessCount:    successCount,
 		SuccessfulTasks: successfulTasks,
+		Tasks:           outcomes,
 	}, nil
 }
 
@@ -623,8 +1148,9 @@ func detectCycle(tasks map[string]*taskState) string {
 	return ""
 }
 
-// executeTask executes a single task after waiting for dependencies
-func executeTask(ctx context.Context, taskID string, tasks map[string]*taskState, resultStore *sync.Map) {
+// executeTask executes a single task after waiting for dependencies.
+// A semaphore slot is only taken once dependencies are done, so waiting tasks never block runnable ones.
+func executeTask(ctx context.Context, taskID string, tasks map[string]*taskState, resultStore *sync.Map, sem chan struct{}) {
 	state := tasks[taskID]
 	defer close(state.done)
 
@@ -640,11 +1166,22 @@ func executeTask(ctx context.Context, taskID string, tasks map[string]*taskState
 		}
 	}
 
+	// Tasks still queued when the client disconnects are never started
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		state.err = ctx.Err()
+		return
+	}
+	defer func() { <-sem }()
+
 	// Resolve variable references in params
 	resolvedParams := resolveVariables(state.cmd.Params, resultStore)
 
 	// Execute the tool
+	start := time.Now()
 	result, err := Run(ctx, state.cmd.Module, state.cmd.Tool, resolvedParams)
+	state.duration = time.Since(start)
 	if err != nil {
 		state.err = err
 		return
@@ -661,6 +1198,41 @@ func executeTask(ctx context.Context, taskID string, tasks map[string]*taskState
 	resultStore.Store(taskID, state.result)
 }
 
+// referencedTasks returns the task IDs referenced by ${id.results[N].field} variables in params
+func referencedTasks(params map[string]interface{}) []string {
+	var refs []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case string:
+			for _, m := range varRefPattern.FindAllStringSubmatch(v, -1) {
+				if !containsString(refs, m[1]) {
+					refs = append(refs, m[1])
+				}
+			}
+		case map[string]interface{}:
+			for _, val := range v {
+				walk(val)
+			}
+		case []interface{}:
+			for _, val := range v {
+				walk(val)
+			}
+		}
+	}
+	walk(map[string]interface{}(params))
+	return refs
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveVariables replaces ${id.items[N].field} references with actual values
 func resolveVariables(params map[string]interface{}, resultStore *sync.Map) map[string]interface{} {
 	if params == nil {
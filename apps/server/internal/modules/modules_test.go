@@ -1,8 +1,24 @@
 package modules
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/ogen-go/ogen/validate"
+
+	"mcpist/server/internal/middleware"
 )
 
 func TestFilterTools(t *testing.T) {
@@ -247,3 +263,815 @@ func TestAvailableModuleNames(t *testing.T) {
 		}
 	})
 }
+
+// batchTestModule echoes its "value" param and records peak concurrency
+type batchTestModule struct {
+	running atomic.Int32
+	peak    atomic.Int32
+}
+
+func (m *batchTestModule) Name() string                { return "batchtest" }
+func (m *batchTestModule) Description() string         { return "" }
+func (m *batchTestModule) Descriptions() LocalizedText { return nil }
+func (m *batchTestModule) APIVersion() string          { return "" }
+func (m *batchTestModule) Tools() []Tool {
+	return []Tool{{ID: "batchtest:echo", Name: "echo", InputSchema: InputSchema{Type: "object"}}}
+}
+func (m *batchTestModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	n := m.running.Add(1)
+	defer m.running.Add(-1)
+	for {
+		p := m.peak.Load()
+		if n <= p || m.peak.CompareAndSwap(p, n) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	v, _ := params["value"].(string)
+	b, _ := json.Marshal([]map[string]string{{"value": v}})
+	return string(b), nil
+}
+func (m *batchTestModule) Resources() []Resource { return nil }
+func (m *batchTestModule) ReadResource(ctx context.Context, uri string) (string, error) {
+	return "", nil
+}
+
+func TestBatchMaxParallel(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+
+	var lines []string
+	for _, id := range []string{"z", "y", "x", "w", "v", "u"} {
+		lines = append(lines, `{"id":"`+id+`","module":"batchtest","tool":"echo","params":{"value":"`+id+`","format":"json"},"output":true}`)
+	}
+	commands := strings.Join(lines, "\n")
+
+	t.Run("serial", func(t *testing.T) {
+		m := &batchTestModule{}
+		registry = map[string]Module{"batchtest": m}
+		res, err := Batch(context.Background(), commands, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.SuccessCount != 6 {
+			t.Errorf("SuccessCount = %d, want 6", res.SuccessCount)
+		}
+		if p := m.peak.Load(); p != 1 {
+			t.Errorf("peak concurrency = %d, want 1", p)
+		}
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		m := &batchTestModule{}
+		registry = map[string]Module{"batchtest": m}
+		res, err := Batch(context.Background(), commands, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p := m.peak.Load(); p > 2 {
+			t.Errorf("peak concurrency = %d, want <= 2", p)
+		}
+		// Results keep input order rather than sorted key order
+		text := res.Result.Content[0].Text
+		last := -1
+		for _, id := range []string{"z", "y", "x", "w", "v", "u"} {
+			i := strings.Index(text, `"`+id+`":`)
+			if i <= last {
+				t.Fatalf("result %q out of input order in %s", id, text)
+			}
+			last = i
+		}
+	})
+
+	t.Run("variable reference implies dependency", func(t *testing.T) {
+		m := &batchTestModule{}
+		registry = map[string]Module{"batchtest": m}
+		cmds := `{"id":"second","module":"batchtest","tool":"echo","params":{"value":"got ${first.results[0].value}","format":"json"},"output":true}` + "\n" +
+			`{"id":"first","module":"batchtest","tool":"echo","params":{"value":"one"}}`
+		res, err := Batch(context.Background(), cmds, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if text := res.Result.Content[0].Text; !strings.Contains(text, "got one") {
+			t.Errorf("expected resolved reference, got %s", text)
+		}
+	})
+}
+
+// compactTestModule compacts results to their first 10 bytes
+type compactTestModule struct{ batchTestModule }
+
+func (m *compactTestModule) ToCompact(toolName, jsonResult string) string {
+	return jsonResult[:min(10, len(jsonResult))]
+}
+
+func TestFormatResultSizeGuard(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"compacttest": &compactTestModule{}}
+
+	big := `[` + strings.Repeat(`{"value":"xxxxxxxx"},`, 50) + `{}]`
+	if got := FormatResult("compacttest", "echo", big, FormatJSON, 0); got != big {
+		t.Error("expected JSON result unchanged without a size cap")
+	}
+	if got := FormatResult("compacttest", "echo", big, FormatJSON, 100); got != big[:10] {
+		t.Errorf("expected fallback to compact output, got %q", got)
+	}
+
+	// Modules without a compact form are truncated with a marker
+	registry = map[string]Module{"batchtest": &batchTestModule{}}
+	got := FormatResult("batchtest", "echo", big, FormatJSON, 400)
+	var res map[string]any
+	if err := json.Unmarshal([]byte(got), &res); err != nil {
+		t.Fatalf("truncated result is not JSON: %v", err)
+	}
+	if res["_truncated"] != true {
+		t.Errorf("expected _truncated marker, got %s", got)
+	}
+	if content, _ := res["content"].(string); !strings.HasPrefix(big, content) || content == "" {
+		t.Errorf("expected a non-empty prefix of the result, got %q", content)
+	}
+	if len(got) > 400 {
+		t.Errorf("truncated result is %d bytes, want <= 400", len(got))
+	}
+}
+
+func TestNormalizeEmpty(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"batchtest": &pagingTestModule{}}
+
+	tests := []struct {
+		tool string
+		in   string
+		want string
+	}{
+		{"get", `null`, `{"items":[],"_count":0}`},
+		{"numbered", `[]`, `{"items":[],"_count":0}`},
+		{"cursor", `{"results":null,"next_cursor":null}`, `{"results":[],"next_cursor":null,"_count":0}`},
+		{"cursor", `{"has_more":false, "results":[ ]}`, `{"has_more":false, "results":[ ],"_count":0}`},
+		{"cursor", `{"results":[{"id":1}]}`, `{"results":[{"id":1}]}`},
+		{"cursor", `{"results":[],"_count":0}`, `{"results":[],"_count":0}`},
+		{"cursor", `{"meta":{"results":null},"results":[1]}`, `{"meta":{"results":null},"results":[1]}`},
+		{"numbered", `[{"id":1}]`, `[{"id":1}]`},
+		// Objects outside a declared item field are left alone
+		{"get", `{"id":"x","labels":[],"status":null}`, `{"id":"x","labels":[],"status":null}`},
+		{"get", `{"issues":[],"total":0}`, `{"issues":[],"total":0}`},
+	}
+	for _, tt := range tests {
+		if got := normalizeEmpty("batchtest", tt.tool, tt.in); got != tt.want {
+			t.Errorf("normalizeEmpty(%s, %s) = %s, want %s", tt.tool, tt.in, got, tt.want)
+		}
+	}
+
+	// Only read-only tools are normalized
+	registry = map[string]Module{"batchtest": &batchTestModule{}}
+	if got := FormatResult("batchtest", "echo", `null`, FormatJSON, 0); got != `null` {
+		t.Errorf("expected unannotated tool result unchanged, got %s", got)
+	}
+}
+
+func TestTakeFormat(t *testing.T) {
+	tests := []struct {
+		params  map[string]any
+		want    OutputFormat
+		wantErr bool
+	}{
+		{map[string]any{}, FormatCompact, false},
+		{map[string]any{FormatParam: "json"}, FormatJSON, false},
+		{map[string]any{FormatParam: "compact", "format": "json"}, FormatCompact, false},
+		{map[string]any{"format": "json"}, FormatJSON, false},
+		{map[string]any{FormatParam: "xml"}, "", true},
+	}
+	for _, tt := range tests {
+		got, err := TakeFormat(tt.params)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("TakeFormat(%v) = %q, %v; want %q, err=%v", tt.params, got, err, tt.want, tt.wantErr)
+		}
+		if _, ok := tt.params[FormatParam]; ok {
+			t.Errorf("expected %s to be removed from %v", FormatParam, tt.params)
+		}
+	}
+}
+
+func TestTakeMaxBytes(t *testing.T) {
+	t.Setenv("MAX_RESPONSE_BYTES", "5000")
+
+	params := map[string]any{MaxBytesParam: float64(1000), "limit": float64(5)}
+	if got := TakeMaxBytes(params); got != 1000 {
+		t.Errorf("TakeMaxBytes = %d, want 1000", got)
+	}
+	if _, ok := params[MaxBytesParam]; ok {
+		t.Error("expected _max_bytes to be removed from params")
+	}
+	if got := TakeMaxBytes(map[string]any{}); got != 5000 {
+		t.Errorf("TakeMaxBytes = %d, want server default 5000", got)
+	}
+}
+
+func TestTakeMetaParams(t *testing.T) {
+	params := map[string]any{
+		MaxBytesParam:     float64(1000),
+		FormatParam:       "json",
+		IncludeMetaParam:  true,
+		ReturnDiffParam:   true,
+		FetchAllParam:     float64(50),
+		ConfirmationParam: "tok",
+		"limit":           float64(5),
+	}
+	meta, err := TakeMetaParams(params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := MetaParams{MaxBytes: 1000, Format: FormatJSON, IncludeMeta: true, ReturnDiff: true, FetchAll: 50, ConfirmationToken: "tok"}
+	if meta != want {
+		t.Errorf("TakeMetaParams = %+v, want %+v", meta, want)
+	}
+	if len(params) != 1 || params["limit"] != float64(5) {
+		t.Errorf("expected only tool params to remain, got %v", params)
+	}
+
+	params = map[string]any{FormatParam: "xml", FetchAllParam: true}
+	if _, err := TakeMetaParams(params); err == nil || len(params) != 0 {
+		t.Errorf("expected a format error with every meta-parameter removed, got %v %v", err, params)
+	}
+}
+
+func TestBatchRejectsRunOnlyMetaParams(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"batchtest": &batchTestModule{}}
+
+	for _, param := range []string{`"_fetch_all":true`, `"_include_meta":true`, `"_return_diff":true`} {
+		cmds := `{"id":"a","module":"batchtest","tool":"echo","params":{"value":"one",` + param + `}}`
+		res, err := Batch(context.Background(), cmds, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !res.Result.IsError || !strings.Contains(res.Result.Content[0].Text, "not supported in batch") {
+			t.Errorf("%s: expected the task to be rejected, got %+v", param, res.Result)
+		}
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	var before, after any
+	json.Unmarshal([]byte(`{"title":"Old","state":"open","assignee":{"login":"a"},"labels":["bug"],"panels":[{"id":1,"title":"CPU"}],"closed_at":null}`), &before)
+	json.Unmarshal([]byte(`{"title":"New","state":"open","assignee":{"login":"b"},"labels":["bug","p1"],"panels":[{"id":1,"title":"Load"}],"closed_at":null,"milestone":"v1"}`), &after)
+
+	got := DiffFields(before, after)
+	want := map[string]FieldChange{
+		"title":          {Before: "Old", After: "New"},
+		"assignee.login": {Before: "a", After: "b"},
+		"labels":         {Before: []any{"bug"}, After: []any{"bug", "p1"}},
+		"panels.0.title": {Before: "CPU", After: "Load"},
+		"milestone":      {Before: nil, After: "v1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffFields = %v, want %v", got, want)
+	}
+
+	params := map[string]any{ReturnDiffParam: true, "title": "x"}
+	if !TakeReturnDiff(params) || params[ReturnDiffParam] != nil {
+		t.Error("expected _return_diff to be taken from params")
+	}
+}
+
+func TestMeteredTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("0123456789"))
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: MeteredTransport(http.DefaultTransport)}
+
+	get := func(ctx context.Context) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	ctx, meter := WithCallMeter(context.Background())
+	get(ctx)
+	get(ctx)
+	get(context.Background()) // unmetered calls are not counted
+
+	meta := meter.Meta(42)
+	if meta.UpstreamCalls != 2 || meta.BytesIn != 20 || meta.BytesOut != 42 {
+		t.Errorf("meta = %+v, want 2 calls, 20 bytes in, 42 bytes out", meta)
+	}
+
+	params := map[string]any{IncludeMetaParam: true}
+	if !TakeIncludeMeta(params) || len(params) != 0 {
+		t.Errorf("TakeIncludeMeta did not consume _include_meta: %v", params)
+	}
+}
+
+// aliasTestModule renamed its old_echo tool to echo
+type aliasTestModule struct{ batchTestModule }
+
+func (m *aliasTestModule) Aliases() map[string]string {
+	return map[string]string{"old_echo": "echo"}
+}
+
+func TestToolAliases(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"batchtest": &aliasTestModule{}}
+
+	if got, aliased := ResolveToolAlias("batchtest", "old_echo"); got != "echo" || !aliased {
+		t.Errorf("ResolveToolAlias(old_echo) = %q, %v; want echo, true", got, aliased)
+	}
+	if got, aliased := ResolveToolAlias("batchtest", "echo"); got != "echo" || aliased {
+		t.Errorf("ResolveToolAlias(echo) = %q, %v; want echo, false", got, aliased)
+	}
+	if _, aliased := ResolveToolAlias("unknown", "old_echo"); aliased {
+		t.Error("expected no alias for an unknown module")
+	}
+
+	cmds := `{"id":"a","module":"batchtest","tool":"old_echo","params":{"value":"one","format":"json"},"output":true}` + "\n" +
+		`{"id":"b","module":"batchtest","tool":"echo","params":{"value":"two","format":"json"},"output":true}`
+	res, err := Batch(context.Background(), cmds, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.SuccessCount != 2 || res.SuccessfulTasks[0].Tool != "echo" {
+		t.Errorf("expected both tasks to run as echo, got %+v", res.SuccessfulTasks)
+	}
+	var resp struct {
+		Warnings map[string]string `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(res.Result.Content[0].Text), &resp); err != nil {
+		t.Fatalf("invalid batch response: %v", err)
+	}
+	if len(resp.Warnings) != 1 || !strings.Contains(resp.Warnings["a"], "renamed to batchtest:echo") {
+		t.Errorf("expected a deprecation warning for task a only, got %v", resp.Warnings)
+	}
+}
+
+func TestDescribeTool(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"desctest": &describeTestModule{}}
+
+	res, err := DescribeTool("desctest", "get_item", nil)
+	if err != nil || res.IsError {
+		t.Fatalf("unexpected error: %v %+v", err, res)
+	}
+	var desc ToolDescription
+	if err := json.Unmarshal([]byte(res.Content[0].Text), &desc); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+	params, _ := desc.Example["params"].(map[string]any)
+	if params["id"] != "<id>" || params["tags"] == nil || params["limit"] != nil {
+		t.Errorf("example params = %v, want required id and tags only", params)
+	}
+	want := "desctest:get_item (read-only). Get an item. Required: id (string), tags (array). Optional: limit (number)."
+	if desc.Summary != want {
+		t.Errorf("summary = %q, want %q", desc.Summary, want)
+	}
+	if desc.Output == nil || desc.Output.Properties["id"] == nil {
+		t.Errorf("outputSchema = %+v, want the tool's output schema", desc.Output)
+	}
+
+	res, _ = DescribeTool("desctest", "missing", nil)
+	if !res.IsError || !strings.Contains(res.Content[0].Text, "Available: get_item") {
+		t.Errorf("expected unknown tool error listing available tools, got %+v", res)
+	}
+	res, _ = DescribeTool("desctest", "get_item", map[string][]string{"other": {"other:x"}})
+	if !res.IsError {
+		t.Error("expected tools of a disabled module to be unknown")
+	}
+}
+
+func TestMetaToolAnnotations(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"desctest": &describeTestModule{}, "batchtest": &confirmTestModule{}}
+
+	annotations := func(enabledModules []string) map[string]*ToolAnnotations {
+		out := map[string]*ToolAnnotations{}
+		for _, tool := range DynamicMetaTools(enabledModules, nil) {
+			out[tool.Name] = tool.Annotations
+		}
+		return out
+	}
+
+	got := annotations([]string{"desctest"})
+	for _, name := range []string{"get_module_schema", "describe_tool", "run", "batch"} {
+		if a := got[name]; a == nil || a.ReadOnlyHint == nil || !*a.ReadOnlyHint {
+			t.Errorf("%s annotations = %+v, want readOnlyHint with only read-only tools enabled", name, a)
+		}
+	}
+
+	got = annotations([]string{"desctest", "batchtest"})
+	if a := got["run"]; a == nil || *a.ReadOnlyHint || !*a.DestructiveHint {
+		t.Errorf("run annotations = %+v, want destructiveHint when a delete tool is enabled", a)
+	}
+	if a := got["get_module_schema"]; !*a.ReadOnlyHint {
+		t.Error("get_module_schema should stay read-only")
+	}
+}
+
+type describeTestModule struct{ batchTestModule }
+
+func (m *describeTestModule) Tools() []Tool {
+	return []Tool{{
+		ID:           "desctest:get_item",
+		Name:         "get_item",
+		Descriptions: LocalizedText{"en-US": "Get an item."},
+		Annotations:  AnnotateReadOnly,
+		InputSchema: InputSchema{
+			Type: "object",
+			Properties: map[string]Property{
+				"id":    {Type: "string", Description: "Item ID"},
+				"tags":  {Type: "array", Description: "Tags", Items: &Property{Type: "string"}},
+				"limit": {Type: "number", Description: "Max results"},
+			},
+			Required: []string{"id", "tags"},
+		},
+		OutputSchema: &OutputSchema{
+			Type:       "object",
+			Properties: map[string]*OutputSchema{"id": {Type: "string"}},
+		},
+	}}
+}
+
+// probeTestModule probes with echo, which fails when err is set
+type probeTestModule struct {
+	batchTestModule
+	err error
+}
+
+func (m *probeTestModule) HealthProbe() (string, map[string]any) {
+	return "echo", map[string]any{"value": "ping"}
+}
+
+func (m *probeTestModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return m.batchTestModule.ExecuteTool(ctx, name, params)
+}
+
+func TestProbeModules(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{
+		"healthy": &probeTestModule{},
+		"broken":  &probeTestModule{err: errors.New("401 Unauthorized")},
+		"noprobe": &batchTestModule{},
+	}
+
+	got := ProbeModules(context.Background(), []string{"healthy", "broken", "noprobe", "unknown"})
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 results, got %d: %v", len(got), got)
+	}
+	if got["healthy"].Status != "ok" || got["healthy"].Tool != "echo" {
+		t.Errorf("healthy = %+v, want status ok via echo", got["healthy"])
+	}
+	if got["broken"].Status != "error" || got["broken"].Error == "" {
+		t.Errorf("broken = %+v, want status error with message", got["broken"])
+	}
+	if got["noprobe"].Status != "unsupported" {
+		t.Errorf("noprobe = %+v, want status unsupported", got["noprobe"])
+	}
+	if _, ok := got["unknown"]; ok {
+		t.Error("unknown module should be skipped")
+	}
+}
+
+// coalesceTestModule counts upstream calls; each call blocks until release is closed
+type coalesceTestModule struct {
+	batchTestModule
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (m *coalesceTestModule) Tools() []Tool {
+	return []Tool{
+		{ID: "batchtest:get", Name: "get", Annotations: AnnotateReadOnly, InputSchema: InputSchema{Type: "object"}},
+		{ID: "batchtest:set", Name: "set", Annotations: AnnotateUpdate, InputSchema: InputSchema{Type: "object"}},
+	}
+}
+
+func (m *coalesceTestModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	m.calls.Add(1)
+	select {
+	case <-m.release:
+		return `{"ok":true}`, nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func TestRunCoalescesIdenticalReads(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+
+	userCtx := func(userID string) context.Context {
+		return context.WithValue(context.Background(), middleware.AuthContextKey, &middleware.AuthContext{UserID: userID})
+	}
+	runConcurrently := func(m *coalesceTestModule, calls []func() (*ToolCallResult, error)) []*ToolCallResult {
+		results := make([]*ToolCallResult, len(calls))
+		var wg sync.WaitGroup
+		for i, call := range calls {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = call()
+			}()
+		}
+		time.Sleep(50 * time.Millisecond) // let every call reach the module or join an in-flight one
+		close(m.release)
+		wg.Wait()
+		return results
+	}
+
+	t.Run("identical reads share one call", func(t *testing.T) {
+		m := &coalesceTestModule{release: make(chan struct{})}
+		registry = map[string]Module{"batchtest": m}
+		call := func() (*ToolCallResult, error) {
+			return Run(userCtx("u1"), "batchtest", "get", map[string]any{"id": "1"})
+		}
+		results := runConcurrently(m, []func() (*ToolCallResult, error){call, call, call, call})
+		if n := m.calls.Load(); n != 1 {
+			t.Errorf("upstream calls = %d, want 1", n)
+		}
+		// Callers get independent copies
+		results[0].Content[0].Text = "changed"
+		for _, r := range results[1:] {
+			if r.Content[0].Text != `{"ok":true}` {
+				t.Errorf("shared result was mutated: %q", r.Content[0].Text)
+			}
+		}
+	})
+
+	t.Run("different users, params and writes are not shared", func(t *testing.T) {
+		m := &coalesceTestModule{release: make(chan struct{})}
+		registry = map[string]Module{"batchtest": m}
+		runConcurrently(m, []func() (*ToolCallResult, error){
+			func() (*ToolCallResult, error) {
+				return Run(userCtx("u1"), "batchtest", "get", map[string]any{"id": "1"})
+			},
+			func() (*ToolCallResult, error) {
+				return Run(userCtx("u2"), "batchtest", "get", map[string]any{"id": "1"})
+			},
+			func() (*ToolCallResult, error) {
+				return Run(userCtx("u1"), "batchtest", "get", map[string]any{"id": "2"})
+			},
+			func() (*ToolCallResult, error) {
+				return Run(userCtx("u1"), "batchtest", "set", map[string]any{"id": "1"})
+			},
+			func() (*ToolCallResult, error) {
+				return Run(userCtx("u1"), "batchtest", "set", map[string]any{"id": "1"})
+			},
+		})
+		if n := m.calls.Load(); n != 5 {
+			t.Errorf("upstream calls = %d, want 5", n)
+		}
+	})
+	t.Run("a cancelled caller does not fail the others", func(t *testing.T) {
+		m := &coalesceTestModule{release: make(chan struct{})}
+		registry = map[string]Module{"batchtest": m}
+		ctx, cancel := context.WithCancel(userCtx("u1"))
+		first := make(chan *ToolCallResult, 1)
+		second := make(chan *ToolCallResult, 1)
+		go func() {
+			r, _ := Run(ctx, "batchtest", "get", map[string]any{"id": "1"})
+			first <- r
+		}()
+		time.Sleep(20 * time.Millisecond)
+		go func() {
+			r, _ := Run(userCtx("u1"), "batchtest", "get", map[string]any{"id": "1"})
+			second <- r
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		cancel()
+		if r := <-first; !r.IsError {
+			t.Errorf("cancelled caller got %q, want an error", r.Content[0].Text)
+		}
+		close(m.release)
+		if r := <-second; r.IsError || r.Content[0].Text != `{"ok":true}` {
+			t.Errorf("other caller got %+v, want the result of its own call", r)
+		}
+		if n := m.calls.Load(); n != 2 {
+			t.Errorf("upstream calls = %d, want 2", n)
+		}
+	})
+}
+
+// pagingTestModule serves 5 items, 2 per call whatever the page size: "cursor"
+// pages through {"results", "next_cursor"} objects, "numbered" through
+// top-level arrays, and "search" like "numbered" but serving only 4 items.
+type pagingTestModule struct {
+	batchTestModule
+	calls atomic.Int32
+}
+
+func (m *pagingTestModule) Tools() []Tool {
+	return []Tool{
+		{ID: "batchtest:cursor", Name: "cursor", Annotations: AnnotateReadOnly, InputSchema: InputSchema{Type: "object"}},
+		{ID: "batchtest:numbered", Name: "numbered", Annotations: AnnotateReadOnly, InputSchema: InputSchema{Type: "object"}},
+		{ID: "batchtest:search", Name: "search", Annotations: AnnotateReadOnly, InputSchema: InputSchema{Type: "object"}},
+		{ID: "batchtest:get", Name: "get", Annotations: AnnotateReadOnly, InputSchema: InputSchema{Type: "object"}},
+	}
+}
+
+func (m *pagingTestModule) Pagination(toolName string) (Pagination, bool) {
+	switch toolName {
+	case "cursor":
+		return Pagination{Items: "results", NextCursor: "next_cursor", HasMore: "has_more", CursorParam: "start_cursor"}, true
+	case "numbered":
+		return Pagination{PageParam: "page", PageSizeParam: "per_page", MaxPageSize: 2}, true
+	case "search":
+		return Pagination{PageParam: "page", PageSizeParam: "per_page", MaxPageSize: 2, MaxItems: 4}, true
+	}
+	return Pagination{}, false
+}
+
+func (m *pagingTestModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	m.calls.Add(1)
+	start := 0
+	if name != "cursor" {
+		page, _ := params["page"].(float64)
+		start = max(int(page)-1, 0) * 2
+	} else if cursor, _ := params["start_cursor"].(string); cursor != "" {
+		fmt.Sscan(cursor, &start)
+	}
+	items := []int{}
+	for i := start; i < min(start+2, 5); i++ {
+		items = append(items, i)
+	}
+	if name != "cursor" {
+		b, _ := json.Marshal(items)
+		return string(b), nil
+	}
+	page := map[string]any{"results": items, "has_more": start+2 < 5}
+	if start+2 < 5 {
+		page["next_cursor"] = fmt.Sprint(start + 2)
+	}
+	b, _ := json.Marshal(page)
+	return string(b), nil
+}
+
+func TestFetchAll(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	ctx := context.WithValue(context.Background(), middleware.AuthContextKey, &middleware.AuthContext{UserID: "u1"})
+
+	tests := []struct {
+		tool      string
+		maxItems  int
+		params    map[string]any
+		want      string
+		calls     int32
+		truncated bool
+	}{
+		{"cursor", 500, nil, `{"has_more":false,"results":[0,1,2,3,4]}`, 3, false},
+		{"numbered", 500, nil, `[0,1,2,3,4]`, 3, false},
+		{"cursor", 3, nil, `{"has_more":false,"results":[0,1,2]}`, 2, true},
+		{"numbered", 4, nil, `[0,1,2,3]`, 2, true},
+		{"cursor", 5, nil, `{"has_more":false,"results":[0,1,2,3,4]}`, 3, false},
+		// A page size above MaxPageSize is clamped, so full pages aren't taken as the last
+		{"numbered", 500, map[string]any{"per_page": float64(10)}, `[0,1,2,3,4]`, 3, false},
+		// MaxItems caps the cap, and paging stops before pages the upstream won't serve
+		{"search", 3, nil, `[0,1,2]`, 2, true},
+		{"search", 500, map[string]any{"page": float64(2)}, `[2,3]`, 1, true},
+	}
+	for _, tt := range tests {
+		m := &pagingTestModule{}
+		registry = map[string]Module{"batchtest": m}
+		params := map[string]any{}
+		maps.Copy(params, tt.params)
+		result, info, err := FetchAll(ctx, "batchtest", tt.tool, params, tt.maxItems)
+		if err != nil {
+			t.Fatalf("FetchAll(%s, %d): %v", tt.tool, tt.maxItems, err)
+		}
+		if got := result.Content[0].Text; got != tt.want {
+			t.Errorf("FetchAll(%s, %d) = %s, want %s", tt.tool, tt.maxItems, got, tt.want)
+		}
+		if n := m.calls.Load(); n != tt.calls || info.Pages != int(tt.calls) {
+			t.Errorf("FetchAll(%s, %d): %d calls, %d pages, want %d", tt.tool, tt.maxItems, n, info.Pages, tt.calls)
+		}
+		if info.Truncated != tt.truncated {
+			t.Errorf("FetchAll(%s, %d): _truncated = %v, want %v", tt.tool, tt.maxItems, info.Truncated, tt.truncated)
+		}
+	}
+
+	if _, _, err := FetchAll(ctx, "batchtest", "get", map[string]any{}, 500); !errors.Is(err, ErrFetchAllUnsupported) {
+		t.Errorf("FetchAll on a tool without pagination: err = %v, want ErrFetchAllUnsupported", err)
+	}
+}
+
+func TestTakeFetchAll(t *testing.T) {
+	t.Setenv("FETCH_ALL_MAX_ITEMS", "")
+	tests := []struct {
+		value any
+		want  int
+	}{
+		{true, defaultFetchAllItems},
+		{false, 0},
+		{float64(50), 50},
+		{float64(1e6), maxFetchAllItems},
+	}
+	for _, tt := range tests {
+		params := map[string]any{FetchAllParam: tt.value}
+		if got := TakeFetchAll(params); got != tt.want {
+			t.Errorf("TakeFetchAll(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+		if _, ok := params[FetchAllParam]; ok {
+			t.Errorf("expected %s to be removed from params", FetchAllParam)
+		}
+	}
+	if got := TakeFetchAll(map[string]any{}); got != 0 {
+		t.Errorf("TakeFetchAll without the param = %d, want 0", got)
+	}
+}
+
+func TestConfirmationToken(t *testing.T) {
+	params := map[string]any{"uid": "abc"}
+	expires := time.Now().Add(time.Minute)
+	token := confirmationToken("u1", "grafana", "delete_folder", params, expires)
+
+	if err := VerifyConfirmation(token, "u1", "grafana", "delete_folder", map[string]any{"uid": "abc"}); err != nil {
+		t.Errorf("valid token rejected: %v", err)
+	}
+	rejected := []struct {
+		name   string
+		token  string
+		userID string
+		params map[string]any
+	}{
+		{"other user", token, "u2", params},
+		{"other params", token, "u1", map[string]any{"uid": "xyz"}},
+		{"malformed", "not-a-token", "u1", params},
+		{"expired", confirmationToken("u1", "grafana", "delete_folder", params, time.Now().Add(-time.Second)), "u1", params},
+	}
+	for _, tt := range rejected {
+		if err := VerifyConfirmation(tt.token, tt.userID, "grafana", "delete_folder", tt.params); err == nil {
+			t.Errorf("%s: token accepted", tt.name)
+		}
+	}
+}
+
+func TestRequiresConfirmation(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"batchtest": &confirmTestModule{}}
+
+	if !RequiresConfirmation("batchtest", "purge") {
+		t.Error("purge should require confirmation")
+	}
+	if RequiresConfirmation("batchtest", "echo") || RequiresConfirmation("unknown", "purge") {
+		t.Error("only AnnotateConfirmedDelete tools should require confirmation")
+	}
+	if got := ToolAnnotation("batchtest", "purge"); got != "delete" {
+		t.Errorf("ToolAnnotation = %q, want delete", got)
+	}
+}
+
+type confirmTestModule struct{ batchTestModule }
+
+func (m *confirmTestModule) Tools() []Tool {
+	return []Tool{
+		{ID: "batchtest:echo", Name: "echo", Annotations: AnnotateDelete, InputSchema: InputSchema{Type: "object"}},
+		{ID: "batchtest:purge", Name: "purge", Annotations: AnnotateConfirmedDelete, InputSchema: InputSchema{Type: "object"}},
+	}
+}
+
+// upstreamErrTestModule fails with a bare ogen status error, as a module
+// that doesn't annotate its errors would.
+type upstreamErrTestModule struct {
+	batchTestModule
+}
+
+func (m *upstreamErrTestModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	return "", fmt.Errorf("decode response: %w", validate.UnexpectedStatusCodeWithResponse(&http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"7"}},
+	}))
+}
+
+func TestRunReportsUpstreamStatus(t *testing.T) {
+	origRegistry := registry
+	defer func() { registry = origRegistry }()
+	registry = map[string]Module{"batchtest": &upstreamErrTestModule{}}
+	ctx := context.WithValue(context.Background(), middleware.AuthContextKey, &middleware.AuthContext{UserID: "u1"})
+
+	res, err := Run(ctx, "batchtest", "echo", map[string]any{})
+	if err != nil || !res.IsError {
+		t.Fatalf("expected a tool error, got %v %+v", err, res)
+	}
+	if res.Meta["upstream_status"] != 429 || res.Meta["retry_after"] != 7 {
+		t.Errorf("meta = %v, want upstream_status 429 and retry_after 7", res.Meta)
+	}
+	if !strings.Contains(res.Content[0].Text, "Retry after 7 seconds.") {
+		t.Errorf("expected the retry hint in the message, got %q", res.Content[0].Text)
+	}
+}
@@ -24,6 +24,24 @@ func BlocksToMarkdown(jsonStr string) string {
 	}
 
 	var sb strings.Builder
+	writeBlocksMarkdown(&sb, results, "")
+
+	// Add pagination info if has_more
+	if hasMore, ok := data["has_more"].(bool); ok && hasMore {
+		if cursor, ok := data["next_cursor"].(string); ok {
+			sb.WriteString(fmt.Sprintf("\n---\n[more: %s]\n", cursor))
+		}
+	}
+	if truncated, ok := data["truncated"].(bool); ok && truncated {
+		sb.WriteString("\n---\n[truncated: block limit reached]\n")
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// writeBlocksMarkdown renders blocks at one nesting level. Children fetched by
+// get_blocks are rendered under their parent, indented by two spaces per level.
+func writeBlocksMarkdown(sb *strings.Builder, results []any, indent string) {
 	var listStack []string // Track nested list types
 
 	for _, item := range results {
@@ -33,20 +51,37 @@ func BlocksToMarkdown(jsonStr string) string {
 		}
 
 		blockType := getString(block, "type")
+		children, _ := block["children"].([]any)
+		if blockType == "toggle" && len(children) > 0 {
+			content, _ := block["toggle"].(map[string]any)
+			sb.WriteString(indentLines(fmt.Sprintf("<details>\n<summary>%s</summary>\n\n", extractRichTextMD(content)), indent))
+			writeBlocksMarkdown(sb, children, indent)
+			sb.WriteString(indent + "</details>\n\n")
+			continue
+		}
+
 		md := blockToMarkdown(block, blockType, &listStack)
 		if md != "" {
-			sb.WriteString(md)
+			sb.WriteString(indentLines(md, indent))
+		}
+		if len(children) > 0 {
+			writeBlocksMarkdown(sb, children, indent+"  ")
 		}
 	}
+}
 
-	// Add pagination info if has_more
-	if hasMore, ok := data["has_more"].(bool); ok && hasMore {
-		if cursor, ok := data["next_cursor"].(string); ok {
-			sb.WriteString(fmt.Sprintf("\n---\n[more: %s]\n", cursor))
+// indentLines prefixes every non-empty line of md with indent
+func indentLines(md, indent string) string {
+	if indent == "" {
+		return md
+	}
+	lines := strings.Split(md, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = indent + line
 		}
 	}
-
-	return strings.TrimSuffix(sb.String(), "\n")
+	return strings.Join(lines, "\n")
 }
 
 // blockToMarkdown converts a single block to Markdown
@@ -32,7 +32,7 @@ func formatMDResult(toolName, jsonStr string) string {
 	switch toolName {
 	case "get_page":
 		return pageToMD(jsonStr)
-	case "get_page_content":
+	case "get_page_content", "get_blocks":
 		return BlocksToMarkdown(jsonStr)
 	case "list_comments":
 		return commentsToMD(jsonStr)
@@ -136,6 +136,12 @@ func commentsToMD(jsonStr string) string {
 			sb.WriteString("\n\n")
 		}
 		sb.WriteString(text + "\n")
+		if discussionID := getString(comment, "discussion_id"); discussionID != "" {
+			sb.WriteString(fmt.Sprintf("\n*discussion_id: %s*\n", discussionID))
+		}
+	}
+	if cursor, ok := data["next_cursor"].(string); ok {
+		sb.WriteString(fmt.Sprintf("\n# next_cursor=%s\n", cursor))
 	}
 
 	return strings.TrimSuffix(sb.String(), "\n")
@@ -151,7 +157,7 @@ func formatCSVResult(toolName, jsonStr string) string {
 		return queryDatabaseToCSV(jsonStr)
 	case "search":
 		return searchToCSV(jsonStr)
-	case "get_database":
+	case "get_database", "create_database":
 		return databaseSchemaToCSV(jsonStr)
 	case "list_users":
 		return usersToCSV(jsonStr)
@@ -594,16 +600,18 @@ func formatCompact(toolName, jsonStr string) string {
 	switch toolName {
 	case "get_page":
 		return formatResult(toolName, "md", jsonStr)
-	case "get_page_content":
+	case "get_page_content", "get_blocks":
 		return formatResult(toolName, "md", jsonStr)
 	case "list_comments":
 		return formatResult(toolName, "md", jsonStr)
-	case "search", "query_database", "get_database", "list_users", "get_user", "get_bot_user":
+	case "search", "query_database", "get_database", "create_database", "list_users", "get_user", "get_bot_user":
 		return formatResult(toolName, "csv", jsonStr)
 	case "create_page", "update_page", "delete_block", "add_comment":
 		return pickKeys(jsonStr, "id", "url")
 	case "append_blocks":
 		return compactBlockCount(jsonStr)
+	case "upload_file":
+		return pickKeys(jsonStr, "id", "filename", "content_type", "status")
 	default:
 		return jsonStr
 	}
@@ -0,0 +1,81 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"time"
+
+	"mcpist/server/internal/modules"
+)
+
+// =============================================================================
+// Module-local HTTP helpers for endpoints that cannot be modeled by ogen:
+//   - upload_file (multipart/form-data POST to /file_uploads/{id}/send)
+// =============================================================================
+
+// fileUpload is the file upload object returned by /file_uploads/{id}/send
+type fileUpload struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	Filename      string `json:"filename"`
+	ContentType   string `json:"content_type"`
+	ContentLength int64  `json:"content_length"`
+	ExpiryTime    string `json:"expiry_time,omitempty"`
+}
+
+// doSendFileUpload sends the file contents of a pending single-part upload to sendURL.
+func doSendFileUpload(ctx context.Context, token, sendURL, filename, contentType string, content []byte) (*fileUpload, error) {
+	modules.LogTrace(ctx, "notion", "upstream_call", map[string]any{"path": "/file_uploads/{id}/send"})
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, filename))
+	header.Set("Content-Type", contentType)
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", sendURL, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Notion-Version", notionVersion)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        fmt.Errorf("upload failed: %s", msg),
+		}
+	}
+
+	var uploaded fileUpload
+	if err := json.NewDecoder(resp.Body).Decode(&uploaded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &uploaded, nil
+}
@@ -61,6 +61,7 @@ func (m *NotionModule) ExecuteTool(ctx context.Context, name string, params map[
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -70,6 +71,25 @@ func (m *NotionModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *NotionModule) HealthProbe() (string, map[string]any) {
+	return "get_bot_user", nil
+}
+
+// cursorPagination is how Notion list endpoints page
+var cursorPagination = modules.Pagination{Items: "results", NextCursor: "next_cursor", HasMore: "has_more", CursorParam: "start_cursor", PageSizeParam: "page_size", MaxPageSize: 100}
+
+// Pagination returns how a list tool pages
+// Implements modules.Paginator interface
+func (m *NotionModule) Pagination(toolName string) (modules.Pagination, bool) {
+	switch toolName {
+	case "search", "query_database", "list_comments":
+		return cursorPagination, true
+	}
+	return modules.Pagination{}, false
+}
+
 // Resources returns all available resources
 func (m *NotionModule) Resources() []modules.Resource {
 	return nil
@@ -102,6 +122,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 
 // newOgenClient creates a new ogen-generated Notion API client
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "notion", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
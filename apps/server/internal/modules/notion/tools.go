@@ -2,12 +2,17 @@ package notion
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"mime"
+	"path"
+	"strings"
 
 	"github.com/go-faster/jx"
 
 	"mcpist/server/internal/modules"
+	"mcpist/server/pkg/notionapi"
 	gen "mcpist/server/pkg/notionapi/gen"
 )
 
@@ -40,6 +45,10 @@ func toolDefinitions() []modules.Tool {
 						Type:        "number",
 						Description: "Number of results (1-100, default 10)",
 					},
+					"start_cursor": {
+						Type:        "string",
+						Description: "Cursor from a previous response's next_cursor",
+					},
 				},
 			},
 		},
@@ -90,6 +99,33 @@ func toolDefinitions() []modules.Tool {
 				Required: []string{"page_id"},
 			},
 		},
+		{
+			ID:   "notion:get_blocks",
+			Name: "get_blocks",
+			Descriptions: modules.LocalizedText{
+				"en-US": "Get all child blocks of a page or block. With recursive, nested blocks (toggles, list items, columns, etc.) are fetched breadth-first up to max_depth and returned under their parent's children field.",
+				"ja-JP": "ページまたはブロックのすべての子ブロックを取得します。recursiveを指定すると、ネストされたブロック（トグル、リスト項目、カラムなど）をmax_depthまで幅優先で取得し、親のchildrenフィールドに格納して返します。",
+			},
+			Annotations: modules.AnnotateReadOnly,
+			InputSchema: modules.InputSchema{
+				Type: "object",
+				Properties: map[string]modules.Property{
+					"block_id": {
+						Type:        "string",
+						Description: "Page or block ID (UUID format)",
+					},
+					"recursive": {
+						Type:        "boolean",
+						Description: "Fetch nested children (default false)",
+					},
+					"max_depth": {
+						Type:        "number",
+						Description: "Maximum nesting depth to fetch when recursive, where 1 is the direct children only (1-10, default 3)",
+					},
+				},
+				Required: []string{"block_id"},
+			},
+		},
 		{
 			ID:   "notion:create_page",
 			Name: "create_page",
@@ -125,8 +161,8 @@ func toolDefinitions() []modules.Tool {
 			ID:   "notion:update_page",
 			Name: "update_page",
 			Descriptions: modules.LocalizedText{
-				"en-US": "Update a Notion page's properties. Use append_blocks to modify block content.",
-				"ja-JP": "Notionページのプロパティを更新します。ブロックコンテンツの変更にはappend_blocksを使用してください。",
+				"en-US": "Update a Notion page's properties (e.g. a database row's status or fields). Use append_blocks to modify block content.",
+				"ja-JP": "Notionページのプロパティ（データベース行のステータスやフィールドなど）を更新します。ブロックコンテンツの変更にはappend_blocksを使用してください。",
 			},
 			Annotations: modules.AnnotateUpdate,
 			InputSchema: modules.InputSchema{
@@ -138,7 +174,7 @@ func toolDefinitions() []modules.Tool {
 					},
 					"properties": {
 						Type:        "object",
-						Description: "Properties to update. Keys are property names, values follow Notion property value format.",
+						Description: "Properties to update, keyed by property name. Values may be plain values converted by the property's type: title/rich_text (string), select/status (option name), multi_select (array of names), date (ISO 8601 string or {start, end}), checkbox (boolean), number, relation (array of page IDs), files (array of file upload IDs from upload_file or external URLs). Notion property value objects (e.g. {\"select\": {\"name\": \"Done\"}}) are passed through as-is.",
 					},
 				},
 				Required: []string{"page_id", "properties"},
@@ -164,6 +200,33 @@ func toolDefinitions() []modules.Tool {
 				Required: []string{"database_id"},
 			},
 		},
+		{
+			ID:   "notion:create_database",
+			Name: "create_database",
+			Descriptions: modules.LocalizedText{
+				"en-US": "Create a Notion database as a child of a page. A title column named \"Name\" is added unless properties defines a title property.",
+				"ja-JP": "ページの子としてNotionデータベースを作成します。propertiesにtitle型のプロパティがない場合は\"Name\"という名前のタイトル列が追加されます。",
+			},
+			Annotations: modules.AnnotateCreate,
+			InputSchema: modules.InputSchema{
+				Type: "object",
+				Properties: map[string]modules.Property{
+					"parent_page_id": {
+						Type:        "string",
+						Description: "Create the database inside this page",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Database title",
+					},
+					"properties": {
+						Type:        "object",
+						Description: "Columns keyed by property name. Values are a type name (title, rich_text, number, select, multi_select, date, people, files, checkbox, url, email, phone_number, created_time, created_by, last_edited_time, last_edited_by) or an object like {\"type\": \"select\", \"options\": [\"Todo\", \"Done\"]} or {\"type\": \"number\", \"format\": \"dollar\"}. Notion property schema objects (e.g. {\"select\": {\"options\": [...]}}) are passed through as-is.",
+					},
+				},
+				Required: []string{"parent_page_id", "title"},
+			},
+		},
 		{
 			ID:   "notion:query_database",
 			Name: "query_database",
@@ -191,6 +254,10 @@ func toolDefinitions() []modules.Tool {
 						Type:        "number",
 						Description: "Number of rows (1-100, default 10)",
 					},
+					"start_cursor": {
+						Type:        "string",
+						Description: "Cursor from a previous response's next_cursor",
+					},
 				},
 				Required: []string{"database_id"},
 			},
@@ -213,7 +280,7 @@ func toolDefinitions() []modules.Tool {
 					},
 					"blocks": {
 						Type:        "array",
-						Description: "Simplified block array. Each element: {\"type\":\"<block_type>\",\"content\":\"<text>\"}. Supported types: paragraph, heading_1, heading_2, heading_3, bulleted_list_item, numbered_list_item, to_do, toggle, code, quote, callout, divider.",
+						Description: "Simplified block array. Each element: {\"type\":\"<block_type>\",\"content\":\"<text>\"}. Supported types: paragraph, heading_1, heading_2, heading_3, bulleted_list_item, numbered_list_item, to_do, toggle, code, quote, callout, divider. Media types image, file, pdf, video and audio take \"file_upload_id\" (from upload_file) or \"url\" instead, with content as the caption.",
 					},
 				},
 				Required: []string{"block_id", "blocks"},
@@ -238,6 +305,34 @@ func toolDefinitions() []modules.Tool {
 				Required: []string{"block_id"},
 			},
 		},
+		// Files
+		{
+			ID:   "notion:upload_file",
+			Name: "upload_file",
+			Descriptions: modules.LocalizedText{
+				"en-US": "Upload a file (max 20 MB) to Notion. Returns a file upload ID to attach within an hour: as an image/file/pdf/video/audio block via append_blocks (file_upload_id), or in a files property via update_page.",
+				"ja-JP": "ファイル（最大20MB）をNotionにアップロードします。返されるファイルアップロードIDを1時間以内に添付してください：append_blocksのimage/file/pdf/video/audioブロック（file_upload_id）、またはupdate_pageのfilesプロパティで使用できます。",
+			},
+			Annotations: modules.AnnotateCreate,
+			InputSchema: modules.InputSchema{
+				Type: "object",
+				Properties: map[string]modules.Property{
+					"filename": {
+						Type:        "string",
+						Description: "File name including extension (e.g., 'report.pdf')",
+					},
+					"content_base64": {
+						Type:        "string",
+						Description: "File content, base64-encoded",
+					},
+					"content_type": {
+						Type:        "string",
+						Description: "MIME type (default: guessed from the filename extension)",
+					},
+				},
+				Required: []string{"filename", "content_base64"},
+			},
+		},
 		// Comments
 		{
 			ID:   "notion:list_comments",
@@ -258,6 +353,10 @@ func toolDefinitions() []modules.Tool {
 						Type:        "number",
 						Description: "Number of comments (1-100, default 50)",
 					},
+					"start_cursor": {
+						Type:        "string",
+						Description: "Cursor from a previous response's next_cursor",
+					},
 				},
 				Required: []string{"block_id"},
 			},
@@ -266,8 +365,8 @@ func toolDefinitions() []modules.Tool {
 			ID:   "notion:add_comment",
 			Name: "add_comment",
 			Descriptions: modules.LocalizedText{
-				"en-US": "Add a text comment to a Notion page.",
-				"ja-JP": "Notionページにテキストコメントを追加します。",
+				"en-US": "Add a comment to a Notion page or block, or reply to an existing discussion. Give exactly one of page_id, block_id or discussion_id, and content or rich_text.",
+				"ja-JP": "Notionページまたはブロックにコメントを追加するか、既存のディスカッションに返信します。page_id、block_id、discussion_idのいずれか1つと、contentまたはrich_textを指定してください。",
 			},
 			Annotations: modules.AnnotateCreate,
 			InputSchema: modules.InputSchema{
@@ -277,12 +376,24 @@ func toolDefinitions() []modules.Tool {
 						Type:        "string",
 						Description: "Page ID to comment on (UUID format)",
 					},
+					"block_id": {
+						Type:        "string",
+						Description: "Block ID to comment on (UUID format)",
+					},
+					"discussion_id": {
+						Type:        "string",
+						Description: "Discussion ID to reply to (from list_comments)",
+					},
 					"content": {
 						Type:        "string",
 						Description: "Comment text",
 					},
+					"rich_text": {
+						Type:        "array",
+						Description: "Notion rich text array, used instead of content for formatting, links or mentions. e.g. [{\"type\":\"text\",\"text\":{\"content\":\"Please review \"}},{\"type\":\"mention\",\"mention\":{\"user\":{\"id\":\"<user_id>\"}}}]",
+						Items:       &modules.Property{Type: "object"},
+					},
 				},
-				Required: []string{"page_id", "content"},
 			},
 		},
 		// Users
@@ -344,12 +455,15 @@ var toolHandlers = map[string]func(ctx context.Context, params map[string]any) (
 	"search":           search,
 	"get_page":         getPage,
 	"get_page_content": getPageContent,
+	"get_blocks":       getBlocks,
 	"create_page":      createPage,
 	"update_page":      updatePage,
 	"get_database":     getDatabase,
+	"create_database":  createDatabase,
 	"query_database":   queryDatabase,
 	"append_blocks":    appendBlocks,
 	"delete_block":     deleteBlock,
+	"upload_file":      uploadFile,
 	"list_comments":    listComments,
 	"add_comment":      addComment,
 	"list_users":       listUsers,
@@ -374,14 +488,11 @@ func search(ctx context.Context, params map[string]any) (string, error) {
 	if filterType, ok := params["filter_type"].(string); ok && filterType != "" {
 		req.Filter = jx.Raw(fmt.Sprintf(`{"property":"object","value":"%s"}`, filterType))
 	}
-	pageSize := 10
-	if ps, ok := params["page_size"].(float64); ok {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 10, 100)
 	req.PageSize.SetTo(pageSize)
+	if cursor, ok := params["start_cursor"].(string); ok && cursor != "" {
+		req.StartCursor.SetTo(cursor)
+	}
 
 	res, err := c.Search(ctx, &req)
 	if err != nil {
@@ -422,13 +533,7 @@ func getPageContent(ctx context.Context, params map[string]any) (string, error)
 	}
 	pageID, _ := params["page_id"].(string)
 
-	pageSize := 100
-	if ps, ok := params["page_size"].(float64); ok {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 100, 100)
 
 	fetchAll := false
 	if fa, ok := params["fetch_all"].(bool); ok {
@@ -451,11 +556,32 @@ func getPageContent(ctx context.Context, params map[string]any) (string, error)
 	}
 
 	// Fetch all mode - loop until has_more is false
+	allResults, err := fetchAllBlockChildren(ctx, c, pageID, pageSize)
+	if err != nil {
+		return "", err
+	}
+
+	// Build combined response
+	result := map[string]any{
+		"object":   "list",
+		"results":  allResults,
+		"has_more": false,
+	}
+	jsonStr, err := toJSON(result)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
+// fetchAllBlockChildren returns every child block of blockID, following
+// pagination until has_more is false
+func fetchAllBlockChildren(ctx context.Context, c *gen.Client, blockID string, pageSize int) ([]json.RawMessage, error) {
 	var allResults []json.RawMessage
 	nextCursor := ""
 
 	for {
-		p := gen.GetBlockChildrenParams{BlockID: pageID}
+		p := gen.GetBlockChildrenParams{BlockID: blockID}
 		p.PageSize.SetTo(pageSize)
 		if nextCursor != "" {
 			p.StartCursor.SetTo(nextCursor)
@@ -463,13 +589,13 @@ func getPageContent(ctx context.Context, params map[string]any) (string, error)
 
 		res, err := c.GetBlockChildren(ctx, p)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		// Extract results from PaginatedList
 		resJSON, err := json.Marshal(res)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		var parsed struct {
 			Results    []json.RawMessage `json:"results"`
@@ -481,17 +607,81 @@ func getPageContent(ctx context.Context, params map[string]any) (string, error)
 		allResults = append(allResults, parsed.Results...)
 
 		if !parsed.HasMore || parsed.NextCursor == nil {
-			break
+			return allResults, nil
 		}
 		nextCursor = *parsed.NextCursor
 	}
+}
+
+// maxBlockFetches bounds the number of blocks get_blocks expands, so a huge
+// page cannot turn into an unbounded number of API calls
+const maxBlockFetches = 200
+
+func getBlocks(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	blockID, _ := params["block_id"].(string)
+	recursive, _ := params["recursive"].(bool)
+	maxDepth := 3
+	if md, ok := params["max_depth"].(float64); ok && md >= 1 {
+		maxDepth = min(int(md), 10)
+	}
+	if !recursive {
+		maxDepth = 1
+	}
+
+	type pending struct {
+		block map[string]any // nil for the root
+		id    string
+		depth int
+	}
+	var root []map[string]any
+	queue := []pending{{id: blockID, depth: 1}}
+	fetches, truncated := 0, false
+
+	// Breadth-first: each level is fetched before any of its grandchildren
+	for len(queue) > 0 {
+		item := queue[0]
+		queue = queue[1:]
+		if fetches >= maxBlockFetches {
+			truncated = true
+			break
+		}
+		fetches++
+
+		raw, err := fetchAllBlockChildren(ctx, c, item.id, 100)
+		if err != nil {
+			return "", err
+		}
+		children := make([]map[string]any, 0, len(raw))
+		for _, r := range raw {
+			var child map[string]any
+			if err := json.Unmarshal(r, &child); err != nil {
+				continue
+			}
+			children = append(children, child)
+			if hasChildren, _ := child["has_children"].(bool); hasChildren && item.depth < maxDepth {
+				id, _ := child["id"].(string)
+				queue = append(queue, pending{block: child, id: id, depth: item.depth + 1})
+			}
+		}
+		if item.block == nil {
+			root = children
+		} else {
+			item.block["children"] = children
+		}
+	}
 
-	// Build combined response
 	result := map[string]any{
 		"object":   "list",
-		"results":  allResults,
+		"results":  root,
 		"has_more": false,
 	}
+	if truncated {
+		result["truncated"] = true
+	}
 	jsonStr, err := toJSON(result)
 	if err != nil {
 		return "", err
@@ -565,7 +755,40 @@ func updatePage(ctx context.Context, params map[string]any) (string, error) {
 		return "", err
 	}
 	pageID, _ := params["page_id"].(string)
-	properties, _ := params["properties"].(map[string]any)
+	input, _ := params["properties"].(map[string]any)
+
+	// Plain values need the page's property types to be converted
+	var types map[string]string
+	for _, v := range input {
+		if !isPropertyValueObject(v) {
+			page, err := c.GetPage(ctx, gen.GetPageParams{PageID: pageID})
+			if err != nil {
+				return "", err
+			}
+			types, err = pagePropertyTypes(page.Properties)
+			if err != nil {
+				return "", err
+			}
+			break
+		}
+	}
+
+	properties := make(map[string]any, len(input))
+	for name, v := range input {
+		if isPropertyValueObject(v) {
+			properties[name] = v
+			continue
+		}
+		propType, ok := types[name]
+		if !ok {
+			return "", fmt.Errorf("unknown property: %s", name)
+		}
+		value, err := buildPropertyValue(propType, v)
+		if err != nil {
+			return "", fmt.Errorf("property %s: %w", name, err)
+		}
+		properties[name] = value
+	}
 
 	body := map[string]any{"properties": properties}
 	bodyJSON, _ := json.Marshal(body)
@@ -583,6 +806,149 @@ func updatePage(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+// propertyValueKeys are the type keys of Notion property value objects
+var propertyValueKeys = []string{
+	"title", "rich_text", "select", "multi_select", "status", "date", "checkbox",
+	"number", "relation", "people", "url", "email", "phone_number", "files",
+}
+
+// isPropertyValueObject reports whether v is already in Notion property value format
+func isPropertyValueObject(v any) bool {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+	for _, key := range propertyValueKeys {
+		if _, ok := obj[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// pagePropertyTypes maps each property name of a page to its Notion type
+func pagePropertyTypes(raw jx.Raw) (map[string]string, error) {
+	var props map[string]struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &props); err != nil {
+		return nil, fmt.Errorf("failed to parse page properties: %w", err)
+	}
+	types := make(map[string]string, len(props))
+	for name, p := range props {
+		types[name] = p.Type
+	}
+	return types, nil
+}
+
+// buildPropertyValue converts a plain value into the Notion property value for propType
+func buildPropertyValue(propType string, v any) (map[string]any, error) {
+	switch propType {
+	case "title", "rich_text":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s expects a string", propType)
+		}
+		return map[string]any{propType: []map[string]any{
+			{"text": map[string]any{"content": s}},
+		}}, nil
+	case "select", "status":
+		if v == nil {
+			return map[string]any{propType: nil}, nil
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s expects an option name", propType)
+		}
+		return map[string]any{propType: map[string]any{"name": s}}, nil
+	case "multi_select":
+		names, ok := stringList(v)
+		if !ok {
+			return nil, fmt.Errorf("multi_select expects an array of option names")
+		}
+		options := make([]map[string]any, 0, len(names))
+		for _, n := range names {
+			options = append(options, map[string]any{"name": n})
+		}
+		return map[string]any{"multi_select": options}, nil
+	case "date":
+		switch d := v.(type) {
+		case nil:
+			return map[string]any{"date": nil}, nil
+		case string:
+			return map[string]any{"date": map[string]any{"start": d}}, nil
+		case map[string]any:
+			if _, ok := d["start"].(string); !ok {
+				return nil, fmt.Errorf("date expects start")
+			}
+			return map[string]any{"date": d}, nil
+		}
+		return nil, fmt.Errorf("date expects an ISO 8601 string or {start, end}")
+	case "checkbox":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("checkbox expects a boolean")
+		}
+		return map[string]any{"checkbox": b}, nil
+	case "number":
+		if v == nil {
+			return map[string]any{"number": nil}, nil
+		}
+		n, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("number expects a number")
+		}
+		return map[string]any{"number": n}, nil
+	case "relation":
+		ids, ok := stringList(v)
+		if !ok {
+			return nil, fmt.Errorf("relation expects an array of page IDs")
+		}
+		pages := make([]map[string]any, 0, len(ids))
+		for _, id := range ids {
+			pages = append(pages, map[string]any{"id": id})
+		}
+		return map[string]any{"relation": pages}, nil
+	case "files":
+		refs, ok := stringList(v)
+		if !ok {
+			return nil, fmt.Errorf("files expects an array of file upload IDs or URLs")
+		}
+		files := make([]map[string]any, 0, len(refs))
+		for _, ref := range refs {
+			var file map[string]any
+			if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+				file = fileObject(map[string]any{"url": ref})
+				file["name"] = path.Base(ref)
+			} else {
+				file = fileObject(map[string]any{"file_upload_id": ref})
+			}
+			files = append(files, file)
+		}
+		return map[string]any{"files": files}, nil
+	}
+	return nil, fmt.Errorf("type %s requires a Notion property value object", propType)
+}
+
+// stringList accepts a single string or an array of strings
+func stringList(v any) ([]string, bool) {
+	switch x := v.(type) {
+	case string:
+		return []string{x}, true
+	case []any:
+		out := make([]string, 0, len(x))
+		for _, item := range x {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			out = append(out, s)
+		}
+		return out, true
+	}
+	return nil, false
+}
+
 // =============================================================================
 // Databases
 // =============================================================================
@@ -604,6 +970,110 @@ func getDatabase(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+func createDatabase(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	parentPageID, _ := params["parent_page_id"].(string)
+	title, _ := params["title"].(string)
+	input, _ := params["properties"].(map[string]any)
+
+	properties := make(map[string]any, len(input)+1)
+	hasTitle := false
+	for name, def := range input {
+		schema, err := databasePropertySchema(name, def)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := schema["title"]; ok {
+			hasTitle = true
+		}
+		properties[name] = schema
+	}
+	// Notion requires exactly one title property
+	if !hasTitle {
+		if _, taken := properties["Name"]; taken {
+			return "", fmt.Errorf("properties must include a title property when \"Name\" is used for another type")
+		}
+		properties["Name"] = map[string]any{"title": map[string]any{}}
+	}
+
+	body := map[string]any{
+		"parent": map[string]any{"type": "page_id", "page_id": parentPageID},
+		"title": []map[string]any{
+			{"type": "text", "text": map[string]any{"content": title}},
+		},
+		"properties": properties,
+	}
+	bodyJSON, _ := json.Marshal(body)
+	var req gen.CreateDatabaseRequest
+	json.Unmarshal(bodyJSON, &req)
+
+	res, err := c.CreateDatabase(ctx, &req)
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
+// databasePropertyTypes are the property types create_database can define.
+// status is missing because the Notion API cannot create status properties.
+var databasePropertyTypes = map[string]bool{
+	"title": true, "rich_text": true, "number": true, "select": true, "multi_select": true,
+	"date": true, "people": true, "files": true, "checkbox": true, "url": true, "email": true,
+	"phone_number": true, "created_time": true, "created_by": true, "last_edited_time": true,
+	"last_edited_by": true,
+}
+
+// databasePropertySchema converts a create_database column definition — a type
+// name or {"type", "options", "format"} — into a Notion property schema object.
+// Objects without a "type" key are assumed to be Notion schema already.
+func databasePropertySchema(name string, def any) (map[string]any, error) {
+	var propType string
+	var obj map[string]any
+	switch v := def.(type) {
+	case string:
+		propType = v
+	case map[string]any:
+		t, ok := v["type"].(string)
+		if !ok {
+			return v, nil
+		}
+		propType, obj = t, v
+	default:
+		return nil, fmt.Errorf("property %q: expected a type name or object", name)
+	}
+	if !databasePropertyTypes[propType] {
+		return nil, fmt.Errorf("property %q: unsupported type %q", name, propType)
+	}
+
+	config := map[string]any{}
+	switch propType {
+	case "select", "multi_select":
+		raw, _ := obj["options"].([]any)
+		options := make([]map[string]any, 0, len(raw))
+		for _, o := range raw {
+			switch ov := o.(type) {
+			case string:
+				options = append(options, map[string]any{"name": ov})
+			case map[string]any:
+				options = append(options, ov)
+			}
+		}
+		config["options"] = options
+	case "number":
+		if format, ok := obj["format"].(string); ok && format != "" {
+			config["format"] = format
+		}
+	}
+	return map[string]any{propType: config}, nil
+}
+
 func queryDatabase(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -618,14 +1088,11 @@ func queryDatabase(ctx context.Context, params map[string]any) (string, error) {
 	if sorts, ok := params["sorts"].([]any); ok {
 		body["sorts"] = sorts
 	}
-	pageSize := 10
-	if ps, ok := params["page_size"].(float64); ok {
-		pageSize = int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-	}
+	pageSize := modules.PageSize(params, "page_size", 10, 100)
 	body["page_size"] = pageSize
+	if cursor, ok := params["start_cursor"].(string); ok && cursor != "" {
+		body["start_cursor"] = cursor
+	}
 
 	bodyJSON, _ := json.Marshal(body)
 	var req gen.QueryDatabaseRequest
@@ -726,6 +1193,12 @@ func buildBlockChildren(blocksInput []any) []map[string]any {
 			notionBlock = map[string]any{"object": "block", "type": "callout", "callout": map[string]any{"rich_text": richText}}
 		case "divider":
 			notionBlock = map[string]any{"object": "block", "type": "divider", "divider": map[string]any{}}
+		case "image", "file", "pdf", "video", "audio":
+			media := fileObject(block)
+			if len(richText) > 0 {
+				media["caption"] = richText
+			}
+			notionBlock = map[string]any{"object": "block", "type": blockType, blockType: media}
 		default:
 			notionBlock = map[string]any{"object": "block", "type": "paragraph", "paragraph": map[string]any{"rich_text": richText}}
 		}
@@ -735,6 +1208,16 @@ func buildBlockChildren(blocksInput []any) []map[string]any {
 	return children
 }
 
+// fileObject builds the file object of a media block or files property value:
+// an uploaded file when file_upload_id is set, otherwise an external URL.
+func fileObject(input map[string]any) map[string]any {
+	if id, _ := input["file_upload_id"].(string); id != "" {
+		return map[string]any{"type": "file_upload", "file_upload": map[string]any{"id": id}}
+	}
+	url, _ := input["url"].(string)
+	return map[string]any{"type": "external", "external": map[string]any{"url": url}}
+}
+
 func deleteBlock(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -752,6 +1235,57 @@ func deleteBlock(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+// =============================================================================
+// Files
+// =============================================================================
+
+// maxSinglePartUpload is Notion's size limit for single-part file uploads
+const maxSinglePartUpload = 20 << 20
+
+func uploadFile(ctx context.Context, params map[string]any) (string, error) {
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+	filename, _ := params["filename"].(string)
+	encoded, _ := params["content_base64"].(string)
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("content_base64 is not valid base64: %w", err)
+	}
+	if len(content) > maxSinglePartUpload {
+		return "", fmt.Errorf("file is %d bytes; at most %d bytes can be uploaded", len(content), maxSinglePartUpload)
+	}
+	contentType, _ := params["content_type"].(string)
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(filename))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	c, err := notionapi.NewClient(creds.AccessToken, notionVersion)
+	if err != nil {
+		return "", err
+	}
+	created, err := c.CreateFileUpload(ctx, &gen.CreateFileUploadRequest{
+		Mode:        gen.NewOptString("single_part"),
+		Filename:    gen.NewOptString(filename),
+		ContentType: gen.NewOptString(contentType),
+	})
+	if err != nil {
+		return "", err
+	}
+	if created.UploadURL.Value == "" {
+		return "", fmt.Errorf("Notion did not return an upload URL for file upload %s", created.ID.Value)
+	}
+	uploaded, err := doSendFileUpload(ctx, creds.AccessToken, created.UploadURL.Value, filename, contentType, content)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(uploaded)
+}
+
 // =============================================================================
 // Comments
 // =============================================================================
@@ -763,14 +1297,9 @@ func listComments(ctx context.Context, params map[string]any) (string, error) {
 	}
 	blockID, _ := params["block_id"].(string)
 	p := gen.ListCommentsParams{BlockID: blockID}
-	if ps, ok := params["page_size"].(float64); ok {
-		pageSize := int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-		p.PageSize.SetTo(pageSize)
-	} else {
-		p.PageSize.SetTo(50)
+	p.PageSize.SetTo(modules.PageSize(params, "page_size", 50, 100))
+	if cursor, ok := params["start_cursor"].(string); ok && cursor != "" {
+		p.StartCursor.SetTo(cursor)
 	}
 
 	res, err := c.ListComments(ctx, p)
@@ -785,18 +1314,37 @@ func listComments(ctx context.Context, params map[string]any) (string, error) {
 }
 
 func addComment(ctx context.Context, params map[string]any) (string, error) {
-	c, err := newOgenClient(ctx)
-	if err != nil {
-		return "", err
+	body := map[string]any{}
+	var targets []string
+	for _, key := range []string{"page_id", "block_id", "discussion_id"} {
+		id, _ := params[key].(string)
+		if id == "" {
+			continue
+		}
+		targets = append(targets, key)
+		if key == "discussion_id" {
+			body["discussion_id"] = id
+		} else {
+			body["parent"] = map[string]any{key: id}
+		}
+	}
+	if len(targets) != 1 {
+		return "", fmt.Errorf("give exactly one of page_id, block_id or discussion_id")
 	}
-	pageID, _ := params["page_id"].(string)
-	content, _ := params["content"].(string)
 
-	body := map[string]any{
-		"parent": map[string]any{"page_id": pageID},
-		"rich_text": []map[string]any{
+	if richText, ok := params["rich_text"].([]any); ok && len(richText) > 0 {
+		body["rich_text"] = richText
+	} else if content, _ := params["content"].(string); content != "" {
+		body["rich_text"] = []map[string]any{
 			{"text": map[string]any{"content": content}},
-		},
+		}
+	} else {
+		return "", fmt.Errorf("content or rich_text is required")
+	}
+
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
 	}
 	bodyJSON, _ := json.Marshal(body)
 	var req gen.AddCommentRequest
@@ -823,15 +1371,7 @@ func listUsers(ctx context.Context, params map[string]any) (string, error) {
 		return "", err
 	}
 	p := gen.ListUsersParams{}
-	if ps, ok := params["page_size"].(float64); ok {
-		pageSize := int(ps)
-		if pageSize > 100 {
-			pageSize = 100
-		}
-		p.PageSize.SetTo(pageSize)
-	} else {
-		p.PageSize.SetTo(50)
-	}
+	p.PageSize.SetTo(modules.PageSize(params, "page_size", 50, 100))
 
 	res, err := c.ListUsers(ctx, p)
 	if err != nil {
@@ -99,9 +99,16 @@ func (m *PostgreSQLModule) ExecuteTool(ctx context.Context, name string, params
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *PostgreSQLModule) HealthProbe() (string, map[string]any) {
+	return "test_connection", nil
+}
+
 // Resources returns all available resources (none for PostgreSQL)
 func (m *PostgreSQLModule) Resources() []modules.Resource {
 	return nil
@@ -313,8 +320,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "postgresql:query",
 		Name: "query",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Execute a SELECT query. Supports parameterized queries with $1, $2, etc.",
-			"ja-JP": "SELECT クエリを実行します。$1, $2 などのパラメータ化クエリをサポートします。",
+			"en-US": "Execute a SELECT query. Supports parameterized queries with $1, $2, etc. Results are capped at max_rows; when truncated is true, call again with offset: next_offset for the next chunk (add ORDER BY for a stable order).",
+			"ja-JP": "SELECT クエリを実行します。$1, $2 などのパラメータ化クエリをサポートします。結果はmax_rowsで打ち切られます。truncatedがtrueの場合はoffsetにnext_offsetを指定して再度呼び出すと続きを取得できます（安定した順序のためORDER BYを付けてください）。",
 		},
 		Annotations: modules.AnnotateReadOnly,
 		InputSchema: modules.InputSchema{
@@ -323,6 +330,7 @@ var toolDefinitions = []modules.Tool{
 				"sql":      {Type: "string", Description: "SELECT query to execute"},
 				"params":   {Type: "array", Description: "Query parameters for $1, $2, etc.", Items: &modules.Property{Type: "string"}},
 				"max_rows": {Type: "integer", Description: "Maximum rows to return. Default: 1000, Max: 10000"},
+				"offset":   {Type: "integer", Description: "Rows to skip before returning results. Pass next_offset from a truncated result to fetch the next chunk"},
 			},
 			Required: []string{"sql"},
 		},
@@ -708,6 +716,11 @@ func queryTool(ctx context.Context, params map[string]any) (string, error) {
 		}
 	}
 
+	offset := 0
+	if v, ok := params["offset"].(float64); ok && v > 0 {
+		offset = int(v)
+	}
+
 	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
 	defer cancel()
 
@@ -724,12 +737,18 @@ func queryTool(ctx context.Context, params map[string]any) (string, error) {
 		columnNames[i] = string(fd.Name)
 	}
 
-	// Fetch rows
+	// Fetch rows; rows before offset are read and discarded so any SELECT
+	// can be paged without rewriting it
 	var resultRows [][]interface{}
 	rowCount := 0
 	truncated := false
+	skipped := 0
 
 	for rows.Next() {
+		if skipped < offset {
+			skipped++
+			continue
+		}
 		if rowCount >= maxRows {
 			truncated = true
 			break
@@ -749,6 +768,9 @@ func queryTool(ctx context.Context, params map[string]any) (string, error) {
 		"row_count": rowCount,
 		"truncated": truncated,
 	}
+	if truncated {
+		result["next_offset"] = offset + rowCount
+	}
 	jsonBytes, _ := json.Marshal(result)
 	return string(jsonBytes), nil
 }
@@ -0,0 +1,224 @@
+package modules
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Meta-parameters are tool params consumed by the server itself: _max_bytes,
+// _format, _include_meta, _return_diff, _fetch_all and confirmation_token.
+// TakeMetaParams removes them before validation, so they never reach module
+// handlers.
+
+// MaxBytesParam is the meta-parameter that caps the size of a tool result.
+const MaxBytesParam = "_max_bytes"
+
+// FormatParam is the meta-parameter that selects the output shape of a tool result.
+const FormatParam = "_format"
+
+// OutputFormat is the shape a tool result is returned in.
+type OutputFormat string
+
+const (
+	// FormatCompact renders results with the module's CompactConverter (CSV/MD).
+	// Modules without one return their JSON unchanged.
+	FormatCompact OutputFormat = "compact"
+	// FormatJSON returns the full JSON response.
+	FormatJSON OutputFormat = "json"
+)
+
+// defaultMaxResponseBytes returns the server-wide result size cap from
+// MAX_RESPONSE_BYTES. 0 (unset or invalid) disables the guard.
+func defaultMaxResponseBytes() int {
+	n, err := strconv.Atoi(os.Getenv("MAX_RESPONSE_BYTES"))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// TakeMaxBytes removes the _max_bytes meta-parameter from params and returns
+// the effective limit: the parameter if set, otherwise the server default.
+func TakeMaxBytes(params map[string]any) int {
+	v, ok := params[MaxBytesParam]
+	if !ok {
+		return defaultMaxResponseBytes()
+	}
+	delete(params, MaxBytesParam)
+	if n, ok := v.(float64); ok && n > 0 {
+		return int(n)
+	}
+	return defaultMaxResponseBytes()
+}
+
+// TakeFormat removes the _format meta-parameter from params and returns the
+// requested output format. Without _format, the legacy format: "json" param is
+// honored; otherwise the result is compact.
+func TakeFormat(params map[string]any) (OutputFormat, error) {
+	v, ok := params[FormatParam]
+	if !ok {
+		if f, _ := params["format"].(string); f == string(FormatJSON) {
+			return FormatJSON, nil
+		}
+		return FormatCompact, nil
+	}
+	delete(params, FormatParam)
+	switch f, _ := v.(string); OutputFormat(f) {
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatCompact:
+		return FormatCompact, nil
+	}
+	return "", fmt.Errorf("%s must be %q or %q, got %v", FormatParam, FormatJSON, FormatCompact, v)
+}
+
+// MetaParams holds the meta-parameters of one tool call.
+type MetaParams struct {
+	MaxBytes          int
+	Format            OutputFormat
+	IncludeMeta       bool
+	ReturnDiff        bool
+	FetchAll          int // Item cap for _fetch_all; 0 when not requested
+	ConfirmationToken string
+}
+
+// TakeMetaParams removes every meta-parameter from params and returns their
+// values. All of them are removed even when the error reports an invalid _format.
+func TakeMetaParams(params map[string]any) (MetaParams, error) {
+	meta := MetaParams{
+		MaxBytes:          TakeMaxBytes(params),
+		IncludeMeta:       TakeIncludeMeta(params),
+		ReturnDiff:        TakeReturnDiff(params),
+		FetchAll:          TakeFetchAll(params),
+		ConfirmationToken: TakeConfirmationToken(params),
+	}
+	format, err := TakeFormat(params)
+	meta.Format = format
+	return meta, err
+}
+
+// FormatResult renders a successful JSON tool result for the client in the
+// requested format. When maxBytes > 0 and the result is larger, a JSON result
+// falls back to the compact form, and anything still too large is truncated by
+// truncateResult.
+//
+// Empty results of read-only tools that are returned as JSON are normalized by
+// normalizeEmpty, so "no results" always looks the same to the model.
+func FormatResult(moduleName, toolName, jsonResult string, format OutputFormat, maxBytes int) string {
+	text := jsonResult
+	if format != FormatJSON {
+		text = ApplyCompact(moduleName, toolName, jsonResult)
+	}
+	compacted := text != jsonResult
+	if !compacted && ToolAnnotation(moduleName, toolName) == "read_only" {
+		text = normalizeEmpty(moduleName, toolName, text)
+	}
+	if maxBytes <= 0 || len(text) <= maxBytes {
+		return text
+	}
+	if !compacted {
+		text = ApplyCompact(moduleName, toolName, jsonResult)
+		if len(text) <= maxBytes {
+			return text
+		}
+	}
+	return truncateResult(text, maxBytes)
+}
+
+// truncatedResult is returned in place of a result that exceeds the size cap.
+type truncatedResult struct {
+	Truncated bool   `json:"_truncated"`
+	Bytes     int    `json:"_original_bytes"`
+	Hint      string `json:"_hint"`
+	Content   string `json:"content"`
+}
+
+// truncateResult keeps as much of the head of text (cut on a UTF-8 boundary)
+// as fits in maxBytes once wrapped in a JSON envelope marked "_truncated": true.
+func truncateResult(text string, maxBytes int) string {
+	res := truncatedResult{
+		Truncated: true,
+		Bytes:     len(text),
+		Hint:      fmt.Sprintf("Result exceeded %d bytes. Request fewer items with the tool's pagination parameters (e.g. limit, page_size, cursor) or raise %s.", maxBytes, MaxBytesParam),
+	}
+	envelope, _ := json.Marshal(res)
+	keep := max(maxBytes-len(envelope), 0)
+	for {
+		for keep > 0 && !utf8.RuneStart(text[keep]) {
+			keep--
+		}
+		res.Content = text[:keep]
+		out, _ := json.Marshal(res)
+		// JSON escaping can grow the content; shrink until the envelope fits
+		if len(out) <= maxBytes || keep == 0 {
+			return string(out)
+		}
+		keep -= min(len(out)-maxBytes, keep)
+	}
+}
+
+// emptyResult replaces a null or [] top-level result.
+const emptyResult = `{"items":[],"_count":0}`
+
+// normalizeEmpty rewrites an empty list result to one shape: empty items plus
+// "_count": 0. A null or [] result becomes {"items":[],"_count":0}. For tools
+// that declare a Pagination, an object whose item field is null or empty gets
+// that field as [] and "_count": 0 appended. Anything else, including single
+// resources with empty array fields, is returned byte for byte unchanged.
+func normalizeEmpty(moduleName, toolName, jsonResult string) string {
+	trimmed := strings.TrimSpace(jsonResult)
+	if trimmed == "null" || trimmed == "[]" {
+		return emptyResult
+	}
+	pager, ok := registry[moduleName].(Paginator)
+	if !ok || !strings.HasPrefix(trimmed, "{") {
+		return jsonResult
+	}
+	p, ok := pager.Pagination(toolName)
+	if !ok || p.Items == "" {
+		return jsonResult
+	}
+	start, end, ok := topLevelValue(trimmed, p.Items)
+	if !ok {
+		return jsonResult
+	}
+	var items []any
+	if json.Unmarshal([]byte(trimmed[start:end]), &items) != nil || len(items) > 0 {
+		return jsonResult
+	}
+	if items == nil {
+		trimmed = trimmed[:start] + "[]" + trimmed[end:]
+	}
+	if _, _, counted := topLevelValue(trimmed, "_count"); counted {
+		return jsonResult
+	}
+	return strings.TrimSuffix(trimmed, "}") + `,"_count":0}`
+}
+
+// topLevelValue returns the byte range of a top-level field's value in the
+// JSON object s, so it can be replaced without re-encoding the rest.
+func topLevelValue(s, key string) (start, end int, ok bool) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return 0, 0, false
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return 0, 0, false
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return 0, 0, false
+		}
+		if t == key {
+			end := int(dec.InputOffset())
+			return end - len(raw), end, true
+		}
+	}
+	return 0, 0, false
+}
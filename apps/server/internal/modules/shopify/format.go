@@ -0,0 +1,269 @@
+package shopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// =============================================================================
+// Compact formatters per tool — pure transformation: (toolName, JSON) → string
+// =============================================================================
+
+func formatCompact(toolName, jsonStr string) string {
+	switch toolName {
+	case "list_products":
+		return productsCSV(jsonStr)
+	case "get_product":
+		return productCompact(jsonStr)
+	case "list_orders":
+		return ordersCSV(jsonStr)
+	case "get_order":
+		return orderCompact(jsonStr)
+	case "update_inventory_level":
+		return pickKeys(jsonStr, "inventory_level", "inventory_item_id", "location_id", "available", "updated_at")
+	case "create_draft_order":
+		return pickKeys(jsonStr, "draft_order", "id", "name", "status", "total_price", "currency", "invoice_url")
+	default:
+		return jsonStr
+	}
+}
+
+// productsCSV formats list_products → CSV with id, title, status, vendor, product_type, variants, inventory.
+func productsCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	products, ok := data["products"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(products) == 0 {
+		return "# 0 products"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,title,status,vendor,product_type,variants,inventory\n")
+	for _, p := range products {
+		pm, ok := p.(map[string]any)
+		if !ok {
+			continue
+		}
+		variants, _ := pm["variants"].([]any)
+		inventory := 0
+		for _, v := range variants {
+			if vm, ok := v.(map[string]any); ok {
+				if q, ok := vm["inventory_quantity"].(float64); ok {
+					inventory += int(q)
+				}
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%d,%d\n",
+			idStr(pm, "id"),
+			csvEscape(str(pm, "title")),
+			str(pm, "status"),
+			csvEscape(str(pm, "vendor")),
+			csvEscape(str(pm, "product_type")),
+			len(variants),
+			inventory,
+		))
+	}
+	sb.WriteString("```")
+	writeNextPage(&sb, data)
+	return sb.String()
+}
+
+// productCompact formats get_product → summary + variants CSV.
+func productCompact(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	p, ok := data["product"].(map[string]any)
+	if !ok {
+		return jsonStr
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s\n", str(p, "title")))
+	sb.WriteString(fmt.Sprintf("- **ID**: %s\n", idStr(p, "id")))
+	sb.WriteString(fmt.Sprintf("- **Status**: %s\n", str(p, "status")))
+	if vendor := str(p, "vendor"); vendor != "" {
+		sb.WriteString(fmt.Sprintf("- **Vendor**: %s\n", vendor))
+	}
+	if pt := str(p, "product_type"); pt != "" {
+		sb.WriteString(fmt.Sprintf("- **Type**: %s\n", pt))
+	}
+	if tags := str(p, "tags"); tags != "" {
+		sb.WriteString(fmt.Sprintf("- **Tags**: %s\n", tags))
+	}
+	sb.WriteString(fmt.Sprintf("- **Handle**: %s\n", str(p, "handle")))
+
+	if variants, ok := p["variants"].([]any); ok && len(variants) > 0 {
+		sb.WriteString("\n```csv\nvariant_id,title,sku,price,inventory_quantity,inventory_item_id\n")
+		for _, v := range variants {
+			vm, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s\n",
+				idStr(vm, "id"),
+				csvEscape(str(vm, "title")),
+				csvEscape(str(vm, "sku")),
+				str(vm, "price"),
+				idStr(vm, "inventory_quantity"),
+				idStr(vm, "inventory_item_id"),
+			))
+		}
+		sb.WriteString("```\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// ordersCSV formats list_orders → CSV with id, name, created_at, financial/fulfillment status, total.
+func ordersCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	orders, ok := data["orders"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(orders) == 0 {
+		return "# 0 orders"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,name,created_at,financial_status,fulfillment_status,total_price,currency,email\n")
+	for _, o := range orders {
+		om, ok := o.(map[string]any)
+		if !ok {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s,%s,%s\n",
+			idStr(om, "id"),
+			csvEscape(str(om, "name")),
+			str(om, "created_at"),
+			str(om, "financial_status"),
+			str(om, "fulfillment_status"),
+			str(om, "total_price"),
+			str(om, "currency"),
+			csvEscape(str(om, "email")),
+		))
+	}
+	sb.WriteString("```")
+	writeNextPage(&sb, data)
+	return sb.String()
+}
+
+// orderCompact formats get_order → summary + line items CSV.
+func orderCompact(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	o, ok := data["order"].(map[string]any)
+	if !ok {
+		return jsonStr
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Order %s\n", str(o, "name")))
+	sb.WriteString(fmt.Sprintf("- **ID**: %s\n", idStr(o, "id")))
+	sb.WriteString(fmt.Sprintf("- **Created**: %s\n", str(o, "created_at")))
+	sb.WriteString(fmt.Sprintf("- **Financial Status**: %s\n", str(o, "financial_status")))
+	if fs := str(o, "fulfillment_status"); fs != "" {
+		sb.WriteString(fmt.Sprintf("- **Fulfillment Status**: %s\n", fs))
+	}
+	if cancelled := str(o, "cancelled_at"); cancelled != "" {
+		sb.WriteString(fmt.Sprintf("- **Cancelled**: %s (%s)\n", cancelled, str(o, "cancel_reason")))
+	}
+	sb.WriteString(fmt.Sprintf("- **Total**: %s %s\n", str(o, "total_price"), str(o, "currency")))
+	if email := str(o, "email"); email != "" {
+		sb.WriteString(fmt.Sprintf("- **Email**: %s\n", email))
+	}
+	if note := str(o, "note"); note != "" {
+		sb.WriteString(fmt.Sprintf("\n## Note\n%s\n", note))
+	}
+
+	if items, ok := o["line_items"].([]any); ok && len(items) > 0 {
+		sb.WriteString("\n```csv\nline_item_id,title,variant_id,sku,quantity,price\n")
+		for _, it := range items {
+			im, ok := it.(map[string]any)
+			if !ok {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s,%s\n",
+				idStr(im, "id"),
+				csvEscape(str(im, "title")),
+				idStr(im, "variant_id"),
+				csvEscape(str(im, "sku")),
+				idStr(im, "quantity"),
+				str(im, "price"),
+			))
+		}
+		sb.WriteString("```\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// pickKeys extracts the specified keys from the object wrapped under root.
+func pickKeys(jsonStr, root string, keys ...string) string {
+	var data map[string]map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	obj, ok := data[root]
+	if !ok {
+		return jsonStr
+	}
+	result := make(map[string]any, len(keys))
+	for _, k := range keys {
+		if v, ok := obj[k]; ok && v != nil {
+			result[k] = v
+		}
+	}
+	out, err := json.Marshal(result)
+	if err != nil {
+		return jsonStr
+	}
+	return string(out)
+}
+
+// writeNextPage appends the pagination cursor, if any.
+func writeNextPage(sb *strings.Builder, data map[string]any) {
+	if next := str(data, "next_page_info"); next != "" {
+		sb.WriteString(fmt.Sprintf("\nnext_page_info=%s", next))
+	}
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func str(obj map[string]any, key string) string {
+	if v, ok := obj[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// idStr formats a numeric field (Shopify IDs and quantities) without exponent notation.
+func idStr(obj map[string]any, key string) string {
+	if v, ok := obj[key].(float64); ok {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return ""
+}
+
+func csvEscape(s string) string {
+	if s == "" {
+		return ""
+	}
+	if strings.ContainsAny(s, ",\"\n\r") {
+		return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+	}
+	return s
+}
@@ -0,0 +1,112 @@
+package shopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"mcpist/server/internal/modules"
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// doRequest sends an authenticated request to the Shopify Admin API and returns the raw response body.
+func doRequest(ctx context.Context, method, path string, query url.Values, body any) (string, error) {
+	respBody, _, err := send(ctx, method, path, query, body)
+	return respBody, err
+}
+
+// doList fetches one page of a list endpoint and returns {<key>: [...], "next_page_info": "..."}.
+// Shopify paginates with a cursor carried in the Link response header.
+func doList(ctx context.Context, path string, query url.Values, key string) (string, error) {
+	respBody, header, err := send(ctx, "GET", path, query, nil)
+	if err != nil {
+		return "", err
+	}
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(respBody), &page); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	result := map[string]any{key: page[key]}
+	if next := nextPageInfo(header.Get("Link")); next != "" {
+		result["next_page_info"] = next
+	}
+	return toJSON(result)
+}
+
+var nextLinkPattern = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// nextPageInfo extracts the page_info cursor of the rel="next" link.
+func nextPageInfo(link string) string {
+	m := nextLinkPattern.FindStringSubmatch(link)
+	if m == nil {
+		return ""
+	}
+	u, err := url.Parse(m[1])
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("page_info")
+}
+
+func send(ctx context.Context, method, path string, query url.Values, body any) (string, http.Header, error) {
+	modules.LogTrace(ctx, "shopify", "upstream_call", map[string]any{"path": path})
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", nil, fmt.Errorf("no credentials available")
+	}
+	baseURL, err := adminBaseURL(creds)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	endpoint := baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Shopify-Access-Token", creds.AccessToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", nil, &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New(string(respBody)),
+		}
+	}
+
+	return string(respBody), resp.Header, nil
+}
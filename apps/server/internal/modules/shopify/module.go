@@ -0,0 +1,335 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/middleware"
+	"mcpist/server/internal/modules"
+)
+
+// shopifyVersion is the Admin REST API version used for every request.
+const shopifyVersion = "2025-01"
+
+// ShopifyModule implements the Module interface for the Shopify Admin API
+type ShopifyModule struct{}
+
+func New() *ShopifyModule { return &ShopifyModule{} }
+
+var moduleDescriptions = modules.LocalizedText{
+	"en-US": "Shopify Admin API - Products, orders, inventory levels, and draft orders",
+	"ja-JP": "Shopify Admin API - 商品、注文、在庫数、下書き注文の管理",
+}
+
+func (m *ShopifyModule) Name() string                        { return "shopify" }
+func (m *ShopifyModule) Descriptions() modules.LocalizedText { return moduleDescriptions }
+func (m *ShopifyModule) Description() string {
+	return moduleDescriptions["en-US"]
+}
+func (m *ShopifyModule) APIVersion() string { return shopifyVersion }
+func (m *ShopifyModule) Tools() []modules.Tool {
+	return toolDefinitions
+}
+
+func (m *ShopifyModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	handler, ok := toolHandlers[name]
+	if !ok {
+		return "", fmt.Errorf("unknown tool: %s", name)
+	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
+	return handler(ctx, params)
+}
+
+// ToCompact converts JSON result to compact format.
+func (m *ShopifyModule) ToCompact(toolName string, jsonResult string) string {
+	return formatCompact(toolName, jsonResult)
+}
+
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *ShopifyModule) HealthProbe() (string, map[string]any) {
+	return "list_products", map[string]any{"limit": float64(1)}
+}
+
+func (m *ShopifyModule) Resources() []modules.Resource { return nil }
+func (m *ShopifyModule) ReadResource(ctx context.Context, uri string) (string, error) {
+	return "", fmt.Errorf("resources not supported")
+}
+
+// =============================================================================
+// Token Management
+// =============================================================================
+
+func getCredentials(ctx context.Context) *broker.Credentials {
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil {
+		return nil
+	}
+	credentials, err := broker.GetTokenBroker().GetModuleToken(ctx, authCtx.UserID, "shopify")
+	if err != nil {
+		return nil
+	}
+	return credentials
+}
+
+// adminBaseURL returns the Admin API base URL for the shop configured in the
+// credential metadata. Only *.myshopify.com domains are accepted; a bare shop
+// name ("my-shop") is expanded to "my-shop.myshopify.com".
+func adminBaseURL(creds *broker.Credentials) (string, error) {
+	domain, _ := creds.Metadata["shop_domain"].(string)
+	domain = strings.TrimPrefix(strings.TrimPrefix(domain, "https://"), "http://")
+	domain = strings.ToLower(strings.TrimRight(domain, "/"))
+	if domain == "" {
+		return "", fmt.Errorf("shopify shop_domain not configured")
+	}
+	if !strings.Contains(domain, ".") {
+		domain += ".myshopify.com"
+	}
+	if !strings.HasSuffix(domain, ".myshopify.com") || strings.ContainsAny(domain, "/?#@") {
+		return "", fmt.Errorf("invalid shopify shop_domain: %s", domain)
+	}
+	return "https://" + domain + "/admin/api/" + shopifyVersion, nil
+}
+
+var toJSON = modules.ToJSON
+
+// =============================================================================
+// Tool Definitions
+// =============================================================================
+
+type toolHandler func(ctx context.Context, params map[string]any) (string, error)
+
+// Shared pagination properties for Shopify list endpoints
+var (
+	limitProperty    = modules.Property{Type: "number", Description: "Number of results per page (1-250, default: 50)"}
+	pageInfoProperty = modules.Property{Type: "string", Description: "Cursor from a previous call's next_page_info. When set, filters other than limit are ignored"}
+)
+
+var toolDefinitions = []modules.Tool{
+	// Products
+	{
+		ID:   "shopify:list_products",
+		Name: "list_products",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List products in the shop. If next_page_info is returned, pass it as page_info to get the next page.",
+			"ja-JP": "ショップの商品を一覧表示します。next_page_info が返された場合、page_info に渡すと次のページを取得できます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"status":       {Type: "string", Description: "Filter by status: active, archived, or draft"},
+				"title":        {Type: "string", Description: "Filter by product title"},
+				"vendor":       {Type: "string", Description: "Filter by vendor"},
+				"product_type": {Type: "string", Description: "Filter by product type"},
+				"limit":        limitProperty,
+				"page_info":    pageInfoProperty,
+			},
+		},
+	},
+	{
+		ID:   "shopify:get_product",
+		Name: "get_product",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get a product with its variants. Each variant's inventory_item_id is used by update_inventory_level.",
+			"ja-JP": "商品とそのバリエーションを取得します。各バリエーションの inventory_item_id は update_inventory_level で使用します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"product_id": {Type: "string", Description: "Product ID"},
+			},
+			Required: []string{"product_id"},
+		},
+	},
+	// Orders
+	{
+		ID:   "shopify:list_orders",
+		Name: "list_orders",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List orders, newest first. If next_page_info is returned, pass it as page_info to get the next page.",
+			"ja-JP": "注文を新しい順に一覧表示します。next_page_info が返された場合、page_info に渡すと次のページを取得できます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"status":             {Type: "string", Description: "Filter by status: open (default), closed, cancelled, or any"},
+				"financial_status":   {Type: "string", Description: "Filter by financial status: authorized, pending, paid, partially_paid, refunded, voided, partially_refunded, unpaid, or any"},
+				"fulfillment_status": {Type: "string", Description: "Filter by fulfillment status: shipped, partial, unshipped, unfulfilled, or any"},
+				"created_at_min":     {Type: "string", Description: "Only orders created at or after this time (ISO 8601)"},
+				"limit":              limitProperty,
+				"page_info":          pageInfoProperty,
+			},
+		},
+	},
+	{
+		ID:   "shopify:get_order",
+		Name: "get_order",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get an order with its line items.",
+			"ja-JP": "注文とその明細を取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"order_id": {Type: "string", Description: "Order ID"},
+			},
+			Required: []string{"order_id"},
+		},
+	},
+	// Inventory
+	{
+		ID:   "shopify:update_inventory_level",
+		Name: "update_inventory_level",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Set the available quantity of an inventory item at a location.",
+			"ja-JP": "ロケーションにおける在庫アイテムの利用可能数を設定します。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"inventory_item_id": {Type: "string", Description: "Inventory item ID (from a product variant's inventory_item_id)"},
+				"location_id":       {Type: "string", Description: "Location ID"},
+				"available":         {Type: "integer", Description: "New available quantity"},
+			},
+			Required: []string{"inventory_item_id", "location_id", "available"},
+		},
+	},
+	// Draft orders
+	{
+		ID:   "shopify:create_draft_order",
+		Name: "create_draft_order",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a draft order. Each line item needs either variant_id or title and price, plus quantity.",
+			"ja-JP": "下書き注文を作成します。各明細には variant_id、または title と price のいずれかと quantity が必要です。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"line_items": {
+					Type:        "array",
+					Description: `Line items, e.g. [{"variant_id": 123, "quantity": 2}, {"title": "Custom item", "price": "10.00", "quantity": 1}]`,
+					Items:       &modules.Property{Type: "object"},
+				},
+				"customer_id": {Type: "string", Description: "Existing customer ID to associate"},
+				"email":       {Type: "string", Description: "Customer email"},
+				"note":        {Type: "string", Description: "Order note"},
+				"tags":        {Type: "string", Description: "Comma-separated tags"},
+			},
+			Required: []string{"line_items"},
+		},
+	},
+}
+
+// =============================================================================
+// Tool Handlers
+// =============================================================================
+
+var toolHandlers = map[string]toolHandler{
+	"list_products":          listProducts,
+	"get_product":            getProduct,
+	"list_orders":            listOrders,
+	"get_order":              getOrder,
+	"update_inventory_level": updateInventoryLevel,
+	"create_draft_order":     createDraftOrder,
+}
+
+// listQuery builds the query for a Shopify list endpoint. Shopify rejects
+// filters alongside page_info, so only limit is kept when paging.
+func listQuery(params map[string]any, filters ...string) url.Values {
+	q := url.Values{}
+	if v, ok := params["limit"].(float64); ok && v > 0 {
+		q.Set("limit", strconv.Itoa(min(int(v), 250)))
+	}
+	if v, ok := params["page_info"].(string); ok && v != "" {
+		q.Set("page_info", v)
+		return q
+	}
+	for _, key := range filters {
+		if v, ok := params[key].(string); ok && v != "" {
+			q.Set(key, v)
+		}
+	}
+	return q
+}
+
+func listProducts(ctx context.Context, params map[string]any) (string, error) {
+	return doList(ctx, "/products.json", listQuery(params, "status", "title", "vendor", "product_type"), "products")
+}
+
+func getProduct(ctx context.Context, params map[string]any) (string, error) {
+	productID, _ := params["product_id"].(string)
+	return doRequest(ctx, "GET", "/products/"+url.PathEscape(productID)+".json", nil, nil)
+}
+
+func listOrders(ctx context.Context, params map[string]any) (string, error) {
+	q := listQuery(params, "status", "financial_status", "fulfillment_status", "created_at_min")
+	if _, paging := params["page_info"].(string); !paging && q.Get("status") == "" {
+		// Shopify defaults to open orders; say so explicitly for predictable results
+		q.Set("status", "open")
+	}
+	return doList(ctx, "/orders.json", q, "orders")
+}
+
+func getOrder(ctx context.Context, params map[string]any) (string, error) {
+	orderID, _ := params["order_id"].(string)
+	return doRequest(ctx, "GET", "/orders/"+url.PathEscape(orderID)+".json", nil, nil)
+}
+
+func updateInventoryLevel(ctx context.Context, params map[string]any) (string, error) {
+	itemID, err := numericID(params, "inventory_item_id")
+	if err != nil {
+		return "", err
+	}
+	locationID, err := numericID(params, "location_id")
+	if err != nil {
+		return "", err
+	}
+	available, _ := params["available"].(float64)
+	body := map[string]any{
+		"inventory_item_id": itemID,
+		"location_id":       locationID,
+		"available":         int(available),
+	}
+	return doRequest(ctx, "POST", "/inventory_levels/set.json", nil, body)
+}
+
+func createDraftOrder(ctx context.Context, params map[string]any) (string, error) {
+	lineItems, _ := params["line_items"].([]any)
+	if len(lineItems) == 0 {
+		return "", fmt.Errorf("line_items must contain at least one item")
+	}
+	draft := map[string]any{"line_items": lineItems}
+	if v, ok := params["customer_id"].(string); ok && v != "" {
+		customerID, err := numericID(params, "customer_id")
+		if err != nil {
+			return "", err
+		}
+		draft["customer"] = map[string]any{"id": customerID}
+	}
+	for _, key := range []string{"email", "note", "tags"} {
+		if v, ok := params[key].(string); ok && v != "" {
+			draft[key] = v
+		}
+	}
+	return doRequest(ctx, "POST", "/draft_orders.json", nil, map[string]any{"draft_order": draft})
+}
+
+// numericID parses a Shopify numeric resource ID given as a string parameter.
+func numericID(params map[string]any, key string) (int64, error) {
+	s, _ := params[key].(string)
+	id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a numeric ID, got %q", key, s)
+	}
+	return id, nil
+}
@@ -0,0 +1,195 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Compact formatters per tool — pure transformation: (toolName, JSON) → string
+// =============================================================================
+
+func formatCompact(toolName, jsonStr string) string {
+	switch toolName {
+	case "list_customers":
+		return listCSV(jsonStr, "customers", "id,name,email,created,delinquent", func(c map[string]any) []string {
+			return []string{str(c, "id"), str(c, "name"), str(c, "email"), unixDate(c, "created"), boolStr(c, "delinquent")}
+		})
+	case "get_customer":
+		return customerCompact(jsonStr)
+	case "list_charges":
+		return listCSV(jsonStr, "charges", "id,amount,currency,status,customer,created,description", func(c map[string]any) []string {
+			return []string{str(c, "id"), intStr(c, "amount"), str(c, "currency"), str(c, "status"), str(c, "customer"), unixDate(c, "created"), str(c, "description")}
+		})
+	case "list_subscriptions":
+		return listCSV(jsonStr, "subscriptions", "id,customer,status,prices,cancel_at_period_end,created", func(s map[string]any) []string {
+			return []string{str(s, "id"), str(s, "customer"), str(s, "status"), subscriptionPrices(s), boolStr(s, "cancel_at_period_end"), unixDate(s, "created")}
+		})
+	case "list_invoices":
+		return listCSV(jsonStr, "invoices", "id,number,customer,status,amount_due,amount_paid,currency,created", func(i map[string]any) []string {
+			return []string{str(i, "id"), str(i, "number"), str(i, "customer"), str(i, "status"), intStr(i, "amount_due"), intStr(i, "amount_paid"), str(i, "currency"), unixDate(i, "created")}
+		})
+	case "get_balance":
+		return balanceCSV(jsonStr)
+	default:
+		return jsonStr
+	}
+}
+
+// listCSV formats a Stripe list object ({data: [...], has_more}) as CSV using row
+// to extract the columns of each object.
+func listCSV(jsonStr, noun, header string, row func(map[string]any) []string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	items, ok := data["data"].([]any)
+	if !ok {
+		return jsonStr
+	}
+	if len(items) == 0 {
+		return fmt.Sprintf("# 0 %s", noun)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```csv\n" + header + "\n")
+	lastID := ""
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		cols := row(obj)
+		for i, c := range cols {
+			cols[i] = csvEscape(c)
+		}
+		sb.WriteString(strings.Join(cols, ",") + "\n")
+		lastID = str(obj, "id")
+	}
+	sb.WriteString("```")
+
+	// Append pagination info
+	if hasMore, _ := data["has_more"].(bool); hasMore {
+		sb.WriteString(fmt.Sprintf("\nhas_more=true starting_after=%s", lastID))
+	}
+	return sb.String()
+}
+
+// customerCompact formats a single customer.
+func customerCompact(jsonStr string) string {
+	var c map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &c); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	name := str(c, "name")
+	if name == "" {
+		name = str(c, "id")
+	}
+	sb.WriteString(fmt.Sprintf("# %s\n", name))
+	sb.WriteString(fmt.Sprintf("- **ID**: %s\n", str(c, "id")))
+	if email := str(c, "email"); email != "" {
+		sb.WriteString(fmt.Sprintf("- **Email**: %s\n", email))
+	}
+	if phone := str(c, "phone"); phone != "" {
+		sb.WriteString(fmt.Sprintf("- **Phone**: %s\n", phone))
+	}
+	if currency := str(c, "currency"); currency != "" {
+		sb.WriteString(fmt.Sprintf("- **Currency**: %s\n", currency))
+	}
+	if balance := intStr(c, "balance"); balance != "" && balance != "0" {
+		sb.WriteString(fmt.Sprintf("- **Balance**: %s\n", balance))
+	}
+	if delinquent, _ := c["delinquent"].(bool); delinquent {
+		sb.WriteString("- **Delinquent**: Yes\n")
+	}
+	sb.WriteString(fmt.Sprintf("- **Created**: %s\n", unixDate(c, "created")))
+	if desc := str(c, "description"); desc != "" {
+		sb.WriteString(fmt.Sprintf("\n## Description\n%s\n", desc))
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// balanceCSV formats the balance object → CSV with type, amount, currency.
+func balanceCSV(jsonStr string) string {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return jsonStr
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\ntype,amount,currency\n")
+	for _, kind := range []string{"available", "pending"} {
+		funds, _ := data[kind].([]any)
+		for _, f := range funds {
+			fm, ok := f.(map[string]any)
+			if !ok {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("%s,%s,%s\n", kind, intStr(fm, "amount"), str(fm, "currency")))
+		}
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// subscriptionPrices joins the price IDs of a subscription's items with ";".
+func subscriptionPrices(sub map[string]any) string {
+	items, _ := sub["items"].(map[string]any)
+	data, _ := items["data"].([]any)
+	ids := make([]string, 0, len(data))
+	for _, it := range data {
+		im, ok := it.(map[string]any)
+		if !ok {
+			continue
+		}
+		if price, ok := im["price"].(map[string]any); ok {
+			ids = append(ids, str(price, "id"))
+		}
+	}
+	return strings.Join(ids, ";")
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func str(obj map[string]any, key string) string {
+	if v, ok := obj[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func intStr(obj map[string]any, key string) string {
+	if v, ok := obj[key].(float64); ok {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return ""
+}
+
+func boolStr(obj map[string]any, key string) string {
+	if v, ok := obj[key].(bool); ok {
+		return fmt.Sprintf("%v", v)
+	}
+	return ""
+}
+
+// unixDate formats a Unix timestamp field (seconds) as RFC 3339 in UTC.
+func unixDate(obj map[string]any, key string) string {
+	if v, ok := obj[key].(float64); ok {
+		return time.Unix(int64(v), 0).UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+func csvEscape(s string) string {
+	if s == "" {
+		return ""
+	}
+	if strings.ContainsAny(s, ",\"\n\r") {
+		return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+	}
+	return s
+}
@@ -0,0 +1,56 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"mcpist/server/internal/modules"
+)
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// doGet sends an authenticated GET request to the Stripe API and returns the raw response body.
+// The module is read-only, so GET is the only method it issues.
+func doGet(ctx context.Context, path string, query url.Values) (string, error) {
+	modules.LogTrace(ctx, "stripe", "upstream_call", map[string]any{"path": path})
+	creds := getCredentials(ctx)
+	if creds == nil {
+		return "", fmt.Errorf("no credentials available")
+	}
+
+	endpoint := stripeAPIBase + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", &modules.UpstreamError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: modules.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			Err:        errors.New(string(respBody)),
+		}
+	}
+
+	return string(respBody), nil
+}
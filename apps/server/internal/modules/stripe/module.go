@@ -0,0 +1,260 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"mcpist/server/internal/broker"
+	"mcpist/server/internal/middleware"
+	"mcpist/server/internal/modules"
+)
+
+const (
+	stripeAPIBase = "https://api.stripe.com/v1"
+	stripeVersion = "v1"
+)
+
+// StripeModule implements the Module interface for the Stripe API (read-only)
+type StripeModule struct{}
+
+func New() *StripeModule { return &StripeModule{} }
+
+var moduleDescriptions = modules.LocalizedText{
+	"en-US": "Stripe API - Read customers, charges, subscriptions, invoices, and balance",
+	"ja-JP": "Stripe API - 顧客、支払い、サブスクリプション、請求書、残高の参照",
+}
+
+func (m *StripeModule) Name() string                        { return "stripe" }
+func (m *StripeModule) Descriptions() modules.LocalizedText { return moduleDescriptions }
+func (m *StripeModule) Description() string {
+	return moduleDescriptions["en-US"]
+}
+func (m *StripeModule) APIVersion() string { return stripeVersion }
+func (m *StripeModule) Tools() []modules.Tool {
+	return toolDefinitions
+}
+
+func (m *StripeModule) ExecuteTool(ctx context.Context, name string, params map[string]any) (string, error) {
+	handler, ok := toolHandlers[name]
+	if !ok {
+		return "", fmt.Errorf("unknown tool: %s", name)
+	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
+	return handler(ctx, params)
+}
+
+// ToCompact converts JSON result to compact format.
+func (m *StripeModule) ToCompact(toolName string, jsonResult string) string {
+	return formatCompact(toolName, jsonResult)
+}
+
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *StripeModule) HealthProbe() (string, map[string]any) {
+	return "get_balance", nil
+}
+
+func (m *StripeModule) Resources() []modules.Resource { return nil }
+func (m *StripeModule) ReadResource(ctx context.Context, uri string) (string, error) {
+	return "", fmt.Errorf("resources not supported")
+}
+
+// =============================================================================
+// Token Management
+// =============================================================================
+
+func getCredentials(ctx context.Context) *broker.Credentials {
+	authCtx := middleware.GetAuthContext(ctx)
+	if authCtx == nil {
+		return nil
+	}
+	credentials, err := broker.GetTokenBroker().GetModuleToken(ctx, authCtx.UserID, "stripe")
+	if err != nil {
+		return nil
+	}
+	return credentials
+}
+
+// =============================================================================
+// Tool Definitions
+// =============================================================================
+
+type toolHandler func(ctx context.Context, params map[string]any) (string, error)
+
+// Shared pagination properties for Stripe list endpoints
+var (
+	limitProperty         = modules.Property{Type: "number", Description: "Number of objects to return (1-100, default: 10)"}
+	startingAfterProperty = modules.Property{Type: "string", Description: "Cursor for pagination: ID of the last object from the previous page"}
+)
+
+var toolDefinitions = []modules.Tool{
+	// Customers
+	{
+		ID:   "stripe:list_customers",
+		Name: "list_customers",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List customers, newest first. If has_more is true, pass the last customer ID as starting_after to get the next page.",
+			"ja-JP": "顧客を新しい順に一覧表示します。has_more が true の場合、最後の顧客IDを starting_after に渡すと次のページを取得できます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"email":          {Type: "string", Description: "Filter by exact email address (case-sensitive)"},
+				"limit":          limitProperty,
+				"starting_after": startingAfterProperty,
+			},
+		},
+	},
+	{
+		ID:   "stripe:get_customer",
+		Name: "get_customer",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get details of a customer.",
+			"ja-JP": "顧客の詳細を取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"customer_id": {Type: "string", Description: "Customer ID (cus_...)"},
+			},
+			Required: []string{"customer_id"},
+		},
+	},
+	// Payments
+	{
+		ID:   "stripe:list_charges",
+		Name: "list_charges",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List charges, newest first. Amounts are in the currency's smallest unit (e.g. cents).",
+			"ja-JP": "支払い（Charge）を新しい順に一覧表示します。金額は通貨の最小単位（例: セント）です。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"customer_id":    {Type: "string", Description: "Only return charges for this customer"},
+				"limit":          limitProperty,
+				"starting_after": startingAfterProperty,
+			},
+		},
+	},
+	// Billing
+	{
+		ID:   "stripe:list_subscriptions",
+		Name: "list_subscriptions",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List subscriptions. By default canceled subscriptions are excluded.",
+			"ja-JP": "サブスクリプションを一覧表示します。デフォルトではキャンセル済みのものは除外されます。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"customer_id":    {Type: "string", Description: "Only return subscriptions for this customer"},
+				"status":         {Type: "string", Description: "Filter by status: active, past_due, unpaid, canceled, incomplete, incomplete_expired, trialing, paused, ended, or all"},
+				"limit":          limitProperty,
+				"starting_after": startingAfterProperty,
+			},
+		},
+	},
+	{
+		ID:   "stripe:list_invoices",
+		Name: "list_invoices",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List invoices, newest first. Amounts are in the currency's smallest unit (e.g. cents).",
+			"ja-JP": "請求書を新しい順に一覧表示します。金額は通貨の最小単位（例: セント）です。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"customer_id":     {Type: "string", Description: "Only return invoices for this customer"},
+				"subscription_id": {Type: "string", Description: "Only return invoices for this subscription"},
+				"status":          {Type: "string", Description: "Filter by status: draft, open, paid, uncollectible, or void"},
+				"limit":           limitProperty,
+				"starting_after":  startingAfterProperty,
+			},
+		},
+	},
+	// Balance
+	{
+		ID:   "stripe:get_balance",
+		Name: "get_balance",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Get the current account balance (available and pending funds per currency).",
+			"ja-JP": "現在のアカウント残高（通貨ごとの利用可能額と保留額）を取得します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type:       "object",
+			Properties: map[string]modules.Property{},
+		},
+	},
+}
+
+// =============================================================================
+// Tool Handlers
+// =============================================================================
+
+var toolHandlers = map[string]toolHandler{
+	"list_customers":     listCustomers,
+	"get_customer":       getCustomer,
+	"list_charges":       listCharges,
+	"list_subscriptions": listSubscriptions,
+	"list_invoices":      listInvoices,
+	"get_balance":        getBalance,
+}
+
+// listQuery builds the common query for Stripe list endpoints, copying the
+// given tool parameters to their Stripe query names.
+func listQuery(params map[string]any, filters map[string]string) url.Values {
+	q := url.Values{}
+	if v, ok := params["limit"].(float64); ok && v > 0 {
+		q.Set("limit", strconv.Itoa(min(int(v), 100)))
+	}
+	if v, ok := params["starting_after"].(string); ok && v != "" {
+		q.Set("starting_after", v)
+	}
+	for param, key := range filters {
+		if v, ok := params[param].(string); ok && v != "" {
+			q.Set(key, v)
+		}
+	}
+	return q
+}
+
+func listCustomers(ctx context.Context, params map[string]any) (string, error) {
+	return doGet(ctx, "/customers", listQuery(params, map[string]string{"email": "email"}))
+}
+
+func getCustomer(ctx context.Context, params map[string]any) (string, error) {
+	customerID, _ := params["customer_id"].(string)
+	return doGet(ctx, "/customers/"+url.PathEscape(customerID), nil)
+}
+
+func listCharges(ctx context.Context, params map[string]any) (string, error) {
+	return doGet(ctx, "/charges", listQuery(params, map[string]string{"customer_id": "customer"}))
+}
+
+func listSubscriptions(ctx context.Context, params map[string]any) (string, error) {
+	return doGet(ctx, "/subscriptions", listQuery(params, map[string]string{
+		"customer_id": "customer",
+		"status":      "status",
+	}))
+}
+
+func listInvoices(ctx context.Context, params map[string]any) (string, error) {
+	return doGet(ctx, "/invoices", listQuery(params, map[string]string{
+		"customer_id":     "customer",
+		"subscription_id": "subscription",
+		"status":          "status",
+	}))
+}
+
+func getBalance(ctx context.Context, params map[string]any) (string, error) {
+	return doGet(ctx, "/balance", nil)
+}
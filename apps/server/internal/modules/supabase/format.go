@@ -184,10 +184,15 @@ func migrationsToCSV(jsonStr string) string {
 
 // queryResultToCSV: dynamic columns from query result
 func queryResultToCSV(jsonStr string) string {
-	var rows []map[string]any
-	if err := json.Unmarshal([]byte(jsonStr), &rows); err != nil {
+	var res struct {
+		Rows      []map[string]any `json:"rows"`
+		Truncated bool             `json:"truncated"`
+		TotalRows int              `json:"total_rows"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &res); err != nil {
 		return jsonStr
 	}
+	rows := res.Rows
 	if len(rows) == 0 {
 		return "# 0 rows"
 	}
@@ -207,6 +212,9 @@ func queryResultToCSV(jsonStr string) string {
 		sb.WriteString("\n")
 	}
 	sb.WriteString("```")
+	if res.Truncated {
+		sb.WriteString(fmt.Sprintf("\n# truncated: %d of %d rows; add LIMIT/OFFSET to a SELECT for the rest", len(rows), res.TotalRows))
+	}
 	return sb.String()
 }
 
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"sync"
 
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -59,6 +58,7 @@ func (m *SupabaseModule) ExecuteTool(ctx context.Context, name string, params ma
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -68,6 +68,12 @@ func (m *SupabaseModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *SupabaseModule) HealthProbe() (string, map[string]any) {
+	return "list_organizations", nil
+}
+
 // Resources returns all available resources (none for Supabase)
 func (m *SupabaseModule) Resources() []modules.Resource {
 	return nil
@@ -95,11 +101,16 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "supabase", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
 	}
-	return supabaseapi.NewClient(creds.AccessToken)
+	serverURL, err := creds.BaseURL(supabaseapi.DefaultServerURL)
+	if err != nil {
+		return nil, err
+	}
+	return supabaseapi.NewClientWithURL(serverURL, creds.AccessToken)
 }
 
 var toJSON = modules.ToJSON
@@ -174,8 +185,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "supabase:run_query",
 		Name: "run_query",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Execute a SQL query against the database. Supports both read and write operations.",
-			"ja-JP": "データベースに対してSQLクエリを実行します。読み取りと書き込みの両方の操作をサポートします。",
+			"en-US": "Execute a SQL query against the database. Supports both read and write operations. Returned rows are capped at max_rows; when truncated is true, page through the rest by adding LIMIT/OFFSET to a SELECT. Re-running a query also re-runs any writes in it.",
+			"ja-JP": "データベースに対してSQLクエリを実行します。読み取りと書き込みの両方の操作をサポートします。返される行はmax_rowsで打ち切られます。truncatedがtrueの場合は、SELECTにLIMIT/OFFSETを付けて続きを取得してください。クエリを再実行するとそのクエリ内の書き込みも再実行されます。",
 		},
 		Annotations: modules.AnnotateDestructive,
 		InputSchema: modules.InputSchema{
@@ -183,6 +194,7 @@ var toolDefinitions = []modules.Tool{
 			Properties: map[string]modules.Property{
 				"project_ref": {Type: "string", Description: "Project reference"},
 				"query":       {Type: "string", Description: "SQL query to execute"},
+				"max_rows":    {Type: "integer", Description: "Maximum rows to return. Default: 1000, Max: 10000"},
 			},
 			Required: []string{"project_ref", "query"},
 		},
@@ -529,10 +541,41 @@ func listTables(ctx context.Context, params map[string]any) (string, error) {
 	return executeQuery(ctx, projectRef, query)
 }
 
+const (
+	defaultMaxRows = 1000
+	maxMaxRows     = 10000
+)
+
+// runQuery returns {rows, row_count, truncated, total_rows}. The Management API
+// has no cursor, so the full result is fetched and cut at max_rows; that still
+// keeps multi-megabyte results out of the tool response. There is no offset
+// param: the query may write, so paging by re-running it would repeat the write.
 func runQuery(ctx context.Context, params map[string]any) (string, error) {
 	projectRef, _ := params["project_ref"].(string)
 	query, _ := params["query"].(string)
-	return executeQuery(ctx, projectRef, query)
+	res, err := executeQuery(ctx, projectRef, query)
+	if err != nil {
+		return "", err
+	}
+	var rows []any
+	if json.Unmarshal([]byte(res), &rows) != nil {
+		return res, nil
+	}
+
+	maxRows := defaultMaxRows
+	if v, ok := params["max_rows"].(float64); ok && v >= 1 {
+		maxRows = min(int(v), maxMaxRows)
+	}
+	end := min(maxRows, len(rows))
+	result := map[string]any{
+		"rows":      rows[:end],
+		"row_count": end,
+		"truncated": end < len(rows),
+	}
+	if end < len(rows) {
+		result["total_rows"] = len(rows)
+	}
+	return toJSON(result)
 }
 
 func listMigrations(ctx context.Context, params map[string]any) (string, error) {
@@ -720,7 +763,7 @@ func getStorageConfig(ctx context.Context, params map[string]any) (string, error
 
 // describeProject returns a comprehensive overview of a Supabase project.
 // Calls: getProject, listTables(SQL), getApiKeys, listEdgeFunctions, listStorageBuckets, getStorageConfig
-// All calls are parallel with goroutines.
+// All calls are parallel with goroutines and abort together when ctx is cancelled.
 func describeProject(ctx context.Context, params map[string]any) (string, error) {
 	c, err := newOgenClient(ctx)
 	if err != nil {
@@ -735,20 +778,15 @@ func describeProject(ctx context.Context, params map[string]any) (string, error)
 	}
 
 	ch := make(chan result, 6)
-	var wg sync.WaitGroup
 
 	// 1. getProject
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.GetProject(ctx, gen.GetProjectParams{Ref: projectRef})
 		ch <- result{"project", res, err}
 	}()
 
 	// 2. listTables (SQL via runDatabaseQuery)
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		query := `SELECT schemaname as schema, tablename as name,
 			(SELECT count(*)::int FROM information_schema.columns
 			 WHERE table_schema = t.schemaname AND table_name = t.tablename) as column_count
@@ -765,9 +803,7 @@ func describeProject(ctx context.Context, params map[string]any) (string, error)
 	}()
 
 	// 3. getApiKeys
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.GetApiKeys(ctx, gen.GetApiKeysParams{Ref: projectRef})
 		if err != nil {
 			ch <- result{"api_keys", nil, err}
@@ -785,9 +821,7 @@ func describeProject(ctx context.Context, params map[string]any) (string, error)
 	}()
 
 	// 4. listEdgeFunctions
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.ListEdgeFunctions(ctx, gen.ListEdgeFunctionsParams{Ref: projectRef})
 		if err != nil {
 			ch <- result{"edge_functions", nil, err}
@@ -806,9 +840,7 @@ func describeProject(ctx context.Context, params map[string]any) (string, error)
 	}()
 
 	// 5. listStorageBuckets
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.ListStorageBuckets(ctx, gen.ListStorageBucketsParams{Ref: projectRef})
 		if err != nil {
 			ch <- result{"storage_buckets", nil, err}
@@ -825,17 +857,20 @@ func describeProject(ctx context.Context, params map[string]any) (string, error)
 	}()
 
 	// 6. getStorageConfig
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.GetStorageConfig(ctx, gen.GetStorageConfigParams{Ref: projectRef})
 		ch <- result{"storage_config", res, err}
 	}()
 
-	go func() { wg.Wait(); close(ch) }()
-
 	out := map[string]any{}
-	for r := range ch {
+	for range cap(ch) {
+		var r result
+		select {
+		case r = <-ch:
+		case <-ctx.Done():
+			// Client disconnected or timed out; the calls above share ctx and abort
+			return "", ctx.Err()
+		}
 		if r.err != nil {
 			continue // skip failed calls
 		}
@@ -879,26 +914,26 @@ func inspectHealth(ctx context.Context, params map[string]any) (string, error) {
 	}
 
 	ch := make(chan result, 2)
-	var wg sync.WaitGroup
 
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.GetSecurityAdvisors(ctx, gen.GetSecurityAdvisorsParams{Ref: projectRef})
 		ch <- result{"security", res, err}
 	}()
 
-	wg.Add(1)
 	go func() {
-		defer wg.Done()
 		res, err := c.GetPerformanceAdvisors(ctx, gen.GetPerformanceAdvisorsParams{Ref: projectRef})
 		ch <- result{"performance", res, err}
 	}()
 
-	go func() { wg.Wait(); close(ch) }()
-
 	out := map[string]any{}
-	for r := range ch {
+	for range cap(ch) {
+		var r result
+		select {
+		case r = <-ch:
+		case <-ctx.Done():
+			// Client disconnected or timed out; the calls above share ctx and abort
+			return "", ctx.Err()
+		}
 		if r.err != nil {
 			continue
 		}
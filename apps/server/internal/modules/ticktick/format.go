@@ -22,7 +22,7 @@ func formatCompact(toolName, jsonStr string) string {
 		return taskToCompact(jsonStr)
 	case "create_project", "update_project":
 		return pickKeys(jsonStr, "id", "name", "kind", "viewMode")
-	case "create_task", "update_task":
+	case "create_task", "update_task", "complete_subtask":
 		return pickKeys(jsonStr, "id", "title", "projectId", "priority", "dueDate", "status")
 	default:
 		return jsonStr
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -59,6 +60,7 @@ func (m *TickTickModule) ExecuteTool(ctx context.Context, name string, params ma
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -68,6 +70,12 @@ func (m *TickTickModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *TickTickModule) HealthProbe() (string, map[string]any) {
+	return "list_projects", nil
+}
+
 // Resources returns all available resources (none for TickTick)
 func (m *TickTickModule) Resources() []modules.Resource {
 	return nil
@@ -97,6 +105,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "ticktick", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -287,7 +296,7 @@ var toolDefinitions = []modules.Tool{
 				"repeat_flag": {Type: "string", Description: "Recurrence rule in RRULE format (optional)"},
 				"priority":    {Type: "number", Description: "Priority: 0 (none), 1 (low), 3 (medium), 5 (high) (optional)"},
 				"sort_order":  {Type: "number", Description: "Sort order value (optional)"},
-				"items":       {Type: "array", Description: "Subtask items (optional)"},
+				"items":       {Type: "array", Description: `Subtask items as array of objects; replaces the whole checklist, so include "id" to keep existing items: [{"id": "...", "title": "subtask1", "status": 2}] (optional). status: 0=normal, 2=completed`},
 			},
 			Required: []string{"task_id", "project_id"},
 		},
@@ -310,6 +319,25 @@ var toolDefinitions = []modules.Tool{
 		},
 		Annotations: modules.AnnotateUpdate,
 	},
+	{
+		ID:   "ticktick:complete_subtask",
+		Name: "complete_subtask",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Mark a checklist item (subtask) of a task as completed, leaving the other items unchanged.",
+			"ja-JP": "タスクのチェックリスト項目（サブタスク）を完了にします。他の項目は変更しません。",
+		},
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"project_id": {Type: "string", Description: "Project ID"},
+				"task_id":    {Type: "string", Description: "Task ID"},
+				"item_id":    {Type: "string", Description: "Checklist item ID (get from get_task)"},
+				"item_title": {Type: "string", Description: "Checklist item title, used when item_id is not given (case-insensitive exact match)"},
+			},
+			Required: []string{"project_id", "task_id"},
+		},
+		Annotations: modules.AnnotateUpdate,
+	},
 	{
 		ID:   "ticktick:delete_task",
 		Name: "delete_task",
@@ -344,11 +372,12 @@ var toolHandlers = map[string]toolHandler{
 	"update_project":   updateProject,
 	"delete_project":   deleteProject,
 	// Task tools
-	"get_task":      getTask,
-	"create_task":   createTask,
-	"update_task":   updateTask,
-	"complete_task": completeTask,
-	"delete_task":   deleteTask,
+	"get_task":         getTask,
+	"create_task":      createTask,
+	"update_task":      updateTask,
+	"complete_task":    completeTask,
+	"complete_subtask": completeSubtask,
+	"delete_task":      deleteTask,
 }
 
 // =============================================================================
@@ -623,6 +652,49 @@ func completeTask(ctx context.Context, params map[string]any) (string, error) {
 	return `{"success":true,"message":"Task completed"}`, nil
 }
 
+// checklistItemCompleted is the TickTick status of a completed checklist item
+const checklistItemCompleted = 2
+
+func completeSubtask(ctx context.Context, params map[string]any) (string, error) {
+	projectID, _ := params["project_id"].(string)
+	taskID, _ := params["task_id"].(string)
+	itemID, _ := params["item_id"].(string)
+	itemTitle, _ := params["item_title"].(string)
+	if itemID == "" && itemTitle == "" {
+		return "", fmt.Errorf("item_id or item_title is required")
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	task, err := c.GetTask(ctx, gen.GetTaskParams{ProjectId: projectID, TaskId: taskID})
+	if err != nil {
+		return "", err
+	}
+
+	// The update replaces the whole checklist, so send every item back with its ID
+	items := task.Items.Value
+	found := -1
+	for i, item := range items {
+		if (itemID != "" && item.ID.Value == itemID) ||
+			(itemID == "" && strings.EqualFold(item.Title.Value, itemTitle)) {
+			found = i
+			break
+		}
+	}
+	if found < 0 {
+		return "", fmt.Errorf("checklist item not found in task %s", taskID)
+	}
+	items[found].Status.SetTo(checklistItemCompleted)
+
+	req := gen.UpdateTaskReq{ID: taskID, ProjectId: projectID, Title: task.Title, Items: items}
+	res, err := c.UpdateTask(ctx, &req, gen.UpdateTaskParams{TaskId: taskID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 func deleteTask(ctx context.Context, params map[string]any) (string, error) {
 	projectID, _ := params["project_id"].(string)
 	taskID, _ := params["task_id"].(string)
@@ -650,6 +722,9 @@ func toChecklistItems(v []interface{}) []gen.ChecklistItem {
 			continue
 		}
 		item := gen.ChecklistItem{}
+		if id, ok := m["id"].(string); ok && id != "" {
+			item.ID.SetTo(id)
+		}
 		if t, ok := m["title"].(string); ok {
 			item.Title.SetTo(t)
 		}
@@ -20,10 +20,18 @@ func formatCompact(toolName, jsonStr string) string {
 		return tasksToCSV(jsonStr)
 	case "get_task":
 		return taskToCompact(jsonStr)
-	case "create_task", "update_task":
-		return pickKeys(jsonStr, "id", "content", "projectId", "due", "priority", "labels")
+	case "create_project":
+		return pickKeys(jsonStr, "id", "name", "parentId", "url")
+	case "create_task", "update_task", "move_task":
+		return pickKeys(jsonStr, "id", "content", "projectId", "sectionId", "parentId", "due", "priority", "labels")
 	case "list_sections":
 		return sectionsToCSV(jsonStr)
+	case "create_section":
+		return pickKeys(jsonStr, "id", "name", "projectId", "sectionOrder")
+	case "list_comments":
+		return commentsToCSV(jsonStr)
+	case "add_comment":
+		return pickKeys(jsonStr, "id", "taskId", "projectId", "postedAt", "content")
 	case "list_labels":
 		return labelsToCSV(jsonStr)
 	default:
@@ -175,6 +183,28 @@ func sectionsToCSV(jsonStr string) string {
 	return sb.String()
 }
 
+// commentsToCSV: id,postedAt,content
+func commentsToCSV(jsonStr string) string {
+	var comments []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &comments); err != nil {
+		return jsonStr
+	}
+	if len(comments) == 0 {
+		return "# 0 comments"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,postedAt,content\n")
+	for _, c := range comments {
+		sb.WriteString(fmt.Sprintf("%s,%s,%s\n",
+			csvEscape(str(c, "id")),
+			str(c, "postedAt"),
+			csvEscape(str(c, "content")),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
 // labelsToCSV: id,name,color,isFavorite
 func labelsToCSV(jsonStr string) string {
 	var labels []map[string]any
@@ -66,6 +66,7 @@ func (m *TodoistModule) ExecuteTool(ctx context.Context, name string, params map
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -75,6 +76,12 @@ func (m *TodoistModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *TodoistModule) HealthProbe() (string, map[string]any) {
+	return "list_projects", nil
+}
+
 // Resources returns all available resources (none for Todoist)
 func (m *TodoistModule) Resources() []modules.Resource {
 	return nil
@@ -104,6 +111,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "todoist", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -149,6 +157,27 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"project_id"},
 		},
 	},
+	{
+		ID:   "todoist:create_project",
+		Name: "create_project",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a new project.",
+			"ja-JP": "新しいプロジェクトを作成します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"name":        {Type: "string", Description: "Project name (required)"},
+				"description": {Type: "string", Description: "Project description"},
+				"parent_id":   {Type: "string", Description: "Parent project ID for sub-projects"},
+				"color":       {Type: "string", Description: "Color name (e.g., 'berry_red', 'blue')"},
+				"is_favorite": {Type: "boolean", Description: "Mark as favorite"},
+				"view_style":  {Type: "string", Description: "View style: list, board, or calendar"},
+			},
+			Required: []string{"name"},
+		},
+	},
 	// Tasks
 	{
 		ID:   "todoist:list_tasks",
@@ -203,6 +232,7 @@ var toolDefinitions = []modules.Tool{
 				"due_string":   {Type: "string", Description: "Due date in natural language (e.g., 'tomorrow', 'next Monday')"},
 				"due_date":     {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
 				"due_datetime":  {Type: "string", Description: "Due datetime (RFC3339 format)"},
+				"timezone":      modules.TimezoneProperty,
 				"labels":       {Type: "array", Description: "Array of label names"},
 				"assignee_id":  {Type: "string", Description: "Assignee user ID (for shared projects)"},
 			},
@@ -213,8 +243,8 @@ var toolDefinitions = []modules.Tool{
 		ID:   "todoist:update_task",
 		Name: "update_task",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing task.",
-			"ja-JP": "既存のタスクを更新します。",
+			"en-US": "Update an existing task. Omitted fields are left unchanged; pass null to clear a field (description, due_string/due_date/due_datetime, labels, assignee_id).",
+			"ja-JP": "既存のタスクを更新します。省略したフィールドは変更されません。フィールドをクリアするにはnullを指定します（description、due_string/due_date/due_datetime、labels、assignee_id）。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
@@ -227,12 +257,32 @@ var toolDefinitions = []modules.Tool{
 				"due_string":   {Type: "string", Description: "Due date in natural language"},
 				"due_date":     {Type: "string", Description: "Due date (YYYY-MM-DD format)"},
 				"due_datetime":  {Type: "string", Description: "Due datetime (RFC3339 format)"},
+				"timezone":      modules.TimezoneProperty,
 				"labels":       {Type: "array", Description: "Array of label names"},
 				"assignee_id":  {Type: "string", Description: "Assignee user ID"},
 			},
 			Required: []string{"task_id"},
 		},
 	},
+	{
+		ID:   "todoist:move_task",
+		Name: "move_task",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Move a task to another project, section, or parent task. Specify exactly one destination.",
+			"ja-JP": "タスクを別のプロジェクト、セクション、または親タスクに移動します。移動先は1つだけ指定してください。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"task_id":    {Type: "string", Description: "Task ID (required)"},
+				"project_id": {Type: "string", Description: "Destination project ID"},
+				"section_id": {Type: "string", Description: "Destination section ID"},
+				"parent_id":  {Type: "string", Description: "Destination parent task ID"},
+			},
+			Required: []string{"task_id"},
+		},
+	},
 	{
 		ID:   "todoist:complete_task",
 		Name: "complete_task",
@@ -297,6 +347,59 @@ var toolDefinitions = []modules.Tool{
 			},
 		},
 	},
+	{
+		ID:   "todoist:create_section",
+		Name: "create_section",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Create a new section in a project.",
+			"ja-JP": "プロジェクトに新しいセクションを作成します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"name":       {Type: "string", Description: "Section name (required)"},
+				"project_id": {Type: "string", Description: "Project ID (required)"},
+				"order":      {Type: "number", Description: "Position of the section among the project's sections"},
+			},
+			Required: []string{"name", "project_id"},
+		},
+	},
+	// Comments
+	{
+		ID:   "todoist:list_comments",
+		Name: "list_comments",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List comments on a task or a project. Specify either task_id or project_id.",
+			"ja-JP": "タスクまたはプロジェクトのコメントを一覧表示します。task_id または project_id のいずれかを指定してください。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"task_id":    {Type: "string", Description: "Task ID"},
+				"project_id": {Type: "string", Description: "Project ID"},
+			},
+		},
+	},
+	{
+		ID:   "todoist:add_comment",
+		Name: "add_comment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a comment to a task or a project. Specify either task_id or project_id.",
+			"ja-JP": "タスクまたはプロジェクトにコメントを追加します。task_id または project_id のいずれかを指定してください。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"task_id":    {Type: "string", Description: "Task ID"},
+				"project_id": {Type: "string", Description: "Project ID"},
+				"content":    {Type: "string", Description: "Comment text, Markdown supported (required)"},
+			},
+			Required: []string{"content"},
+		},
+	},
 	// Labels
 	{
 		ID:   "todoist:list_labels",
@@ -320,17 +423,22 @@ var toolDefinitions = []modules.Tool{
 type toolHandler func(ctx context.Context, params map[string]any) (string, error)
 
 var toolHandlers = map[string]toolHandler{
-	"list_projects": listProjects,
-	"get_project":   getProject,
-	"list_tasks":    listTasks,
-	"get_task":      getTask,
-	"create_task":   createTask,
-	"update_task":   updateTask,
-	"complete_task": completeTask,
-	"reopen_task":   reopenTask,
-	"delete_task":   deleteTask,
-	"list_sections": listSections,
-	"list_labels":   listLabels,
+	"list_projects":  listProjects,
+	"get_project":    getProject,
+	"create_project": createProject,
+	"list_tasks":     listTasks,
+	"get_task":       getTask,
+	"create_task":    createTask,
+	"update_task":    updateTask,
+	"move_task":      moveTask,
+	"complete_task":  completeTask,
+	"reopen_task":    reopenTask,
+	"delete_task":    deleteTask,
+	"list_sections":  listSections,
+	"create_section": createSection,
+	"list_comments":  listComments,
+	"add_comment":    addComment,
+	"list_labels":    listLabels,
 }
 
 // =============================================================================
@@ -370,6 +478,39 @@ func getProject(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+func createProject(ctx context.Context, params map[string]any) (string, error) {
+	name, _ := params["name"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	req := gen.CreateProjectReq{Name: name}
+	if v, ok := params["description"].(string); ok && v != "" {
+		req.Description.SetTo(v)
+	}
+	if v, ok := params["parent_id"].(string); ok && v != "" {
+		req.ParentId.SetTo(v)
+	}
+	if v, ok := params["color"].(string); ok && v != "" {
+		req.Color.SetTo(v)
+	}
+	if v, ok := params["is_favorite"].(bool); ok {
+		req.IsFavorite.SetTo(v)
+	}
+	if v, ok := params["view_style"].(string); ok && v != "" {
+		req.ViewStyle.SetTo(v)
+	}
+	res, err := c.CreateProject(ctx, &req)
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
 // =============================================================================
 // Tasks
 // =============================================================================
@@ -442,11 +583,23 @@ func createTask(ctx context.Context, params map[string]any) (string, error) {
 	if v, ok := params["due_string"].(string); ok && v != "" {
 		req.DueString.SetTo(v)
 	}
+	loc, err := modules.LocationParam(params)
+	if err != nil {
+		return "", err
+	}
 	if v, ok := params["due_date"].(string); ok && v != "" {
-		req.DueDate.SetTo(v)
+		d, err := modules.NormalizeDate(v, loc)
+		if err != nil {
+			return "", fmt.Errorf("due_date: %w", err)
+		}
+		req.DueDate.SetTo(d)
 	}
 	if v, ok := params["due_datetime"].(string); ok && v != "" {
-		req.DueDatetime.SetTo(v)
+		d, err := modules.NormalizeDateTime(v, loc)
+		if err != nil {
+			return "", fmt.Errorf("due_datetime: %w", err)
+		}
+		req.DueDatetime.SetTo(d)
 	}
 	if v, ok := params["labels"].([]interface{}); ok && len(v) > 0 {
 		req.Labels = toStringSlice(v)
@@ -472,29 +625,53 @@ func updateTask(ctx context.Context, params map[string]any) (string, error) {
 		return "", err
 	}
 	req := gen.UpdateTaskReq{}
-	if v, ok := params["content"].(string); ok && v != "" {
+	if v, op := modules.StringUpdate(params, "content"); op == modules.FieldSet {
 		req.Content.SetTo(v)
 	}
-	if v, ok := params["description"].(string); ok {
+	if v, op := modules.StringUpdate(params, "description"); op != modules.FieldUnchanged {
 		req.Description.SetTo(v)
 	}
 	if v, ok := params["priority"].(float64); ok {
 		req.Priority.SetTo(int(v))
 	}
-	if v, ok := params["due_string"].(string); ok && v != "" {
+	if v, op := modules.StringUpdate(params, "due_string"); op == modules.FieldSet {
 		req.DueString.SetTo(v)
 	}
-	if v, ok := params["due_date"].(string); ok && v != "" {
-		req.DueDate.SetTo(v)
+	loc, err := modules.LocationParam(params)
+	if err != nil {
+		return "", err
 	}
-	if v, ok := params["due_datetime"].(string); ok && v != "" {
-		req.DueDatetime.SetTo(v)
+	if v, op := modules.StringUpdate(params, "due_date"); op == modules.FieldSet {
+		d, err := modules.NormalizeDate(v, loc)
+		if err != nil {
+			return "", fmt.Errorf("due_date: %w", err)
+		}
+		req.DueDate.SetTo(d)
+	}
+	if v, op := modules.StringUpdate(params, "due_datetime"); op == modules.FieldSet {
+		d, err := modules.NormalizeDateTime(v, loc)
+		if err != nil {
+			return "", fmt.Errorf("due_datetime: %w", err)
+		}
+		req.DueDatetime.SetTo(d)
+	}
+	// Todoist removes the due date when due_string is "no date"
+	dueCleared := modules.UpdateOf(params, "due_string") == modules.FieldCleared ||
+		modules.UpdateOf(params, "due_date") == modules.FieldCleared ||
+		modules.UpdateOf(params, "due_datetime") == modules.FieldCleared
+	if dueCleared && !req.DueString.Set && !req.DueDate.Set && !req.DueDatetime.Set {
+		req.DueString.SetTo("no date")
 	}
 	if v, ok := params["labels"].([]interface{}); ok {
 		req.Labels = toStringSlice(v)
+	} else if modules.UpdateOf(params, "labels") == modules.FieldCleared {
+		req.Labels = []string{}
 	}
-	if v, ok := params["assignee_id"].(string); ok {
+	switch v, op := modules.StringUpdate(params, "assignee_id"); op {
+	case modules.FieldSet:
 		req.AssigneeId.SetTo(v)
+	case modules.FieldCleared:
+		req.AssigneeId.SetToNull()
 	}
 	res, err := c.UpdateTask(ctx, &req, gen.UpdateTaskParams{TaskId: taskID})
 	if err != nil {
@@ -507,6 +684,40 @@ func updateTask(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+func moveTask(ctx context.Context, params map[string]any) (string, error) {
+	taskID, _ := params["task_id"].(string)
+	req := gen.MoveTaskReq{}
+	destinations := 0
+	if v, ok := params["project_id"].(string); ok && v != "" {
+		req.ProjectId.SetTo(v)
+		destinations++
+	}
+	if v, ok := params["section_id"].(string); ok && v != "" {
+		req.SectionId.SetTo(v)
+		destinations++
+	}
+	if v, ok := params["parent_id"].(string); ok && v != "" {
+		req.ParentId.SetTo(v)
+		destinations++
+	}
+	if destinations != 1 {
+		return "", fmt.Errorf("specify exactly one of project_id, section_id, or parent_id")
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.MoveTask(ctx, &req, gen.MoveTaskParams{TaskId: taskID})
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
 func completeTask(ctx context.Context, params map[string]any) (string, error) {
 	taskID, _ := params["task_id"].(string)
 	c, err := newOgenClient(ctx)
@@ -570,6 +781,95 @@ func listSections(ctx context.Context, params map[string]any) (string, error) {
 	return jsonStr, nil
 }
 
+func createSection(ctx context.Context, params map[string]any) (string, error) {
+	name, _ := params["name"].(string)
+	projectID, _ := params["project_id"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	req := gen.CreateSectionReq{Name: name, ProjectId: projectID}
+	if v, ok := params["order"].(float64); ok {
+		req.Order.SetTo(int(v))
+	}
+	res, err := c.CreateSection(ctx, &req)
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
+// =============================================================================
+// Comments
+// =============================================================================
+
+// commentTarget returns the task or project ID a comment tool operates on.
+func commentTarget(params map[string]any) (taskID, projectID string, err error) {
+	taskID, _ = params["task_id"].(string)
+	projectID, _ = params["project_id"].(string)
+	if (taskID == "") == (projectID == "") {
+		return "", "", fmt.Errorf("specify either task_id or project_id")
+	}
+	return taskID, projectID, nil
+}
+
+func listComments(ctx context.Context, params map[string]any) (string, error) {
+	taskID, projectID, err := commentTarget(params)
+	if err != nil {
+		return "", err
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	p := gen.ListCommentsParams{}
+	if taskID != "" {
+		p.TaskId.SetTo(taskID)
+	} else {
+		p.ProjectId.SetTo(projectID)
+	}
+	res, err := c.ListComments(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res.Results)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
+func addComment(ctx context.Context, params map[string]any) (string, error) {
+	taskID, projectID, err := commentTarget(params)
+	if err != nil {
+		return "", err
+	}
+	content, _ := params["content"].(string)
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	req := gen.CreateCommentReq{Content: content}
+	if taskID != "" {
+		req.TaskId.SetTo(taskID)
+	} else {
+		req.ProjectId.SetTo(projectID)
+	}
+	res, err := c.CreateComment(ctx, &req)
+	if err != nil {
+		return "", err
+	}
+	jsonStr, err := toJSON(res)
+	if err != nil {
+		return "", err
+	}
+	return jsonStr, nil
+}
+
 // =============================================================================
 // Labels
 // =============================================================================
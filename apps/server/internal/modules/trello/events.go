@@ -0,0 +1,61 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	gen "mcpist/server/pkg/trelloapi/gen"
+)
+
+// =============================================================================
+// Event Subscriptions (modules.EventSource)
+// =============================================================================
+
+// Subscribe creates a Trello webhook on a model (board, list or card ID).
+// Trello sends every action on the model; event filtering is done on delivery.
+func (m *TrelloModule) Subscribe(ctx context.Context, resource string, events []string, deliveryURL string) (string, error) {
+	if resource == "" {
+		return "", fmt.Errorf("resource must be a board, list or card ID")
+	}
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	hook, err := c.CreateWebhook(ctx, gen.CreateWebhookParams{
+		CallbackURL: deliveryURL,
+		IdModel:     resource,
+		Description: gen.NewOptString("mcpist event subscription"),
+	})
+	if err != nil {
+		return "", err
+	}
+	id, ok := hook.ID.Get()
+	if !ok {
+		return "", fmt.Errorf("trello did not return a webhook ID")
+	}
+	return id, nil
+}
+
+// Unsubscribe deletes the Trello webhook created by Subscribe.
+func (m *TrelloModule) Unsubscribe(ctx context.Context, resource, hookID string) error {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return err
+	}
+	return c.DeleteWebhook(ctx, gen.DeleteWebhookParams{WebhookId: hookID})
+}
+
+// EventType returns the action type of a Trello webhook payload (e.g. "createCard").
+func (m *TrelloModule) EventType(header http.Header, body []byte) string {
+	var payload struct {
+		Action struct {
+			Type string `json:"type"`
+		} `json:"action"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ""
+	}
+	return payload.Action.Type
+}
@@ -28,6 +28,14 @@ func formatCompact(toolName, jsonStr string) string {
 		return checkItemsToCSV(jsonStr)
 	case "create_card", "update_card", "move_card":
 		return pickKeys(jsonStr, "id", "name", "idList")
+	case "add_label":
+		return pickKeys(jsonStr, "id", "name", "color", "idLabels")
+	case "add_comment":
+		return pickKeys(jsonStr, "id", "type", "date")
+	case "add_attachment":
+		return pickKeys(jsonStr, "id", "name", "url")
+	case "list_actions":
+		return actionsToCSV(jsonStr)
 	case "create_checklist":
 		return pickKeys(jsonStr, "id", "name", "idCard")
 	case "add_checklist_item", "update_checklist_item":
@@ -157,6 +165,9 @@ func cardToCompact(jsonStr string) string {
 	sb.WriteString(fmt.Sprintf("- **List**: %s\n", str(c, "idList")))
 	sb.WriteString(fmt.Sprintf("- **Board**: %s\n", str(c, "idBoard")))
 	if due := str(c, "due"); due != "" {
+		if done, ok := c["dueComplete"].(bool); ok && done {
+			due += " (complete)"
+		}
 		sb.WriteString(fmt.Sprintf("- **Due**: %s\n", due))
 	}
 	if closed, ok := c["closed"].(bool); ok && closed {
@@ -217,6 +228,50 @@ func cardToCompact(jsonStr string) string {
 	return strings.TrimSuffix(sb.String(), "\n")
 }
 
+// actionsToCSV: id,date,type,member,text
+func actionsToCSV(jsonStr string) string {
+	var actions []map[string]any
+	if err := json.Unmarshal([]byte(jsonStr), &actions); err != nil {
+		return jsonStr
+	}
+	if len(actions) == 0 {
+		return "# 0 actions"
+	}
+	var sb strings.Builder
+	sb.WriteString("```csv\nid,date,type,member,text\n")
+	for _, a := range actions {
+		var member, text string
+		if m, ok := a["memberCreator"].(map[string]any); ok {
+			member = str(m, "username")
+		}
+		if d, ok := a["data"].(map[string]any); ok {
+			text = str(d, "text")
+			if text == "" {
+				text = actionListChange(d)
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,%s\n",
+			csvEscape(str(a, "id")),
+			str(a, "date"),
+			str(a, "type"),
+			csvEscape(member),
+			csvEscape(text),
+		))
+	}
+	sb.WriteString("```")
+	return sb.String()
+}
+
+// actionListChange describes a list move ("Before → After") from action data.
+func actionListChange(data map[string]any) string {
+	before, _ := data["listBefore"].(map[string]any)
+	after, _ := data["listAfter"].(map[string]any)
+	if before == nil || after == nil {
+		return ""
+	}
+	return str(before, "name") + " → " + str(after, "name")
+}
+
 // checklistsToCSV: checklist_id,checklist_name,item_id,item_name,state
 func checklistsToCSV(jsonStr string) string {
 	var checklists []map[string]any
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"mcpist/server/internal/middleware"
 	"mcpist/server/internal/modules"
@@ -61,6 +63,7 @@ func (m *TrelloModule) ExecuteTool(ctx context.Context, name string, params map[
 	if !ok {
 		return "", fmt.Errorf("unknown tool: %s", name)
 	}
+	modules.LogTrace(ctx, m.Name(), "handler", map[string]any{"tool": name})
 	return handler(ctx, params)
 }
 
@@ -70,6 +73,12 @@ func (m *TrelloModule) ToCompact(toolName string, jsonResult string) string {
 	return formatCompact(toolName, jsonResult)
 }
 
+// HealthProbe returns the read-only tool used to check connectivity
+// Implements modules.HealthProber interface
+func (m *TrelloModule) HealthProbe() (string, map[string]any) {
+	return "list_boards", nil
+}
+
 // Resources returns all available resources (none for Trello)
 func (m *TrelloModule) Resources() []modules.Resource {
 	return nil
@@ -99,6 +108,7 @@ func getCredentials(ctx context.Context) *broker.Credentials {
 }
 
 func newOgenClient(ctx context.Context) (*gen.Client, error) {
+	modules.LogTrace(ctx, "trello", "upstream_client", nil)
 	creds := getCredentials(ctx)
 	if creds == nil {
 		return nil, fmt.Errorf("no credentials available")
@@ -205,13 +215,14 @@ var toolDefinitions = []modules.Tool{
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"list_id":    {Type: "string", Description: "List ID to add the card to"},
-				"name":       {Type: "string", Description: "Card name/title"},
-				"desc":       {Type: "string", Description: "Card description"},
-				"pos":        {Type: "string", Description: "Position: 'top', 'bottom', or a positive number"},
-				"due":        {Type: "string", Description: "Due date (ISO 8601 format)"},
-				"labels":     {Type: "string", Description: "Comma-separated label IDs"},
-				"member_ids": {Type: "string", Description: "Comma-separated member IDs"},
+				"list_id":      {Type: "string", Description: "List ID to add the card to"},
+				"name":         {Type: "string", Description: "Card name/title"},
+				"desc":         {Type: "string", Description: "Card description"},
+				"pos":          {Type: "string", Description: "Position: 'top', 'bottom', or a positive number"},
+				"due":          {Type: "string", Description: "Due date (ISO 8601 format)"},
+				"due_complete": {Type: "boolean", Description: "Mark the due date as complete"},
+				"labels":       {Type: "string", Description: "Comma-separated label IDs"},
+				"member_ids":   {Type: "string", Description: "Comma-separated member IDs"},
 			},
 			Required: []string{"list_id", "name"},
 		},
@@ -220,19 +231,20 @@ var toolDefinitions = []modules.Tool{
 		ID:   "trello:update_card",
 		Name: "update_card",
 		Descriptions: modules.LocalizedText{
-			"en-US": "Update an existing card.",
-			"ja-JP": "既存のカードを更新します。",
+			"en-US": "Update an existing card. Omitted fields are left unchanged; pass null to clear desc or due.",
+			"ja-JP": "既存のカードを更新します。省略したフィールドは変更されません。descまたはdueをクリアするにはnullを指定します。",
 		},
 		Annotations: modules.AnnotateUpdate,
 		InputSchema: modules.InputSchema{
 			Type: "object",
 			Properties: map[string]modules.Property{
-				"card_id": {Type: "string", Description: "Card ID"},
-				"name":    {Type: "string", Description: "New card name"},
-				"desc":    {Type: "string", Description: "New card description"},
-				"closed":  {Type: "boolean", Description: "Archive the card"},
-				"due":     {Type: "string", Description: "Due date (ISO 8601 format)"},
-				"list_id": {Type: "string", Description: "Move to different list"},
+				"card_id":      {Type: "string", Description: "Card ID"},
+				"name":         {Type: "string", Description: "New card name"},
+				"desc":         {Type: "string", Description: "New card description"},
+				"closed":       {Type: "boolean", Description: "Archive the card"},
+				"due":          {Type: "string", Description: "Due date (ISO 8601 format), or null to remove the due date"},
+				"due_complete": {Type: "boolean", Description: "Mark the due date as complete or incomplete"},
+				"list_id":      {Type: "string", Description: "Move to different list"},
 			},
 			Required: []string{"card_id"},
 		},
@@ -271,6 +283,97 @@ var toolDefinitions = []modules.Tool{
 			Required: []string{"card_id"},
 		},
 	},
+	// Labels
+	{
+		ID:   "trello:add_label",
+		Name: "add_label",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a label to a card. Specify label_id, or name/color to use a matching board label (a new board label is created when none matches and color is given).",
+			"ja-JP": "カードにラベルを追加します。label_id、または name/color を指定すると一致するボードのラベルを使用します（一致するラベルがなく color が指定されている場合は新しいラベルを作成します）。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"card_id":  {Type: "string", Description: "Card ID"},
+				"label_id": {Type: "string", Description: "Label ID (get from get_board or get_card)"},
+				"name":     {Type: "string", Description: "Label name, used when label_id is not given"},
+				"color":    {Type: "string", Description: "Label color: green, yellow, orange, red, purple, blue, sky, lime, pink, black"},
+			},
+			Required: []string{"card_id"},
+		},
+	},
+	{
+		ID:   "trello:remove_label",
+		Name: "remove_label",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Remove a label from a card. The label itself stays on the board.",
+			"ja-JP": "カードからラベルを外します。ラベル自体はボードに残ります。",
+		},
+		Annotations: modules.AnnotateUpdate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"card_id":  {Type: "string", Description: "Card ID"},
+				"label_id": {Type: "string", Description: "Label ID"},
+			},
+			Required: []string{"card_id", "label_id"},
+		},
+	},
+	// Comments & Attachments
+	{
+		ID:   "trello:add_comment",
+		Name: "add_comment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Add a comment to a card.",
+			"ja-JP": "カードにコメントを追加します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"card_id": {Type: "string", Description: "Card ID"},
+				"text":    {Type: "string", Description: "Comment text (Markdown supported)"},
+			},
+			Required: []string{"card_id", "text"},
+		},
+	},
+	{
+		ID:   "trello:add_attachment",
+		Name: "add_attachment",
+		Descriptions: modules.LocalizedText{
+			"en-US": "Attach a link (URL) to a card.",
+			"ja-JP": "カードにリンク（URL）を添付します。",
+		},
+		Annotations: modules.AnnotateCreate,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"card_id": {Type: "string", Description: "Card ID"},
+				"url":     {Type: "string", Description: "URL to attach"},
+				"name":    {Type: "string", Description: "Attachment display name (optional)"},
+			},
+			Required: []string{"card_id", "url"},
+		},
+	},
+	{
+		ID:   "trello:list_actions",
+		Name: "list_actions",
+		Descriptions: modules.LocalizedText{
+			"en-US": "List the activity feed of a card (comments, moves, updates), newest first.",
+			"ja-JP": "カードのアクティビティ（コメント、移動、更新）を新しい順に一覧表示します。",
+		},
+		Annotations: modules.AnnotateReadOnly,
+		InputSchema: modules.InputSchema{
+			Type: "object",
+			Properties: map[string]modules.Property{
+				"card_id": {Type: "string", Description: "Card ID"},
+				"filter":  {Type: "string", Description: "Comma-separated action types, e.g. 'commentCard' or 'all' (default: all)"},
+				"limit":   {Type: "number", Description: "Maximum number of actions (default: 50, max: 1000)"},
+			},
+			Required: []string{"card_id"},
+		},
+	},
 	// Checklists
 	{
 		ID:   "trello:get_checklists",
@@ -413,6 +516,11 @@ var toolHandlers = map[string]toolHandler{
 	"update_card":           updateCard,
 	"move_card":             moveCard,
 	"delete_card":           deleteCard,
+	"add_label":             addLabel,
+	"remove_label":          removeLabel,
+	"add_comment":           addComment,
+	"add_attachment":        addAttachment,
+	"list_actions":          listActions,
 	"get_checklists":        getChecklists,
 	"create_checklist":      createChecklist,
 	"delete_checklist":      deleteChecklist,
@@ -501,7 +609,7 @@ func getCards(ctx context.Context, params map[string]any) (string, error) {
 
 	if hasListID && listID != "" {
 		p := gen.GetCardsByListParams{ListId: listID}
-		p.Fields.SetTo("id,name,desc,due,closed,pos,labels")
+		p.Fields.SetTo("id,name,desc,due,dueComplete,closed,pos,labels")
 		res, err := c.GetCardsByList(ctx, p)
 		if err != nil {
 			return "", err
@@ -515,7 +623,7 @@ func getCards(ctx context.Context, params map[string]any) (string, error) {
 
 	if hasBoardID && boardID != "" {
 		p := gen.GetCardsByBoardParams{BoardId: boardID}
-		p.Fields.SetTo("id,name,desc,due,closed,pos,labels,idList")
+		p.Fields.SetTo("id,name,desc,due,dueComplete,closed,pos,labels,idList")
 		res, err := c.GetCardsByBoard(ctx, p)
 		if err != nil {
 			return "", err
@@ -537,7 +645,7 @@ func getCard(ctx context.Context, params map[string]any) (string, error) {
 	}
 	cardID, _ := params["card_id"].(string)
 	p := gen.GetCardParams{CardId: cardID}
-	p.Fields.SetTo("id,name,desc,due,closed,pos,labels,idList,idBoard")
+	p.Fields.SetTo("id,name,desc,due,dueComplete,closed,pos,labels,idList,idBoard")
 	p.Checklists.SetTo("all")
 	res, err := c.GetCard(ctx, p)
 	if err != nil {
@@ -568,6 +676,9 @@ func createCard(ctx context.Context, params map[string]any) (string, error) {
 	if v, ok := params["due"].(string); ok && v != "" {
 		p.Due.SetTo(v)
 	}
+	if v, ok := params["due_complete"].(bool); ok {
+		p.DueComplete.SetTo(strconv.FormatBool(v))
+	}
 	if v, ok := params["labels"].(string); ok && v != "" {
 		p.IdLabels.SetTo(v)
 	}
@@ -597,7 +708,7 @@ func updateCard(ctx context.Context, params map[string]any) (string, error) {
 	if v, ok := params["name"].(string); ok && v != "" {
 		p.Name.SetTo(v)
 	}
-	if v, ok := params["desc"].(string); ok {
+	if v, op := modules.StringUpdate(params, "desc"); op != modules.FieldUnchanged {
 		p.Desc.SetTo(v)
 	}
 	if closed, ok := params["closed"].(bool); ok {
@@ -607,8 +718,15 @@ func updateCard(ctx context.Context, params map[string]any) (string, error) {
 			p.Closed.SetTo("false")
 		}
 	}
-	if v, ok := params["due"].(string); ok && v != "" {
+	switch v, op := modules.StringUpdate(params, "due"); op {
+	case modules.FieldSet:
 		p.Due.SetTo(v)
+	case modules.FieldCleared:
+		// Trello removes the due date when due is the literal "null"
+		p.Due.SetTo("null")
+	}
+	if v, ok := params["due_complete"].(bool); ok {
+		p.DueComplete.SetTo(strconv.FormatBool(v))
 	}
 	if v, ok := params["list_id"].(string); ok && v != "" {
 		p.IdList.SetTo(v)
@@ -663,6 +781,147 @@ func deleteCard(ctx context.Context, params map[string]any) (string, error) {
 	return `{"success":true,"message":"Card deleted"}`, nil
 }
 
+// =============================================================================
+// Labels
+// =============================================================================
+
+func addLabel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	cardID, _ := params["card_id"].(string)
+	labelID, _ := params["label_id"].(string)
+	name, _ := params["name"].(string)
+	color, _ := params["color"].(string)
+
+	if labelID == "" {
+		if name == "" && color == "" {
+			return "", fmt.Errorf("label_id, name, or color is required")
+		}
+		labelID, err = findBoardLabel(ctx, c, cardID, name, color)
+		if err != nil {
+			return "", err
+		}
+	}
+	if labelID == "" {
+		// No matching label on the board; create one directly on the card
+		if color == "" {
+			return "", fmt.Errorf("no label named %q on the board; specify color to create it", name)
+		}
+		p := gen.CreateCardLabelParams{CardId: cardID, Color: color}
+		if name != "" {
+			p.Name.SetTo(name)
+		}
+		res, err := c.CreateCardLabel(ctx, p)
+		if err != nil {
+			return "", err
+		}
+		return toJSON(res)
+	}
+
+	labelIDs, err := c.AddCardLabel(ctx, gen.AddCardLabelParams{CardId: cardID, Value: labelID})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(map[string]any{"id": labelID, "idCard": cardID, "idLabels": labelIDs})
+}
+
+// findBoardLabel returns the ID of the label on the card's board matching
+// name (case-insensitive) and color. Empty criteria match any value.
+func findBoardLabel(ctx context.Context, c *gen.Client, cardID, name, color string) (string, error) {
+	cp := gen.GetCardParams{CardId: cardID}
+	cp.Fields.SetTo("idBoard")
+	card, err := c.GetCard(ctx, cp)
+	if err != nil {
+		return "", err
+	}
+	labels, err := c.GetBoardLabels(ctx, gen.GetBoardLabelsParams{BoardId: card.IdBoard.Value})
+	if err != nil {
+		return "", err
+	}
+	for _, l := range labels {
+		if name != "" && !strings.EqualFold(l.Name.Value, name) {
+			continue
+		}
+		if color != "" && l.Color.Value != color {
+			continue
+		}
+		return l.ID.Value, nil
+	}
+	return "", nil
+}
+
+func removeLabel(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	cardID, _ := params["card_id"].(string)
+	labelID, _ := params["label_id"].(string)
+	err = c.RemoveCardLabel(ctx, gen.RemoveCardLabelParams{CardId: cardID, IdLabel: labelID})
+	if err != nil {
+		return "", err
+	}
+	return `{"success":true,"message":"Label removed"}`, nil
+}
+
+// =============================================================================
+// Comments & Attachments
+// =============================================================================
+
+func addComment(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	cardID, _ := params["card_id"].(string)
+	text, _ := params["text"].(string)
+	res, err := c.AddCardComment(ctx, gen.AddCardCommentParams{CardId: cardID, Text: text})
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func addAttachment(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	cardID, _ := params["card_id"].(string)
+	attachURL, _ := params["url"].(string)
+	p := gen.AddCardAttachmentParams{CardId: cardID, URL: attachURL}
+	if v, ok := params["name"].(string); ok && v != "" {
+		p.Name.SetTo(v)
+	}
+	res, err := c.AddCardAttachment(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
+func listActions(ctx context.Context, params map[string]any) (string, error) {
+	c, err := newOgenClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	cardID, _ := params["card_id"].(string)
+	p := gen.GetCardActionsParams{CardId: cardID}
+	filter := "all"
+	if v, ok := params["filter"].(string); ok && v != "" {
+		filter = v
+	}
+	p.Filter.SetTo(filter)
+	p.Limit.SetTo(modules.PageSize(params, "limit", 50, 1000))
+	res, err := c.GetCardActions(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return toJSON(res)
+}
+
 // =============================================================================
 // Checklists
 // =============================================================================
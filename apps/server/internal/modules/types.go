@@ -1,6 +1,9 @@
 package modules
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 // =============================================================================
 // Localization (used by Console UI for multilingual tool descriptions)
@@ -41,6 +44,52 @@ type CompactConverter interface {
 	ToCompact(toolName string, jsonResult string) string
 }
 
+// ToolAliaser is an optional interface for modules that have renamed tools.
+// Aliases maps each deprecated tool name to its current name, so existing
+// integrations keep working (with a deprecation notice) after a rename.
+type ToolAliaser interface {
+	Aliases() map[string]string
+}
+
+// HealthProber is an optional interface for modules that can verify their
+// connection with a cheap read-only tool call (used by /health/modules).
+// HealthProbe returns the tool name and its params.
+type HealthProber interface {
+	HealthProbe() (string, map[string]any)
+}
+
+// EventSource is an optional interface for modules whose service can push
+// events to a webhook (used by the subscribe_events meta-tool).
+// Subscribe registers deliveryURL for events on resource (module-specific,
+// e.g. "owner/repo" or a board ID) and returns the upstream webhook ID.
+// EventType extracts the event name from a delivered request.
+type EventSource interface {
+	Subscribe(ctx context.Context, resource string, events []string, deliveryURL string) (string, error)
+	Unsubscribe(ctx context.Context, resource, hookID string) error
+	EventType(header http.Header, body []byte) string
+}
+
+// DeletePreviewer is an optional interface for modules with tools annotated
+// AnnotateConfirmedDelete. PreviewDelete summarizes what the call would delete
+// (e.g. the dashboards in a folder) without deleting anything.
+type DeletePreviewer interface {
+	PreviewDelete(ctx context.Context, toolName string, params map[string]any) (any, error)
+}
+
+// UpdateSnapshotter is an optional interface for modules whose update tools
+// support _return_diff. SnapshotForUpdate returns the JSON of the resource the
+// call changes (usually the module's own get tool), or ErrDiffUnsupported.
+type UpdateSnapshotter interface {
+	SnapshotForUpdate(ctx context.Context, toolName string, params map[string]any) (string, error)
+}
+
+// Paginator is an optional interface for modules whose list tools support
+// _fetch_all. Pagination describes how toolName pages, or returns false for
+// tools that don't take part.
+type Paginator interface {
+	Pagination(toolName string) (Pagination, bool)
+}
+
 // =============================================================================
 // Tool Definition
 // =============================================================================
@@ -51,6 +100,9 @@ type ToolAnnotations struct {
 	DestructiveHint *bool `json:"destructiveHint,omitempty"`
 	IdempotentHint  *bool `json:"idempotentHint,omitempty"`
 	OpenWorldHint   *bool `json:"openWorldHint,omitempty"`
+
+	// confirm makes the server require a confirmation token (see AnnotateConfirmedDelete)
+	confirm bool
 }
 
 // Helper to create *bool for annotation fields
@@ -84,6 +136,16 @@ var (
 		IdempotentHint:  boolPtr(true),
 		OpenWorldHint:   boolPtr(false),
 	}
+	// AnnotateConfirmedDelete: deletes with a large blast radius (cascading or bulk).
+	// The first call returns a preview and a confirmation token; the delete runs
+	// only when the call is repeated with that token.
+	AnnotateConfirmedDelete = &ToolAnnotations{
+		ReadOnlyHint:    boolPtr(false),
+		DestructiveHint: boolPtr(true),
+		IdempotentHint:  boolPtr(true),
+		OpenWorldHint:   boolPtr(false),
+		confirm:         true,
+	}
 	// AnnotateDestructive: run_query, apply_migration (destructive, non-idempotent)
 	AnnotateDestructive = &ToolAnnotations{
 		ReadOnlyHint:    boolPtr(false),
@@ -101,6 +163,7 @@ type Tool struct {
 	Descriptions LocalizedText    `json:"descriptions,omitempty"`  // Multilingual descriptions (for export)
 	InputSchema  InputSchema      `json:"inputSchema"`
 	Annotations  *ToolAnnotations `json:"annotations,omitempty"`
+	OutputSchema *OutputSchema    `json:"outputSchema,omitempty"` // Shape of the _format: "json" result (optional)
 }
 
 // InputSchema defines the input parameters for a tool
@@ -117,6 +180,17 @@ type Property struct {
 	Items       *Property `json:"items,omitempty"`
 }
 
+// OutputSchema describes the JSON result of a tool (returned with _format: "json"),
+// so clients can parse it as structured output. Objects list their known fields;
+// APIs may return additional ones.
+type OutputSchema struct {
+	Type        string                   `json:"type"`
+	Description string                   `json:"description,omitempty"`
+	Properties  map[string]*OutputSchema `json:"properties,omitempty"`
+	Items       *OutputSchema            `json:"items,omitempty"`
+	Required    []string                 `json:"required,omitempty"`
+}
+
 // =============================================================================
 // Resource Definition
 // =============================================================================
@@ -137,6 +211,9 @@ type Resource struct {
 type ToolCallResult struct {
 	Content []ContentBlock `json:"content"`
 	IsError bool           `json:"isError,omitempty"`
+	// Meta carries machine-readable error details, e.g. the upstream status and
+	// retry_after seconds of a rate-limited call
+	Meta map[string]any `json:"_meta,omitempty"`
 }
 
 // ContentBlock represents a content block in the result
@@ -2,54 +2,132 @@ package modules
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 )
 
+// ValidationError lists every missing required parameter and type mismatch
+// found for a tool call, so the caller can fix all of them in one retry.
+type ValidationError struct {
+	Missing    []string // required parameters that are absent, null, or empty strings
+	Mismatches []string // one message per parameter whose value has the wrong type
+}
+
+func (e *ValidationError) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("missing required parameter(s): %s", strings.Join(e.Missing, ", ")))
+	}
+	parts = append(parts, e.Mismatches...)
+	return strings.Join(parts, "; ")
+}
+
 // ValidateParams checks params against InputSchema.
-// - Required fields: returns error if missing
+// - Required fields: reports every missing one
 // - Type check: verifies value matches declared property type
-// - Type coercion: JSON numbers (float64) are kept as-is (handlers already expect float64)
-// Returns validated params (shallow copy) or error.
+// - Type coercion: JSON numbers (float64) are kept as-is (handlers already expect float64);
+//   string-encoded values are converted beforehand by CoerceParams
+// Returns validated params (shallow copy) or a *ValidationError.
 func ValidateParams(schema InputSchema, params map[string]any) (map[string]any, error) {
 	if params == nil {
 		params = make(map[string]any)
 	}
 
+	verr := &ValidationError{}
+
 	// Check required fields
-	var missing []string
 	for _, key := range schema.Required {
 		val, exists := params[key]
 		if !exists || val == nil {
-			missing = append(missing, key)
+			verr.Missing = append(verr.Missing, key)
 			continue
 		}
 		// Check for zero-value strings on required fields
 		if s, ok := val.(string); ok && s == "" {
-			missing = append(missing, key)
+			verr.Missing = append(verr.Missing, key)
 		}
 	}
-	if len(missing) > 0 {
-		return nil, fmt.Errorf("missing required parameter(s): %s", strings.Join(missing, ", "))
-	}
 
-	// Type check provided params against schema properties
-	for key, val := range params {
+	// Type check provided params against schema properties (sorted for stable messages)
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
 		prop, declared := schema.Properties[key]
 		if !declared {
 			// Extra params not in schema are passed through (lenient)
 			continue
 		}
+		val := params[key]
 		if val == nil {
 			continue
 		}
 		if err := checkType(key, val, prop.Type); err != nil {
-			return nil, err
+			verr.Mismatches = append(verr.Mismatches, err.Error())
 		}
 	}
 
+	if len(verr.Missing) > 0 || len(verr.Mismatches) > 0 {
+		return nil, verr
+	}
 	return params, nil
 }
 
+// ValidateToolParams coerces and validates params against the InputSchema of moduleName's toolName.
+// Unknown modules and tools pass through; dispatch reports those separately.
+func ValidateToolParams(moduleName, toolName string, params map[string]any) (map[string]any, error) {
+	m, ok := registry[moduleName]
+	if !ok {
+		return params, nil
+	}
+	tool, found := findTool(m.Tools(), toolName)
+	if !found {
+		return params, nil
+	}
+	return ValidateParams(tool.InputSchema, CoerceParams(tool.InputSchema, params))
+}
+
+// CoerceParams converts string-encoded numbers and booleans (e.g. "30", "true"),
+// which LLMs often send, to float64/bool when the schema declares number, integer
+// or boolean. Strings that don't parse are left untouched for ValidateParams to report.
+// Returns a shallow copy; params itself is not modified.
+func CoerceParams(schema InputSchema, params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+	coerced := make(map[string]any, len(params))
+	for key, val := range params {
+		coerced[key] = val
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		prop, declared := schema.Properties[key]
+		if !declared {
+			continue
+		}
+		s = strings.TrimSpace(s)
+		switch prop.Type {
+		case "number", "integer":
+			if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+				coerced[key] = f
+			}
+		case "boolean":
+			switch strings.ToLower(s) {
+			case "true":
+				coerced[key] = true
+			case "false":
+				coerced[key] = false
+			}
+		}
+	}
+	return coerced
+}
+
 // checkType verifies that val matches the expected JSON Schema type.
 func checkType(key string, val any, expectedType string) error {
 	switch expectedType {
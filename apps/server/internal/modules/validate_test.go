@@ -158,6 +158,38 @@ func TestValidateParams_TypeCheck(t *testing.T) {
 	}
 }
 
+func TestValidateParams_ReportsAllProblems(t *testing.T) {
+	schema := InputSchema{
+		Type: "object",
+		Properties: map[string]Property{
+			"owner":    {Type: "string"},
+			"repo":     {Type: "string"},
+			"per_page": {Type: "number"},
+			"draft":    {Type: "boolean"},
+		},
+		Required: []string{"owner", "repo"},
+	}
+
+	_, err := ValidateParams(schema, map[string]any{"owner": "octocat", "per_page": "ten", "draft": "yes"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T", err)
+	}
+	if len(verr.Missing) != 1 || verr.Missing[0] != "repo" {
+		t.Errorf("Missing = %v, want [repo]", verr.Missing)
+	}
+	if len(verr.Mismatches) != 2 {
+		t.Errorf("Mismatches = %v, want 2 entries", verr.Mismatches)
+	}
+	want := `missing required parameter(s): repo; parameter "draft": expected boolean, got string; parameter "per_page": expected number, got string`
+	if err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
+	}
+}
+
 func TestValidateParams_NoRequiredNoProperties(t *testing.T) {
 	// Schema with no required and no properties (e.g., get_user)
 	schema := InputSchema{
@@ -214,3 +246,43 @@ func TestFindTool(t *testing.T) {
 		t.Error("expected not to find nonexistent tool")
 	}
 }
+
+func TestCoerceParams(t *testing.T) {
+	schema := InputSchema{
+		Type: "object",
+		Properties: map[string]Property{
+			"per_page": {Type: "number"},
+			"page":     {Type: "integer"},
+			"draft":    {Type: "boolean"},
+			"name":     {Type: "string"},
+		},
+	}
+
+	params := map[string]any{"per_page": "30", "page": " 2 ", "draft": "TRUE", "name": "42", "extra": "7"}
+	got := CoerceParams(schema, params)
+
+	if got["per_page"] != float64(30) {
+		t.Errorf("per_page = %#v, want 30.0", got["per_page"])
+	}
+	if got["page"] != float64(2) {
+		t.Errorf("page = %#v, want 2.0", got["page"])
+	}
+	if got["draft"] != true {
+		t.Errorf("draft = %#v, want true", got["draft"])
+	}
+	if got["name"] != "42" {
+		t.Errorf("name = %#v, want unchanged string", got["name"])
+	}
+	if got["extra"] != "7" {
+		t.Errorf("extra = %#v, want unchanged string", got["extra"])
+	}
+	if params["per_page"] != "30" {
+		t.Error("CoerceParams must not modify the input map")
+	}
+
+	// Unparseable strings are left for ValidateParams to reject
+	got = CoerceParams(schema, map[string]any{"per_page": "thirty", "draft": "yes"})
+	if _, err := ValidateParams(schema, got); err == nil {
+		t.Error("expected validation error for unparseable values")
+	}
+}
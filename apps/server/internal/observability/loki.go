@@ -149,7 +149,7 @@ func (c *LokiClient) push(labels map[string]string, data map[string]any) {
 }
 
 // LogToolCall logs a tool call to Loki
-func LogToolCall(requestID, userID, module, tool string, durationMs int64, status string, errMsg string) {
+func LogToolCall(requestID, traceID, userID, module, tool string, durationMs int64, status string, errMsg string) {
 	level := "info"
 	if status == "error" {
 		level = "error"
@@ -162,6 +162,7 @@ func LogToolCall(requestID, userID, module, tool string, durationMs int64, statu
 
 	data := map[string]any{
 		"request_id":  requestID,
+		"trace_id":    traceID,
 		"user_id":     userID,
 		"module":      module,
 		"tool":        tool,
@@ -176,6 +177,28 @@ func LogToolCall(requestID, userID, module, tool string, durationMs int64, statu
 	Push(labels, data)
 }
 
+// LogTrace logs a step in a single MCP call's tree (handler dispatch, upstream client use)
+// so every call made on behalf of one trace ID can be correlated in Loki
+func LogTrace(traceID, requestID, module, event string, details map[string]any) {
+	labels := map[string]string{
+		"type":   "trace",
+		"module": module,
+		"level":  "info",
+	}
+
+	data := map[string]any{
+		"trace_id":   traceID,
+		"request_id": requestID,
+		"module":     module,
+		"event":      event,
+	}
+	for k, v := range details {
+		data[k] = v
+	}
+
+	Push(labels, data)
+}
+
 // LogRequest logs an incoming request to Loki
 func LogRequest(method, path string, statusCode int, durationMs int64) {
 	labels := map[string]string{
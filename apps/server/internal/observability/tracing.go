@@ -0,0 +1,85 @@
+package observability
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer resolves against the global TracerProvider, so spans started before
+// InitTracing (or with tracing disabled) are no-ops.
+var tracer = otel.Tracer("mcpist/server")
+
+// InitTracing installs an OTLP/HTTP TracerProvider as the global provider.
+// It is enabled by the standard OTEL_EXPORTER_OTLP_ENDPOINT (or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT) variable; headers, protocol details and
+// OTEL_SERVICE_NAME are read from the environment by the SDK.
+// ogen API clients pick up the global provider, so every upstream request made
+// through newOgenClient is recorded as a child span of the tool span.
+// The returned function flushes pending spans and must be called on shutdown.
+func InitTracing(ctx context.Context) func(context.Context) error {
+	noop := func(context.Context) error { return nil }
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		log.Println("OpenTelemetry not configured, tracing disabled")
+		return noop
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		log.Printf("OpenTelemetry: failed to create OTLP exporter: %v", err)
+		return noop
+	}
+
+	appName := os.Getenv("APP_ENV")
+	if appName == "" {
+		appName = "mcpist-dev"
+	}
+	// Attributes first so OTEL_SERVICE_NAME / OTEL_RESOURCE_ATTRIBUTES override them
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "mcpist-server"),
+			attribute.String("deployment.environment", appName),
+			attribute.String("service.instance.id", firstNonEmpty(
+				os.Getenv("INSTANCE_ID"),
+				os.Getenv("RENDER_INSTANCE_ID"),
+				os.Getenv("KOYEB_INSTANCE_ID"),
+				"local",
+			)),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		log.Printf("OpenTelemetry: failed to build resource: %v", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Println("OpenTelemetry tracing initialized")
+	return tp.Shutdown
+}
+
+// StartToolSpan starts the span wrapping one module tool execution.
+// traceID is the MCP call's own trace ID, recorded so spans can be joined with Loki logs.
+func StartToolSpan(ctx context.Context, module, tool, traceID string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, module+":"+tool,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("mcpist.module", module),
+			attribute.String("mcpist.tool", tool),
+			attribute.String("mcpist.trace_id", traceID),
+		),
+	)
+}
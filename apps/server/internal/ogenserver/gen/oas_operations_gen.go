@@ -28,6 +28,7 @@ const (
 	ListOAuthConsentsOperation       OperationName = "ListOAuthConsents"
 	ListPlansOperation               OperationName = "ListPlans"
 	ListPromptsOperation             OperationName = "ListPrompts"
+	ListToolCallsOperation           OperationName = "ListToolCalls"
 	RegisterUserOperation            OperationName = "RegisterUser"
 	RevokeApiKeyOperation            OperationName = "RevokeApiKey"
 	RevokeOAuthConsentOperation      OperationName = "RevokeOAuthConsent"
@@ -574,6 +574,123 @@ func decodeListPromptsParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// ListToolCallsParams is parameters of listToolCalls operation.
+type ListToolCallsParams struct {
+	// Filter by module name.
+	Module OptString `json:",omitempty,omitzero"`
+	// Maximum records to return (default 50, max 200).
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListToolCallsParams(packed middleware.Parameters) (params ListToolCallsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "module",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Module = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListToolCallsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListToolCallsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: module.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "module",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModuleVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModuleVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Module.SetTo(paramsDotModuleVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "module",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // RevokeApiKeyParams is parameters of revokeApiKey operation.
 type RevokeApiKeyParams struct {
 	ID string
@@ -47,6 +47,9 @@ var (
 	rn43AllowedHeaders = map[string]string{
 		"PUT": "Content-Type,X-Gateway-Token",
 	}
+	rn44AllowedHeaders = map[string]string{
+		"GET": "X-Gateway-Token",
+	}
 	rn30AllowedHeaders = map[string]string{
 		"GET": "X-Gateway-Token",
 	}
@@ -871,6 +874,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
+					case 't': // Prefix: "tool-calls"
+
+						if l := len("tool-calls"); len(elem) >= l && elem[0:l] == "tool-calls" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListToolCallsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn44AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
 					case 'u': // Prefix: "usage"
 
 						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
@@ -1859,6 +1887,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
+					case 't': // Prefix: "tool-calls"
+
+						if l := len("tool-calls"); len(elem) >= l && elem[0:l] == "tool-calls" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListToolCallsOperation
+								r.summary = "List tool calls"
+								r.operationID = "listToolCalls"
+								r.operationGroup = ""
+								r.pathPattern = "/v1/me/tool-calls"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					case 'u': // Prefix: "usage"
 
 						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
@@ -1587,6 +1587,109 @@ func (s *SuccessResult) SetSuccess(val bool) {
 	s.Success = val
 }
 
+// Ref: #/components/schemas/ToolCallRecord
+type ToolCallRecord struct {
+	ID         string       `json:"id"`
+	RequestID  OptNilString `json:"request_id"`
+	MetaTool   string       `json:"meta_tool"`
+	Module     string       `json:"module"`
+	Tool       string       `json:"tool"`
+	Annotation string       `json:"annotation"`
+	Status     string       `json:"status"`
+	DurationMs int          `json:"duration_ms"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ToolCallRecord) GetID() string {
+	return s.ID
+}
+
+// GetRequestID returns the value of RequestID.
+func (s *ToolCallRecord) GetRequestID() OptNilString {
+	return s.RequestID
+}
+
+// GetMetaTool returns the value of MetaTool.
+func (s *ToolCallRecord) GetMetaTool() string {
+	return s.MetaTool
+}
+
+// GetModule returns the value of Module.
+func (s *ToolCallRecord) GetModule() string {
+	return s.Module
+}
+
+// GetTool returns the value of Tool.
+func (s *ToolCallRecord) GetTool() string {
+	return s.Tool
+}
+
+// GetAnnotation returns the value of Annotation.
+func (s *ToolCallRecord) GetAnnotation() string {
+	return s.Annotation
+}
+
+// GetStatus returns the value of Status.
+func (s *ToolCallRecord) GetStatus() string {
+	return s.Status
+}
+
+// GetDurationMs returns the value of DurationMs.
+func (s *ToolCallRecord) GetDurationMs() int {
+	return s.DurationMs
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ToolCallRecord) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ToolCallRecord) SetID(val string) {
+	s.ID = val
+}
+
+// SetRequestID sets the value of RequestID.
+func (s *ToolCallRecord) SetRequestID(val OptNilString) {
+	s.RequestID = val
+}
+
+// SetMetaTool sets the value of MetaTool.
+func (s *ToolCallRecord) SetMetaTool(val string) {
+	s.MetaTool = val
+}
+
+// SetModule sets the value of Module.
+func (s *ToolCallRecord) SetModule(val string) {
+	s.Module = val
+}
+
+// SetTool sets the value of Tool.
+func (s *ToolCallRecord) SetTool(val string) {
+	s.Tool = val
+}
+
+// SetAnnotation sets the value of Annotation.
+func (s *ToolCallRecord) SetAnnotation(val string) {
+	s.Annotation = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ToolCallRecord) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetDurationMs sets the value of DurationMs.
+func (s *ToolCallRecord) SetDurationMs(val int) {
+	s.DurationMs = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ToolCallRecord) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
 // Ref: #/components/schemas/UpdatePromptBody
 type UpdatePromptBody struct {
 	Name        string    `json:"name"`
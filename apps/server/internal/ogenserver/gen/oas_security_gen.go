@@ -55,6 +55,7 @@ var operationRolesGatewayToken = map[string][]string{
 	ListOAuthAppsOperation:           []string{},
 	ListOAuthConsentsOperation:       []string{},
 	ListPromptsOperation:             []string{},
+	ListToolCallsOperation:           []string{},
 	RegisterUserOperation:            []string{},
 	RevokeApiKeyOperation:            []string{},
 	RevokeOAuthConsentOperation:      []string{},
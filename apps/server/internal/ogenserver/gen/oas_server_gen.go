@@ -140,6 +140,12 @@ type Handler interface {
 	//
 	// GET /v1/me/prompts
 	ListPrompts(ctx context.Context, params ListPromptsParams) ([]Prompt, error)
+	// ListToolCalls implements listToolCalls operation.
+	//
+	// List tool calls.
+	//
+	// GET /v1/me/tool-calls
+	ListToolCalls(ctx context.Context, params ListToolCallsParams) ([]ToolCallRecord, error)
 	// RegisterUser implements registerUser operation.
 	//
 	// Register or find existing user from Clerk ID.
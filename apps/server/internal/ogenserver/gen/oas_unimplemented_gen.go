@@ -211,6 +211,15 @@ func (UnimplementedHandler) ListPrompts(ctx context.Context, params ListPromptsP
 	return r, ht.ErrNotImplemented
 }
 
+// ListToolCalls implements listToolCalls operation.
+//
+// List tool calls.
+//
+// GET /v1/me/tool-calls
+func (UnimplementedHandler) ListToolCalls(ctx context.Context, params ListToolCallsParams) (r []ToolCallRecord, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RegisterUser implements registerUser operation.
 //
 // Register or find existing user from Clerk ID.
@@ -355,6 +355,41 @@ func (h *handler) RevokeApiKey(ctx context.Context, params gen.RevokeApiKeyParam
 	return &gen.SuccessResult{Success: true}, nil
 }
 
+// ── Tool Calls ───────────────────────────────────────────────
+
+func (h *handler) ListToolCalls(ctx context.Context, params gen.ListToolCallsParams) ([]gen.ToolCallRecord, error) {
+	var modulePtr *string
+	if m, ok := params.Module.Get(); ok {
+		modulePtr = &m
+	}
+	limit := params.Limit.Or(50)
+	switch {
+	case limit < 1:
+		limit = 50
+	case limit > 200:
+		limit = 200
+	}
+	calls, err := db.ListToolCalls(h.db, getUserID(ctx), modulePtr, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tool calls")
+	}
+	out := make([]gen.ToolCallRecord, len(calls))
+	for i, c := range calls {
+		out[i] = gen.ToolCallRecord{
+			ID:         c.ID,
+			RequestID:  optNilStringFromPtr(c.RequestID),
+			MetaTool:   c.MetaTool,
+			Module:     c.Module,
+			Tool:       c.Tool,
+			Annotation: c.Annotation,
+			Status:     c.Status,
+			DurationMs: c.DurationMs,
+			CreatedAt:  c.CreatedAt,
+		}
+	}
+	return out, nil
+}
+
 // ── Prompts ──────────────────────────────────────────────────
 
 func (h *handler) ListPrompts(ctx context.Context, params gen.ListPromptsParams) ([]gen.Prompt, error) {
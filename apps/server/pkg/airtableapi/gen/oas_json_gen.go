@@ -1236,6 +1236,39 @@ func (s *OptString) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes UpdateRecordsReqPerformUpsert as json.
+func (o OptUpdateRecordsReqPerformUpsert) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UpdateRecordsReqPerformUpsert from json.
+func (o *OptUpdateRecordsReqPerformUpsert) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUpdateRecordsReqPerformUpsert to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUpdateRecordsReqPerformUpsert) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUpdateRecordsReqPerformUpsert) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *Record) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -1710,11 +1743,18 @@ func (s *UpdateRecordsReq) encodeFields(e *jx.Encoder) {
 			s.Typecast.Encode(e)
 		}
 	}
+	{
+		if s.PerformUpsert.Set {
+			e.FieldStart("performUpsert")
+			s.PerformUpsert.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateRecordsReq = [2]string{
+var jsonFieldsNameOfUpdateRecordsReq = [3]string{
 	0: "records",
 	1: "typecast",
+	2: "performUpsert",
 }
 
 // Decode decodes UpdateRecordsReq from json.
@@ -1754,6 +1794,16 @@ func (s *UpdateRecordsReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"typecast\"")
 			}
+		case "performUpsert":
+			if err := func() error {
+				s.PerformUpsert.Reset()
+				if err := s.PerformUpsert.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"performUpsert\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -1810,6 +1860,114 @@ func (s *UpdateRecordsReq) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *UpdateRecordsReqPerformUpsert) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpdateRecordsReqPerformUpsert) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("fieldsToMergeOn")
+		e.ArrStart()
+		for _, elem := range s.FieldsToMergeOn {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfUpdateRecordsReqPerformUpsert = [1]string{
+	0: "fieldsToMergeOn",
+}
+
+// Decode decodes UpdateRecordsReqPerformUpsert from json.
+func (s *UpdateRecordsReqPerformUpsert) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateRecordsReqPerformUpsert to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "fieldsToMergeOn":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.FieldsToMergeOn = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.FieldsToMergeOn = append(s.FieldsToMergeOn, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fieldsToMergeOn\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateRecordsReqPerformUpsert")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUpdateRecordsReqPerformUpsert) {
+					name = jsonFieldsNameOfUpdateRecordsReqPerformUpsert[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateRecordsReqPerformUpsert) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateRecordsReqPerformUpsert) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *UpdateRecordsReqRecordsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -1909,10 +2067,32 @@ func (s *UpdateRecordsResponse) encodeFields(e *jx.Encoder) {
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.CreatedRecords != nil {
+			e.FieldStart("createdRecords")
+			e.ArrStart()
+			for _, elem := range s.CreatedRecords {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.UpdatedRecords != nil {
+			e.FieldStart("updatedRecords")
+			e.ArrStart()
+			for _, elem := range s.UpdatedRecords {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateRecordsResponse = [1]string{
+var jsonFieldsNameOfUpdateRecordsResponse = [3]string{
 	0: "records",
+	1: "createdRecords",
+	2: "updatedRecords",
 }
 
 // Decode decodes UpdateRecordsResponse from json.
@@ -1940,6 +2120,44 @@ func (s *UpdateRecordsResponse) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"records\"")
 			}
+		case "createdRecords":
+			if err := func() error {
+				s.CreatedRecords = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.CreatedRecords = append(s.CreatedRecords, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"createdRecords\"")
+			}
+		case "updatedRecords":
+			if err := func() error {
+				s.UpdatedRecords = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.UpdatedRecords = append(s.UpdatedRecords, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updatedRecords\"")
+			}
 		default:
 			return d.Skip()
 		}
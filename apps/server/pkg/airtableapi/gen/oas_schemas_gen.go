@@ -706,6 +706,52 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptUpdateRecordsReqPerformUpsert returns new OptUpdateRecordsReqPerformUpsert with value set to v.
+func NewOptUpdateRecordsReqPerformUpsert(v UpdateRecordsReqPerformUpsert) OptUpdateRecordsReqPerformUpsert {
+	return OptUpdateRecordsReqPerformUpsert{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateRecordsReqPerformUpsert is optional UpdateRecordsReqPerformUpsert.
+type OptUpdateRecordsReqPerformUpsert struct {
+	Value UpdateRecordsReqPerformUpsert
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateRecordsReqPerformUpsert was set.
+func (o OptUpdateRecordsReqPerformUpsert) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateRecordsReqPerformUpsert) Reset() {
+	var v UpdateRecordsReqPerformUpsert
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateRecordsReqPerformUpsert) SetTo(v UpdateRecordsReqPerformUpsert) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateRecordsReqPerformUpsert) Get() (v UpdateRecordsReqPerformUpsert, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateRecordsReqPerformUpsert) Or(d UpdateRecordsReqPerformUpsert) UpdateRecordsReqPerformUpsert {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/Record
 type Record struct {
 	ID          OptString       `json:"id"`
@@ -857,8 +903,9 @@ func (s *TableListResponse) SetTables(val []Table) {
 
 // Ref: #/components/schemas/UpdateRecordsReq
 type UpdateRecordsReq struct {
-	Records  []UpdateRecordsReqRecordsItem `json:"records"`
-	Typecast OptBool                       `json:"typecast"`
+	Records       []UpdateRecordsReqRecordsItem    `json:"records"`
+	Typecast      OptBool                          `json:"typecast"`
+	PerformUpsert OptUpdateRecordsReqPerformUpsert `json:"performUpsert"`
 }
 
 // GetRecords returns the value of Records.
@@ -871,6 +918,11 @@ func (s *UpdateRecordsReq) GetTypecast() OptBool {
 	return s.Typecast
 }
 
+// GetPerformUpsert returns the value of PerformUpsert.
+func (s *UpdateRecordsReq) GetPerformUpsert() OptUpdateRecordsReqPerformUpsert {
+	return s.PerformUpsert
+}
+
 // SetRecords sets the value of Records.
 func (s *UpdateRecordsReq) SetRecords(val []UpdateRecordsReqRecordsItem) {
 	s.Records = val
@@ -881,6 +933,25 @@ func (s *UpdateRecordsReq) SetTypecast(val OptBool) {
 	s.Typecast = val
 }
 
+// SetPerformUpsert sets the value of PerformUpsert.
+func (s *UpdateRecordsReq) SetPerformUpsert(val OptUpdateRecordsReqPerformUpsert) {
+	s.PerformUpsert = val
+}
+
+type UpdateRecordsReqPerformUpsert struct {
+	FieldsToMergeOn []string `json:"fieldsToMergeOn"`
+}
+
+// GetFieldsToMergeOn returns the value of FieldsToMergeOn.
+func (s *UpdateRecordsReqPerformUpsert) GetFieldsToMergeOn() []string {
+	return s.FieldsToMergeOn
+}
+
+// SetFieldsToMergeOn sets the value of FieldsToMergeOn.
+func (s *UpdateRecordsReqPerformUpsert) SetFieldsToMergeOn(val []string) {
+	s.FieldsToMergeOn = val
+}
+
 type UpdateRecordsReqRecordsItem struct {
 	ID     OptString       `json:"id"`
 	Fields OptRecordFields `json:"fields"`
@@ -908,7 +979,9 @@ func (s *UpdateRecordsReqRecordsItem) SetFields(val OptRecordFields) {
 
 // Ref: #/components/schemas/UpdateRecordsResponse
 type UpdateRecordsResponse struct {
-	Records []Record `json:"records"`
+	Records        []Record `json:"records"`
+	CreatedRecords []string `json:"createdRecords"`
+	UpdatedRecords []string `json:"updatedRecords"`
 }
 
 // GetRecords returns the value of Records.
@@ -916,11 +989,31 @@ func (s *UpdateRecordsResponse) GetRecords() []Record {
 	return s.Records
 }
 
+// GetCreatedRecords returns the value of CreatedRecords.
+func (s *UpdateRecordsResponse) GetCreatedRecords() []string {
+	return s.CreatedRecords
+}
+
+// GetUpdatedRecords returns the value of UpdatedRecords.
+func (s *UpdateRecordsResponse) GetUpdatedRecords() []string {
+	return s.UpdatedRecords
+}
+
 // SetRecords sets the value of Records.
 func (s *UpdateRecordsResponse) SetRecords(val []Record) {
 	s.Records = val
 }
 
+// SetCreatedRecords sets the value of CreatedRecords.
+func (s *UpdateRecordsResponse) SetCreatedRecords(val []string) {
+	s.CreatedRecords = val
+}
+
+// SetUpdatedRecords sets the value of UpdatedRecords.
+func (s *UpdateRecordsResponse) SetUpdatedRecords(val []string) {
+	s.UpdatedRecords = val
+}
+
 // Ref: #/components/schemas/UpdateTableReq
 type UpdateTableReq struct {
 	Name        OptString `json:"name"`
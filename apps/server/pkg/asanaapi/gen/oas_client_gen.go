@@ -28,12 +28,24 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AddTaskProject invokes addTaskProject operation.
+	//
+	// Add a project to a task.
+	//
+	// POST /tasks/{task_gid}/addProject
+	AddTaskProject(ctx context.Context, request *AddTaskProjectRequest, params AddTaskProjectParams) (*EmptyDataResponse, error)
 	// AddTaskToSection invokes addTaskToSection operation.
 	//
 	// Add a task to a section.
 	//
 	// POST /sections/{section_gid}/addTask
 	AddTaskToSection(ctx context.Context, request *AddTaskToSectionRequest, params AddTaskToSectionParams) (*EmptyDataResponse, error)
+	// CreatePortfolio invokes createPortfolio operation.
+	//
+	// Create a portfolio.
+	//
+	// POST /portfolios
+	CreatePortfolio(ctx context.Context, request *CreatePortfolioRequest) (*PortfolioResponse, error)
 	// CreateProject invokes createProject operation.
 	//
 	// Create a project.
@@ -82,12 +94,30 @@ type Invoker interface {
 	//
 	// DELETE /tasks/{task_gid}
 	DeleteTask(ctx context.Context, params DeleteTaskParams) (*EmptyDataResponse, error)
+	// GetEvents invokes getEvents operation.
+	//
+	// Get events on a resource.
+	//
+	// GET /events
+	GetEvents(ctx context.Context, params GetEventsParams) (GetEventsRes, error)
 	// GetMe invokes getMe operation.
 	//
 	// Get the current user.
 	//
 	// GET /users/me
 	GetMe(ctx context.Context) (*UserResponse, error)
+	// GetPortfolio invokes getPortfolio operation.
+	//
+	// Get a portfolio.
+	//
+	// GET /portfolios/{portfolio_gid}
+	GetPortfolio(ctx context.Context, params GetPortfolioParams) (*PortfolioResponse, error)
+	// GetPortfolioItems invokes getPortfolioItems operation.
+	//
+	// Get projects in a portfolio.
+	//
+	// GET /portfolios/{portfolio_gid}/items
+	GetPortfolioItems(ctx context.Context, params GetPortfolioItemsParams) (*ProjectListResponse, error)
 	// GetProject invokes getProject operation.
 	//
 	// Get a project.
@@ -100,12 +130,24 @@ type Invoker interface {
 	//
 	// GET /tasks/{task_gid}
 	GetTask(ctx context.Context, params GetTaskParams) (*TaskResponse, error)
+	// GetUser invokes getUser operation.
+	//
+	// Get a user.
+	//
+	// GET /users/{user_gid}
+	GetUser(ctx context.Context, params GetUserParams) (*UserResponse, error)
 	// GetWorkspace invokes getWorkspace operation.
 	//
 	// Get a workspace.
 	//
 	// GET /workspaces/{workspace_gid}
 	GetWorkspace(ctx context.Context, params GetWorkspaceParams) (*WorkspaceResponse, error)
+	// ListPortfolios invokes listPortfolios operation.
+	//
+	// List portfolios owned by a user in a workspace.
+	//
+	// GET /portfolios
+	ListPortfolios(ctx context.Context, params ListPortfoliosParams) (*PortfolioListResponse, error)
 	// ListProjectsByTeam invokes listProjectsByTeam operation.
 	//
 	// List projects in a team.
@@ -160,12 +202,24 @@ type Invoker interface {
 	//
 	// GET /sections/{section_gid}/tasks
 	ListTasksBySection(ctx context.Context, params ListTasksBySectionParams) (*TaskListResponse, error)
+	// ListUsersByWorkspace invokes listUsersByWorkspace operation.
+	//
+	// List users in a workspace.
+	//
+	// GET /workspaces/{workspace_gid}/users
+	ListUsersByWorkspace(ctx context.Context, params ListUsersByWorkspaceParams) (*UserListResponse, error)
 	// ListWorkspaces invokes listWorkspaces operation.
 	//
 	// List all workspaces.
 	//
 	// GET /workspaces
 	ListWorkspaces(ctx context.Context) (*WorkspaceListResponse, error)
+	// RemoveTaskProject invokes removeTaskProject operation.
+	//
+	// Remove a project from a task.
+	//
+	// POST /tasks/{task_gid}/removeProject
+	RemoveTaskProject(ctx context.Context, request *RemoveTaskProjectRequest, params RemoveTaskProjectParams) (*EmptyDataResponse, error)
 	// SearchTasks invokes searchTasks operation.
 	//
 	// Search tasks in a workspace.
@@ -227,6 +281,134 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// AddTaskProject invokes addTaskProject operation.
+//
+// Add a project to a task.
+//
+// POST /tasks/{task_gid}/addProject
+func (c *Client) AddTaskProject(ctx context.Context, request *AddTaskProjectRequest, params AddTaskProjectParams) (*EmptyDataResponse, error) {
+	res, err := c.sendAddTaskProject(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendAddTaskProject(ctx context.Context, request *AddTaskProjectRequest, params AddTaskProjectParams) (res *EmptyDataResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("addTaskProject"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/tasks/{task_gid}/addProject"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AddTaskProjectOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/tasks/"
+	{
+		// Encode "task_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "task_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.TaskGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/addProject"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAddTaskProjectRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AddTaskProjectOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAddTaskProjectResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // AddTaskToSection invokes addTaskToSection operation.
 //
 // Add a task to a section.
@@ -355,6 +537,115 @@ func (c *Client) sendAddTaskToSection(ctx context.Context, request *AddTaskToSec
 	return result, nil
 }
 
+// CreatePortfolio invokes createPortfolio operation.
+//
+// Create a portfolio.
+//
+// POST /portfolios
+func (c *Client) CreatePortfolio(ctx context.Context, request *CreatePortfolioRequest) (*PortfolioResponse, error) {
+	res, err := c.sendCreatePortfolio(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreatePortfolio(ctx context.Context, request *CreatePortfolioRequest) (res *PortfolioResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createPortfolio"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/portfolios"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreatePortfolioOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/portfolios"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreatePortfolioRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CreatePortfolioOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreatePortfolioResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // CreateProject invokes createProject operation.
 //
 // Create a project.
@@ -1314,21 +1605,21 @@ func (c *Client) sendDeleteTask(ctx context.Context, params DeleteTaskParams) (r
 	return result, nil
 }
 
-// GetMe invokes getMe operation.
+// GetEvents invokes getEvents operation.
 //
-// Get the current user.
+// Get events on a resource.
 //
-// GET /users/me
-func (c *Client) GetMe(ctx context.Context) (*UserResponse, error) {
-	res, err := c.sendGetMe(ctx)
+// GET /events
+func (c *Client) GetEvents(ctx context.Context, params GetEventsParams) (GetEventsRes, error) {
+	res, err := c.sendGetEvents(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
+func (c *Client) sendGetEvents(ctx context.Context, params GetEventsParams) (res GetEventsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getMe"),
+		otelogen.OperationID("getEvents"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/users/me"),
+		semconv.URLTemplateKey.String("/events"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1344,7 +1635,7 @@ func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetMeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEventsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1362,21 +1653,56 @@ func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/users/me"
+	pathParts[0] = "/events"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "resource" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Resource))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sync" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sync",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sync.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetMeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetEventsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1412,7 +1738,7 @@ func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetMeResponse(resp)
+	result, err := decodeGetEventsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1420,21 +1746,21 @@ func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
 	return result, nil
 }
 
-// GetProject invokes getProject operation.
+// GetMe invokes getMe operation.
 //
-// Get a project.
+// Get the current user.
 //
-// GET /projects/{project_gid}
-func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (*ProjectResponse, error) {
-	res, err := c.sendGetProject(ctx, params)
+// GET /users/me
+func (c *Client) GetMe(ctx context.Context) (*UserResponse, error) {
+	res, err := c.sendGetMe(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res *ProjectResponse, err error) {
+func (c *Client) sendGetMe(ctx context.Context) (res *UserResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getProject"),
+		otelogen.OperationID("getMe"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{project_gid}"),
+		semconv.URLTemplateKey.String("/users/me"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1450,7 +1776,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetMeOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1467,26 +1793,8 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/projects/"
-	{
-		// Encode "project_gid" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_gid",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectGid))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/users/me"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1500,7 +1808,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetProjectOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetMeOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1536,7 +1844,7 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetProjectResponse(resp)
+	result, err := decodeGetMeResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1544,21 +1852,21 @@ func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (r
 	return result, nil
 }
 
-// GetTask invokes getTask operation.
+// GetPortfolio invokes getPortfolio operation.
 //
-// Get a task.
+// Get a portfolio.
 //
-// GET /tasks/{task_gid}
-func (c *Client) GetTask(ctx context.Context, params GetTaskParams) (*TaskResponse, error) {
-	res, err := c.sendGetTask(ctx, params)
+// GET /portfolios/{portfolio_gid}
+func (c *Client) GetPortfolio(ctx context.Context, params GetPortfolioParams) (*PortfolioResponse, error) {
+	res, err := c.sendGetPortfolio(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *TaskResponse, err error) {
+func (c *Client) sendGetPortfolio(ctx context.Context, params GetPortfolioParams) (res *PortfolioResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTask"),
+		otelogen.OperationID("getPortfolio"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks/{task_gid}"),
+		semconv.URLTemplateKey.String("/portfolios/{portfolio_gid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1574,7 +1882,7 @@ func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *Ta
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetTaskOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPortfolioOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1592,16 +1900,16 @@ func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *Ta
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/tasks/"
+	pathParts[0] = "/portfolios/"
 	{
-		// Encode "task_gid" parameter.
+		// Encode "portfolio_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "task_gid",
+			Param:   "portfolio_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.TaskGid))
+			return e.EncodeValue(conv.StringToString(params.PortfolioGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1624,7 +1932,7 @@ func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *Ta
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetTaskOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetPortfolioOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1660,7 +1968,7 @@ func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *Ta
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetTaskResponse(resp)
+	result, err := decodeGetPortfolioResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1668,21 +1976,21 @@ func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *Ta
 	return result, nil
 }
 
-// GetWorkspace invokes getWorkspace operation.
+// GetPortfolioItems invokes getPortfolioItems operation.
 //
-// Get a workspace.
+// Get projects in a portfolio.
 //
-// GET /workspaces/{workspace_gid}
-func (c *Client) GetWorkspace(ctx context.Context, params GetWorkspaceParams) (*WorkspaceResponse, error) {
-	res, err := c.sendGetWorkspace(ctx, params)
+// GET /portfolios/{portfolio_gid}/items
+func (c *Client) GetPortfolioItems(ctx context.Context, params GetPortfolioItemsParams) (*ProjectListResponse, error) {
+	res, err := c.sendGetPortfolioItems(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams) (res *WorkspaceResponse, err error) {
+func (c *Client) sendGetPortfolioItems(ctx context.Context, params GetPortfolioItemsParams) (res *ProjectListResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getWorkspace"),
+		otelogen.OperationID("getPortfolioItems"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/workspaces/{workspace_gid}"),
+		semconv.URLTemplateKey.String("/portfolios/{portfolio_gid}/items"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1698,7 +2006,7 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetWorkspaceOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPortfolioItemsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1715,17 +2023,17 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/workspaces/"
+	var pathParts [3]string
+	pathParts[0] = "/portfolios/"
 	{
-		// Encode "workspace_gid" parameter.
+		// Encode "portfolio_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "workspace_gid",
+			Param:   "portfolio_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.WorkspaceGid))
+			return e.EncodeValue(conv.StringToString(params.PortfolioGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1735,8 +2043,30 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/items"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "opt_fields" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "opt_fields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OptFields.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -1748,7 +2078,7 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetWorkspaceOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetPortfolioItemsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1784,7 +2114,7 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetWorkspaceResponse(resp)
+	result, err := decodeGetPortfolioItemsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1792,21 +2122,21 @@ func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams
 	return result, nil
 }
 
-// ListProjectsByTeam invokes listProjectsByTeam operation.
+// GetProject invokes getProject operation.
 //
-// List projects in a team.
+// Get a project.
 //
-// GET /teams/{team_gid}/projects
-func (c *Client) ListProjectsByTeam(ctx context.Context, params ListProjectsByTeamParams) (*ProjectListResponse, error) {
-	res, err := c.sendListProjectsByTeam(ctx, params)
+// GET /projects/{project_gid}
+func (c *Client) GetProject(ctx context.Context, params GetProjectParams) (*ProjectResponse, error) {
+	res, err := c.sendGetProject(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjectsByTeamParams) (res *ProjectListResponse, err error) {
+func (c *Client) sendGetProject(ctx context.Context, params GetProjectParams) (res *ProjectResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listProjectsByTeam"),
+		otelogen.OperationID("getProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/teams/{team_gid}/projects"),
+		semconv.URLTemplateKey.String("/projects/{project_gid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1822,7 +2152,7 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsByTeamOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1839,17 +2169,17 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/teams/"
+	var pathParts [2]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "team_gid" parameter.
+		// Encode "project_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "team_gid",
+			Param:   "project_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.TeamGid))
+			return e.EncodeValue(conv.StringToString(params.ProjectGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1859,30 +2189,8 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/projects"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "archived" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "archived",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Archived.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -1894,7 +2202,7 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListProjectsByTeamOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1930,7 +2238,7 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListProjectsByTeamResponse(resp)
+	result, err := decodeGetProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1938,21 +2246,21 @@ func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjects
 	return result, nil
 }
 
-// ListProjectsByWorkspace invokes listProjectsByWorkspace operation.
+// GetTask invokes getTask operation.
 //
-// List projects in a workspace.
+// Get a task.
 //
-// GET /workspaces/{workspace_gid}/projects
-func (c *Client) ListProjectsByWorkspace(ctx context.Context, params ListProjectsByWorkspaceParams) (*ProjectListResponse, error) {
-	res, err := c.sendListProjectsByWorkspace(ctx, params)
+// GET /tasks/{task_gid}
+func (c *Client) GetTask(ctx context.Context, params GetTaskParams) (*TaskResponse, error) {
+	res, err := c.sendGetTask(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListProjectsByWorkspaceParams) (res *ProjectListResponse, err error) {
+func (c *Client) sendGetTask(ctx context.Context, params GetTaskParams) (res *TaskResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listProjectsByWorkspace"),
+		otelogen.OperationID("getTask"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/workspaces/{workspace_gid}/projects"),
+		semconv.URLTemplateKey.String("/tasks/{task_gid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1968,7 +2276,7 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsByWorkspaceOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetTaskOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1985,17 +2293,17 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/workspaces/"
+	var pathParts [2]string
+	pathParts[0] = "/tasks/"
 	{
-		// Encode "workspace_gid" parameter.
+		// Encode "task_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "workspace_gid",
+			Param:   "task_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.WorkspaceGid))
+			return e.EncodeValue(conv.StringToString(params.TaskGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2005,30 +2313,8 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/projects"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "archived" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "archived",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Archived.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -2040,7 +2326,7 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListProjectsByWorkspaceOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetTaskOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2076,7 +2362,7 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListProjectsByWorkspaceResponse(resp)
+	result, err := decodeGetTaskResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2084,21 +2370,21 @@ func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListPro
 	return result, nil
 }
 
-// ListSections invokes listSections operation.
+// GetUser invokes getUser operation.
 //
-// List sections in a project.
+// Get a user.
 //
-// GET /projects/{project_gid}/sections
-func (c *Client) ListSections(ctx context.Context, params ListSectionsParams) (*SectionListResponse, error) {
-	res, err := c.sendListSections(ctx, params)
+// GET /users/{user_gid}
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*UserResponse, error) {
+	res, err := c.sendGetUser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams) (res *SectionListResponse, err error) {
+func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *UserResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listSections"),
+		otelogen.OperationID("getUser"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{project_gid}/sections"),
+		semconv.URLTemplateKey.String("/users/{user_gid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2114,7 +2400,7 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListSectionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2131,17 +2417,17 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	var pathParts [2]string
+	pathParts[0] = "/users/"
 	{
-		// Encode "project_gid" parameter.
+		// Encode "user_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_gid",
+			Param:   "user_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectGid))
+			return e.EncodeValue(conv.StringToString(params.UserGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2151,7 +2437,6 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/sections"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2165,7 +2450,7 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListSectionsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2201,7 +2486,7 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListSectionsResponse(resp)
+	result, err := decodeGetUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2209,15 +2494,711 @@ func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams
 	return result, nil
 }
 
-// ListStories invokes listStories operation.
+// GetWorkspace invokes getWorkspace operation.
 //
-// List stories on a task.
+// Get a workspace.
 //
-// GET /tasks/{task_gid}/stories
-func (c *Client) ListStories(ctx context.Context, params ListStoriesParams) (*StoryListResponse, error) {
-	res, err := c.sendListStories(ctx, params)
-	return res, err
-}
+// GET /workspaces/{workspace_gid}
+func (c *Client) GetWorkspace(ctx context.Context, params GetWorkspaceParams) (*WorkspaceResponse, error) {
+	res, err := c.sendGetWorkspace(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetWorkspace(ctx context.Context, params GetWorkspaceParams) (res *WorkspaceResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getWorkspace"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/workspaces/{workspace_gid}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetWorkspaceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/workspaces/"
+	{
+		// Encode "workspace_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "workspace_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.WorkspaceGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GetWorkspaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetWorkspaceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListPortfolios invokes listPortfolios operation.
+//
+// List portfolios owned by a user in a workspace.
+//
+// GET /portfolios
+func (c *Client) ListPortfolios(ctx context.Context, params ListPortfoliosParams) (*PortfolioListResponse, error) {
+	res, err := c.sendListPortfolios(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListPortfolios(ctx context.Context, params ListPortfoliosParams) (res *PortfolioListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPortfolios"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/portfolios"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPortfoliosOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/portfolios"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "workspace" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "workspace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Workspace))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "owner" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "owner",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Owner))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "opt_fields" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "opt_fields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OptFields.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListPortfoliosOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPortfoliosResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectsByTeam invokes listProjectsByTeam operation.
+//
+// List projects in a team.
+//
+// GET /teams/{team_gid}/projects
+func (c *Client) ListProjectsByTeam(ctx context.Context, params ListProjectsByTeamParams) (*ProjectListResponse, error) {
+	res, err := c.sendListProjectsByTeam(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectsByTeam(ctx context.Context, params ListProjectsByTeamParams) (res *ProjectListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listProjectsByTeam"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/teams/{team_gid}/projects"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsByTeamOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/teams/"
+	{
+		// Encode "team_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "team_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.TeamGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/projects"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "archived" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "archived",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Archived.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectsByTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectsByTeamResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProjectsByWorkspace invokes listProjectsByWorkspace operation.
+//
+// List projects in a workspace.
+//
+// GET /workspaces/{workspace_gid}/projects
+func (c *Client) ListProjectsByWorkspace(ctx context.Context, params ListProjectsByWorkspaceParams) (*ProjectListResponse, error) {
+	res, err := c.sendListProjectsByWorkspace(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProjectsByWorkspace(ctx context.Context, params ListProjectsByWorkspaceParams) (res *ProjectListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listProjectsByWorkspace"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/workspaces/{workspace_gid}/projects"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProjectsByWorkspaceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/workspaces/"
+	{
+		// Encode "workspace_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "workspace_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.WorkspaceGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/projects"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "archived" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "archived",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Archived.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListProjectsByWorkspaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProjectsByWorkspaceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListSections invokes listSections operation.
+//
+// List sections in a project.
+//
+// GET /projects/{project_gid}/sections
+func (c *Client) ListSections(ctx context.Context, params ListSectionsParams) (*SectionListResponse, error) {
+	res, err := c.sendListSections(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListSections(ctx context.Context, params ListSectionsParams) (res *SectionListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listSections"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/projects/{project_gid}/sections"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListSectionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
+	{
+		// Encode "project_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/sections"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListSectionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListSectionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListStories invokes listStories operation.
+//
+// List stories on a task.
+//
+// GET /tasks/{task_gid}/stories
+func (c *Client) ListStories(ctx context.Context, params ListStoriesParams) (*StoryListResponse, error) {
+	res, err := c.sendListStories(ctx, params)
+	return res, err
+}
 
 func (c *Client) sendListStories(ctx context.Context, params ListStoriesParams) (res *StoryListResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
@@ -2617,9 +3598,198 @@ func (c *Client) ListTasksByAssignee(ctx context.Context, params ListTasksByAssi
 
 func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksByAssigneeParams) (res *TaskListResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTasksByAssignee"),
+		otelogen.OperationID("listTasksByAssignee"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/tasks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksByAssigneeOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/tasks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "assignee" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "assignee",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Assignee))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "workspace" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "workspace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Workspace))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "completed_since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "completed_since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CompletedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "modified_since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "opt_fields" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "opt_fields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.OptFields.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListTasksByAssigneeOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListTasksByAssigneeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTasksByProject invokes listTasksByProject operation.
+//
+// List tasks in a project.
+//
+// GET /projects/{project_gid}/tasks
+func (c *Client) ListTasksByProject(ctx context.Context, params ListTasksByProjectParams) (*TaskListResponse, error) {
+	res, err := c.sendListTasksByProject(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByProjectParams) (res *TaskListResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listTasksByProject"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/tasks"),
+		semconv.URLTemplateKey.String("/projects/{project_gid}/tasks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2635,7 +3805,7 @@ func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksBy
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksByAssigneeOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksByProjectOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2652,50 +3822,58 @@ func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksBy
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/tasks"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/projects/"
 	{
-		// Encode "assignee" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "assignee",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "project_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "project_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ProjectGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Assignee))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
+	pathParts[2] = "/tasks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "workspace" parameter.
+		// Encode "completed_since" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "workspace",
+			Name:    "completed_since",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Workspace))
+			if val, ok := params.CompletedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "completed_since" parameter.
+		// Encode "modified_since" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "completed_since",
+			Name:    "modified_since",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CompletedSince.Get(); ok {
+			if val, ok := params.ModifiedSince.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -2733,7 +3911,7 @@ func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksBy
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTasksByAssigneeOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListTasksByProjectOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2769,7 +3947,7 @@ func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksBy
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTasksByAssigneeResponse(resp)
+	result, err := decodeListTasksByProjectResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2777,21 +3955,21 @@ func (c *Client) sendListTasksByAssignee(ctx context.Context, params ListTasksBy
 	return result, nil
 }
 
-// ListTasksByProject invokes listTasksByProject operation.
+// ListTasksBySection invokes listTasksBySection operation.
 //
-// List tasks in a project.
+// List tasks in a section.
 //
-// GET /projects/{project_gid}/tasks
-func (c *Client) ListTasksByProject(ctx context.Context, params ListTasksByProjectParams) (*TaskListResponse, error) {
-	res, err := c.sendListTasksByProject(ctx, params)
+// GET /sections/{section_gid}/tasks
+func (c *Client) ListTasksBySection(ctx context.Context, params ListTasksBySectionParams) (*TaskListResponse, error) {
+	res, err := c.sendListTasksBySection(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByProjectParams) (res *TaskListResponse, err error) {
+func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksBySectionParams) (res *TaskListResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTasksByProject"),
+		otelogen.OperationID("listTasksBySection"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/projects/{project_gid}/tasks"),
+		semconv.URLTemplateKey.String("/sections/{section_gid}/tasks"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2807,7 +3985,7 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksByProjectOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksBySectionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2825,16 +4003,16 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/projects/"
+	pathParts[0] = "/sections/"
 	{
-		// Encode "project_gid" parameter.
+		// Encode "section_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "project_gid",
+			Param:   "section_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ProjectGid))
+			return e.EncodeValue(conv.StringToString(params.SectionGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2866,6 +4044,23 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "modified_since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedSince.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "opt_fields" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -2896,7 +4091,7 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTasksByProjectOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListTasksBySectionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2932,7 +4127,7 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTasksByProjectResponse(resp)
+	result, err := decodeListTasksBySectionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2940,21 +4135,21 @@ func (c *Client) sendListTasksByProject(ctx context.Context, params ListTasksByP
 	return result, nil
 }
 
-// ListTasksBySection invokes listTasksBySection operation.
+// ListUsersByWorkspace invokes listUsersByWorkspace operation.
 //
-// List tasks in a section.
+// List users in a workspace.
 //
-// GET /sections/{section_gid}/tasks
-func (c *Client) ListTasksBySection(ctx context.Context, params ListTasksBySectionParams) (*TaskListResponse, error) {
-	res, err := c.sendListTasksBySection(ctx, params)
+// GET /workspaces/{workspace_gid}/users
+func (c *Client) ListUsersByWorkspace(ctx context.Context, params ListUsersByWorkspaceParams) (*UserListResponse, error) {
+	res, err := c.sendListUsersByWorkspace(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksBySectionParams) (res *TaskListResponse, err error) {
+func (c *Client) sendListUsersByWorkspace(ctx context.Context, params ListUsersByWorkspaceParams) (res *UserListResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listTasksBySection"),
+		otelogen.OperationID("listUsersByWorkspace"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/sections/{section_gid}/tasks"),
+		semconv.URLTemplateKey.String("/workspaces/{workspace_gid}/users"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2970,7 +4165,7 @@ func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksByS
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListTasksBySectionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListUsersByWorkspaceOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2988,16 +4183,16 @@ func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksByS
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/sections/"
+	pathParts[0] = "/workspaces/"
 	{
-		// Encode "section_gid" parameter.
+		// Encode "workspace_gid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "section_gid",
+			Param:   "workspace_gid",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.SectionGid))
+			return e.EncodeValue(conv.StringToString(params.WorkspaceGid))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3007,28 +4202,11 @@ func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksByS
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/tasks"
+	pathParts[2] = "/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
-	{
-		// Encode "completed_since" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "completed_since",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.CompletedSince.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "opt_fields" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3059,7 +4237,7 @@ func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksByS
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListTasksBySectionOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ListUsersByWorkspaceOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3095,7 +4273,7 @@ func (c *Client) sendListTasksBySection(ctx context.Context, params ListTasksByS
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListTasksBySectionResponse(resp)
+	result, err := decodeListUsersByWorkspaceResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3209,6 +4387,134 @@ func (c *Client) sendListWorkspaces(ctx context.Context) (res *WorkspaceListResp
 	return result, nil
 }
 
+// RemoveTaskProject invokes removeTaskProject operation.
+//
+// Remove a project from a task.
+//
+// POST /tasks/{task_gid}/removeProject
+func (c *Client) RemoveTaskProject(ctx context.Context, request *RemoveTaskProjectRequest, params RemoveTaskProjectParams) (*EmptyDataResponse, error) {
+	res, err := c.sendRemoveTaskProject(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRemoveTaskProject(ctx context.Context, request *RemoveTaskProjectRequest, params RemoveTaskProjectParams) (res *EmptyDataResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("removeTaskProject"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/tasks/{task_gid}/removeProject"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveTaskProjectOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/tasks/"
+	{
+		// Encode "task_gid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "task_gid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.TaskGid))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/removeProject"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRemoveTaskProjectRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, RemoveTaskProjectOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRemoveTaskProjectResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // SearchTasks invokes searchTasks operation.
 //
 // Search tasks in a workspace.
@@ -6,7 +6,9 @@ package gen
 type OperationName = string
 
 const (
+	AddTaskProjectOperation          OperationName = "AddTaskProject"
 	AddTaskToSectionOperation        OperationName = "AddTaskToSection"
+	CreatePortfolioOperation         OperationName = "CreatePortfolio"
 	CreateProjectOperation           OperationName = "CreateProject"
 	CreateSectionOperation           OperationName = "CreateSection"
 	CreateStoryOperation             OperationName = "CreateStory"
@@ -15,10 +17,15 @@ const (
 	CreateTaskOperation              OperationName = "CreateTask"
 	DeleteProjectOperation           OperationName = "DeleteProject"
 	DeleteTaskOperation              OperationName = "DeleteTask"
+	GetEventsOperation               OperationName = "GetEvents"
 	GetMeOperation                   OperationName = "GetMe"
+	GetPortfolioOperation            OperationName = "GetPortfolio"
+	GetPortfolioItemsOperation       OperationName = "GetPortfolioItems"
 	GetProjectOperation              OperationName = "GetProject"
 	GetTaskOperation                 OperationName = "GetTask"
+	GetUserOperation                 OperationName = "GetUser"
 	GetWorkspaceOperation            OperationName = "GetWorkspace"
+	ListPortfoliosOperation          OperationName = "ListPortfolios"
 	ListProjectsByTeamOperation      OperationName = "ListProjectsByTeam"
 	ListProjectsByWorkspaceOperation OperationName = "ListProjectsByWorkspace"
 	ListSectionsOperation            OperationName = "ListSections"
@@ -28,7 +35,9 @@ const (
 	ListTasksByAssigneeOperation     OperationName = "ListTasksByAssignee"
 	ListTasksByProjectOperation      OperationName = "ListTasksByProject"
 	ListTasksBySectionOperation      OperationName = "ListTasksBySection"
+	ListUsersByWorkspaceOperation    OperationName = "ListUsersByWorkspace"
 	ListWorkspacesOperation          OperationName = "ListWorkspaces"
+	RemoveTaskProjectOperation       OperationName = "RemoveTaskProject"
 	SearchTasksOperation             OperationName = "SearchTasks"
 	UpdateProjectOperation           OperationName = "UpdateProject"
 	UpdateTaskOperation              OperationName = "UpdateTask"
@@ -2,6 +2,11 @@
 
 package gen
 
+// AddTaskProjectParams is parameters of addTaskProject operation.
+type AddTaskProjectParams struct {
+	TaskGid string
+}
+
 // AddTaskToSectionParams is parameters of addTaskToSection operation.
 type AddTaskToSectionParams struct {
 	SectionGid string
@@ -32,6 +37,23 @@ type DeleteTaskParams struct {
 	TaskGid string
 }
 
+// GetEventsParams is parameters of getEvents operation.
+type GetEventsParams struct {
+	Resource string
+	Sync     OptString `json:",omitempty,omitzero"`
+}
+
+// GetPortfolioParams is parameters of getPortfolio operation.
+type GetPortfolioParams struct {
+	PortfolioGid string
+}
+
+// GetPortfolioItemsParams is parameters of getPortfolioItems operation.
+type GetPortfolioItemsParams struct {
+	PortfolioGid string
+	OptFields    OptString `json:",omitempty,omitzero"`
+}
+
 // GetProjectParams is parameters of getProject operation.
 type GetProjectParams struct {
 	ProjectGid string
@@ -42,11 +64,23 @@ type GetTaskParams struct {
 	TaskGid string
 }
 
+// GetUserParams is parameters of getUser operation.
+type GetUserParams struct {
+	UserGid string
+}
+
 // GetWorkspaceParams is parameters of getWorkspace operation.
 type GetWorkspaceParams struct {
 	WorkspaceGid string
 }
 
+// ListPortfoliosParams is parameters of listPortfolios operation.
+type ListPortfoliosParams struct {
+	Workspace string
+	Owner     string
+	OptFields OptString `json:",omitempty,omitzero"`
+}
+
 // ListProjectsByTeamParams is parameters of listProjectsByTeam operation.
 type ListProjectsByTeamParams struct {
 	TeamGid  string
@@ -85,6 +119,7 @@ type ListTasksByAssigneeParams struct {
 	Assignee       string
 	Workspace      string
 	CompletedSince OptString `json:",omitempty,omitzero"`
+	ModifiedSince  OptString `json:",omitempty,omitzero"`
 	OptFields      OptString `json:",omitempty,omitzero"`
 }
 
@@ -92,6 +127,7 @@ type ListTasksByAssigneeParams struct {
 type ListTasksByProjectParams struct {
 	ProjectGid     string
 	CompletedSince OptString `json:",omitempty,omitzero"`
+	ModifiedSince  OptString `json:",omitempty,omitzero"`
 	OptFields      OptString `json:",omitempty,omitzero"`
 }
 
@@ -99,9 +135,21 @@ type ListTasksByProjectParams struct {
 type ListTasksBySectionParams struct {
 	SectionGid     string
 	CompletedSince OptString `json:",omitempty,omitzero"`
+	ModifiedSince  OptString `json:",omitempty,omitzero"`
 	OptFields      OptString `json:",omitempty,omitzero"`
 }
 
+// ListUsersByWorkspaceParams is parameters of listUsersByWorkspace operation.
+type ListUsersByWorkspaceParams struct {
+	WorkspaceGid string
+	OptFields    OptString `json:",omitempty,omitzero"`
+}
+
+// RemoveTaskProjectParams is parameters of removeTaskProject operation.
+type RemoveTaskProjectParams struct {
+	TaskGid string
+}
+
 // SearchTasksParams is parameters of searchTasks operation.
 type SearchTasksParams struct {
 	WorkspaceGid  string
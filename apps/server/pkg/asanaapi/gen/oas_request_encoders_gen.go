@@ -10,6 +10,20 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+func encodeAddTaskProjectRequest(
+	req *AddTaskProjectRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAddTaskToSectionRequest(
 	req *AddTaskToSectionRequest,
 	r *http.Request,
@@ -24,6 +38,20 @@ func encodeAddTaskToSectionRequest(
 	return nil
 }
 
+func encodeCreatePortfolioRequest(
+	req *CreatePortfolioRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateProjectRequest(
 	req *CreateProjectRequest,
 	r *http.Request,
@@ -108,6 +136,20 @@ func encodeCreateTaskRequest(
 	return nil
 }
 
+func encodeRemoveTaskProjectRequest(
+	req *RemoveTaskProjectRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateProjectRequest(
 	req *UpdateProjectRequest,
 	r *http.Request,
@@ -6,6 +6,69 @@ import (
 	"github.com/go-faster/jx"
 )
 
+// Ref: #/components/schemas/AddTaskProjectRequest
+type AddTaskProjectRequest struct {
+	Data AddTaskProjectRequestData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *AddTaskProjectRequest) GetData() AddTaskProjectRequestData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *AddTaskProjectRequest) SetData(val AddTaskProjectRequestData) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/AddTaskProjectRequestData
+type AddTaskProjectRequestData struct {
+	Project      string    `json:"project"`
+	Section      OptString `json:"section"`
+	InsertBefore OptString `json:"insert_before"`
+	InsertAfter  OptString `json:"insert_after"`
+}
+
+// GetProject returns the value of Project.
+func (s *AddTaskProjectRequestData) GetProject() string {
+	return s.Project
+}
+
+// GetSection returns the value of Section.
+func (s *AddTaskProjectRequestData) GetSection() OptString {
+	return s.Section
+}
+
+// GetInsertBefore returns the value of InsertBefore.
+func (s *AddTaskProjectRequestData) GetInsertBefore() OptString {
+	return s.InsertBefore
+}
+
+// GetInsertAfter returns the value of InsertAfter.
+func (s *AddTaskProjectRequestData) GetInsertAfter() OptString {
+	return s.InsertAfter
+}
+
+// SetProject sets the value of Project.
+func (s *AddTaskProjectRequestData) SetProject(val string) {
+	s.Project = val
+}
+
+// SetSection sets the value of Section.
+func (s *AddTaskProjectRequestData) SetSection(val OptString) {
+	s.Section = val
+}
+
+// SetInsertBefore sets the value of InsertBefore.
+func (s *AddTaskProjectRequestData) SetInsertBefore(val OptString) {
+	s.InsertBefore = val
+}
+
+// SetInsertAfter sets the value of InsertAfter.
+func (s *AddTaskProjectRequestData) SetInsertAfter(val OptString) {
+	s.InsertAfter = val
+}
+
 // Ref: #/components/schemas/AddTaskToSectionRequest
 type AddTaskToSectionRequest struct {
 	Data AddTaskToSectionRequestData `json:"data"`
@@ -61,6 +124,69 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// Ref: #/components/schemas/CreatePortfolioRequest
+type CreatePortfolioRequest struct {
+	Data CreatePortfolioRequestData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *CreatePortfolioRequest) GetData() CreatePortfolioRequestData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *CreatePortfolioRequest) SetData(val CreatePortfolioRequestData) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/CreatePortfolioRequestData
+type CreatePortfolioRequestData struct {
+	Name      string    `json:"name"`
+	Workspace string    `json:"workspace"`
+	Color     OptString `json:"color"`
+	Public    OptBool   `json:"public"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePortfolioRequestData) GetName() string {
+	return s.Name
+}
+
+// GetWorkspace returns the value of Workspace.
+func (s *CreatePortfolioRequestData) GetWorkspace() string {
+	return s.Workspace
+}
+
+// GetColor returns the value of Color.
+func (s *CreatePortfolioRequestData) GetColor() OptString {
+	return s.Color
+}
+
+// GetPublic returns the value of Public.
+func (s *CreatePortfolioRequestData) GetPublic() OptBool {
+	return s.Public
+}
+
+// SetName sets the value of Name.
+func (s *CreatePortfolioRequestData) SetName(val string) {
+	s.Name = val
+}
+
+// SetWorkspace sets the value of Workspace.
+func (s *CreatePortfolioRequestData) SetWorkspace(val string) {
+	s.Workspace = val
+}
+
+// SetColor sets the value of Color.
+func (s *CreatePortfolioRequestData) SetColor(val OptString) {
+	s.Color = val
+}
+
+// SetPublic sets the value of Public.
+func (s *CreatePortfolioRequestData) SetPublic(val OptBool) {
+	s.Public = val
+}
+
 // Ref: #/components/schemas/CreateProjectRequest
 type CreateProjectRequest struct {
 	Data CreateProjectRequestData `json:"data"`
@@ -477,48 +603,437 @@ type EmptyDataResponse struct {
 	Data jx.Raw `json:"data"`
 }
 
-// GetData returns the value of Data.
-func (s *EmptyDataResponse) GetData() jx.Raw {
-	return s.Data
+// GetData returns the value of Data.
+func (s *EmptyDataResponse) GetData() jx.Raw {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *EmptyDataResponse) SetData(val jx.Raw) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/Event
+type Event struct {
+	Action    OptString        `json:"action"`
+	CreatedAt OptString        `json:"created_at"`
+	Type      OptString        `json:"type"`
+	User      OptEventUser     `json:"user"`
+	Resource  OptEventResource `json:"resource"`
+	Parent    OptEventResource `json:"parent"`
+	Change    OptEventChange   `json:"change"`
+}
+
+// GetAction returns the value of Action.
+func (s *Event) GetAction() OptString {
+	return s.Action
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Event) GetCreatedAt() OptString {
+	return s.CreatedAt
+}
+
+// GetType returns the value of Type.
+func (s *Event) GetType() OptString {
+	return s.Type
+}
+
+// GetUser returns the value of User.
+func (s *Event) GetUser() OptEventUser {
+	return s.User
+}
+
+// GetResource returns the value of Resource.
+func (s *Event) GetResource() OptEventResource {
+	return s.Resource
+}
+
+// GetParent returns the value of Parent.
+func (s *Event) GetParent() OptEventResource {
+	return s.Parent
+}
+
+// GetChange returns the value of Change.
+func (s *Event) GetChange() OptEventChange {
+	return s.Change
+}
+
+// SetAction sets the value of Action.
+func (s *Event) SetAction(val OptString) {
+	s.Action = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Event) SetCreatedAt(val OptString) {
+	s.CreatedAt = val
+}
+
+// SetType sets the value of Type.
+func (s *Event) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetUser sets the value of User.
+func (s *Event) SetUser(val OptEventUser) {
+	s.User = val
+}
+
+// SetResource sets the value of Resource.
+func (s *Event) SetResource(val OptEventResource) {
+	s.Resource = val
+}
+
+// SetParent sets the value of Parent.
+func (s *Event) SetParent(val OptEventResource) {
+	s.Parent = val
+}
+
+// SetChange sets the value of Change.
+func (s *Event) SetChange(val OptEventChange) {
+	s.Change = val
+}
+
+type EventChange struct {
+	Field        OptString `json:"field"`
+	Action       OptString `json:"action"`
+	NewValue     jx.Raw    `json:"new_value"`
+	AddedValue   jx.Raw    `json:"added_value"`
+	RemovedValue jx.Raw    `json:"removed_value"`
+}
+
+// GetField returns the value of Field.
+func (s *EventChange) GetField() OptString {
+	return s.Field
+}
+
+// GetAction returns the value of Action.
+func (s *EventChange) GetAction() OptString {
+	return s.Action
+}
+
+// GetNewValue returns the value of NewValue.
+func (s *EventChange) GetNewValue() jx.Raw {
+	return s.NewValue
+}
+
+// GetAddedValue returns the value of AddedValue.
+func (s *EventChange) GetAddedValue() jx.Raw {
+	return s.AddedValue
+}
+
+// GetRemovedValue returns the value of RemovedValue.
+func (s *EventChange) GetRemovedValue() jx.Raw {
+	return s.RemovedValue
+}
+
+// SetField sets the value of Field.
+func (s *EventChange) SetField(val OptString) {
+	s.Field = val
+}
+
+// SetAction sets the value of Action.
+func (s *EventChange) SetAction(val OptString) {
+	s.Action = val
+}
+
+// SetNewValue sets the value of NewValue.
+func (s *EventChange) SetNewValue(val jx.Raw) {
+	s.NewValue = val
+}
+
+// SetAddedValue sets the value of AddedValue.
+func (s *EventChange) SetAddedValue(val jx.Raw) {
+	s.AddedValue = val
+}
+
+// SetRemovedValue sets the value of RemovedValue.
+func (s *EventChange) SetRemovedValue(val jx.Raw) {
+	s.RemovedValue = val
+}
+
+// Ref: #/components/schemas/EventListResponse
+type EventListResponse struct {
+	Data    []Event   `json:"data"`
+	Sync    OptString `json:"sync"`
+	HasMore OptBool   `json:"has_more"`
+}
+
+// GetData returns the value of Data.
+func (s *EventListResponse) GetData() []Event {
+	return s.Data
+}
+
+// GetSync returns the value of Sync.
+func (s *EventListResponse) GetSync() OptString {
+	return s.Sync
+}
+
+// GetHasMore returns the value of HasMore.
+func (s *EventListResponse) GetHasMore() OptBool {
+	return s.HasMore
+}
+
+// SetData sets the value of Data.
+func (s *EventListResponse) SetData(val []Event) {
+	s.Data = val
+}
+
+// SetSync sets the value of Sync.
+func (s *EventListResponse) SetSync(val OptString) {
+	s.Sync = val
+}
+
+// SetHasMore sets the value of HasMore.
+func (s *EventListResponse) SetHasMore(val OptBool) {
+	s.HasMore = val
+}
+
+func (*EventListResponse) getEventsRes() {}
+
+// Ref: #/components/schemas/EventResource
+type EventResource struct {
+	Gid             OptString `json:"gid"`
+	ResourceType    OptString `json:"resource_type"`
+	ResourceSubtype OptString `json:"resource_subtype"`
+	Name            OptString `json:"name"`
+}
+
+// GetGid returns the value of Gid.
+func (s *EventResource) GetGid() OptString {
+	return s.Gid
+}
+
+// GetResourceType returns the value of ResourceType.
+func (s *EventResource) GetResourceType() OptString {
+	return s.ResourceType
+}
+
+// GetResourceSubtype returns the value of ResourceSubtype.
+func (s *EventResource) GetResourceSubtype() OptString {
+	return s.ResourceSubtype
+}
+
+// GetName returns the value of Name.
+func (s *EventResource) GetName() OptString {
+	return s.Name
+}
+
+// SetGid sets the value of Gid.
+func (s *EventResource) SetGid(val OptString) {
+	s.Gid = val
+}
+
+// SetResourceType sets the value of ResourceType.
+func (s *EventResource) SetResourceType(val OptString) {
+	s.ResourceType = val
+}
+
+// SetResourceSubtype sets the value of ResourceSubtype.
+func (s *EventResource) SetResourceSubtype(val OptString) {
+	s.ResourceSubtype = val
+}
+
+// SetName sets the value of Name.
+func (s *EventResource) SetName(val OptString) {
+	s.Name = val
+}
+
+type EventUser struct {
+	Gid  OptString `json:"gid"`
+	Name OptString `json:"name"`
+}
+
+// GetGid returns the value of Gid.
+func (s *EventUser) GetGid() OptString {
+	return s.Gid
+}
+
+// GetName returns the value of Name.
+func (s *EventUser) GetName() OptString {
+	return s.Name
+}
+
+// SetGid sets the value of Gid.
+func (s *EventUser) SetGid(val OptString) {
+	s.Gid = val
+}
+
+// SetName sets the value of Name.
+func (s *EventUser) SetName(val OptString) {
+	s.Name = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventChange returns new OptEventChange with value set to v.
+func NewOptEventChange(v EventChange) OptEventChange {
+	return OptEventChange{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventChange is optional EventChange.
+type OptEventChange struct {
+	Value EventChange
+	Set   bool
+}
+
+// IsSet returns true if OptEventChange was set.
+func (o OptEventChange) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventChange) Reset() {
+	var v EventChange
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventChange) SetTo(v EventChange) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventChange) Get() (v EventChange, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventChange) Or(d EventChange) EventChange {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventResource returns new OptEventResource with value set to v.
+func NewOptEventResource(v EventResource) OptEventResource {
+	return OptEventResource{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventResource is optional EventResource.
+type OptEventResource struct {
+	Value EventResource
+	Set   bool
+}
+
+// IsSet returns true if OptEventResource was set.
+func (o OptEventResource) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventResource) Reset() {
+	var v EventResource
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventResource) SetTo(v EventResource) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventResource) Get() (v EventResource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetData sets the value of Data.
-func (s *EmptyDataResponse) SetData(val jx.Raw) {
-	s.Data = val
+// Or returns value if set, or given parameter if does not.
+func (o OptEventResource) Or(d EventResource) EventResource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
+// NewOptEventUser returns new OptEventUser with value set to v.
+func NewOptEventUser(v EventUser) OptEventUser {
+	return OptEventUser{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
+// OptEventUser is optional EventUser.
+type OptEventUser struct {
+	Value EventUser
 	Set   bool
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// IsSet returns true if OptEventUser was set.
+func (o OptEventUser) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
+func (o *OptEventUser) Reset() {
+	var v EventUser
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
+func (o *OptEventUser) SetTo(v EventUser) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
+func (o OptEventUser) Get() (v EventUser, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -526,7 +1041,7 @@ func (o OptBool) Get() (v bool, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
+func (o OptEventUser) Or(d EventUser) EventUser {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -722,6 +1237,98 @@ func (o OptNilTaskParent) Or(d TaskParent) TaskParent {
 	return d
 }
 
+// NewOptPortfolio returns new OptPortfolio with value set to v.
+func NewOptPortfolio(v Portfolio) OptPortfolio {
+	return OptPortfolio{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPortfolio is optional Portfolio.
+type OptPortfolio struct {
+	Value Portfolio
+	Set   bool
+}
+
+// IsSet returns true if OptPortfolio was set.
+func (o OptPortfolio) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPortfolio) Reset() {
+	var v Portfolio
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPortfolio) SetTo(v Portfolio) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPortfolio) Get() (v Portfolio, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPortfolio) Or(d Portfolio) Portfolio {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPortfolioWorkspace returns new OptPortfolioWorkspace with value set to v.
+func NewOptPortfolioWorkspace(v PortfolioWorkspace) OptPortfolioWorkspace {
+	return OptPortfolioWorkspace{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPortfolioWorkspace is optional PortfolioWorkspace.
+type OptPortfolioWorkspace struct {
+	Value PortfolioWorkspace
+	Set   bool
+}
+
+// IsSet returns true if OptPortfolioWorkspace was set.
+func (o OptPortfolioWorkspace) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPortfolioWorkspace) Reset() {
+	var v PortfolioWorkspace
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPortfolioWorkspace) SetTo(v PortfolioWorkspace) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPortfolioWorkspace) Get() (v PortfolioWorkspace, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPortfolioWorkspace) Or(d PortfolioWorkspace) PortfolioWorkspace {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptProject returns new OptProject with value set to v.
 func NewOptProject(v Project) OptProject {
 	return OptProject{
@@ -1228,6 +1835,164 @@ func (o OptWorkspace) Or(d Workspace) Workspace {
 	return d
 }
 
+// Ref: #/components/schemas/Portfolio
+type Portfolio struct {
+	Gid          OptString             `json:"gid"`
+	Name         OptString             `json:"name"`
+	ResourceType OptString             `json:"resource_type"`
+	Color        OptNilString          `json:"color"`
+	Public       OptBool               `json:"public"`
+	PermalinkURL OptString             `json:"permalink_url"`
+	CreatedAt    OptString             `json:"created_at"`
+	Owner        OptUser               `json:"owner"`
+	Workspace    OptPortfolioWorkspace `json:"workspace"`
+}
+
+// GetGid returns the value of Gid.
+func (s *Portfolio) GetGid() OptString {
+	return s.Gid
+}
+
+// GetName returns the value of Name.
+func (s *Portfolio) GetName() OptString {
+	return s.Name
+}
+
+// GetResourceType returns the value of ResourceType.
+func (s *Portfolio) GetResourceType() OptString {
+	return s.ResourceType
+}
+
+// GetColor returns the value of Color.
+func (s *Portfolio) GetColor() OptNilString {
+	return s.Color
+}
+
+// GetPublic returns the value of Public.
+func (s *Portfolio) GetPublic() OptBool {
+	return s.Public
+}
+
+// GetPermalinkURL returns the value of PermalinkURL.
+func (s *Portfolio) GetPermalinkURL() OptString {
+	return s.PermalinkURL
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Portfolio) GetCreatedAt() OptString {
+	return s.CreatedAt
+}
+
+// GetOwner returns the value of Owner.
+func (s *Portfolio) GetOwner() OptUser {
+	return s.Owner
+}
+
+// GetWorkspace returns the value of Workspace.
+func (s *Portfolio) GetWorkspace() OptPortfolioWorkspace {
+	return s.Workspace
+}
+
+// SetGid sets the value of Gid.
+func (s *Portfolio) SetGid(val OptString) {
+	s.Gid = val
+}
+
+// SetName sets the value of Name.
+func (s *Portfolio) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetResourceType sets the value of ResourceType.
+func (s *Portfolio) SetResourceType(val OptString) {
+	s.ResourceType = val
+}
+
+// SetColor sets the value of Color.
+func (s *Portfolio) SetColor(val OptNilString) {
+	s.Color = val
+}
+
+// SetPublic sets the value of Public.
+func (s *Portfolio) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetPermalinkURL sets the value of PermalinkURL.
+func (s *Portfolio) SetPermalinkURL(val OptString) {
+	s.PermalinkURL = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Portfolio) SetCreatedAt(val OptString) {
+	s.CreatedAt = val
+}
+
+// SetOwner sets the value of Owner.
+func (s *Portfolio) SetOwner(val OptUser) {
+	s.Owner = val
+}
+
+// SetWorkspace sets the value of Workspace.
+func (s *Portfolio) SetWorkspace(val OptPortfolioWorkspace) {
+	s.Workspace = val
+}
+
+// Ref: #/components/schemas/PortfolioListResponse
+type PortfolioListResponse struct {
+	Data []Portfolio `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *PortfolioListResponse) GetData() []Portfolio {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *PortfolioListResponse) SetData(val []Portfolio) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/PortfolioResponse
+type PortfolioResponse struct {
+	Data OptPortfolio `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *PortfolioResponse) GetData() OptPortfolio {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *PortfolioResponse) SetData(val OptPortfolio) {
+	s.Data = val
+}
+
+type PortfolioWorkspace struct {
+	Gid  OptString `json:"gid"`
+	Name OptString `json:"name"`
+}
+
+// GetGid returns the value of Gid.
+func (s *PortfolioWorkspace) GetGid() OptString {
+	return s.Gid
+}
+
+// GetName returns the value of Name.
+func (s *PortfolioWorkspace) GetName() OptString {
+	return s.Name
+}
+
+// SetGid sets the value of Gid.
+func (s *PortfolioWorkspace) SetGid(val OptString) {
+	s.Gid = val
+}
+
+// SetName sets the value of Name.
+func (s *PortfolioWorkspace) SetName(val OptString) {
+	s.Name = val
+}
+
 // Ref: #/components/schemas/Project
 type Project struct {
 	Gid          OptString           `json:"gid"`
@@ -1444,6 +2209,36 @@ func (s *ProjectWorkspace) SetName(val OptString) {
 	s.Name = val
 }
 
+// Ref: #/components/schemas/RemoveTaskProjectRequest
+type RemoveTaskProjectRequest struct {
+	Data RemoveTaskProjectRequestData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RemoveTaskProjectRequest) GetData() RemoveTaskProjectRequestData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RemoveTaskProjectRequest) SetData(val RemoveTaskProjectRequestData) {
+	s.Data = val
+}
+
+// Ref: #/components/schemas/RemoveTaskProjectRequestData
+type RemoveTaskProjectRequestData struct {
+	Project string `json:"project"`
+}
+
+// GetProject returns the value of Project.
+func (s *RemoveTaskProjectRequestData) GetProject() string {
+	return s.Project
+}
+
+// SetProject sets the value of Project.
+func (s *RemoveTaskProjectRequestData) SetProject(val string) {
+	s.Project = val
+}
+
 // Ref: #/components/schemas/Section
 type Section struct {
 	Gid          OptString `json:"gid"`
@@ -1636,6 +2431,48 @@ func (s *StoryResponse) SetData(val OptStory) {
 	s.Data = val
 }
 
+// Ref: #/components/schemas/SyncTokenError
+type SyncTokenError struct {
+	Sync   OptString                  `json:"sync"`
+	Errors []SyncTokenErrorErrorsItem `json:"errors"`
+}
+
+// GetSync returns the value of Sync.
+func (s *SyncTokenError) GetSync() OptString {
+	return s.Sync
+}
+
+// GetErrors returns the value of Errors.
+func (s *SyncTokenError) GetErrors() []SyncTokenErrorErrorsItem {
+	return s.Errors
+}
+
+// SetSync sets the value of Sync.
+func (s *SyncTokenError) SetSync(val OptString) {
+	s.Sync = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *SyncTokenError) SetErrors(val []SyncTokenErrorErrorsItem) {
+	s.Errors = val
+}
+
+func (*SyncTokenError) getEventsRes() {}
+
+type SyncTokenErrorErrorsItem struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *SyncTokenErrorErrorsItem) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *SyncTokenErrorErrorsItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
 // Ref: #/components/schemas/Tag
 type Tag struct {
 	Gid          OptString    `json:"gid"`
@@ -2041,12 +2878,12 @@ func (s *UpdateProjectRequest) SetData(val UpdateProjectRequestData) {
 
 // Ref: #/components/schemas/UpdateProjectRequestData
 type UpdateProjectRequestData struct {
-	Name        OptString `json:"name"`
-	Notes       OptString `json:"notes"`
-	Color       OptString `json:"color"`
-	DefaultView OptString `json:"default_view"`
-	DueOn       OptString `json:"due_on"`
-	Archived    OptBool   `json:"archived"`
+	Name        OptString    `json:"name"`
+	Notes       OptString    `json:"notes"`
+	Color       OptNilString `json:"color"`
+	DefaultView OptString    `json:"default_view"`
+	DueOn       OptNilString `json:"due_on"`
+	Archived    OptBool      `json:"archived"`
 }
 
 // GetName returns the value of Name.
@@ -2060,7 +2897,7 @@ func (s *UpdateProjectRequestData) GetNotes() OptString {
 }
 
 // GetColor returns the value of Color.
-func (s *UpdateProjectRequestData) GetColor() OptString {
+func (s *UpdateProjectRequestData) GetColor() OptNilString {
 	return s.Color
 }
 
@@ -2070,7 +2907,7 @@ func (s *UpdateProjectRequestData) GetDefaultView() OptString {
 }
 
 // GetDueOn returns the value of DueOn.
-func (s *UpdateProjectRequestData) GetDueOn() OptString {
+func (s *UpdateProjectRequestData) GetDueOn() OptNilString {
 	return s.DueOn
 }
 
@@ -2090,7 +2927,7 @@ func (s *UpdateProjectRequestData) SetNotes(val OptString) {
 }
 
 // SetColor sets the value of Color.
-func (s *UpdateProjectRequestData) SetColor(val OptString) {
+func (s *UpdateProjectRequestData) SetColor(val OptNilString) {
 	s.Color = val
 }
 
@@ -2100,7 +2937,7 @@ func (s *UpdateProjectRequestData) SetDefaultView(val OptString) {
 }
 
 // SetDueOn sets the value of DueOn.
-func (s *UpdateProjectRequestData) SetDueOn(val OptString) {
+func (s *UpdateProjectRequestData) SetDueOn(val OptNilString) {
 	s.DueOn = val
 }
 
@@ -2126,14 +2963,14 @@ func (s *UpdateTaskRequest) SetData(val UpdateTaskRequestData) {
 
 // Ref: #/components/schemas/UpdateTaskRequestData
 type UpdateTaskRequestData struct {
-	Name      OptString `json:"name"`
-	Notes     OptString `json:"notes"`
-	HTMLNotes OptString `json:"html_notes"`
-	DueOn     OptString `json:"due_on"`
-	DueAt     OptString `json:"due_at"`
-	StartOn   OptString `json:"start_on"`
-	Completed OptBool   `json:"completed"`
-	Assignee  OptString `json:"assignee"`
+	Name      OptString    `json:"name"`
+	Notes     OptString    `json:"notes"`
+	HTMLNotes OptString    `json:"html_notes"`
+	DueOn     OptNilString `json:"due_on"`
+	DueAt     OptNilString `json:"due_at"`
+	StartOn   OptNilString `json:"start_on"`
+	Completed OptBool      `json:"completed"`
+	Assignee  OptNilString `json:"assignee"`
 }
 
 // GetName returns the value of Name.
@@ -2152,17 +2989,17 @@ func (s *UpdateTaskRequestData) GetHTMLNotes() OptString {
 }
 
 // GetDueOn returns the value of DueOn.
-func (s *UpdateTaskRequestData) GetDueOn() OptString {
+func (s *UpdateTaskRequestData) GetDueOn() OptNilString {
 	return s.DueOn
 }
 
 // GetDueAt returns the value of DueAt.
-func (s *UpdateTaskRequestData) GetDueAt() OptString {
+func (s *UpdateTaskRequestData) GetDueAt() OptNilString {
 	return s.DueAt
 }
 
 // GetStartOn returns the value of StartOn.
-func (s *UpdateTaskRequestData) GetStartOn() OptString {
+func (s *UpdateTaskRequestData) GetStartOn() OptNilString {
 	return s.StartOn
 }
 
@@ -2172,7 +3009,7 @@ func (s *UpdateTaskRequestData) GetCompleted() OptBool {
 }
 
 // GetAssignee returns the value of Assignee.
-func (s *UpdateTaskRequestData) GetAssignee() OptString {
+func (s *UpdateTaskRequestData) GetAssignee() OptNilString {
 	return s.Assignee
 }
 
@@ -2192,17 +3029,17 @@ func (s *UpdateTaskRequestData) SetHTMLNotes(val OptString) {
 }
 
 // SetDueOn sets the value of DueOn.
-func (s *UpdateTaskRequestData) SetDueOn(val OptString) {
+func (s *UpdateTaskRequestData) SetDueOn(val OptNilString) {
 	s.DueOn = val
 }
 
 // SetDueAt sets the value of DueAt.
-func (s *UpdateTaskRequestData) SetDueAt(val OptString) {
+func (s *UpdateTaskRequestData) SetDueAt(val OptNilString) {
 	s.DueAt = val
 }
 
 // SetStartOn sets the value of StartOn.
-func (s *UpdateTaskRequestData) SetStartOn(val OptString) {
+func (s *UpdateTaskRequestData) SetStartOn(val OptNilString) {
 	s.StartOn = val
 }
 
@@ -2212,7 +3049,7 @@ func (s *UpdateTaskRequestData) SetCompleted(val OptBool) {
 }
 
 // SetAssignee sets the value of Assignee.
-func (s *UpdateTaskRequestData) SetAssignee(val OptString) {
+func (s *UpdateTaskRequestData) SetAssignee(val OptNilString) {
 	s.Assignee = val
 }
 
@@ -2264,6 +3101,21 @@ func (s *User) SetResourceType(val OptString) {
 	s.ResourceType = val
 }
 
+// Ref: #/components/schemas/UserListResponse
+type UserListResponse struct {
+	Data []User `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *UserListResponse) GetData() []User {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *UserListResponse) SetData(val []User) {
+	s.Data = val
+}
+
 // Ref: #/components/schemas/UserResponse
 type UserResponse struct {
 	Data OptUser `json:"data"`
@@ -10,9 +10,11 @@ const (
 	AddPageLabelOperation    OperationName = "AddPageLabel"
 	CreatePageOperation      OperationName = "CreatePage"
 	DeletePageOperation      OperationName = "DeletePage"
+	GetChildPagesOperation   OperationName = "GetChildPages"
 	GetPageOperation         OperationName = "GetPage"
 	GetPageCommentsOperation OperationName = "GetPageComments"
 	GetPageLabelsOperation   OperationName = "GetPageLabels"
+	GetPageVersionsOperation OperationName = "GetPageVersions"
 	GetPagesOperation        OperationName = "GetPages"
 	GetSpaceByIdOperation    OperationName = "GetSpaceById"
 	GetSpaceByKeyOperation   OperationName = "GetSpaceByKey"
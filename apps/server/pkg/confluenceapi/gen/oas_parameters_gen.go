@@ -12,6 +12,13 @@ type DeletePageParams struct {
 	PageId string
 }
 
+// GetChildPagesParams is parameters of getChildPages operation.
+type GetChildPagesParams struct {
+	ID     string
+	Limit  OptInt    `json:",omitempty,omitzero"`
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
 // GetPageParams is parameters of getPage operation.
 type GetPageParams struct {
 	PageId     string
@@ -30,6 +37,14 @@ type GetPageLabelsParams struct {
 	PageId string
 }
 
+// GetPageVersionsParams is parameters of getPageVersions operation.
+type GetPageVersionsParams struct {
+	ID     string
+	Limit  OptInt    `json:",omitempty,omitzero"`
+	Cursor OptString `json:",omitempty,omitzero"`
+	Sort   OptString `json:",omitempty,omitzero"`
+}
+
 // GetPagesParams is parameters of getPages operation.
 type GetPagesParams struct {
 	SpaceId string
@@ -84,6 +84,91 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// Ref: #/components/schemas/ChildPage
+type ChildPage struct {
+	ID            OptString `json:"id"`
+	Title         OptString `json:"title"`
+	Status        OptString `json:"status"`
+	SpaceId       OptString `json:"spaceId"`
+	ChildPosition OptInt    `json:"childPosition"`
+}
+
+// GetID returns the value of ID.
+func (s *ChildPage) GetID() OptString {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *ChildPage) GetTitle() OptString {
+	return s.Title
+}
+
+// GetStatus returns the value of Status.
+func (s *ChildPage) GetStatus() OptString {
+	return s.Status
+}
+
+// GetSpaceId returns the value of SpaceId.
+func (s *ChildPage) GetSpaceId() OptString {
+	return s.SpaceId
+}
+
+// GetChildPosition returns the value of ChildPosition.
+func (s *ChildPage) GetChildPosition() OptInt {
+	return s.ChildPosition
+}
+
+// SetID sets the value of ID.
+func (s *ChildPage) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ChildPage) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ChildPage) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetSpaceId sets the value of SpaceId.
+func (s *ChildPage) SetSpaceId(val OptString) {
+	s.SpaceId = val
+}
+
+// SetChildPosition sets the value of ChildPosition.
+func (s *ChildPage) SetChildPosition(val OptInt) {
+	s.ChildPosition = val
+}
+
+// Ref: #/components/schemas/ChildPageListResult
+type ChildPageListResult struct {
+	Results []ChildPage `json:"results"`
+	Links   jx.Raw      `json:"_links"`
+}
+
+// GetResults returns the value of Results.
+func (s *ChildPageListResult) GetResults() []ChildPage {
+	return s.Results
+}
+
+// GetLinks returns the value of Links.
+func (s *ChildPageListResult) GetLinks() jx.Raw {
+	return s.Links
+}
+
+// SetResults sets the value of Results.
+func (s *ChildPageListResult) SetResults(val []ChildPage) {
+	s.Results = val
+}
+
+// SetLinks sets the value of Links.
+func (s *ChildPageListResult) SetLinks(val jx.Raw) {
+	s.Links = val
+}
+
 // Ref: #/components/schemas/Comment
 type Comment struct {
 	ID      OptString `json:"id"`
@@ -331,6 +416,52 @@ func (s *LabelListResult) SetResults(val []Label) {
 	s.Results = val
 }
 
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -685,6 +816,91 @@ func (s *PageListResult) SetLinks(val jx.Raw) {
 	s.Links = val
 }
 
+// Ref: #/components/schemas/PageVersion
+type PageVersion struct {
+	Number    OptInt    `json:"number"`
+	Message   OptString `json:"message"`
+	MinorEdit OptBool   `json:"minorEdit"`
+	AuthorId  OptString `json:"authorId"`
+	CreatedAt OptString `json:"createdAt"`
+}
+
+// GetNumber returns the value of Number.
+func (s *PageVersion) GetNumber() OptInt {
+	return s.Number
+}
+
+// GetMessage returns the value of Message.
+func (s *PageVersion) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMinorEdit returns the value of MinorEdit.
+func (s *PageVersion) GetMinorEdit() OptBool {
+	return s.MinorEdit
+}
+
+// GetAuthorId returns the value of AuthorId.
+func (s *PageVersion) GetAuthorId() OptString {
+	return s.AuthorId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PageVersion) GetCreatedAt() OptString {
+	return s.CreatedAt
+}
+
+// SetNumber sets the value of Number.
+func (s *PageVersion) SetNumber(val OptInt) {
+	s.Number = val
+}
+
+// SetMessage sets the value of Message.
+func (s *PageVersion) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMinorEdit sets the value of MinorEdit.
+func (s *PageVersion) SetMinorEdit(val OptBool) {
+	s.MinorEdit = val
+}
+
+// SetAuthorId sets the value of AuthorId.
+func (s *PageVersion) SetAuthorId(val OptString) {
+	s.AuthorId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PageVersion) SetCreatedAt(val OptString) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/PageVersionListResult
+type PageVersionListResult struct {
+	Results []PageVersion `json:"results"`
+	Links   jx.Raw        `json:"_links"`
+}
+
+// GetResults returns the value of Results.
+func (s *PageVersionListResult) GetResults() []PageVersion {
+	return s.Results
+}
+
+// GetLinks returns the value of Links.
+func (s *PageVersionListResult) GetLinks() jx.Raw {
+	return s.Links
+}
+
+// SetResults sets the value of Results.
+func (s *PageVersionListResult) SetResults(val []PageVersion) {
+	s.Results = val
+}
+
+// SetLinks sets the value of Links.
+func (s *PageVersionListResult) SetLinks(val jx.Raw) {
+	s.Links = val
+}
+
 // Ref: #/components/schemas/SearchResult
 type SearchResult struct {
 	Results   []jx.Raw `json:"results"`
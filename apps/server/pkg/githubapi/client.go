@@ -7,7 +7,8 @@ import (
 	gen "mcpist/server/pkg/githubapi/gen"
 )
 
-const serverURL = "https://api.github.com"
+// DefaultServerURL is the GitHub.com REST API endpoint, used unless a base_url is configured.
+const DefaultServerURL = "https://api.github.com"
 
 // tokenSecuritySource implements gen.SecuritySource using a static token.
 type tokenSecuritySource struct {
@@ -20,5 +21,11 @@ func (s *tokenSecuritySource) BearerAuth(_ context.Context, _ gen.OperationName)
 
 // NewClient creates a new GitHub API client with the given access token.
 func NewClient(token string) (*gen.Client, error) {
+	return NewClientWithURL(DefaultServerURL, token)
+}
+
+// NewClientWithURL creates a new GitHub API client for serverURL, e.g. a
+// GitHub Enterprise Server endpoint (https://github.example.com/api/v3).
+func NewClientWithURL(serverURL, token string) (*gen.Client, error) {
 	return gen.NewClient(serverURL, &tokenSecuritySource{token: token})
 }
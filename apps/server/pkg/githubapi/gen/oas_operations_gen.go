@@ -6,28 +6,61 @@ package gen
 type OperationName = string
 
 const (
-	ActionsListWorkflowRunsOperation         OperationName = "ActionsListWorkflowRuns"
-	ActionsListWorkflowRunsByIdOperation     OperationName = "ActionsListWorkflowRunsById"
-	ActionsListWorkflowsOperation            OperationName = "ActionsListWorkflows"
-	ActivityListPublicEventsForUserOperation OperationName = "ActivityListPublicEventsForUser"
-	ActivityListReposStarredByUserOperation  OperationName = "ActivityListReposStarredByUser"
-	IssuesCreateOperation                    OperationName = "IssuesCreate"
-	IssuesCreateCommentOperation             OperationName = "IssuesCreateComment"
-	IssuesGetOperation                       OperationName = "IssuesGet"
-	IssuesListForRepoOperation               OperationName = "IssuesListForRepo"
-	IssuesUpdateOperation                    OperationName = "IssuesUpdate"
-	OrgsListForUserOperation                 OperationName = "OrgsListForUser"
-	PullsCreateOperation                     OperationName = "PullsCreate"
-	PullsGetOperation                        OperationName = "PullsGet"
-	PullsListFilesOperation                  OperationName = "PullsListFiles"
-	PullsListForRepoOperation                OperationName = "PullsListForRepo"
-	ReposGetOperation                        OperationName = "ReposGet"
-	ReposGetContentOperation                 OperationName = "ReposGetContent"
-	ReposListBranchesOperation               OperationName = "ReposListBranches"
-	ReposListCommitsOperation                OperationName = "ReposListCommits"
-	ReposListForUserOperation                OperationName = "ReposListForUser"
-	SearchCodeOperation                      OperationName = "SearchCode"
-	SearchIssuesOperation                    OperationName = "SearchIssues"
-	SearchReposOperation                     OperationName = "SearchRepos"
-	UsersGetByNameOperation                  OperationName = "UsersGetByName"
+	ActionsListWorkflowRunsOperation                       OperationName = "ActionsListWorkflowRuns"
+	ActionsListWorkflowRunsByIdOperation                   OperationName = "ActionsListWorkflowRunsById"
+	ActionsListWorkflowsOperation                          OperationName = "ActionsListWorkflows"
+	ActivityListNotificationsForAuthenticatedUserOperation OperationName = "ActivityListNotificationsForAuthenticatedUser"
+	ActivityListPublicEventsForUserOperation               OperationName = "ActivityListPublicEventsForUser"
+	ActivityListReposStarredByUserOperation                OperationName = "ActivityListReposStarredByUser"
+	ActivityMarkThreadAsReadOperation                      OperationName = "ActivityMarkThreadAsRead"
+	GistsCreateOperation                                   OperationName = "GistsCreate"
+	GistsListOperation                                     OperationName = "GistsList"
+	GistsListForUserOperation                              OperationName = "GistsListForUser"
+	GistsUpdateOperation                                   OperationName = "GistsUpdate"
+	GitCreateRefOperation                                  OperationName = "GitCreateRef"
+	GitDeleteRefOperation                                  OperationName = "GitDeleteRef"
+	GitGetRefOperation                                     OperationName = "GitGetRef"
+	IssuesCreateOperation                                  OperationName = "IssuesCreate"
+	IssuesCreateCommentOperation                           OperationName = "IssuesCreateComment"
+	IssuesCreateLabelOperation                             OperationName = "IssuesCreateLabel"
+	IssuesDeleteCommentOperation                           OperationName = "IssuesDeleteComment"
+	IssuesGetOperation                                     OperationName = "IssuesGet"
+	IssuesListCommentsOperation                            OperationName = "IssuesListComments"
+	IssuesListForRepoOperation                             OperationName = "IssuesListForRepo"
+	IssuesListLabelsForRepoOperation                       OperationName = "IssuesListLabelsForRepo"
+	IssuesLockOperation                                    OperationName = "IssuesLock"
+	IssuesUnlockOperation                                  OperationName = "IssuesUnlock"
+	IssuesUpdateOperation                                  OperationName = "IssuesUpdate"
+	IssuesUpdateCommentOperation                           OperationName = "IssuesUpdateComment"
+	OrgsListForUserOperation                               OperationName = "OrgsListForUser"
+	PullsCreateOperation                                   OperationName = "PullsCreate"
+	PullsGetOperation                                      OperationName = "PullsGet"
+	PullsListCommitsOperation                              OperationName = "PullsListCommits"
+	PullsListFilesOperation                                OperationName = "PullsListFiles"
+	PullsListForRepoOperation                              OperationName = "PullsListForRepo"
+	PullsListReviewCommentsOperation                       OperationName = "PullsListReviewComments"
+	PullsRequestReviewersOperation                         OperationName = "PullsRequestReviewers"
+	RateLimitGetOperation                                  OperationName = "RateLimitGet"
+	ReposAddCollaboratorOperation                          OperationName = "ReposAddCollaborator"
+	ReposCreateWebhookOperation                            OperationName = "ReposCreateWebhook"
+	ReposDeleteWebhookOperation                            OperationName = "ReposDeleteWebhook"
+	ReposGetOperation                                      OperationName = "ReposGet"
+	ReposGetAllTopicsOperation                             OperationName = "ReposGetAllTopics"
+	ReposGetContentOperation                               OperationName = "ReposGetContent"
+	ReposGetReleaseByTagOperation                          OperationName = "ReposGetReleaseByTag"
+	ReposListBranchesOperation                             OperationName = "ReposListBranches"
+	ReposListCollaboratorsOperation                        OperationName = "ReposListCollaborators"
+	ReposListCommitsOperation                              OperationName = "ReposListCommits"
+	ReposListContributorsOperation                         OperationName = "ReposListContributors"
+	ReposListForUserOperation                              OperationName = "ReposListForUser"
+	ReposListLanguagesOperation                            OperationName = "ReposListLanguages"
+	ReposListReleaseAssetsOperation                        OperationName = "ReposListReleaseAssets"
+	ReposListTagsOperation                                 OperationName = "ReposListTags"
+	ReposReplaceAllTopicsOperation                         OperationName = "ReposReplaceAllTopics"
+	SearchCodeOperation                                    OperationName = "SearchCode"
+	SearchIssuesOperation                                  OperationName = "SearchIssues"
+	SearchReposOperation                                   OperationName = "SearchRepos"
+	SearchUsersOperation                                   OperationName = "SearchUsers"
+	UsersGetByNameOperation                                OperationName = "UsersGetByName"
+	UsersListFollowersForUserOperation                     OperationName = "UsersListFollowersForUser"
 )
@@ -26,6 +26,16 @@ type ActionsListWorkflowsParams struct {
 	PerPage OptInt `json:",omitempty,omitzero"`
 }
 
+// ActivityListNotificationsForAuthenticatedUserParams is parameters of activityListNotificationsForAuthenticatedUser operation.
+type ActivityListNotificationsForAuthenticatedUserParams struct {
+	All           OptBool     `json:",omitempty,omitzero"`
+	Participating OptBool     `json:",omitempty,omitzero"`
+	Since         OptDateTime `json:",omitempty,omitzero"`
+	Before        OptDateTime `json:",omitempty,omitzero"`
+	PerPage       OptInt      `json:",omitempty,omitzero"`
+	Page          OptInt      `json:",omitempty,omitzero"`
+}
+
 // ActivityListPublicEventsForUserParams is parameters of activityListPublicEventsForUser operation.
 type ActivityListPublicEventsForUserParams struct {
 	Username string
@@ -42,6 +52,49 @@ type ActivityListReposStarredByUserParams struct {
 	Page      OptInt                                     `json:",omitempty,omitzero"`
 }
 
+// ActivityMarkThreadAsReadParams is parameters of activityMarkThreadAsRead operation.
+type ActivityMarkThreadAsReadParams struct {
+	ThreadID int64
+}
+
+// GistsListParams is parameters of gistsList operation.
+type GistsListParams struct {
+	PerPage OptInt `json:",omitempty,omitzero"`
+	Page    OptInt `json:",omitempty,omitzero"`
+}
+
+// GistsListForUserParams is parameters of gistsListForUser operation.
+type GistsListForUserParams struct {
+	Username string
+	PerPage  OptInt `json:",omitempty,omitzero"`
+	Page     OptInt `json:",omitempty,omitzero"`
+}
+
+// GistsUpdateParams is parameters of gistsUpdate operation.
+type GistsUpdateParams struct {
+	GistID string
+}
+
+// GitCreateRefParams is parameters of gitCreateRef operation.
+type GitCreateRefParams struct {
+	Owner string
+	Repo  string
+}
+
+// GitDeleteRefParams is parameters of gitDeleteRef operation.
+type GitDeleteRefParams struct {
+	Owner string
+	Repo  string
+	Ref   string
+}
+
+// GitGetRefParams is parameters of gitGetRef operation.
+type GitGetRefParams struct {
+	Owner string
+	Repo  string
+	Ref   string
+}
+
 // IssuesCreateParams is parameters of issuesCreate operation.
 type IssuesCreateParams struct {
 	Owner string
@@ -55,6 +108,19 @@ type IssuesCreateCommentParams struct {
 	IssueNumber int
 }
 
+// IssuesCreateLabelParams is parameters of issuesCreateLabel operation.
+type IssuesCreateLabelParams struct {
+	Owner string
+	Repo  string
+}
+
+// IssuesDeleteCommentParams is parameters of issuesDeleteComment operation.
+type IssuesDeleteCommentParams struct {
+	Owner     string
+	Repo      string
+	CommentID int64
+}
+
 // IssuesGetParams is parameters of issuesGet operation.
 type IssuesGetParams struct {
 	Owner       string
@@ -62,6 +128,15 @@ type IssuesGetParams struct {
 	IssueNumber int
 }
 
+// IssuesListCommentsParams is parameters of issuesListComments operation.
+type IssuesListCommentsParams struct {
+	Owner       string
+	Repo        string
+	IssueNumber int
+	PerPage     OptInt `json:",omitempty,omitzero"`
+	Page        OptInt `json:",omitempty,omitzero"`
+}
+
 // IssuesListForRepoParams is parameters of issuesListForRepo operation.
 type IssuesListForRepoParams struct {
 	Owner   string
@@ -71,6 +146,28 @@ type IssuesListForRepoParams struct {
 	Page    OptInt                    `json:",omitempty,omitzero"`
 }
 
+// IssuesListLabelsForRepoParams is parameters of issuesListLabelsForRepo operation.
+type IssuesListLabelsForRepoParams struct {
+	Owner   string
+	Repo    string
+	PerPage OptInt `json:",omitempty,omitzero"`
+	Page    OptInt `json:",omitempty,omitzero"`
+}
+
+// IssuesLockParams is parameters of issuesLock operation.
+type IssuesLockParams struct {
+	Owner       string
+	Repo        string
+	IssueNumber int
+}
+
+// IssuesUnlockParams is parameters of issuesUnlock operation.
+type IssuesUnlockParams struct {
+	Owner       string
+	Repo        string
+	IssueNumber int
+}
+
 // IssuesUpdateParams is parameters of issuesUpdate operation.
 type IssuesUpdateParams struct {
 	Owner       string
@@ -78,6 +175,13 @@ type IssuesUpdateParams struct {
 	IssueNumber int
 }
 
+// IssuesUpdateCommentParams is parameters of issuesUpdateComment operation.
+type IssuesUpdateCommentParams struct {
+	Owner     string
+	Repo      string
+	CommentID int64
+}
+
 // OrgsListForUserParams is parameters of orgsListForUser operation.
 type OrgsListForUserParams struct {
 	Username string
@@ -97,6 +201,15 @@ type PullsGetParams struct {
 	PullNumber int
 }
 
+// PullsListCommitsParams is parameters of pullsListCommits operation.
+type PullsListCommitsParams struct {
+	Owner      string
+	Repo       string
+	PullNumber int
+	PerPage    OptInt `json:",omitempty,omitzero"`
+	Page       OptInt `json:",omitempty,omitzero"`
+}
+
 // PullsListFilesParams is parameters of pullsListFiles operation.
 type PullsListFilesParams struct {
 	Owner      string
@@ -114,12 +227,54 @@ type PullsListForRepoParams struct {
 	Page    OptInt                   `json:",omitempty,omitzero"`
 }
 
+// PullsListReviewCommentsParams is parameters of pullsListReviewComments operation.
+type PullsListReviewCommentsParams struct {
+	Owner      string
+	Repo       string
+	PullNumber int
+	PerPage    OptInt `json:",omitempty,omitzero"`
+	Page       OptInt `json:",omitempty,omitzero"`
+}
+
+// PullsRequestReviewersParams is parameters of pullsRequestReviewers operation.
+type PullsRequestReviewersParams struct {
+	Owner      string
+	Repo       string
+	PullNumber int
+}
+
+// ReposAddCollaboratorParams is parameters of reposAddCollaborator operation.
+type ReposAddCollaboratorParams struct {
+	Owner    string
+	Repo     string
+	Username string
+}
+
+// ReposCreateWebhookParams is parameters of reposCreateWebhook operation.
+type ReposCreateWebhookParams struct {
+	Owner string
+	Repo  string
+}
+
+// ReposDeleteWebhookParams is parameters of reposDeleteWebhook operation.
+type ReposDeleteWebhookParams struct {
+	Owner  string
+	Repo   string
+	HookID int64
+}
+
 // ReposGetParams is parameters of reposGet operation.
 type ReposGetParams struct {
 	Owner string
 	Repo  string
 }
 
+// ReposGetAllTopicsParams is parameters of reposGetAllTopics operation.
+type ReposGetAllTopicsParams struct {
+	Owner string
+	Repo  string
+}
+
 // ReposGetContentParams is parameters of reposGetContent operation.
 type ReposGetContentParams struct {
 	Owner string
@@ -128,6 +283,13 @@ type ReposGetContentParams struct {
 	Ref   OptString `json:",omitempty,omitzero"`
 }
 
+// ReposGetReleaseByTagParams is parameters of reposGetReleaseByTag operation.
+type ReposGetReleaseByTagParams struct {
+	Owner string
+	Repo  string
+	Tag   string
+}
+
 // ReposListBranchesParams is parameters of reposListBranches operation.
 type ReposListBranchesParams struct {
 	Owner   string
@@ -135,6 +297,16 @@ type ReposListBranchesParams struct {
 	PerPage OptInt `json:",omitempty,omitzero"`
 }
 
+// ReposListCollaboratorsParams is parameters of reposListCollaborators operation.
+type ReposListCollaboratorsParams struct {
+	Owner       string
+	Repo        string
+	Affiliation OptString `json:",omitempty,omitzero"`
+	Permission  OptString `json:",omitempty,omitzero"`
+	PerPage     OptInt    `json:",omitempty,omitzero"`
+	Page        OptInt    `json:",omitempty,omitzero"`
+}
+
 // ReposListCommitsParams is parameters of reposListCommits operation.
 type ReposListCommitsParams struct {
 	Owner   string
@@ -144,6 +316,15 @@ type ReposListCommitsParams struct {
 	Page    OptInt    `json:",omitempty,omitzero"`
 }
 
+// ReposListContributorsParams is parameters of reposListContributors operation.
+type ReposListContributorsParams struct {
+	Owner   string
+	Repo    string
+	Anon    OptString `json:",omitempty,omitzero"`
+	PerPage OptInt    `json:",omitempty,omitzero"`
+	Page    OptInt    `json:",omitempty,omitzero"`
+}
+
 // ReposListForUserParams is parameters of reposListForUser operation.
 type ReposListForUserParams struct {
 	Username  string
@@ -154,6 +335,35 @@ type ReposListForUserParams struct {
 	Page      OptInt                       `json:",omitempty,omitzero"`
 }
 
+// ReposListLanguagesParams is parameters of reposListLanguages operation.
+type ReposListLanguagesParams struct {
+	Owner string
+	Repo  string
+}
+
+// ReposListReleaseAssetsParams is parameters of reposListReleaseAssets operation.
+type ReposListReleaseAssetsParams struct {
+	Owner     string
+	Repo      string
+	ReleaseID int64
+	PerPage   OptInt `json:",omitempty,omitzero"`
+	Page      OptInt `json:",omitempty,omitzero"`
+}
+
+// ReposListTagsParams is parameters of reposListTags operation.
+type ReposListTagsParams struct {
+	Owner   string
+	Repo    string
+	PerPage OptInt `json:",omitempty,omitzero"`
+	Page    OptInt `json:",omitempty,omitzero"`
+}
+
+// ReposReplaceAllTopicsParams is parameters of reposReplaceAllTopics operation.
+type ReposReplaceAllTopicsParams struct {
+	Owner string
+	Repo  string
+}
+
 // SearchCodeParams is parameters of searchCode operation.
 type SearchCodeParams struct {
 	Q       string
@@ -177,7 +387,22 @@ type SearchReposParams struct {
 	Page    OptInt    `json:",omitempty,omitzero"`
 }
 
+// SearchUsersParams is parameters of searchUsers operation.
+type SearchUsersParams struct {
+	Q       string
+	Sort    OptString `json:",omitempty,omitzero"`
+	PerPage OptInt    `json:",omitempty,omitzero"`
+	Page    OptInt    `json:",omitempty,omitzero"`
+}
+
 // UsersGetByNameParams is parameters of usersGetByName operation.
 type UsersGetByNameParams struct {
 	Username string
 }
+
+// UsersListFollowersForUserParams is parameters of usersListFollowersForUser operation.
+type UsersListFollowersForUserParams struct {
+	Username string
+	PerPage  OptInt `json:",omitempty,omitzero"`
+	Page     OptInt `json:",omitempty,omitzero"`
+}
@@ -163,6 +163,64 @@ func decodeActionsListWorkflowsResponse(resp *http.Response) (res *WorkflowsResp
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
+func decodeActivityListNotificationsForAuthenticatedUserResponse(resp *http.Response) (res []Thread, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Thread
+			if err := func() error {
+				response = make([]Thread, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Thread
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
 func decodeActivityListPublicEventsForUserResponse(resp *http.Response) (res []PublicEvent, _ error) {
 	switch resp.StatusCode {
 	case 200:
@@ -279,7 +337,1250 @@ func decodeActivityListReposStarredByUserResponse(resp *http.Response) (res []Re
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeIssuesCreateResponse(resp *http.Response) (res *Issue, _ error) {
+func decodeActivityMarkThreadAsReadResponse(resp *http.Response) (res *ActivityMarkThreadAsReadResetContent, _ error) {
+	switch resp.StatusCode {
+	case 205:
+		// Code 205.
+		return &ActivityMarkThreadAsReadResetContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGistsCreateResponse(resp *http.Response) (res *Gist, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Gist
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGistsListResponse(resp *http.Response) (res []Gist, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Gist
+			if err := func() error {
+				response = make([]Gist, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Gist
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGistsListForUserResponse(resp *http.Response) (res []Gist, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Gist
+			if err := func() error {
+				response = make([]Gist, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Gist
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGistsUpdateResponse(resp *http.Response) (res *Gist, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Gist
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGitCreateRefResponse(resp *http.Response) (res *GitRef, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GitRef
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGitDeleteRefResponse(resp *http.Response) (res *GitDeleteRefNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &GitDeleteRefNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeGitGetRefResponse(resp *http.Response) (res *GitRef, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response GitRef
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesCreateResponse(resp *http.Response) (res *Issue, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Issue
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesCreateCommentResponse(resp *http.Response) (res *IssueComment, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response IssueComment
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesCreateLabelResponse(resp *http.Response) (res *Label, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Label
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesDeleteCommentResponse(resp *http.Response) (res *IssuesDeleteCommentNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &IssuesDeleteCommentNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesGetResponse(resp *http.Response) (res *Issue, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Issue
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesListCommentsResponse(resp *http.Response) (res []IssueComment, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []IssueComment
+			if err := func() error {
+				response = make([]IssueComment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueComment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesListForRepoResponse(resp *http.Response) (res []Issue, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Issue
+			if err := func() error {
+				response = make([]Issue, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Issue
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesListLabelsForRepoResponse(resp *http.Response) (res []Label, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Label
+			if err := func() error {
+				response = make([]Label, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Label
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesLockResponse(resp *http.Response) (res *IssuesLockNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &IssuesLockNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesUnlockResponse(resp *http.Response) (res *IssuesUnlockNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &IssuesUnlockNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesUpdateResponse(resp *http.Response) (res *Issue, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response Issue
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeIssuesUpdateCommentResponse(resp *http.Response) (res *IssueComment, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response IssueComment
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeOrgsListForUserResponse(resp *http.Response) (res []Organization, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Organization
+			if err := func() error {
+				response = make([]Organization, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Organization
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsCreateResponse(resp *http.Response) (res *PullRequest, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PullRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsGetResponse(resp *http.Response) (res *PullRequest, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PullRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsListCommitsResponse(resp *http.Response) (res []Commit, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []Commit
+			if err := func() error {
+				response = make([]Commit, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Commit
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsListFilesResponse(resp *http.Response) (res []PullRequestFile, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []PullRequestFile
+			if err := func() error {
+				response = make([]PullRequestFile, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PullRequestFile
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsListForRepoResponse(resp *http.Response) (res []PullRequest, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []PullRequest
+			if err := func() error {
+				response = make([]PullRequest, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PullRequest
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsListReviewCommentsResponse(resp *http.Response) (res []PullRequestReviewComment, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []PullRequestReviewComment
+			if err := func() error {
+				response = make([]PullRequestReviewComment, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PullRequestReviewComment
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodePullsRequestReviewersResponse(resp *http.Response) (res *PullRequest, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response PullRequest
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeRateLimitGetResponse(resp *http.Response) (res *RateLimitOverview, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RateLimitOverview
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeReposAddCollaboratorResponse(resp *http.Response) (res ReposAddCollaboratorRes, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response RepositoryInvitation
+			if err := func() error {
+				if err := response.Decode(d); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			return &response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	case 204:
+		// Code 204.
+		return &ReposAddCollaboratorNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeReposCreateWebhookResponse(resp *http.Response) (res *Webhook, _ error) {
 	switch resp.StatusCode {
 	case 201:
 		// Code 201.
@@ -295,7 +1596,7 @@ func decodeIssuesCreateResponse(resp *http.Response) (res *Issue, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Issue
+			var response Webhook
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -320,10 +1621,19 @@ func decodeIssuesCreateResponse(resp *http.Response) (res *Issue, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeIssuesCreateCommentResponse(resp *http.Response) (res *IssueComment, _ error) {
+func decodeReposDeleteWebhookResponse(resp *http.Response) (res *ReposDeleteWebhookNoContent, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 204:
+		// Code 204.
+		return &ReposDeleteWebhookNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
+func decodeReposGetResponse(resp *http.Response) (res *Repository, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -336,7 +1646,7 @@ func decodeIssuesCreateCommentResponse(resp *http.Response) (res *IssueComment,
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response IssueComment
+			var response Repository
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -361,7 +1671,7 @@ func decodeIssuesCreateCommentResponse(resp *http.Response) (res *IssueComment,
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeIssuesGetResponse(resp *http.Response) (res *Issue, _ error) {
+func decodeReposGetAllTopicsResponse(resp *http.Response) (res *Topic, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -377,7 +1687,7 @@ func decodeIssuesGetResponse(resp *http.Response) (res *Issue, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Issue
+			var response Topic
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -394,6 +1704,15 @@ func decodeIssuesGetResponse(resp *http.Response) (res *Issue, _ error) {
 				}
 				return res, err
 			}
+			// Validate response.
+			if err := func() error {
+				if err := response.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
 			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
@@ -402,7 +1721,7 @@ func decodeIssuesGetResponse(resp *http.Response) (res *Issue, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeIssuesListForRepoResponse(resp *http.Response) (res []Issue, _ error) {
+func decodeReposGetContentResponse(resp *http.Response) (res *FileContent, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -418,17 +1737,9 @@ func decodeIssuesListForRepoResponse(resp *http.Response) (res []Issue, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Issue
+			var response FileContent
 			if err := func() error {
-				response = make([]Issue, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Issue
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -443,16 +1754,7 @@ func decodeIssuesListForRepoResponse(resp *http.Response) (res []Issue, _ error)
 				}
 				return res, err
 			}
-			// Validate response.
-			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
-				}
-				return nil
-			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
-			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -460,7 +1762,7 @@ func decodeIssuesListForRepoResponse(resp *http.Response) (res []Issue, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeIssuesUpdateResponse(resp *http.Response) (res *Issue, _ error) {
+func decodeReposGetReleaseByTagResponse(resp *http.Response) (res *Release, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -476,7 +1778,7 @@ func decodeIssuesUpdateResponse(resp *http.Response) (res *Issue, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Issue
+			var response Release
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -501,7 +1803,7 @@ func decodeIssuesUpdateResponse(resp *http.Response) (res *Issue, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeOrgsListForUserResponse(resp *http.Response) (res []Organization, _ error) {
+func decodeReposListBranchesResponse(resp *http.Response) (res []Branch, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -517,11 +1819,11 @@ func decodeOrgsListForUserResponse(resp *http.Response) (res []Organization, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Organization
+			var response []Branch
 			if err := func() error {
-				response = make([]Organization, 0)
+				response = make([]Branch, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Organization
+					var elem Branch
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -559,10 +1861,10 @@ func decodeOrgsListForUserResponse(resp *http.Response) (res []Organization, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodePullsCreateResponse(resp *http.Response) (res *PullRequest, _ error) {
+func decodeReposListCollaboratorsResponse(resp *http.Response) (res []Collaborator, _ error) {
 	switch resp.StatusCode {
-	case 201:
-		// Code 201.
+	case 200:
+		// Code 200.
 		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
 		if err != nil {
 			return res, errors.Wrap(err, "parse media type")
@@ -575,9 +1877,17 @@ func decodePullsCreateResponse(resp *http.Response) (res *PullRequest, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PullRequest
+			var response []Collaborator
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Collaborator, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Collaborator
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -592,7 +1902,16 @@ func decodePullsCreateResponse(resp *http.Response) (res *PullRequest, _ error)
 				}
 				return res, err
 			}
-			return &response, nil
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -600,7 +1919,7 @@ func decodePullsCreateResponse(resp *http.Response) (res *PullRequest, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodePullsGetResponse(resp *http.Response) (res *PullRequest, _ error) {
+func decodeReposListCommitsResponse(resp *http.Response) (res []Commit, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -616,9 +1935,17 @@ func decodePullsGetResponse(resp *http.Response) (res *PullRequest, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response PullRequest
+			var response []Commit
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]Commit, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Commit
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -633,7 +1960,16 @@ func decodePullsGetResponse(resp *http.Response) (res *PullRequest, _ error) {
 				}
 				return res, err
 			}
-			return &response, nil
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -641,7 +1977,7 @@ func decodePullsGetResponse(resp *http.Response) (res *PullRequest, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodePullsListFilesResponse(resp *http.Response) (res []PullRequestFile, _ error) {
+func decodeReposListContributorsResponse(resp *http.Response) (res []Contributor, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -657,11 +1993,11 @@ func decodePullsListFilesResponse(resp *http.Response) (res []PullRequestFile, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []PullRequestFile
+			var response []Contributor
 			if err := func() error {
-				response = make([]PullRequestFile, 0)
+				response = make([]Contributor, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PullRequestFile
+					var elem Contributor
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -699,7 +2035,7 @@ func decodePullsListFilesResponse(resp *http.Response) (res []PullRequestFile, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodePullsListForRepoResponse(resp *http.Response) (res []PullRequest, _ error) {
+func decodeReposListForUserResponse(resp *http.Response) (res []Repository, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -715,11 +2051,11 @@ func decodePullsListForRepoResponse(resp *http.Response) (res []PullRequest, _ e
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []PullRequest
+			var response []Repository
 			if err := func() error {
-				response = make([]PullRequest, 0)
+				response = make([]Repository, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PullRequest
+					var elem Repository
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -757,7 +2093,7 @@ func decodePullsListForRepoResponse(resp *http.Response) (res []PullRequest, _ e
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReposGetResponse(resp *http.Response) (res *Repository, _ error) {
+func decodeReposListLanguagesResponse(resp *http.Response) (res jx.Raw, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -773,9 +2109,11 @@ func decodeReposGetResponse(resp *http.Response) (res *Repository, _ error) {
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response Repository
+			var response jx.Raw
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				v, err := d.RawAppend(nil)
+				response = jx.Raw(v)
+				if err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -790,7 +2128,7 @@ func decodeReposGetResponse(resp *http.Response) (res *Repository, _ error) {
 				}
 				return res, err
 			}
-			return &response, nil
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -798,7 +2136,7 @@ func decodeReposGetResponse(resp *http.Response) (res *Repository, _ error) {
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReposGetContentResponse(resp *http.Response) (res *FileContent, _ error) {
+func decodeReposListReleaseAssetsResponse(resp *http.Response) (res []ReleaseAsset, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -814,9 +2152,17 @@ func decodeReposGetContentResponse(resp *http.Response) (res *FileContent, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response FileContent
+			var response []ReleaseAsset
 			if err := func() error {
-				if err := response.Decode(d); err != nil {
+				response = make([]ReleaseAsset, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ReleaseAsset
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -831,7 +2177,16 @@ func decodeReposGetContentResponse(resp *http.Response) (res *FileContent, _ err
 				}
 				return res, err
 			}
-			return &response, nil
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -839,7 +2194,7 @@ func decodeReposGetContentResponse(resp *http.Response) (res *FileContent, _ err
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReposListBranchesResponse(resp *http.Response) (res []Branch, _ error) {
+func decodeReposListTagsResponse(resp *http.Response) (res []Tag, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -855,11 +2210,11 @@ func decodeReposListBranchesResponse(resp *http.Response) (res []Branch, _ error
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Branch
+			var response []Tag
 			if err := func() error {
-				response = make([]Branch, 0)
+				response = make([]Tag, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Branch
+					var elem Tag
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
@@ -897,7 +2252,7 @@ func decodeReposListBranchesResponse(resp *http.Response) (res []Branch, _ error
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReposListCommitsResponse(resp *http.Response) (res []Commit, _ error) {
+func decodeReposReplaceAllTopicsResponse(resp *http.Response) (res *Topic, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -913,17 +2268,9 @@ func decodeReposListCommitsResponse(resp *http.Response) (res []Commit, _ error)
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Commit
+			var response Topic
 			if err := func() error {
-				response = make([]Commit, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Commit
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -940,14 +2287,14 @@ func decodeReposListCommitsResponse(resp *http.Response) (res []Commit, _ error)
 			}
 			// Validate response.
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -955,7 +2302,7 @@ func decodeReposListCommitsResponse(resp *http.Response) (res []Commit, _ error)
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeReposListForUserResponse(resp *http.Response) (res []Repository, _ error) {
+func decodeSearchCodeResponse(resp *http.Response) (res *SearchResultCode, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -971,17 +2318,9 @@ func decodeReposListForUserResponse(resp *http.Response) (res []Repository, _ er
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response []Repository
+			var response SearchResultCode
 			if err := func() error {
-				response = make([]Repository, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Repository
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					response = append(response, elem)
-					return nil
-				}); err != nil {
+				if err := response.Decode(d); err != nil {
 					return err
 				}
 				if err := d.Skip(); err != io.EOF {
@@ -998,14 +2337,14 @@ func decodeReposListForUserResponse(resp *http.Response) (res []Repository, _ er
 			}
 			// Validate response.
 			if err := func() error {
-				if response == nil {
-					return errors.New("nil is invalid value")
+				if err := response.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return res, errors.Wrap(err, "validate")
 			}
-			return response, nil
+			return &response, nil
 		default:
 			return res, validate.InvalidContentType(ct)
 		}
@@ -1013,7 +2352,7 @@ func decodeReposListForUserResponse(resp *http.Response) (res []Repository, _ er
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeSearchCodeResponse(resp *http.Response) (res *SearchResultCode, _ error) {
+func decodeSearchIssuesResponse(resp *http.Response) (res *SearchResultIssues, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1029,7 +2368,7 @@ func decodeSearchCodeResponse(resp *http.Response) (res *SearchResultCode, _ err
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchResultCode
+			var response SearchResultIssues
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1063,7 +2402,7 @@ func decodeSearchCodeResponse(resp *http.Response) (res *SearchResultCode, _ err
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeSearchIssuesResponse(resp *http.Response) (res *SearchResultIssues, _ error) {
+func decodeSearchReposResponse(resp *http.Response) (res *SearchResultRepositories, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1079,7 +2418,7 @@ func decodeSearchIssuesResponse(resp *http.Response) (res *SearchResultIssues, _
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchResultIssues
+			var response SearchResultRepositories
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1113,7 +2452,7 @@ func decodeSearchIssuesResponse(resp *http.Response) (res *SearchResultIssues, _
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
-func decodeSearchReposResponse(resp *http.Response) (res *SearchResultRepositories, _ error) {
+func decodeSearchUsersResponse(resp *http.Response) (res *SearchResultUsers, _ error) {
 	switch resp.StatusCode {
 	case 200:
 		// Code 200.
@@ -1129,7 +2468,7 @@ func decodeSearchReposResponse(resp *http.Response) (res *SearchResultRepositori
 			}
 			d := jx.DecodeBytes(buf)
 
-			var response SearchResultRepositories
+			var response SearchResultUsers
 			if err := func() error {
 				if err := response.Decode(d); err != nil {
 					return err
@@ -1203,3 +2542,61 @@ func decodeUsersGetByNameResponse(resp *http.Response) (res *SimpleUser, _ error
 	}
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
+
+func decodeUsersListFollowersForUserResponse(resp *http.Response) (res []SimpleUser, _ error) {
+	switch resp.StatusCode {
+	case 200:
+		// Code 200.
+		ct, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		if err != nil {
+			return res, errors.Wrap(err, "parse media type")
+		}
+		switch {
+		case ct == "application/json":
+			buf, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return res, err
+			}
+			d := jx.DecodeBytes(buf)
+
+			var response []SimpleUser
+			if err := func() error {
+				response = make([]SimpleUser, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem SimpleUser
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					response = append(response, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if err := d.Skip(); err != io.EOF {
+					return errors.New("unexpected trailing data")
+				}
+				return nil
+			}(); err != nil {
+				err = &ogenerrors.DecodeBodyError{
+					ContentType: ct,
+					Body:        buf,
+					Err:         err,
+				}
+				return res, err
+			}
+			// Validate response.
+			if err := func() error {
+				if response == nil {
+					return errors.New("nil is invalid value")
+				}
+				return nil
+			}(); err != nil {
+				return res, errors.Wrap(err, "validate")
+			}
+			return response, nil
+		default:
+			return res, validate.InvalidContentType(ct)
+		}
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
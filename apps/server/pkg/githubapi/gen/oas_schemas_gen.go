@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 type ActivityListReposStarredByUserDirection string
@@ -91,6 +92,24 @@ func (s *ActivityListReposStarredByUserSort) UnmarshalText(data []byte) error {
 	}
 }
 
+// ActivityMarkThreadAsReadResetContent is response for ActivityMarkThreadAsRead operation.
+type ActivityMarkThreadAsReadResetContent struct{}
+
+// Ref: #/components/schemas/AddCollaboratorRequest
+type AddCollaboratorRequest struct {
+	Permission OptString `json:"permission"`
+}
+
+// GetPermission returns the value of Permission.
+func (s *AddCollaboratorRequest) GetPermission() OptString {
+	return s.Permission
+}
+
+// SetPermission sets the value of Permission.
+func (s *AddCollaboratorRequest) SetPermission(val OptString) {
+	s.Permission = val
+}
+
 type BearerAuth struct {
 	Token string
 	Roles []string
@@ -142,6 +161,145 @@ func (s *Branch) SetProtected(val OptBool) {
 	s.Protected = val
 }
 
+// Ref: #/components/schemas/Collaborator
+type Collaborator struct {
+	ID          int64                      `json:"id"`
+	Login       string                     `json:"login"`
+	HTMLURL     OptURI                     `json:"html_url"`
+	Type        OptString                  `json:"type"`
+	SiteAdmin   OptBool                    `json:"site_admin"`
+	Permissions OptCollaboratorPermissions `json:"permissions"`
+	RoleName    OptString                  `json:"role_name"`
+}
+
+// GetID returns the value of ID.
+func (s *Collaborator) GetID() int64 {
+	return s.ID
+}
+
+// GetLogin returns the value of Login.
+func (s *Collaborator) GetLogin() string {
+	return s.Login
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *Collaborator) GetHTMLURL() OptURI {
+	return s.HTMLURL
+}
+
+// GetType returns the value of Type.
+func (s *Collaborator) GetType() OptString {
+	return s.Type
+}
+
+// GetSiteAdmin returns the value of SiteAdmin.
+func (s *Collaborator) GetSiteAdmin() OptBool {
+	return s.SiteAdmin
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *Collaborator) GetPermissions() OptCollaboratorPermissions {
+	return s.Permissions
+}
+
+// GetRoleName returns the value of RoleName.
+func (s *Collaborator) GetRoleName() OptString {
+	return s.RoleName
+}
+
+// SetID sets the value of ID.
+func (s *Collaborator) SetID(val int64) {
+	s.ID = val
+}
+
+// SetLogin sets the value of Login.
+func (s *Collaborator) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *Collaborator) SetHTMLURL(val OptURI) {
+	s.HTMLURL = val
+}
+
+// SetType sets the value of Type.
+func (s *Collaborator) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetSiteAdmin sets the value of SiteAdmin.
+func (s *Collaborator) SetSiteAdmin(val OptBool) {
+	s.SiteAdmin = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *Collaborator) SetPermissions(val OptCollaboratorPermissions) {
+	s.Permissions = val
+}
+
+// SetRoleName sets the value of RoleName.
+func (s *Collaborator) SetRoleName(val OptString) {
+	s.RoleName = val
+}
+
+type CollaboratorPermissions struct {
+	Pull     OptBool `json:"pull"`
+	Triage   OptBool `json:"triage"`
+	Push     OptBool `json:"push"`
+	Maintain OptBool `json:"maintain"`
+	Admin    OptBool `json:"admin"`
+}
+
+// GetPull returns the value of Pull.
+func (s *CollaboratorPermissions) GetPull() OptBool {
+	return s.Pull
+}
+
+// GetTriage returns the value of Triage.
+func (s *CollaboratorPermissions) GetTriage() OptBool {
+	return s.Triage
+}
+
+// GetPush returns the value of Push.
+func (s *CollaboratorPermissions) GetPush() OptBool {
+	return s.Push
+}
+
+// GetMaintain returns the value of Maintain.
+func (s *CollaboratorPermissions) GetMaintain() OptBool {
+	return s.Maintain
+}
+
+// GetAdmin returns the value of Admin.
+func (s *CollaboratorPermissions) GetAdmin() OptBool {
+	return s.Admin
+}
+
+// SetPull sets the value of Pull.
+func (s *CollaboratorPermissions) SetPull(val OptBool) {
+	s.Pull = val
+}
+
+// SetTriage sets the value of Triage.
+func (s *CollaboratorPermissions) SetTriage(val OptBool) {
+	s.Triage = val
+}
+
+// SetPush sets the value of Push.
+func (s *CollaboratorPermissions) SetPush(val OptBool) {
+	s.Push = val
+}
+
+// SetMaintain sets the value of Maintain.
+func (s *CollaboratorPermissions) SetMaintain(val OptBool) {
+	s.Maintain = val
+}
+
+// SetAdmin sets the value of Admin.
+func (s *CollaboratorPermissions) SetAdmin(val OptBool) {
+	s.Admin = val
+}
+
 // Ref: #/components/schemas/Commit
 type Commit struct {
 	Sha     string             `json:"sha"`
@@ -240,6 +398,65 @@ func (s *CommitCommitAuthor) SetDate(val OptDateTime) {
 	s.Date = val
 }
 
+// Ref: #/components/schemas/Contributor
+type Contributor struct {
+	Login         OptString `json:"login"`
+	ID            OptInt64  `json:"id"`
+	HTMLURL       OptURI    `json:"html_url"`
+	Type          OptString `json:"type"`
+	Contributions int       `json:"contributions"`
+}
+
+// GetLogin returns the value of Login.
+func (s *Contributor) GetLogin() OptString {
+	return s.Login
+}
+
+// GetID returns the value of ID.
+func (s *Contributor) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *Contributor) GetHTMLURL() OptURI {
+	return s.HTMLURL
+}
+
+// GetType returns the value of Type.
+func (s *Contributor) GetType() OptString {
+	return s.Type
+}
+
+// GetContributions returns the value of Contributions.
+func (s *Contributor) GetContributions() int {
+	return s.Contributions
+}
+
+// SetLogin sets the value of Login.
+func (s *Contributor) SetLogin(val OptString) {
+	s.Login = val
+}
+
+// SetID sets the value of ID.
+func (s *Contributor) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *Contributor) SetHTMLURL(val OptURI) {
+	s.HTMLURL = val
+}
+
+// SetType sets the value of Type.
+func (s *Contributor) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetContributions sets the value of Contributions.
+func (s *Contributor) SetContributions(val int) {
+	s.Contributions = val
+}
+
 // Ref: #/components/schemas/CreateCommentRequest
 type CreateCommentRequest struct {
 	Body string `json:"body"`
@@ -255,6 +472,43 @@ func (s *CreateCommentRequest) SetBody(val string) {
 	s.Body = val
 }
 
+// Ref: #/components/schemas/CreateGistRequest
+type CreateGistRequest struct {
+	Description OptString `json:"description"`
+	Public      OptBool   `json:"public"`
+	Files       jx.Raw    `json:"files"`
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateGistRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetPublic returns the value of Public.
+func (s *CreateGistRequest) GetPublic() OptBool {
+	return s.Public
+}
+
+// GetFiles returns the value of Files.
+func (s *CreateGistRequest) GetFiles() jx.Raw {
+	return s.Files
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateGistRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetPublic sets the value of Public.
+func (s *CreateGistRequest) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetFiles sets the value of Files.
+func (s *CreateGistRequest) SetFiles(val jx.Raw) {
+	s.Files = val
+}
+
 // Ref: #/components/schemas/CreateIssueRequest
 type CreateIssueRequest struct {
 	Title     string    `json:"title"`
@@ -303,6 +557,43 @@ func (s *CreateIssueRequest) SetAssignees(val []string) {
 	s.Assignees = val
 }
 
+// Ref: #/components/schemas/CreateLabelRequest
+type CreateLabelRequest struct {
+	Name        string    `json:"name"`
+	Color       OptString `json:"color"`
+	Description OptString `json:"description"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateLabelRequest) GetName() string {
+	return s.Name
+}
+
+// GetColor returns the value of Color.
+func (s *CreateLabelRequest) GetColor() OptString {
+	return s.Color
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateLabelRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// SetName sets the value of Name.
+func (s *CreateLabelRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetColor sets the value of Color.
+func (s *CreateLabelRequest) SetColor(val OptString) {
+	s.Color = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateLabelRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
 // Ref: #/components/schemas/CreatePRRequest
 type CreatePRRequest struct {
 	Title string    `json:"title"`
@@ -362,6 +653,80 @@ func (s *CreatePRRequest) SetDraft(val OptBool) {
 	s.Draft = val
 }
 
+// Ref: #/components/schemas/CreateRefRequest
+type CreateRefRequest struct {
+	Ref string `json:"ref"`
+	Sha string `json:"sha"`
+}
+
+// GetRef returns the value of Ref.
+func (s *CreateRefRequest) GetRef() string {
+	return s.Ref
+}
+
+// GetSha returns the value of Sha.
+func (s *CreateRefRequest) GetSha() string {
+	return s.Sha
+}
+
+// SetRef sets the value of Ref.
+func (s *CreateRefRequest) SetRef(val string) {
+	s.Ref = val
+}
+
+// SetSha sets the value of Sha.
+func (s *CreateRefRequest) SetSha(val string) {
+	s.Sha = val
+}
+
+// Ref: #/components/schemas/CreateWebhookRequest
+type CreateWebhookRequest struct {
+	Name   OptString     `json:"name"`
+	Active OptBool       `json:"active"`
+	Events []string      `json:"events"`
+	Config WebhookConfig `json:"config"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateWebhookRequest) GetName() OptString {
+	return s.Name
+}
+
+// GetActive returns the value of Active.
+func (s *CreateWebhookRequest) GetActive() OptBool {
+	return s.Active
+}
+
+// GetEvents returns the value of Events.
+func (s *CreateWebhookRequest) GetEvents() []string {
+	return s.Events
+}
+
+// GetConfig returns the value of Config.
+func (s *CreateWebhookRequest) GetConfig() WebhookConfig {
+	return s.Config
+}
+
+// SetName sets the value of Name.
+func (s *CreateWebhookRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetActive sets the value of Active.
+func (s *CreateWebhookRequest) SetActive(val OptBool) {
+	s.Active = val
+}
+
+// SetEvents sets the value of Events.
+func (s *CreateWebhookRequest) SetEvents(val []string) {
+	s.Events = val
+}
+
+// SetConfig sets the value of Config.
+func (s *CreateWebhookRequest) SetConfig(val WebhookConfig) {
+	s.Config = val
+}
+
 // Ref: #/components/schemas/FileContent
 type FileContent struct {
 	Name     string    `json:"name"`
@@ -454,80 +819,270 @@ func (s *FileContent) SetHTMLURL(val OptURI) {
 	s.HTMLURL = val
 }
 
-// Ref: #/components/schemas/Issue
-type Issue struct {
-	ID        int64          `json:"id"`
-	Number    int            `json:"number"`
-	Title     string         `json:"title"`
-	State     string         `json:"state"`
-	Body      OptNilString   `json:"body"`
-	HTMLURL   url.URL        `json:"html_url"`
-	User      OptIssueUser   `json:"user"`
-	Labels    []Label        `json:"labels"`
-	Assignees []IssueUser    `json:"assignees"`
-	CreatedAt OptDateTime    `json:"created_at"`
-	UpdatedAt OptDateTime    `json:"updated_at"`
-	ClosedAt  OptNilDateTime `json:"closed_at"`
+// Ref: #/components/schemas/Gist
+type Gist struct {
+	ID          string       `json:"id"`
+	HTMLURL     url.URL      `json:"html_url"`
+	Description OptNilString `json:"description"`
+	Public      OptBool      `json:"public"`
+	Comments    OptInt       `json:"comments"`
+	Owner       OptIssueUser `json:"owner"`
+	Files       jx.Raw       `json:"files"`
+	CreatedAt   OptDateTime  `json:"created_at"`
+	UpdatedAt   OptDateTime  `json:"updated_at"`
 }
 
 // GetID returns the value of ID.
-func (s *Issue) GetID() int64 {
+func (s *Gist) GetID() string {
 	return s.ID
 }
 
-// GetNumber returns the value of Number.
-func (s *Issue) GetNumber() int {
-	return s.Number
-}
-
-// GetTitle returns the value of Title.
-func (s *Issue) GetTitle() string {
-	return s.Title
-}
-
-// GetState returns the value of State.
-func (s *Issue) GetState() string {
-	return s.State
+// GetHTMLURL returns the value of HTMLURL.
+func (s *Gist) GetHTMLURL() url.URL {
+	return s.HTMLURL
 }
 
-// GetBody returns the value of Body.
-func (s *Issue) GetBody() OptNilString {
-	return s.Body
+// GetDescription returns the value of Description.
+func (s *Gist) GetDescription() OptNilString {
+	return s.Description
 }
 
-// GetHTMLURL returns the value of HTMLURL.
-func (s *Issue) GetHTMLURL() url.URL {
-	return s.HTMLURL
+// GetPublic returns the value of Public.
+func (s *Gist) GetPublic() OptBool {
+	return s.Public
 }
 
-// GetUser returns the value of User.
-func (s *Issue) GetUser() OptIssueUser {
-	return s.User
+// GetComments returns the value of Comments.
+func (s *Gist) GetComments() OptInt {
+	return s.Comments
 }
 
-// GetLabels returns the value of Labels.
-func (s *Issue) GetLabels() []Label {
-	return s.Labels
+// GetOwner returns the value of Owner.
+func (s *Gist) GetOwner() OptIssueUser {
+	return s.Owner
 }
 
-// GetAssignees returns the value of Assignees.
-func (s *Issue) GetAssignees() []IssueUser {
-	return s.Assignees
+// GetFiles returns the value of Files.
+func (s *Gist) GetFiles() jx.Raw {
+	return s.Files
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *Issue) GetCreatedAt() OptDateTime {
+func (s *Gist) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
 }
 
 // GetUpdatedAt returns the value of UpdatedAt.
-func (s *Issue) GetUpdatedAt() OptDateTime {
+func (s *Gist) GetUpdatedAt() OptDateTime {
 	return s.UpdatedAt
 }
 
-// GetClosedAt returns the value of ClosedAt.
-func (s *Issue) GetClosedAt() OptNilDateTime {
-	return s.ClosedAt
+// SetID sets the value of ID.
+func (s *Gist) SetID(val string) {
+	s.ID = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *Gist) SetHTMLURL(val url.URL) {
+	s.HTMLURL = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Gist) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetPublic sets the value of Public.
+func (s *Gist) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetComments sets the value of Comments.
+func (s *Gist) SetComments(val OptInt) {
+	s.Comments = val
+}
+
+// SetOwner sets the value of Owner.
+func (s *Gist) SetOwner(val OptIssueUser) {
+	s.Owner = val
+}
+
+// SetFiles sets the value of Files.
+func (s *Gist) SetFiles(val jx.Raw) {
+	s.Files = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Gist) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Gist) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// GitDeleteRefNoContent is response for GitDeleteRef operation.
+type GitDeleteRefNoContent struct{}
+
+// Ref: #/components/schemas/GitRef
+type GitRef struct {
+	Ref    string       `json:"ref"`
+	NodeID OptString    `json:"node_id"`
+	URL    OptURI       `json:"url"`
+	Object GitRefObject `json:"object"`
+}
+
+// GetRef returns the value of Ref.
+func (s *GitRef) GetRef() string {
+	return s.Ref
+}
+
+// GetNodeID returns the value of NodeID.
+func (s *GitRef) GetNodeID() OptString {
+	return s.NodeID
+}
+
+// GetURL returns the value of URL.
+func (s *GitRef) GetURL() OptURI {
+	return s.URL
+}
+
+// GetObject returns the value of Object.
+func (s *GitRef) GetObject() GitRefObject {
+	return s.Object
+}
+
+// SetRef sets the value of Ref.
+func (s *GitRef) SetRef(val string) {
+	s.Ref = val
+}
+
+// SetNodeID sets the value of NodeID.
+func (s *GitRef) SetNodeID(val OptString) {
+	s.NodeID = val
+}
+
+// SetURL sets the value of URL.
+func (s *GitRef) SetURL(val OptURI) {
+	s.URL = val
+}
+
+// SetObject sets the value of Object.
+func (s *GitRef) SetObject(val GitRefObject) {
+	s.Object = val
+}
+
+type GitRefObject struct {
+	Sha  string `json:"sha"`
+	Type string `json:"type"`
+	URL  OptURI `json:"url"`
+}
+
+// GetSha returns the value of Sha.
+func (s *GitRefObject) GetSha() string {
+	return s.Sha
+}
+
+// GetType returns the value of Type.
+func (s *GitRefObject) GetType() string {
+	return s.Type
+}
+
+// GetURL returns the value of URL.
+func (s *GitRefObject) GetURL() OptURI {
+	return s.URL
+}
+
+// SetSha sets the value of Sha.
+func (s *GitRefObject) SetSha(val string) {
+	s.Sha = val
+}
+
+// SetType sets the value of Type.
+func (s *GitRefObject) SetType(val string) {
+	s.Type = val
+}
+
+// SetURL sets the value of URL.
+func (s *GitRefObject) SetURL(val OptURI) {
+	s.URL = val
+}
+
+// Ref: #/components/schemas/Issue
+type Issue struct {
+	ID        int64          `json:"id"`
+	Number    int            `json:"number"`
+	Title     string         `json:"title"`
+	State     string         `json:"state"`
+	Body      OptNilString   `json:"body"`
+	HTMLURL   url.URL        `json:"html_url"`
+	User      OptIssueUser   `json:"user"`
+	Labels    []Label        `json:"labels"`
+	Assignees []IssueUser    `json:"assignees"`
+	CreatedAt OptDateTime    `json:"created_at"`
+	UpdatedAt OptDateTime    `json:"updated_at"`
+	ClosedAt  OptNilDateTime `json:"closed_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Issue) GetID() int64 {
+	return s.ID
+}
+
+// GetNumber returns the value of Number.
+func (s *Issue) GetNumber() int {
+	return s.Number
+}
+
+// GetTitle returns the value of Title.
+func (s *Issue) GetTitle() string {
+	return s.Title
+}
+
+// GetState returns the value of State.
+func (s *Issue) GetState() string {
+	return s.State
+}
+
+// GetBody returns the value of Body.
+func (s *Issue) GetBody() OptNilString {
+	return s.Body
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *Issue) GetHTMLURL() url.URL {
+	return s.HTMLURL
+}
+
+// GetUser returns the value of User.
+func (s *Issue) GetUser() OptIssueUser {
+	return s.User
+}
+
+// GetLabels returns the value of Labels.
+func (s *Issue) GetLabels() []Label {
+	return s.Labels
+}
+
+// GetAssignees returns the value of Assignees.
+func (s *Issue) GetAssignees() []IssueUser {
+	return s.Assignees
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Issue) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Issue) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetClosedAt returns the value of ClosedAt.
+func (s *Issue) GetClosedAt() OptNilDateTime {
+	return s.ClosedAt
 }
 
 // SetID sets the value of ID.
@@ -596,6 +1151,7 @@ type IssueComment struct {
 	Body      string       `json:"body"`
 	HTMLURL   url.URL      `json:"html_url"`
 	User      OptIssueUser `json:"user"`
+	Reactions OptReactions `json:"reactions"`
 	CreatedAt OptDateTime  `json:"created_at"`
 	UpdatedAt OptDateTime  `json:"updated_at"`
 }
@@ -620,6 +1176,11 @@ func (s *IssueComment) GetUser() OptIssueUser {
 	return s.User
 }
 
+// GetReactions returns the value of Reactions.
+func (s *IssueComment) GetReactions() OptReactions {
+	return s.Reactions
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *IssueComment) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -650,6 +1211,11 @@ func (s *IssueComment) SetUser(val OptIssueUser) {
 	s.User = val
 }
 
+// SetReactions sets the value of Reactions.
+func (s *IssueComment) SetReactions(val OptReactions) {
+	s.Reactions = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *IssueComment) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
@@ -686,6 +1252,9 @@ func (s *IssueUser) SetID(val int64) {
 	s.ID = val
 }
 
+// IssuesDeleteCommentNoContent is response for IssuesDeleteComment operation.
+type IssuesDeleteCommentNoContent struct{}
+
 type IssuesListForRepoState string
 
 const (
@@ -734,10 +1303,24 @@ func (s *IssuesListForRepoState) UnmarshalText(data []byte) error {
 	}
 }
 
+// IssuesLockNoContent is response for IssuesLock operation.
+type IssuesLockNoContent struct{}
+
+// IssuesUnlockNoContent is response for IssuesUnlock operation.
+type IssuesUnlockNoContent struct{}
+
 // Ref: #/components/schemas/Label
 type Label struct {
-	Name  OptString `json:"name"`
-	Color OptString `json:"color"`
+	ID          OptInt64     `json:"id"`
+	Name        OptString    `json:"name"`
+	Color       OptString    `json:"color"`
+	Description OptNilString `json:"description"`
+	Default     OptBool      `json:"default"`
+}
+
+// GetID returns the value of ID.
+func (s *Label) GetID() OptInt64 {
+	return s.ID
 }
 
 // GetName returns the value of Name.
@@ -750,6 +1333,21 @@ func (s *Label) GetColor() OptString {
 	return s.Color
 }
 
+// GetDescription returns the value of Description.
+func (s *Label) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetDefault returns the value of Default.
+func (s *Label) GetDefault() OptBool {
+	return s.Default
+}
+
+// SetID sets the value of ID.
+func (s *Label) SetID(val OptInt64) {
+	s.ID = val
+}
+
 // SetName sets the value of Name.
 func (s *Label) SetName(val OptString) {
 	s.Name = val
@@ -760,6 +1358,31 @@ func (s *Label) SetColor(val OptString) {
 	s.Color = val
 }
 
+// SetDescription sets the value of Description.
+func (s *Label) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetDefault sets the value of Default.
+func (s *Label) SetDefault(val OptBool) {
+	s.Default = val
+}
+
+// Ref: #/components/schemas/LockIssueRequest
+type LockIssueRequest struct {
+	LockReason OptString `json:"lock_reason"`
+}
+
+// GetLockReason returns the value of LockReason.
+func (s *LockIssueRequest) GetLockReason() OptString {
+	return s.LockReason
+}
+
+// SetLockReason sets the value of LockReason.
+func (s *LockIssueRequest) SetLockReason(val OptString) {
+	s.LockReason = val
+}
+
 // NewOptActivityListReposStarredByUserDirection returns new OptActivityListReposStarredByUserDirection with value set to v.
 func NewOptActivityListReposStarredByUserDirection(v ActivityListReposStarredByUserDirection) OptActivityListReposStarredByUserDirection {
 	return OptActivityListReposStarredByUserDirection{
@@ -898,6 +1521,52 @@ func (o OptBool) Or(d bool) bool {
 	return d
 }
 
+// NewOptCollaboratorPermissions returns new OptCollaboratorPermissions with value set to v.
+func NewOptCollaboratorPermissions(v CollaboratorPermissions) OptCollaboratorPermissions {
+	return OptCollaboratorPermissions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCollaboratorPermissions is optional CollaboratorPermissions.
+type OptCollaboratorPermissions struct {
+	Value CollaboratorPermissions
+	Set   bool
+}
+
+// IsSet returns true if OptCollaboratorPermissions was set.
+func (o OptCollaboratorPermissions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCollaboratorPermissions) Reset() {
+	var v CollaboratorPermissions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCollaboratorPermissions) SetTo(v CollaboratorPermissions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCollaboratorPermissions) Get() (v CollaboratorPermissions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCollaboratorPermissions) Or(d CollaboratorPermissions) CollaboratorPermissions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptCommitCommit returns new OptCommitCommit with value set to v.
 func NewOptCommitCommit(v CommitCommit) OptCommitCommit {
 	return OptCommitCommit{
@@ -1036,38 +1705,38 @@ func (o OptDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptFloat64) Reset() {
+	var v float64
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptFloat64) SetTo(v float64) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptFloat64) Get() (v float64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1075,45 +1744,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptFloat64) Or(d float64) float64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptIssueUser returns new OptIssueUser with value set to v.
-func NewOptIssueUser(v IssueUser) OptIssueUser {
-	return OptIssueUser{
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptIssueUser is optional IssueUser.
-type OptIssueUser struct {
-	Value IssueUser
+// OptInt is optional int.
+type OptInt struct {
+	Value int
 	Set   bool
 }
 
-// IsSet returns true if OptIssueUser was set.
-func (o OptIssueUser) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptIssueUser) Reset() {
-	var v IssueUser
+func (o *OptInt) Reset() {
+	var v int
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptIssueUser) SetTo(v IssueUser) {
+func (o *OptInt) SetTo(v int) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptIssueUser) Get() (v IssueUser, ok bool) {
+func (o OptInt) Get() (v int, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1121,16 +1790,108 @@ func (o OptIssueUser) Get() (v IssueUser, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptIssueUser) Or(d IssueUser) IssueUser {
+func (o OptInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptIssuesListForRepoState returns new OptIssuesListForRepoState with value set to v.
-func NewOptIssuesListForRepoState(v IssuesListForRepoState) OptIssuesListForRepoState {
-	return OptIssuesListForRepoState{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssueUser returns new OptIssueUser with value set to v.
+func NewOptIssueUser(v IssueUser) OptIssueUser {
+	return OptIssueUser{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIssueUser is optional IssueUser.
+type OptIssueUser struct {
+	Value IssueUser
+	Set   bool
+}
+
+// IsSet returns true if OptIssueUser was set.
+func (o OptIssueUser) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIssueUser) Reset() {
+	var v IssueUser
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIssueUser) SetTo(v IssueUser) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIssueUser) Get() (v IssueUser, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIssueUser) Or(d IssueUser) IssueUser {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIssuesListForRepoState returns new OptIssuesListForRepoState with value set to v.
+func NewOptIssuesListForRepoState(v IssuesListForRepoState) OptIssuesListForRepoState {
+	return OptIssuesListForRepoState{
 		Value: v,
 		Set:   true,
 	}
@@ -1237,6 +1998,69 @@ func (o OptNilDateTime) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilString returns new OptNilString with value set to v.
 func NewOptNilString(v string) OptNilString {
 	return OptNilString{
@@ -1484,6 +2308,98 @@ func (o OptPullsListForRepoState) Or(d PullsListForRepoState) PullsListForRepoSt
 	return d
 }
 
+// NewOptRateLimit returns new OptRateLimit with value set to v.
+func NewOptRateLimit(v RateLimit) OptRateLimit {
+	return OptRateLimit{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRateLimit is optional RateLimit.
+type OptRateLimit struct {
+	Value RateLimit
+	Set   bool
+}
+
+// IsSet returns true if OptRateLimit was set.
+func (o OptRateLimit) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRateLimit) Reset() {
+	var v RateLimit
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRateLimit) SetTo(v RateLimit) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRateLimit) Get() (v RateLimit, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRateLimit) Or(d RateLimit) RateLimit {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReactions returns new OptReactions with value set to v.
+func NewOptReactions(v Reactions) OptReactions {
+	return OptReactions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReactions is optional Reactions.
+type OptReactions struct {
+	Value Reactions
+	Set   bool
+}
+
+// IsSet returns true if OptReactions was set.
+func (o OptReactions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReactions) Reset() {
+	var v Reactions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReactions) SetTo(v Reactions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReactions) Get() (v Reactions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReactions) Or(d Reactions) Reactions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptReposListForUserDirection returns new OptReposListForUserDirection with value set to v.
 func NewOptReposListForUserDirection(v ReposListForUserDirection) OptReposListForUserDirection {
 	return OptReposListForUserDirection{
@@ -1806,6 +2722,52 @@ func (o OptURI) Or(d url.URL) url.URL {
 	return d
 }
 
+// NewOptWebhookConfig returns new OptWebhookConfig with value set to v.
+func NewOptWebhookConfig(v WebhookConfig) OptWebhookConfig {
+	return OptWebhookConfig{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWebhookConfig is optional WebhookConfig.
+type OptWebhookConfig struct {
+	Value WebhookConfig
+	Set   bool
+}
+
+// IsSet returns true if OptWebhookConfig was set.
+func (o OptWebhookConfig) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWebhookConfig) Reset() {
+	var v WebhookConfig
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWebhookConfig) SetTo(v WebhookConfig) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWebhookConfig) Get() (v WebhookConfig, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWebhookConfig) Or(d WebhookConfig) WebhookConfig {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/Organization
 type Organization struct {
 	Login       string       `json:"login"`
@@ -1918,20 +2880,22 @@ func (s *PublicEventRepo) SetName(val OptString) {
 
 // Ref: #/components/schemas/PullRequest
 type PullRequest struct {
-	ID        int64              `json:"id"`
-	Number    int                `json:"number"`
-	Title     string             `json:"title"`
-	State     string             `json:"state"`
-	Body      OptNilString       `json:"body"`
-	HTMLURL   url.URL            `json:"html_url"`
-	User      OptIssueUser       `json:"user"`
-	Draft     OptBool            `json:"draft"`
-	Merged    OptBool            `json:"merged"`
-	Head      OptPullRequestHead `json:"head"`
-	Base      OptPullRequestBase `json:"base"`
-	CreatedAt OptDateTime        `json:"created_at"`
-	UpdatedAt OptDateTime        `json:"updated_at"`
-	MergedAt  OptNilDateTime     `json:"merged_at"`
+	ID                 int64              `json:"id"`
+	Number             int                `json:"number"`
+	Title              string             `json:"title"`
+	State              string             `json:"state"`
+	Body               OptNilString       `json:"body"`
+	HTMLURL            url.URL            `json:"html_url"`
+	User               OptIssueUser       `json:"user"`
+	Draft              OptBool            `json:"draft"`
+	Merged             OptBool            `json:"merged"`
+	Head               OptPullRequestHead `json:"head"`
+	Base               OptPullRequestBase `json:"base"`
+	CreatedAt          OptDateTime        `json:"created_at"`
+	UpdatedAt          OptDateTime        `json:"updated_at"`
+	MergedAt           OptNilDateTime     `json:"merged_at"`
+	RequestedReviewers []IssueUser        `json:"requested_reviewers"`
+	RequestedTeams     []Team             `json:"requested_teams"`
 }
 
 // GetID returns the value of ID.
@@ -2004,6 +2968,16 @@ func (s *PullRequest) GetMergedAt() OptNilDateTime {
 	return s.MergedAt
 }
 
+// GetRequestedReviewers returns the value of RequestedReviewers.
+func (s *PullRequest) GetRequestedReviewers() []IssueUser {
+	return s.RequestedReviewers
+}
+
+// GetRequestedTeams returns the value of RequestedTeams.
+func (s *PullRequest) GetRequestedTeams() []Team {
+	return s.RequestedTeams
+}
+
 // SetID sets the value of ID.
 func (s *PullRequest) SetID(val int64) {
 	s.ID = val
@@ -2074,6 +3048,16 @@ func (s *PullRequest) SetMergedAt(val OptNilDateTime) {
 	s.MergedAt = val
 }
 
+// SetRequestedReviewers sets the value of RequestedReviewers.
+func (s *PullRequest) SetRequestedReviewers(val []IssueUser) {
+	s.RequestedReviewers = val
+}
+
+// SetRequestedTeams sets the value of RequestedTeams.
+func (s *PullRequest) SetRequestedTeams(val []Team) {
+	s.RequestedTeams = val
+}
+
 type PullRequestBase struct {
 	Ref OptString `json:"ref"`
 	Sha OptString `json:"sha"`
@@ -2205,24 +3189,171 @@ func (s *PullRequestHead) SetSha(val OptString) {
 	s.Sha = val
 }
 
-type PullsListForRepoState string
-
-const (
-	PullsListForRepoStateOpen   PullsListForRepoState = "open"
-	PullsListForRepoStateClosed PullsListForRepoState = "closed"
-	PullsListForRepoStateAll    PullsListForRepoState = "all"
-)
-
-// AllValues returns all PullsListForRepoState values.
-func (PullsListForRepoState) AllValues() []PullsListForRepoState {
-	return []PullsListForRepoState{
-		PullsListForRepoStateOpen,
-		PullsListForRepoStateClosed,
-		PullsListForRepoStateAll,
-	}
+// Ref: #/components/schemas/PullRequestReviewComment
+type PullRequestReviewComment struct {
+	ID           int64        `json:"id"`
+	Body         string       `json:"body"`
+	Path         string       `json:"path"`
+	Line         OptNilInt    `json:"line"`
+	OriginalLine OptNilInt    `json:"original_line"`
+	Side         OptString    `json:"side"`
+	CommitID     OptString    `json:"commit_id"`
+	InReplyToID  OptInt64     `json:"in_reply_to_id"`
+	HTMLURL      url.URL      `json:"html_url"`
+	User         OptIssueUser `json:"user"`
+	Reactions    OptReactions `json:"reactions"`
+	CreatedAt    OptDateTime  `json:"created_at"`
+	UpdatedAt    OptDateTime  `json:"updated_at"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
+// GetID returns the value of ID.
+func (s *PullRequestReviewComment) GetID() int64 {
+	return s.ID
+}
+
+// GetBody returns the value of Body.
+func (s *PullRequestReviewComment) GetBody() string {
+	return s.Body
+}
+
+// GetPath returns the value of Path.
+func (s *PullRequestReviewComment) GetPath() string {
+	return s.Path
+}
+
+// GetLine returns the value of Line.
+func (s *PullRequestReviewComment) GetLine() OptNilInt {
+	return s.Line
+}
+
+// GetOriginalLine returns the value of OriginalLine.
+func (s *PullRequestReviewComment) GetOriginalLine() OptNilInt {
+	return s.OriginalLine
+}
+
+// GetSide returns the value of Side.
+func (s *PullRequestReviewComment) GetSide() OptString {
+	return s.Side
+}
+
+// GetCommitID returns the value of CommitID.
+func (s *PullRequestReviewComment) GetCommitID() OptString {
+	return s.CommitID
+}
+
+// GetInReplyToID returns the value of InReplyToID.
+func (s *PullRequestReviewComment) GetInReplyToID() OptInt64 {
+	return s.InReplyToID
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *PullRequestReviewComment) GetHTMLURL() url.URL {
+	return s.HTMLURL
+}
+
+// GetUser returns the value of User.
+func (s *PullRequestReviewComment) GetUser() OptIssueUser {
+	return s.User
+}
+
+// GetReactions returns the value of Reactions.
+func (s *PullRequestReviewComment) GetReactions() OptReactions {
+	return s.Reactions
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PullRequestReviewComment) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *PullRequestReviewComment) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *PullRequestReviewComment) SetID(val int64) {
+	s.ID = val
+}
+
+// SetBody sets the value of Body.
+func (s *PullRequestReviewComment) SetBody(val string) {
+	s.Body = val
+}
+
+// SetPath sets the value of Path.
+func (s *PullRequestReviewComment) SetPath(val string) {
+	s.Path = val
+}
+
+// SetLine sets the value of Line.
+func (s *PullRequestReviewComment) SetLine(val OptNilInt) {
+	s.Line = val
+}
+
+// SetOriginalLine sets the value of OriginalLine.
+func (s *PullRequestReviewComment) SetOriginalLine(val OptNilInt) {
+	s.OriginalLine = val
+}
+
+// SetSide sets the value of Side.
+func (s *PullRequestReviewComment) SetSide(val OptString) {
+	s.Side = val
+}
+
+// SetCommitID sets the value of CommitID.
+func (s *PullRequestReviewComment) SetCommitID(val OptString) {
+	s.CommitID = val
+}
+
+// SetInReplyToID sets the value of InReplyToID.
+func (s *PullRequestReviewComment) SetInReplyToID(val OptInt64) {
+	s.InReplyToID = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *PullRequestReviewComment) SetHTMLURL(val url.URL) {
+	s.HTMLURL = val
+}
+
+// SetUser sets the value of User.
+func (s *PullRequestReviewComment) SetUser(val OptIssueUser) {
+	s.User = val
+}
+
+// SetReactions sets the value of Reactions.
+func (s *PullRequestReviewComment) SetReactions(val OptReactions) {
+	s.Reactions = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PullRequestReviewComment) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *PullRequestReviewComment) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+type PullsListForRepoState string
+
+const (
+	PullsListForRepoStateOpen   PullsListForRepoState = "open"
+	PullsListForRepoStateClosed PullsListForRepoState = "closed"
+	PullsListForRepoStateAll    PullsListForRepoState = "all"
+)
+
+// AllValues returns all PullsListForRepoState values.
+func (PullsListForRepoState) AllValues() []PullsListForRepoState {
+	return []PullsListForRepoState{
+		PullsListForRepoStateOpen,
+		PullsListForRepoStateClosed,
+		PullsListForRepoStateAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
 func (s PullsListForRepoState) MarshalText() ([]byte, error) {
 	switch s {
 	case PullsListForRepoStateOpen:
@@ -2253,6 +3384,448 @@ func (s *PullsListForRepoState) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/RateLimit
+type RateLimit struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Reset     int64 `json:"reset"`
+	Used      int   `json:"used"`
+}
+
+// GetLimit returns the value of Limit.
+func (s *RateLimit) GetLimit() int {
+	return s.Limit
+}
+
+// GetRemaining returns the value of Remaining.
+func (s *RateLimit) GetRemaining() int {
+	return s.Remaining
+}
+
+// GetReset returns the value of Reset.
+func (s *RateLimit) GetReset() int64 {
+	return s.Reset
+}
+
+// GetUsed returns the value of Used.
+func (s *RateLimit) GetUsed() int {
+	return s.Used
+}
+
+// SetLimit sets the value of Limit.
+func (s *RateLimit) SetLimit(val int) {
+	s.Limit = val
+}
+
+// SetRemaining sets the value of Remaining.
+func (s *RateLimit) SetRemaining(val int) {
+	s.Remaining = val
+}
+
+// SetReset sets the value of Reset.
+func (s *RateLimit) SetReset(val int64) {
+	s.Reset = val
+}
+
+// SetUsed sets the value of Used.
+func (s *RateLimit) SetUsed(val int) {
+	s.Used = val
+}
+
+// Ref: #/components/schemas/RateLimitOverview
+type RateLimitOverview struct {
+	Resources RateLimitOverviewResources `json:"resources"`
+	Rate      RateLimit                  `json:"rate"`
+}
+
+// GetResources returns the value of Resources.
+func (s *RateLimitOverview) GetResources() RateLimitOverviewResources {
+	return s.Resources
+}
+
+// GetRate returns the value of Rate.
+func (s *RateLimitOverview) GetRate() RateLimit {
+	return s.Rate
+}
+
+// SetResources sets the value of Resources.
+func (s *RateLimitOverview) SetResources(val RateLimitOverviewResources) {
+	s.Resources = val
+}
+
+// SetRate sets the value of Rate.
+func (s *RateLimitOverview) SetRate(val RateLimit) {
+	s.Rate = val
+}
+
+type RateLimitOverviewResources struct {
+	Core       RateLimit    `json:"core"`
+	Search     OptRateLimit `json:"search"`
+	Graphql    OptRateLimit `json:"graphql"`
+	CodeSearch OptRateLimit `json:"code_search"`
+}
+
+// GetCore returns the value of Core.
+func (s *RateLimitOverviewResources) GetCore() RateLimit {
+	return s.Core
+}
+
+// GetSearch returns the value of Search.
+func (s *RateLimitOverviewResources) GetSearch() OptRateLimit {
+	return s.Search
+}
+
+// GetGraphql returns the value of Graphql.
+func (s *RateLimitOverviewResources) GetGraphql() OptRateLimit {
+	return s.Graphql
+}
+
+// GetCodeSearch returns the value of CodeSearch.
+func (s *RateLimitOverviewResources) GetCodeSearch() OptRateLimit {
+	return s.CodeSearch
+}
+
+// SetCore sets the value of Core.
+func (s *RateLimitOverviewResources) SetCore(val RateLimit) {
+	s.Core = val
+}
+
+// SetSearch sets the value of Search.
+func (s *RateLimitOverviewResources) SetSearch(val OptRateLimit) {
+	s.Search = val
+}
+
+// SetGraphql sets the value of Graphql.
+func (s *RateLimitOverviewResources) SetGraphql(val OptRateLimit) {
+	s.Graphql = val
+}
+
+// SetCodeSearch sets the value of CodeSearch.
+func (s *RateLimitOverviewResources) SetCodeSearch(val OptRateLimit) {
+	s.CodeSearch = val
+}
+
+// Ref: #/components/schemas/Reactions
+type Reactions struct {
+	TotalCount OptInt `json:"total_count"`
+	Plus1      OptInt `json:"+1"`
+	Minus1     OptInt `json:"-1"`
+	Laugh      OptInt `json:"laugh"`
+	Hooray     OptInt `json:"hooray"`
+	Confused   OptInt `json:"confused"`
+	Heart      OptInt `json:"heart"`
+	Rocket     OptInt `json:"rocket"`
+	Eyes       OptInt `json:"eyes"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *Reactions) GetTotalCount() OptInt {
+	return s.TotalCount
+}
+
+// GetPlus1 returns the value of Plus1.
+func (s *Reactions) GetPlus1() OptInt {
+	return s.Plus1
+}
+
+// GetMinus1 returns the value of Minus1.
+func (s *Reactions) GetMinus1() OptInt {
+	return s.Minus1
+}
+
+// GetLaugh returns the value of Laugh.
+func (s *Reactions) GetLaugh() OptInt {
+	return s.Laugh
+}
+
+// GetHooray returns the value of Hooray.
+func (s *Reactions) GetHooray() OptInt {
+	return s.Hooray
+}
+
+// GetConfused returns the value of Confused.
+func (s *Reactions) GetConfused() OptInt {
+	return s.Confused
+}
+
+// GetHeart returns the value of Heart.
+func (s *Reactions) GetHeart() OptInt {
+	return s.Heart
+}
+
+// GetRocket returns the value of Rocket.
+func (s *Reactions) GetRocket() OptInt {
+	return s.Rocket
+}
+
+// GetEyes returns the value of Eyes.
+func (s *Reactions) GetEyes() OptInt {
+	return s.Eyes
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *Reactions) SetTotalCount(val OptInt) {
+	s.TotalCount = val
+}
+
+// SetPlus1 sets the value of Plus1.
+func (s *Reactions) SetPlus1(val OptInt) {
+	s.Plus1 = val
+}
+
+// SetMinus1 sets the value of Minus1.
+func (s *Reactions) SetMinus1(val OptInt) {
+	s.Minus1 = val
+}
+
+// SetLaugh sets the value of Laugh.
+func (s *Reactions) SetLaugh(val OptInt) {
+	s.Laugh = val
+}
+
+// SetHooray sets the value of Hooray.
+func (s *Reactions) SetHooray(val OptInt) {
+	s.Hooray = val
+}
+
+// SetConfused sets the value of Confused.
+func (s *Reactions) SetConfused(val OptInt) {
+	s.Confused = val
+}
+
+// SetHeart sets the value of Heart.
+func (s *Reactions) SetHeart(val OptInt) {
+	s.Heart = val
+}
+
+// SetRocket sets the value of Rocket.
+func (s *Reactions) SetRocket(val OptInt) {
+	s.Rocket = val
+}
+
+// SetEyes sets the value of Eyes.
+func (s *Reactions) SetEyes(val OptInt) {
+	s.Eyes = val
+}
+
+// Ref: #/components/schemas/Release
+type Release struct {
+	ID          int64          `json:"id"`
+	TagName     string         `json:"tag_name"`
+	Name        OptNilString   `json:"name"`
+	HTMLURL     url.URL        `json:"html_url"`
+	Draft       OptBool        `json:"draft"`
+	Prerelease  OptBool        `json:"prerelease"`
+	CreatedAt   OptDateTime    `json:"created_at"`
+	PublishedAt OptNilDateTime `json:"published_at"`
+	Assets      []ReleaseAsset `json:"assets"`
+}
+
+// GetID returns the value of ID.
+func (s *Release) GetID() int64 {
+	return s.ID
+}
+
+// GetTagName returns the value of TagName.
+func (s *Release) GetTagName() string {
+	return s.TagName
+}
+
+// GetName returns the value of Name.
+func (s *Release) GetName() OptNilString {
+	return s.Name
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *Release) GetHTMLURL() url.URL {
+	return s.HTMLURL
+}
+
+// GetDraft returns the value of Draft.
+func (s *Release) GetDraft() OptBool {
+	return s.Draft
+}
+
+// GetPrerelease returns the value of Prerelease.
+func (s *Release) GetPrerelease() OptBool {
+	return s.Prerelease
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Release) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetPublishedAt returns the value of PublishedAt.
+func (s *Release) GetPublishedAt() OptNilDateTime {
+	return s.PublishedAt
+}
+
+// GetAssets returns the value of Assets.
+func (s *Release) GetAssets() []ReleaseAsset {
+	return s.Assets
+}
+
+// SetID sets the value of ID.
+func (s *Release) SetID(val int64) {
+	s.ID = val
+}
+
+// SetTagName sets the value of TagName.
+func (s *Release) SetTagName(val string) {
+	s.TagName = val
+}
+
+// SetName sets the value of Name.
+func (s *Release) SetName(val OptNilString) {
+	s.Name = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *Release) SetHTMLURL(val url.URL) {
+	s.HTMLURL = val
+}
+
+// SetDraft sets the value of Draft.
+func (s *Release) SetDraft(val OptBool) {
+	s.Draft = val
+}
+
+// SetPrerelease sets the value of Prerelease.
+func (s *Release) SetPrerelease(val OptBool) {
+	s.Prerelease = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Release) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetPublishedAt sets the value of PublishedAt.
+func (s *Release) SetPublishedAt(val OptNilDateTime) {
+	s.PublishedAt = val
+}
+
+// SetAssets sets the value of Assets.
+func (s *Release) SetAssets(val []ReleaseAsset) {
+	s.Assets = val
+}
+
+// Ref: #/components/schemas/ReleaseAsset
+type ReleaseAsset struct {
+	ID                 int64        `json:"id"`
+	Name               string       `json:"name"`
+	Label              OptNilString `json:"label"`
+	ContentType        OptString    `json:"content_type"`
+	Size               int64        `json:"size"`
+	DownloadCount      OptInt       `json:"download_count"`
+	BrowserDownloadURL url.URL      `json:"browser_download_url"`
+	URL                OptURI       `json:"url"`
+}
+
+// GetID returns the value of ID.
+func (s *ReleaseAsset) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ReleaseAsset) GetName() string {
+	return s.Name
+}
+
+// GetLabel returns the value of Label.
+func (s *ReleaseAsset) GetLabel() OptNilString {
+	return s.Label
+}
+
+// GetContentType returns the value of ContentType.
+func (s *ReleaseAsset) GetContentType() OptString {
+	return s.ContentType
+}
+
+// GetSize returns the value of Size.
+func (s *ReleaseAsset) GetSize() int64 {
+	return s.Size
+}
+
+// GetDownloadCount returns the value of DownloadCount.
+func (s *ReleaseAsset) GetDownloadCount() OptInt {
+	return s.DownloadCount
+}
+
+// GetBrowserDownloadURL returns the value of BrowserDownloadURL.
+func (s *ReleaseAsset) GetBrowserDownloadURL() url.URL {
+	return s.BrowserDownloadURL
+}
+
+// GetURL returns the value of URL.
+func (s *ReleaseAsset) GetURL() OptURI {
+	return s.URL
+}
+
+// SetID sets the value of ID.
+func (s *ReleaseAsset) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ReleaseAsset) SetName(val string) {
+	s.Name = val
+}
+
+// SetLabel sets the value of Label.
+func (s *ReleaseAsset) SetLabel(val OptNilString) {
+	s.Label = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ReleaseAsset) SetContentType(val OptString) {
+	s.ContentType = val
+}
+
+// SetSize sets the value of Size.
+func (s *ReleaseAsset) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetDownloadCount sets the value of DownloadCount.
+func (s *ReleaseAsset) SetDownloadCount(val OptInt) {
+	s.DownloadCount = val
+}
+
+// SetBrowserDownloadURL sets the value of BrowserDownloadURL.
+func (s *ReleaseAsset) SetBrowserDownloadURL(val url.URL) {
+	s.BrowserDownloadURL = val
+}
+
+// SetURL sets the value of URL.
+func (s *ReleaseAsset) SetURL(val OptURI) {
+	s.URL = val
+}
+
+// Ref: #/components/schemas/ReplaceTopicsRequest
+type ReplaceTopicsRequest struct {
+	Names []string `json:"names"`
+}
+
+// GetNames returns the value of Names.
+func (s *ReplaceTopicsRequest) GetNames() []string {
+	return s.Names
+}
+
+// SetNames sets the value of Names.
+func (s *ReplaceTopicsRequest) SetNames(val []string) {
+	s.Names = val
+}
+
+// ReposAddCollaboratorNoContent is response for ReposAddCollaborator operation.
+type ReposAddCollaboratorNoContent struct{}
+
+func (*ReposAddCollaboratorNoContent) reposAddCollaboratorRes() {}
+
+// ReposDeleteWebhookNoContent is response for ReposDeleteWebhook operation.
+type ReposDeleteWebhookNoContent struct{}
+
 type ReposListForUserDirection string
 
 const (
@@ -2632,6 +4205,89 @@ func (s *Repository) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
+// Ref: #/components/schemas/RepositoryInvitation
+type RepositoryInvitation struct {
+	ID          int64              `json:"id"`
+	Invitee     OptRepositoryOwner `json:"invitee"`
+	Inviter     OptRepositoryOwner `json:"inviter"`
+	Permissions OptString          `json:"permissions"`
+	CreatedAt   OptDateTime        `json:"created_at"`
+	HTMLURL     OptURI             `json:"html_url"`
+	Expired     OptBool            `json:"expired"`
+}
+
+// GetID returns the value of ID.
+func (s *RepositoryInvitation) GetID() int64 {
+	return s.ID
+}
+
+// GetInvitee returns the value of Invitee.
+func (s *RepositoryInvitation) GetInvitee() OptRepositoryOwner {
+	return s.Invitee
+}
+
+// GetInviter returns the value of Inviter.
+func (s *RepositoryInvitation) GetInviter() OptRepositoryOwner {
+	return s.Inviter
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *RepositoryInvitation) GetPermissions() OptString {
+	return s.Permissions
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RepositoryInvitation) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *RepositoryInvitation) GetHTMLURL() OptURI {
+	return s.HTMLURL
+}
+
+// GetExpired returns the value of Expired.
+func (s *RepositoryInvitation) GetExpired() OptBool {
+	return s.Expired
+}
+
+// SetID sets the value of ID.
+func (s *RepositoryInvitation) SetID(val int64) {
+	s.ID = val
+}
+
+// SetInvitee sets the value of Invitee.
+func (s *RepositoryInvitation) SetInvitee(val OptRepositoryOwner) {
+	s.Invitee = val
+}
+
+// SetInviter sets the value of Inviter.
+func (s *RepositoryInvitation) SetInviter(val OptRepositoryOwner) {
+	s.Inviter = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *RepositoryInvitation) SetPermissions(val OptString) {
+	s.Permissions = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RepositoryInvitation) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *RepositoryInvitation) SetHTMLURL(val OptURI) {
+	s.HTMLURL = val
+}
+
+// SetExpired sets the value of Expired.
+func (s *RepositoryInvitation) SetExpired(val OptBool) {
+	s.Expired = val
+}
+
+func (*RepositoryInvitation) reposAddCollaboratorRes() {}
+
 // Ref: #/components/schemas/RepositoryOwner
 type RepositoryOwner struct {
 	Login     string    `json:"login"`
@@ -2691,6 +4347,32 @@ func (s *RepositoryOwner) SetType(val OptString) {
 	s.Type = val
 }
 
+// Ref: #/components/schemas/RequestReviewersRequest
+type RequestReviewersRequest struct {
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"team_reviewers"`
+}
+
+// GetReviewers returns the value of Reviewers.
+func (s *RequestReviewersRequest) GetReviewers() []string {
+	return s.Reviewers
+}
+
+// GetTeamReviewers returns the value of TeamReviewers.
+func (s *RequestReviewersRequest) GetTeamReviewers() []string {
+	return s.TeamReviewers
+}
+
+// SetReviewers sets the value of Reviewers.
+func (s *RequestReviewersRequest) SetReviewers(val []string) {
+	s.Reviewers = val
+}
+
+// SetTeamReviewers sets the value of TeamReviewers.
+func (s *RequestReviewersRequest) SetTeamReviewers(val []string) {
+	s.TeamReviewers = val
+}
+
 // Ref: #/components/schemas/SearchResultCode
 type SearchResultCode struct {
 	TotalCount        int                         `json:"total_count"`
@@ -2813,51 +4495,157 @@ func (s *SearchResultIssues) SetTotalCount(val int) {
 	s.TotalCount = val
 }
 
-// SetIncompleteResults sets the value of IncompleteResults.
-func (s *SearchResultIssues) SetIncompleteResults(val OptBool) {
-	s.IncompleteResults = val
+// SetIncompleteResults sets the value of IncompleteResults.
+func (s *SearchResultIssues) SetIncompleteResults(val OptBool) {
+	s.IncompleteResults = val
+}
+
+// SetItems sets the value of Items.
+func (s *SearchResultIssues) SetItems(val []Issue) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/SearchResultRepositories
+type SearchResultRepositories struct {
+	TotalCount        int          `json:"total_count"`
+	IncompleteResults OptBool      `json:"incomplete_results"`
+	Items             []Repository `json:"items"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *SearchResultRepositories) GetTotalCount() int {
+	return s.TotalCount
+}
+
+// GetIncompleteResults returns the value of IncompleteResults.
+func (s *SearchResultRepositories) GetIncompleteResults() OptBool {
+	return s.IncompleteResults
+}
+
+// GetItems returns the value of Items.
+func (s *SearchResultRepositories) GetItems() []Repository {
+	return s.Items
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *SearchResultRepositories) SetTotalCount(val int) {
+	s.TotalCount = val
+}
+
+// SetIncompleteResults sets the value of IncompleteResults.
+func (s *SearchResultRepositories) SetIncompleteResults(val OptBool) {
+	s.IncompleteResults = val
+}
+
+// SetItems sets the value of Items.
+func (s *SearchResultRepositories) SetItems(val []Repository) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/SearchResultUsers
+type SearchResultUsers struct {
+	TotalCount        int                          `json:"total_count"`
+	IncompleteResults OptBool                      `json:"incomplete_results"`
+	Items             []SearchResultUsersItemsItem `json:"items"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *SearchResultUsers) GetTotalCount() int {
+	return s.TotalCount
+}
+
+// GetIncompleteResults returns the value of IncompleteResults.
+func (s *SearchResultUsers) GetIncompleteResults() OptBool {
+	return s.IncompleteResults
+}
+
+// GetItems returns the value of Items.
+func (s *SearchResultUsers) GetItems() []SearchResultUsersItemsItem {
+	return s.Items
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *SearchResultUsers) SetTotalCount(val int) {
+	s.TotalCount = val
+}
+
+// SetIncompleteResults sets the value of IncompleteResults.
+func (s *SearchResultUsers) SetIncompleteResults(val OptBool) {
+	s.IncompleteResults = val
+}
+
+// SetItems sets the value of Items.
+func (s *SearchResultUsers) SetItems(val []SearchResultUsersItemsItem) {
+	s.Items = val
+}
+
+type SearchResultUsersItemsItem struct {
+	Login     OptString  `json:"login"`
+	ID        OptInt64   `json:"id"`
+	Type      OptString  `json:"type"`
+	Score     OptFloat64 `json:"score"`
+	HTMLURL   OptURI     `json:"html_url"`
+	AvatarURL OptURI     `json:"avatar_url"`
+}
+
+// GetLogin returns the value of Login.
+func (s *SearchResultUsersItemsItem) GetLogin() OptString {
+	return s.Login
+}
+
+// GetID returns the value of ID.
+func (s *SearchResultUsersItemsItem) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *SearchResultUsersItemsItem) GetType() OptString {
+	return s.Type
+}
+
+// GetScore returns the value of Score.
+func (s *SearchResultUsersItemsItem) GetScore() OptFloat64 {
+	return s.Score
 }
 
-// SetItems sets the value of Items.
-func (s *SearchResultIssues) SetItems(val []Issue) {
-	s.Items = val
+// GetHTMLURL returns the value of HTMLURL.
+func (s *SearchResultUsersItemsItem) GetHTMLURL() OptURI {
+	return s.HTMLURL
 }
 
-// Ref: #/components/schemas/SearchResultRepositories
-type SearchResultRepositories struct {
-	TotalCount        int          `json:"total_count"`
-	IncompleteResults OptBool      `json:"incomplete_results"`
-	Items             []Repository `json:"items"`
+// GetAvatarURL returns the value of AvatarURL.
+func (s *SearchResultUsersItemsItem) GetAvatarURL() OptURI {
+	return s.AvatarURL
 }
 
-// GetTotalCount returns the value of TotalCount.
-func (s *SearchResultRepositories) GetTotalCount() int {
-	return s.TotalCount
+// SetLogin sets the value of Login.
+func (s *SearchResultUsersItemsItem) SetLogin(val OptString) {
+	s.Login = val
 }
 
-// GetIncompleteResults returns the value of IncompleteResults.
-func (s *SearchResultRepositories) GetIncompleteResults() OptBool {
-	return s.IncompleteResults
+// SetID sets the value of ID.
+func (s *SearchResultUsersItemsItem) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// GetItems returns the value of Items.
-func (s *SearchResultRepositories) GetItems() []Repository {
-	return s.Items
+// SetType sets the value of Type.
+func (s *SearchResultUsersItemsItem) SetType(val OptString) {
+	s.Type = val
 }
 
-// SetTotalCount sets the value of TotalCount.
-func (s *SearchResultRepositories) SetTotalCount(val int) {
-	s.TotalCount = val
+// SetScore sets the value of Score.
+func (s *SearchResultUsersItemsItem) SetScore(val OptFloat64) {
+	s.Score = val
 }
 
-// SetIncompleteResults sets the value of IncompleteResults.
-func (s *SearchResultRepositories) SetIncompleteResults(val OptBool) {
-	s.IncompleteResults = val
+// SetHTMLURL sets the value of HTMLURL.
+func (s *SearchResultUsersItemsItem) SetHTMLURL(val OptURI) {
+	s.HTMLURL = val
 }
 
-// SetItems sets the value of Items.
-func (s *SearchResultRepositories) SetItems(val []Repository) {
-	s.Items = val
+// SetAvatarURL sets the value of AvatarURL.
+func (s *SearchResultUsersItemsItem) SetAvatarURL(val OptURI) {
+	s.AvatarURL = val
 }
 
 // Ref: #/components/schemas/SimpleUser
@@ -3018,6 +4806,288 @@ func (s *SimpleUser) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
+// Ref: #/components/schemas/Tag
+type Tag struct {
+	Name   string    `json:"name"`
+	Commit TagCommit `json:"commit"`
+}
+
+// GetName returns the value of Name.
+func (s *Tag) GetName() string {
+	return s.Name
+}
+
+// GetCommit returns the value of Commit.
+func (s *Tag) GetCommit() TagCommit {
+	return s.Commit
+}
+
+// SetName sets the value of Name.
+func (s *Tag) SetName(val string) {
+	s.Name = val
+}
+
+// SetCommit sets the value of Commit.
+func (s *Tag) SetCommit(val TagCommit) {
+	s.Commit = val
+}
+
+type TagCommit struct {
+	Sha string `json:"sha"`
+}
+
+// GetSha returns the value of Sha.
+func (s *TagCommit) GetSha() string {
+	return s.Sha
+}
+
+// SetSha sets the value of Sha.
+func (s *TagCommit) SetSha(val string) {
+	s.Sha = val
+}
+
+// Ref: #/components/schemas/Team
+type Team struct {
+	ID   OptInt64  `json:"id"`
+	Slug OptString `json:"slug"`
+	Name OptString `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *Team) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *Team) GetSlug() OptString {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *Team) GetName() OptString {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *Team) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *Team) SetSlug(val OptString) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *Team) SetName(val OptString) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/Thread
+type Thread struct {
+	ID         string           `json:"id"`
+	Unread     bool             `json:"unread"`
+	Reason     string           `json:"reason"`
+	UpdatedAt  OptDateTime      `json:"updated_at"`
+	LastReadAt OptNilDateTime   `json:"last_read_at"`
+	Subject    ThreadSubject    `json:"subject"`
+	Repository ThreadRepository `json:"repository"`
+}
+
+// GetID returns the value of ID.
+func (s *Thread) GetID() string {
+	return s.ID
+}
+
+// GetUnread returns the value of Unread.
+func (s *Thread) GetUnread() bool {
+	return s.Unread
+}
+
+// GetReason returns the value of Reason.
+func (s *Thread) GetReason() string {
+	return s.Reason
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Thread) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetLastReadAt returns the value of LastReadAt.
+func (s *Thread) GetLastReadAt() OptNilDateTime {
+	return s.LastReadAt
+}
+
+// GetSubject returns the value of Subject.
+func (s *Thread) GetSubject() ThreadSubject {
+	return s.Subject
+}
+
+// GetRepository returns the value of Repository.
+func (s *Thread) GetRepository() ThreadRepository {
+	return s.Repository
+}
+
+// SetID sets the value of ID.
+func (s *Thread) SetID(val string) {
+	s.ID = val
+}
+
+// SetUnread sets the value of Unread.
+func (s *Thread) SetUnread(val bool) {
+	s.Unread = val
+}
+
+// SetReason sets the value of Reason.
+func (s *Thread) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Thread) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetLastReadAt sets the value of LastReadAt.
+func (s *Thread) SetLastReadAt(val OptNilDateTime) {
+	s.LastReadAt = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *Thread) SetSubject(val ThreadSubject) {
+	s.Subject = val
+}
+
+// SetRepository sets the value of Repository.
+func (s *Thread) SetRepository(val ThreadRepository) {
+	s.Repository = val
+}
+
+type ThreadRepository struct {
+	ID       int64  `json:"id"`
+	FullName string `json:"full_name"`
+	HTMLURL  OptURI `json:"html_url"`
+}
+
+// GetID returns the value of ID.
+func (s *ThreadRepository) GetID() int64 {
+	return s.ID
+}
+
+// GetFullName returns the value of FullName.
+func (s *ThreadRepository) GetFullName() string {
+	return s.FullName
+}
+
+// GetHTMLURL returns the value of HTMLURL.
+func (s *ThreadRepository) GetHTMLURL() OptURI {
+	return s.HTMLURL
+}
+
+// SetID sets the value of ID.
+func (s *ThreadRepository) SetID(val int64) {
+	s.ID = val
+}
+
+// SetFullName sets the value of FullName.
+func (s *ThreadRepository) SetFullName(val string) {
+	s.FullName = val
+}
+
+// SetHTMLURL sets the value of HTMLURL.
+func (s *ThreadRepository) SetHTMLURL(val OptURI) {
+	s.HTMLURL = val
+}
+
+type ThreadSubject struct {
+	Title            string       `json:"title"`
+	URL              OptNilString `json:"url"`
+	LatestCommentURL OptNilString `json:"latest_comment_url"`
+	Type             string       `json:"type"`
+}
+
+// GetTitle returns the value of Title.
+func (s *ThreadSubject) GetTitle() string {
+	return s.Title
+}
+
+// GetURL returns the value of URL.
+func (s *ThreadSubject) GetURL() OptNilString {
+	return s.URL
+}
+
+// GetLatestCommentURL returns the value of LatestCommentURL.
+func (s *ThreadSubject) GetLatestCommentURL() OptNilString {
+	return s.LatestCommentURL
+}
+
+// GetType returns the value of Type.
+func (s *ThreadSubject) GetType() string {
+	return s.Type
+}
+
+// SetTitle sets the value of Title.
+func (s *ThreadSubject) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetURL sets the value of URL.
+func (s *ThreadSubject) SetURL(val OptNilString) {
+	s.URL = val
+}
+
+// SetLatestCommentURL sets the value of LatestCommentURL.
+func (s *ThreadSubject) SetLatestCommentURL(val OptNilString) {
+	s.LatestCommentURL = val
+}
+
+// SetType sets the value of Type.
+func (s *ThreadSubject) SetType(val string) {
+	s.Type = val
+}
+
+// Ref: #/components/schemas/Topic
+type Topic struct {
+	Names []string `json:"names"`
+}
+
+// GetNames returns the value of Names.
+func (s *Topic) GetNames() []string {
+	return s.Names
+}
+
+// SetNames sets the value of Names.
+func (s *Topic) SetNames(val []string) {
+	s.Names = val
+}
+
+// Ref: #/components/schemas/UpdateGistRequest
+type UpdateGistRequest struct {
+	Description OptString `json:"description"`
+	Files       jx.Raw    `json:"files"`
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateGistRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetFiles returns the value of Files.
+func (s *UpdateGistRequest) GetFiles() jx.Raw {
+	return s.Files
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateGistRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetFiles sets the value of Files.
+func (s *UpdateGistRequest) SetFiles(val jx.Raw) {
+	s.Files = val
+}
+
 // Ref: #/components/schemas/UpdateIssueRequest
 type UpdateIssueRequest struct {
 	Title     OptString `json:"title"`
@@ -3077,6 +5147,113 @@ func (s *UpdateIssueRequest) SetAssignees(val []string) {
 	s.Assignees = val
 }
 
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID     int64            `json:"id"`
+	Name   OptString        `json:"name"`
+	Active OptBool          `json:"active"`
+	Events []string         `json:"events"`
+	Config OptWebhookConfig `json:"config"`
+}
+
+// GetID returns the value of ID.
+func (s *Webhook) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Webhook) GetName() OptString {
+	return s.Name
+}
+
+// GetActive returns the value of Active.
+func (s *Webhook) GetActive() OptBool {
+	return s.Active
+}
+
+// GetEvents returns the value of Events.
+func (s *Webhook) GetEvents() []string {
+	return s.Events
+}
+
+// GetConfig returns the value of Config.
+func (s *Webhook) GetConfig() OptWebhookConfig {
+	return s.Config
+}
+
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Webhook) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetActive sets the value of Active.
+func (s *Webhook) SetActive(val OptBool) {
+	s.Active = val
+}
+
+// SetEvents sets the value of Events.
+func (s *Webhook) SetEvents(val []string) {
+	s.Events = val
+}
+
+// SetConfig sets the value of Config.
+func (s *Webhook) SetConfig(val OptWebhookConfig) {
+	s.Config = val
+}
+
+// Ref: #/components/schemas/WebhookConfig
+type WebhookConfig struct {
+	URL         OptString `json:"url"`
+	ContentType OptString `json:"content_type"`
+	Secret      OptString `json:"secret"`
+	InsecureSsl OptString `json:"insecure_ssl"`
+}
+
+// GetURL returns the value of URL.
+func (s *WebhookConfig) GetURL() OptString {
+	return s.URL
+}
+
+// GetContentType returns the value of ContentType.
+func (s *WebhookConfig) GetContentType() OptString {
+	return s.ContentType
+}
+
+// GetSecret returns the value of Secret.
+func (s *WebhookConfig) GetSecret() OptString {
+	return s.Secret
+}
+
+// GetInsecureSsl returns the value of InsecureSsl.
+func (s *WebhookConfig) GetInsecureSsl() OptString {
+	return s.InsecureSsl
+}
+
+// SetURL sets the value of URL.
+func (s *WebhookConfig) SetURL(val OptString) {
+	s.URL = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *WebhookConfig) SetContentType(val OptString) {
+	s.ContentType = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *WebhookConfig) SetSecret(val OptString) {
+	s.Secret = val
+}
+
+// SetInsecureSsl sets the value of InsecureSsl.
+func (s *WebhookConfig) SetInsecureSsl(val OptString) {
+	s.InsecureSsl = val
+}
+
 // Ref: #/components/schemas/Workflow
 type Workflow struct {
 	ID        int64       `json:"id"`
@@ -12,6 +12,7 @@ const (
 	GetEventOperation      OperationName = "GetEvent"
 	ListCalendarsOperation OperationName = "ListCalendars"
 	ListEventsOperation    OperationName = "ListEvents"
+	MoveEventOperation     OperationName = "MoveEvent"
 	QuickAddOperation      OperationName = "QuickAdd"
 	UpdateEventOperation   OperationName = "UpdateEvent"
 )
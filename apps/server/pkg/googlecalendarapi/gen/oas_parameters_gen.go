@@ -35,6 +35,13 @@ type ListEventsParams struct {
 	Q            OptString `json:",omitempty,omitzero"`
 }
 
+// MoveEventParams is parameters of moveEvent operation.
+type MoveEventParams struct {
+	CalendarId  string
+	EventId     string
+	Destination string
+}
+
 // QuickAddParams is parameters of quickAdd operation.
 type QuickAddParams struct {
 	CalendarId string
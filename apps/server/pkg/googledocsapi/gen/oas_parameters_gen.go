@@ -9,5 +9,6 @@ type BatchUpdateDocumentParams struct {
 
 // GetDocumentParams is parameters of getDocument operation.
 type GetDocumentParams struct {
-	DocumentId string
+	DocumentId         string
+	IncludeTabsContent OptBool `json:",omitempty,omitzero"`
 }
@@ -943,19 +943,33 @@ func (s *File) encodeFields(e *jx.Encoder) {
 			s.Trashed.Encode(e)
 		}
 	}
+	{
+		if s.Starred.Set {
+			e.FieldStart("starred")
+			s.Starred.Encode(e)
+		}
+	}
+	{
+		if s.ViewedByMeTime.Set {
+			e.FieldStart("viewedByMeTime")
+			s.ViewedByMeTime.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfFile = [10]string{
-	0: "id",
-	1: "name",
-	2: "mimeType",
-	3: "size",
-	4: "createdTime",
-	5: "modifiedTime",
-	6: "parents",
-	7: "webViewLink",
-	8: "iconLink",
-	9: "trashed",
+var jsonFieldsNameOfFile = [12]string{
+	0:  "id",
+	1:  "name",
+	2:  "mimeType",
+	3:  "size",
+	4:  "createdTime",
+	5:  "modifiedTime",
+	6:  "parents",
+	7:  "webViewLink",
+	8:  "iconLink",
+	9:  "trashed",
+	10: "starred",
+	11: "viewedByMeTime",
 }
 
 // Decode decodes File from json.
@@ -1066,6 +1080,26 @@ func (s *File) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"trashed\"")
 			}
+		case "starred":
+			if err := func() error {
+				s.Starred.Reset()
+				if err := s.Starred.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"starred\"")
+			}
+		case "viewedByMeTime":
+			if err := func() error {
+				s.ViewedByMeTime.Reset()
+				if err := s.ViewedByMeTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"viewedByMeTime\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -1214,13 +1248,20 @@ func (s *FileMetadata) encodeFields(e *jx.Encoder) {
 			s.Trashed.Encode(e)
 		}
 	}
+	{
+		if s.Starred.Set {
+			e.FieldStart("starred")
+			s.Starred.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfFileMetadata = [4]string{
+var jsonFieldsNameOfFileMetadata = [5]string{
 	0: "name",
 	1: "mimeType",
 	2: "parents",
 	3: "trashed",
+	4: "starred",
 }
 
 // Decode decodes FileMetadata from json.
@@ -1271,6 +1312,16 @@ func (s *FileMetadata) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"trashed\"")
 			}
+		case "starred":
+			if err := func() error {
+				s.Starred.Reset()
+				if err := s.Starred.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"starred\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -354,16 +354,18 @@ type DeletePermissionNoContent struct{}
 
 // Ref: #/components/schemas/File
 type File struct {
-	ID           OptString         `json:"id"`
-	Name         OptNilString      `json:"name"`
-	MimeType     OptNilString      `json:"mimeType"`
-	Size         OptNilString      `json:"size"`
-	CreatedTime  OptNilString      `json:"createdTime"`
-	ModifiedTime OptNilString      `json:"modifiedTime"`
-	Parents      OptNilStringArray `json:"parents"`
-	WebViewLink  OptNilString      `json:"webViewLink"`
-	IconLink     OptNilString      `json:"iconLink"`
-	Trashed      OptNilBool        `json:"trashed"`
+	ID             OptString         `json:"id"`
+	Name           OptNilString      `json:"name"`
+	MimeType       OptNilString      `json:"mimeType"`
+	Size           OptNilString      `json:"size"`
+	CreatedTime    OptNilString      `json:"createdTime"`
+	ModifiedTime   OptNilString      `json:"modifiedTime"`
+	Parents        OptNilStringArray `json:"parents"`
+	WebViewLink    OptNilString      `json:"webViewLink"`
+	IconLink       OptNilString      `json:"iconLink"`
+	Trashed        OptNilBool        `json:"trashed"`
+	Starred        OptNilBool        `json:"starred"`
+	ViewedByMeTime OptNilString      `json:"viewedByMeTime"`
 }
 
 // GetID returns the value of ID.
@@ -416,6 +418,16 @@ func (s *File) GetTrashed() OptNilBool {
 	return s.Trashed
 }
 
+// GetStarred returns the value of Starred.
+func (s *File) GetStarred() OptNilBool {
+	return s.Starred
+}
+
+// GetViewedByMeTime returns the value of ViewedByMeTime.
+func (s *File) GetViewedByMeTime() OptNilString {
+	return s.ViewedByMeTime
+}
+
 // SetID sets the value of ID.
 func (s *File) SetID(val OptString) {
 	s.ID = val
@@ -466,6 +478,16 @@ func (s *File) SetTrashed(val OptNilBool) {
 	s.Trashed = val
 }
 
+// SetStarred sets the value of Starred.
+func (s *File) SetStarred(val OptNilBool) {
+	s.Starred = val
+}
+
+// SetViewedByMeTime sets the value of ViewedByMeTime.
+func (s *File) SetViewedByMeTime(val OptNilString) {
+	s.ViewedByMeTime = val
+}
+
 // Ref: #/components/schemas/FileList
 type FileList struct {
 	Files         []File       `json:"files"`
@@ -498,6 +520,7 @@ type FileMetadata struct {
 	MimeType OptNilString      `json:"mimeType"`
 	Parents  OptNilStringArray `json:"parents"`
 	Trashed  OptNilBool        `json:"trashed"`
+	Starred  OptNilBool        `json:"starred"`
 }
 
 // GetName returns the value of Name.
@@ -520,6 +543,11 @@ func (s *FileMetadata) GetTrashed() OptNilBool {
 	return s.Trashed
 }
 
+// GetStarred returns the value of Starred.
+func (s *FileMetadata) GetStarred() OptNilBool {
+	return s.Starred
+}
+
 // SetName sets the value of Name.
 func (s *FileMetadata) SetName(val OptNilString) {
 	s.Name = val
@@ -540,6 +568,11 @@ func (s *FileMetadata) SetTrashed(val OptNilBool) {
 	s.Trashed = val
 }
 
+// SetStarred sets the value of Starred.
+func (s *FileMetadata) SetStarred(val OptNilBool) {
+	s.Starred = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -82,6 +82,12 @@ type Invoker interface {
 	//
 	// GET /spreadsheets/{spreadsheetId}/values/{range}
 	GetValues(ctx context.Context, params GetValuesParams) (*ValueRange, error)
+	// SearchDeveloperMetadata invokes searchDeveloperMetadata operation.
+	//
+	// Search developer metadata.
+	//
+	// POST /spreadsheets/{spreadsheetId}/developerMetadata:search
+	SearchDeveloperMetadata(ctx context.Context, request *SearchDeveloperMetadataRequest, params SearchDeveloperMetadataParams) (*SearchDeveloperMetadataResponse, error)
 	// UpdateValues invokes updateValues operation.
 	//
 	// Update cell values in a range.
@@ -1491,6 +1497,134 @@ func (c *Client) sendGetValues(ctx context.Context, params GetValuesParams) (res
 	return result, nil
 }
 
+// SearchDeveloperMetadata invokes searchDeveloperMetadata operation.
+//
+// Search developer metadata.
+//
+// POST /spreadsheets/{spreadsheetId}/developerMetadata:search
+func (c *Client) SearchDeveloperMetadata(ctx context.Context, request *SearchDeveloperMetadataRequest, params SearchDeveloperMetadataParams) (*SearchDeveloperMetadataResponse, error) {
+	res, err := c.sendSearchDeveloperMetadata(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendSearchDeveloperMetadata(ctx context.Context, request *SearchDeveloperMetadataRequest, params SearchDeveloperMetadataParams) (res *SearchDeveloperMetadataResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("searchDeveloperMetadata"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/spreadsheets/{spreadsheetId}/developerMetadata:search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchDeveloperMetadataOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/spreadsheets/"
+	{
+		// Encode "spreadsheetId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "spreadsheetId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.SpreadsheetId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/developerMetadata:search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSearchDeveloperMetadataRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchDeveloperMetadataOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeSearchDeveloperMetadataResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpdateValues invokes updateValues operation.
 //
 // Update cell values in a range.
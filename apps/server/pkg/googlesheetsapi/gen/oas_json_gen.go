@@ -1153,6 +1153,869 @@ func (s *CreateSpreadsheetRequestSheetsItem) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *DataFilter) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DataFilter) encodeFields(e *jx.Encoder) {
+	{
+		if s.DeveloperMetadataLookup.Set {
+			e.FieldStart("developerMetadataLookup")
+			s.DeveloperMetadataLookup.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDataFilter = [1]string{
+	0: "developerMetadataLookup",
+}
+
+// Decode decodes DataFilter from json.
+func (s *DataFilter) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DataFilter to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "developerMetadataLookup":
+			if err := func() error {
+				s.DeveloperMetadataLookup.Reset()
+				if err := s.DeveloperMetadataLookup.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"developerMetadataLookup\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DataFilter")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DataFilter) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DataFilter) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeveloperMetadata) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeveloperMetadata) encodeFields(e *jx.Encoder) {
+	{
+		if s.MetadataId.Set {
+			e.FieldStart("metadataId")
+			s.MetadataId.Encode(e)
+		}
+	}
+	{
+		if s.MetadataKey.Set {
+			e.FieldStart("metadataKey")
+			s.MetadataKey.Encode(e)
+		}
+	}
+	{
+		if s.MetadataValue.Set {
+			e.FieldStart("metadataValue")
+			s.MetadataValue.Encode(e)
+		}
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		if s.Visibility.Set {
+			e.FieldStart("visibility")
+			s.Visibility.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDeveloperMetadata = [5]string{
+	0: "metadataId",
+	1: "metadataKey",
+	2: "metadataValue",
+	3: "location",
+	4: "visibility",
+}
+
+// Decode decodes DeveloperMetadata from json.
+func (s *DeveloperMetadata) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeveloperMetadata to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "metadataId":
+			if err := func() error {
+				s.MetadataId.Reset()
+				if err := s.MetadataId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataId\"")
+			}
+		case "metadataKey":
+			if err := func() error {
+				s.MetadataKey.Reset()
+				if err := s.MetadataKey.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataKey\"")
+			}
+		case "metadataValue":
+			if err := func() error {
+				s.MetadataValue.Reset()
+				if err := s.MetadataValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataValue\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "visibility":
+			if err := func() error {
+				s.Visibility.Reset()
+				if err := s.Visibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeveloperMetadata")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeveloperMetadata) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeveloperMetadata) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeveloperMetadataLocation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeveloperMetadataLocation) encodeFields(e *jx.Encoder) {
+	{
+		if s.LocationType.Set {
+			e.FieldStart("locationType")
+			s.LocationType.Encode(e)
+		}
+	}
+	{
+		if s.Spreadsheet.Set {
+			e.FieldStart("spreadsheet")
+			s.Spreadsheet.Encode(e)
+		}
+	}
+	{
+		if s.SheetId.Set {
+			e.FieldStart("sheetId")
+			s.SheetId.Encode(e)
+		}
+	}
+	{
+		if s.DimensionRange.Set {
+			e.FieldStart("dimensionRange")
+			s.DimensionRange.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDeveloperMetadataLocation = [4]string{
+	0: "locationType",
+	1: "spreadsheet",
+	2: "sheetId",
+	3: "dimensionRange",
+}
+
+// Decode decodes DeveloperMetadataLocation from json.
+func (s *DeveloperMetadataLocation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeveloperMetadataLocation to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "locationType":
+			if err := func() error {
+				s.LocationType.Reset()
+				if err := s.LocationType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationType\"")
+			}
+		case "spreadsheet":
+			if err := func() error {
+				s.Spreadsheet.Reset()
+				if err := s.Spreadsheet.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spreadsheet\"")
+			}
+		case "sheetId":
+			if err := func() error {
+				s.SheetId.Reset()
+				if err := s.SheetId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sheetId\"")
+			}
+		case "dimensionRange":
+			if err := func() error {
+				s.DimensionRange.Reset()
+				if err := s.DimensionRange.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dimensionRange\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeveloperMetadataLocation")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeveloperMetadataLocation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeveloperMetadataLocation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeveloperMetadataLookup) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeveloperMetadataLookup) encodeFields(e *jx.Encoder) {
+	{
+		if s.MetadataId.Set {
+			e.FieldStart("metadataId")
+			s.MetadataId.Encode(e)
+		}
+	}
+	{
+		if s.MetadataKey.Set {
+			e.FieldStart("metadataKey")
+			s.MetadataKey.Encode(e)
+		}
+	}
+	{
+		if s.MetadataValue.Set {
+			e.FieldStart("metadataValue")
+			s.MetadataValue.Encode(e)
+		}
+	}
+	{
+		if s.LocationType.Set {
+			e.FieldStart("locationType")
+			s.LocationType.Encode(e)
+		}
+	}
+	{
+		if s.MetadataLocation.Set {
+			e.FieldStart("metadataLocation")
+			s.MetadataLocation.Encode(e)
+		}
+	}
+	{
+		if s.LocationMatchingStrategy.Set {
+			e.FieldStart("locationMatchingStrategy")
+			s.LocationMatchingStrategy.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDeveloperMetadataLookup = [6]string{
+	0: "metadataId",
+	1: "metadataKey",
+	2: "metadataValue",
+	3: "locationType",
+	4: "metadataLocation",
+	5: "locationMatchingStrategy",
+}
+
+// Decode decodes DeveloperMetadataLookup from json.
+func (s *DeveloperMetadataLookup) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeveloperMetadataLookup to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "metadataId":
+			if err := func() error {
+				s.MetadataId.Reset()
+				if err := s.MetadataId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataId\"")
+			}
+		case "metadataKey":
+			if err := func() error {
+				s.MetadataKey.Reset()
+				if err := s.MetadataKey.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataKey\"")
+			}
+		case "metadataValue":
+			if err := func() error {
+				s.MetadataValue.Reset()
+				if err := s.MetadataValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataValue\"")
+			}
+		case "locationType":
+			if err := func() error {
+				s.LocationType.Reset()
+				if err := s.LocationType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationType\"")
+			}
+		case "metadataLocation":
+			if err := func() error {
+				s.MetadataLocation.Reset()
+				if err := s.MetadataLocation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"metadataLocation\"")
+			}
+		case "locationMatchingStrategy":
+			if err := func() error {
+				s.LocationMatchingStrategy.Reset()
+				if err := s.LocationMatchingStrategy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locationMatchingStrategy\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeveloperMetadataLookup")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeveloperMetadataLookup) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeveloperMetadataLookup) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DimensionRange) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DimensionRange) encodeFields(e *jx.Encoder) {
+	{
+		if s.SheetId.Set {
+			e.FieldStart("sheetId")
+			s.SheetId.Encode(e)
+		}
+	}
+	{
+		if s.Dimension.Set {
+			e.FieldStart("dimension")
+			s.Dimension.Encode(e)
+		}
+	}
+	{
+		if s.StartIndex.Set {
+			e.FieldStart("startIndex")
+			s.StartIndex.Encode(e)
+		}
+	}
+	{
+		if s.EndIndex.Set {
+			e.FieldStart("endIndex")
+			s.EndIndex.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDimensionRange = [4]string{
+	0: "sheetId",
+	1: "dimension",
+	2: "startIndex",
+	3: "endIndex",
+}
+
+// Decode decodes DimensionRange from json.
+func (s *DimensionRange) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DimensionRange to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "sheetId":
+			if err := func() error {
+				s.SheetId.Reset()
+				if err := s.SheetId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sheetId\"")
+			}
+		case "dimension":
+			if err := func() error {
+				s.Dimension.Reset()
+				if err := s.Dimension.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dimension\"")
+			}
+		case "startIndex":
+			if err := func() error {
+				s.StartIndex.Reset()
+				if err := s.StartIndex.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"startIndex\"")
+			}
+		case "endIndex":
+			if err := func() error {
+				s.EndIndex.Reset()
+				if err := s.EndIndex.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"endIndex\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DimensionRange")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DimensionRange) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DimensionRange) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MatchedDeveloperMetadata) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MatchedDeveloperMetadata) encodeFields(e *jx.Encoder) {
+	{
+		if s.DeveloperMetadata.Set {
+			e.FieldStart("developerMetadata")
+			s.DeveloperMetadata.Encode(e)
+		}
+	}
+	{
+		if s.DataFilters != nil {
+			e.FieldStart("dataFilters")
+			e.ArrStart()
+			for _, elem := range s.DataFilters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfMatchedDeveloperMetadata = [2]string{
+	0: "developerMetadata",
+	1: "dataFilters",
+}
+
+// Decode decodes MatchedDeveloperMetadata from json.
+func (s *MatchedDeveloperMetadata) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MatchedDeveloperMetadata to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "developerMetadata":
+			if err := func() error {
+				s.DeveloperMetadata.Reset()
+				if err := s.DeveloperMetadata.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"developerMetadata\"")
+			}
+		case "dataFilters":
+			if err := func() error {
+				s.DataFilters = make([]DataFilter, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DataFilter
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.DataFilters = append(s.DataFilters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dataFilters\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MatchedDeveloperMetadata")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MatchedDeveloperMetadata) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MatchedDeveloperMetadata) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeveloperMetadata as json.
+func (o OptDeveloperMetadata) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DeveloperMetadata from json.
+func (o *OptDeveloperMetadata) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDeveloperMetadata to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDeveloperMetadata) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDeveloperMetadata) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeveloperMetadataLocation as json.
+func (o OptDeveloperMetadataLocation) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DeveloperMetadataLocation from json.
+func (o *OptDeveloperMetadataLocation) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDeveloperMetadataLocation to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDeveloperMetadataLocation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDeveloperMetadataLocation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DeveloperMetadataLookup as json.
+func (o OptDeveloperMetadataLookup) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DeveloperMetadataLookup from json.
+func (o *OptDeveloperMetadataLookup) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDeveloperMetadataLookup to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDeveloperMetadataLookup) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDeveloperMetadataLookup) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DimensionRange as json.
+func (o OptDimensionRange) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DimensionRange from json.
+func (o *OptDimensionRange) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDimensionRange to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDimensionRange) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDimensionRange) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes [][]jx.Raw as json.
 func (o OptNilAnyArrayArray) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -1761,6 +2624,186 @@ func (s *OptString) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *SearchDeveloperMetadataRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SearchDeveloperMetadataRequest) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("dataFilters")
+		e.ArrStart()
+		for _, elem := range s.DataFilters {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfSearchDeveloperMetadataRequest = [1]string{
+	0: "dataFilters",
+}
+
+// Decode decodes SearchDeveloperMetadataRequest from json.
+func (s *SearchDeveloperMetadataRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchDeveloperMetadataRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "dataFilters":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.DataFilters = make([]DataFilter, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DataFilter
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.DataFilters = append(s.DataFilters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"dataFilters\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchDeveloperMetadataRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfSearchDeveloperMetadataRequest) {
+					name = jsonFieldsNameOfSearchDeveloperMetadataRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SearchDeveloperMetadataRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SearchDeveloperMetadataRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SearchDeveloperMetadataResponse) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SearchDeveloperMetadataResponse) encodeFields(e *jx.Encoder) {
+	{
+		if s.MatchedDeveloperMetadata != nil {
+			e.FieldStart("matchedDeveloperMetadata")
+			e.ArrStart()
+			for _, elem := range s.MatchedDeveloperMetadata {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfSearchDeveloperMetadataResponse = [1]string{
+	0: "matchedDeveloperMetadata",
+}
+
+// Decode decodes SearchDeveloperMetadataResponse from json.
+func (s *SearchDeveloperMetadataResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SearchDeveloperMetadataResponse to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "matchedDeveloperMetadata":
+			if err := func() error {
+				s.MatchedDeveloperMetadata = make([]MatchedDeveloperMetadata, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MatchedDeveloperMetadata
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MatchedDeveloperMetadata = append(s.MatchedDeveloperMetadata, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"matchedDeveloperMetadata\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SearchDeveloperMetadataResponse")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SearchDeveloperMetadataResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SearchDeveloperMetadataResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *SheetProperties) Encode(e *jx.Encoder) {
 	e.ObjStart()
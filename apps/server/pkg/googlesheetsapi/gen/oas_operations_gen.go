@@ -6,14 +6,15 @@ package api
 type OperationName = string
 
 const (
-	AppendValuesOperation      OperationName = "AppendValues"
-	BatchGetValuesOperation    OperationName = "BatchGetValues"
-	BatchUpdateOperation       OperationName = "BatchUpdate"
-	BatchUpdateValuesOperation OperationName = "BatchUpdateValues"
-	ClearValuesOperation       OperationName = "ClearValues"
-	CopySheetToOperation       OperationName = "CopySheetTo"
-	CreateSpreadsheetOperation OperationName = "CreateSpreadsheet"
-	GetSpreadsheetOperation    OperationName = "GetSpreadsheet"
-	GetValuesOperation         OperationName = "GetValues"
-	UpdateValuesOperation      OperationName = "UpdateValues"
+	AppendValuesOperation            OperationName = "AppendValues"
+	BatchGetValuesOperation          OperationName = "BatchGetValues"
+	BatchUpdateOperation             OperationName = "BatchUpdate"
+	BatchUpdateValuesOperation       OperationName = "BatchUpdateValues"
+	ClearValuesOperation             OperationName = "ClearValues"
+	CopySheetToOperation             OperationName = "CopySheetTo"
+	CreateSpreadsheetOperation       OperationName = "CreateSpreadsheet"
+	GetSpreadsheetOperation          OperationName = "GetSpreadsheet"
+	GetValuesOperation               OperationName = "GetValues"
+	SearchDeveloperMetadataOperation OperationName = "SearchDeveloperMetadata"
+	UpdateValuesOperation            OperationName = "UpdateValues"
 )
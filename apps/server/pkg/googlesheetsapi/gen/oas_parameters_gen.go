@@ -1153,6 +1153,11 @@ func decodeGetValuesParams(args [2]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
+// SearchDeveloperMetadataParams is parameters of searchDeveloperMetadata operation.
+type SearchDeveloperMetadataParams struct {
+	SpreadsheetId string
+}
+
 // UpdateValuesParams is parameters of updateValues operation.
 type UpdateValuesParams struct {
 	SpreadsheetId    string
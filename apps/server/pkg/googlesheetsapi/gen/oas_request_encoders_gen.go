@@ -94,6 +94,20 @@ func encodeCreateSpreadsheetRequest(
 	return nil
 }
 
+func encodeSearchDeveloperMetadataRequest(
+	req *SearchDeveloperMetadataRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateValuesRequest(
 	req *ValueRange,
 	r *http.Request,
@@ -344,6 +344,548 @@ func (s *CreateSpreadsheetRequestSheetsItem) init() CreateSpreadsheetRequestShee
 	return m
 }
 
+// Ref: #/components/schemas/DataFilter
+type DataFilter struct {
+	DeveloperMetadataLookup OptDeveloperMetadataLookup `json:"developerMetadataLookup"`
+}
+
+// GetDeveloperMetadataLookup returns the value of DeveloperMetadataLookup.
+func (s *DataFilter) GetDeveloperMetadataLookup() OptDeveloperMetadataLookup {
+	return s.DeveloperMetadataLookup
+}
+
+// SetDeveloperMetadataLookup sets the value of DeveloperMetadataLookup.
+func (s *DataFilter) SetDeveloperMetadataLookup(val OptDeveloperMetadataLookup) {
+	s.DeveloperMetadataLookup = val
+}
+
+// Ref: #/components/schemas/DeveloperMetadata
+type DeveloperMetadata struct {
+	MetadataId    OptInt                       `json:"metadataId"`
+	MetadataKey   OptString                    `json:"metadataKey"`
+	MetadataValue OptString                    `json:"metadataValue"`
+	Location      OptDeveloperMetadataLocation `json:"location"`
+	Visibility    OptString                    `json:"visibility"`
+}
+
+// GetMetadataId returns the value of MetadataId.
+func (s *DeveloperMetadata) GetMetadataId() OptInt {
+	return s.MetadataId
+}
+
+// GetMetadataKey returns the value of MetadataKey.
+func (s *DeveloperMetadata) GetMetadataKey() OptString {
+	return s.MetadataKey
+}
+
+// GetMetadataValue returns the value of MetadataValue.
+func (s *DeveloperMetadata) GetMetadataValue() OptString {
+	return s.MetadataValue
+}
+
+// GetLocation returns the value of Location.
+func (s *DeveloperMetadata) GetLocation() OptDeveloperMetadataLocation {
+	return s.Location
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *DeveloperMetadata) GetVisibility() OptString {
+	return s.Visibility
+}
+
+// SetMetadataId sets the value of MetadataId.
+func (s *DeveloperMetadata) SetMetadataId(val OptInt) {
+	s.MetadataId = val
+}
+
+// SetMetadataKey sets the value of MetadataKey.
+func (s *DeveloperMetadata) SetMetadataKey(val OptString) {
+	s.MetadataKey = val
+}
+
+// SetMetadataValue sets the value of MetadataValue.
+func (s *DeveloperMetadata) SetMetadataValue(val OptString) {
+	s.MetadataValue = val
+}
+
+// SetLocation sets the value of Location.
+func (s *DeveloperMetadata) SetLocation(val OptDeveloperMetadataLocation) {
+	s.Location = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *DeveloperMetadata) SetVisibility(val OptString) {
+	s.Visibility = val
+}
+
+// Ref: #/components/schemas/DeveloperMetadataLocation
+type DeveloperMetadataLocation struct {
+	LocationType   OptString         `json:"locationType"`
+	Spreadsheet    OptBool           `json:"spreadsheet"`
+	SheetId        OptInt            `json:"sheetId"`
+	DimensionRange OptDimensionRange `json:"dimensionRange"`
+}
+
+// GetLocationType returns the value of LocationType.
+func (s *DeveloperMetadataLocation) GetLocationType() OptString {
+	return s.LocationType
+}
+
+// GetSpreadsheet returns the value of Spreadsheet.
+func (s *DeveloperMetadataLocation) GetSpreadsheet() OptBool {
+	return s.Spreadsheet
+}
+
+// GetSheetId returns the value of SheetId.
+func (s *DeveloperMetadataLocation) GetSheetId() OptInt {
+	return s.SheetId
+}
+
+// GetDimensionRange returns the value of DimensionRange.
+func (s *DeveloperMetadataLocation) GetDimensionRange() OptDimensionRange {
+	return s.DimensionRange
+}
+
+// SetLocationType sets the value of LocationType.
+func (s *DeveloperMetadataLocation) SetLocationType(val OptString) {
+	s.LocationType = val
+}
+
+// SetSpreadsheet sets the value of Spreadsheet.
+func (s *DeveloperMetadataLocation) SetSpreadsheet(val OptBool) {
+	s.Spreadsheet = val
+}
+
+// SetSheetId sets the value of SheetId.
+func (s *DeveloperMetadataLocation) SetSheetId(val OptInt) {
+	s.SheetId = val
+}
+
+// SetDimensionRange sets the value of DimensionRange.
+func (s *DeveloperMetadataLocation) SetDimensionRange(val OptDimensionRange) {
+	s.DimensionRange = val
+}
+
+// Ref: #/components/schemas/DeveloperMetadataLookup
+type DeveloperMetadataLookup struct {
+	MetadataId               OptInt                       `json:"metadataId"`
+	MetadataKey              OptString                    `json:"metadataKey"`
+	MetadataValue            OptString                    `json:"metadataValue"`
+	LocationType             OptString                    `json:"locationType"`
+	MetadataLocation         OptDeveloperMetadataLocation `json:"metadataLocation"`
+	LocationMatchingStrategy OptString                    `json:"locationMatchingStrategy"`
+}
+
+// GetMetadataId returns the value of MetadataId.
+func (s *DeveloperMetadataLookup) GetMetadataId() OptInt {
+	return s.MetadataId
+}
+
+// GetMetadataKey returns the value of MetadataKey.
+func (s *DeveloperMetadataLookup) GetMetadataKey() OptString {
+	return s.MetadataKey
+}
+
+// GetMetadataValue returns the value of MetadataValue.
+func (s *DeveloperMetadataLookup) GetMetadataValue() OptString {
+	return s.MetadataValue
+}
+
+// GetLocationType returns the value of LocationType.
+func (s *DeveloperMetadataLookup) GetLocationType() OptString {
+	return s.LocationType
+}
+
+// GetMetadataLocation returns the value of MetadataLocation.
+func (s *DeveloperMetadataLookup) GetMetadataLocation() OptDeveloperMetadataLocation {
+	return s.MetadataLocation
+}
+
+// GetLocationMatchingStrategy returns the value of LocationMatchingStrategy.
+func (s *DeveloperMetadataLookup) GetLocationMatchingStrategy() OptString {
+	return s.LocationMatchingStrategy
+}
+
+// SetMetadataId sets the value of MetadataId.
+func (s *DeveloperMetadataLookup) SetMetadataId(val OptInt) {
+	s.MetadataId = val
+}
+
+// SetMetadataKey sets the value of MetadataKey.
+func (s *DeveloperMetadataLookup) SetMetadataKey(val OptString) {
+	s.MetadataKey = val
+}
+
+// SetMetadataValue sets the value of MetadataValue.
+func (s *DeveloperMetadataLookup) SetMetadataValue(val OptString) {
+	s.MetadataValue = val
+}
+
+// SetLocationType sets the value of LocationType.
+func (s *DeveloperMetadataLookup) SetLocationType(val OptString) {
+	s.LocationType = val
+}
+
+// SetMetadataLocation sets the value of MetadataLocation.
+func (s *DeveloperMetadataLookup) SetMetadataLocation(val OptDeveloperMetadataLocation) {
+	s.MetadataLocation = val
+}
+
+// SetLocationMatchingStrategy sets the value of LocationMatchingStrategy.
+func (s *DeveloperMetadataLookup) SetLocationMatchingStrategy(val OptString) {
+	s.LocationMatchingStrategy = val
+}
+
+// Ref: #/components/schemas/DimensionRange
+type DimensionRange struct {
+	SheetId    OptInt    `json:"sheetId"`
+	Dimension  OptString `json:"dimension"`
+	StartIndex OptInt    `json:"startIndex"`
+	EndIndex   OptInt    `json:"endIndex"`
+}
+
+// GetSheetId returns the value of SheetId.
+func (s *DimensionRange) GetSheetId() OptInt {
+	return s.SheetId
+}
+
+// GetDimension returns the value of Dimension.
+func (s *DimensionRange) GetDimension() OptString {
+	return s.Dimension
+}
+
+// GetStartIndex returns the value of StartIndex.
+func (s *DimensionRange) GetStartIndex() OptInt {
+	return s.StartIndex
+}
+
+// GetEndIndex returns the value of EndIndex.
+func (s *DimensionRange) GetEndIndex() OptInt {
+	return s.EndIndex
+}
+
+// SetSheetId sets the value of SheetId.
+func (s *DimensionRange) SetSheetId(val OptInt) {
+	s.SheetId = val
+}
+
+// SetDimension sets the value of Dimension.
+func (s *DimensionRange) SetDimension(val OptString) {
+	s.Dimension = val
+}
+
+// SetStartIndex sets the value of StartIndex.
+func (s *DimensionRange) SetStartIndex(val OptInt) {
+	s.StartIndex = val
+}
+
+// SetEndIndex sets the value of EndIndex.
+func (s *DimensionRange) SetEndIndex(val OptInt) {
+	s.EndIndex = val
+}
+
+// Ref: #/components/schemas/MatchedDeveloperMetadata
+type MatchedDeveloperMetadata struct {
+	DeveloperMetadata OptDeveloperMetadata `json:"developerMetadata"`
+	DataFilters       []DataFilter         `json:"dataFilters"`
+}
+
+// GetDeveloperMetadata returns the value of DeveloperMetadata.
+func (s *MatchedDeveloperMetadata) GetDeveloperMetadata() OptDeveloperMetadata {
+	return s.DeveloperMetadata
+}
+
+// GetDataFilters returns the value of DataFilters.
+func (s *MatchedDeveloperMetadata) GetDataFilters() []DataFilter {
+	return s.DataFilters
+}
+
+// SetDeveloperMetadata sets the value of DeveloperMetadata.
+func (s *MatchedDeveloperMetadata) SetDeveloperMetadata(val OptDeveloperMetadata) {
+	s.DeveloperMetadata = val
+}
+
+// SetDataFilters sets the value of DataFilters.
+func (s *MatchedDeveloperMetadata) SetDataFilters(val []DataFilter) {
+	s.DataFilters = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeveloperMetadata returns new OptDeveloperMetadata with value set to v.
+func NewOptDeveloperMetadata(v DeveloperMetadata) OptDeveloperMetadata {
+	return OptDeveloperMetadata{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeveloperMetadata is optional DeveloperMetadata.
+type OptDeveloperMetadata struct {
+	Value DeveloperMetadata
+	Set   bool
+}
+
+// IsSet returns true if OptDeveloperMetadata was set.
+func (o OptDeveloperMetadata) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeveloperMetadata) Reset() {
+	var v DeveloperMetadata
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeveloperMetadata) SetTo(v DeveloperMetadata) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeveloperMetadata) Get() (v DeveloperMetadata, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeveloperMetadata) Or(d DeveloperMetadata) DeveloperMetadata {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeveloperMetadataLocation returns new OptDeveloperMetadataLocation with value set to v.
+func NewOptDeveloperMetadataLocation(v DeveloperMetadataLocation) OptDeveloperMetadataLocation {
+	return OptDeveloperMetadataLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeveloperMetadataLocation is optional DeveloperMetadataLocation.
+type OptDeveloperMetadataLocation struct {
+	Value DeveloperMetadataLocation
+	Set   bool
+}
+
+// IsSet returns true if OptDeveloperMetadataLocation was set.
+func (o OptDeveloperMetadataLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeveloperMetadataLocation) Reset() {
+	var v DeveloperMetadataLocation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeveloperMetadataLocation) SetTo(v DeveloperMetadataLocation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeveloperMetadataLocation) Get() (v DeveloperMetadataLocation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeveloperMetadataLocation) Or(d DeveloperMetadataLocation) DeveloperMetadataLocation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeveloperMetadataLookup returns new OptDeveloperMetadataLookup with value set to v.
+func NewOptDeveloperMetadataLookup(v DeveloperMetadataLookup) OptDeveloperMetadataLookup {
+	return OptDeveloperMetadataLookup{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeveloperMetadataLookup is optional DeveloperMetadataLookup.
+type OptDeveloperMetadataLookup struct {
+	Value DeveloperMetadataLookup
+	Set   bool
+}
+
+// IsSet returns true if OptDeveloperMetadataLookup was set.
+func (o OptDeveloperMetadataLookup) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeveloperMetadataLookup) Reset() {
+	var v DeveloperMetadataLookup
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeveloperMetadataLookup) SetTo(v DeveloperMetadataLookup) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeveloperMetadataLookup) Get() (v DeveloperMetadataLookup, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeveloperMetadataLookup) Or(d DeveloperMetadataLookup) DeveloperMetadataLookup {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDimensionRange returns new OptDimensionRange with value set to v.
+func NewOptDimensionRange(v DimensionRange) OptDimensionRange {
+	return OptDimensionRange{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDimensionRange is optional DimensionRange.
+type OptDimensionRange struct {
+	Value DimensionRange
+	Set   bool
+}
+
+// IsSet returns true if OptDimensionRange was set.
+func (o OptDimensionRange) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDimensionRange) Reset() {
+	var v DimensionRange
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDimensionRange) SetTo(v DimensionRange) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDimensionRange) Get() (v DimensionRange, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDimensionRange) Or(d DimensionRange) DimensionRange {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilAnyArrayArray returns new OptNilAnyArrayArray with value set to v.
 func NewOptNilAnyArrayArray(v [][]jx.Raw) OptNilAnyArrayArray {
 	return OptNilAnyArrayArray{
@@ -1020,6 +1562,36 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// Ref: #/components/schemas/SearchDeveloperMetadataRequest
+type SearchDeveloperMetadataRequest struct {
+	DataFilters []DataFilter `json:"dataFilters"`
+}
+
+// GetDataFilters returns the value of DataFilters.
+func (s *SearchDeveloperMetadataRequest) GetDataFilters() []DataFilter {
+	return s.DataFilters
+}
+
+// SetDataFilters sets the value of DataFilters.
+func (s *SearchDeveloperMetadataRequest) SetDataFilters(val []DataFilter) {
+	s.DataFilters = val
+}
+
+// Ref: #/components/schemas/SearchDeveloperMetadataResponse
+type SearchDeveloperMetadataResponse struct {
+	MatchedDeveloperMetadata []MatchedDeveloperMetadata `json:"matchedDeveloperMetadata"`
+}
+
+// GetMatchedDeveloperMetadata returns the value of MatchedDeveloperMetadata.
+func (s *SearchDeveloperMetadataResponse) GetMatchedDeveloperMetadata() []MatchedDeveloperMetadata {
+	return s.MatchedDeveloperMetadata
+}
+
+// SetMatchedDeveloperMetadata sets the value of MatchedDeveloperMetadata.
+func (s *SearchDeveloperMetadataResponse) SetMatchedDeveloperMetadata(val []MatchedDeveloperMetadata) {
+	s.MatchedDeveloperMetadata = val
+}
+
 // Ref: #/components/schemas/SheetProperties
 type SheetProperties struct {
 	SheetId   OptNilInt    `json:"sheetId"`
@@ -176,6 +176,29 @@ func (s *CreateSpreadsheetRequest) Validate() error {
 	return nil
 }
 
+func (s *SearchDeveloperMetadataRequest) Validate() error {
+	if s == nil {
+		return validate.ErrNilPointer
+	}
+
+	var failures []validate.FieldError
+	if err := func() error {
+		if s.DataFilters == nil {
+			return errors.New("nil is invalid value")
+		}
+		return nil
+	}(); err != nil {
+		failures = append(failures, validate.FieldError{
+			Name:  "dataFilters",
+			Error: err,
+		})
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+	return nil
+}
+
 func (s *Spreadsheet) Validate() error {
 	if s == nil {
 		return validate.ErrNilPointer
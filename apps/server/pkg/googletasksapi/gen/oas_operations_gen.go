@@ -6,11 +6,12 @@ package api
 type OperationName = string
 
 const (
-	CreateTaskOperation    OperationName = "CreateTask"
-	DeleteTaskOperation    OperationName = "DeleteTask"
-	GetTaskOperation       OperationName = "GetTask"
-	GetTaskListOperation   OperationName = "GetTaskList"
-	ListTaskListsOperation OperationName = "ListTaskLists"
-	ListTasksOperation     OperationName = "ListTasks"
-	UpdateTaskOperation    OperationName = "UpdateTask"
+	ClearCompletedTasksOperation OperationName = "ClearCompletedTasks"
+	CreateTaskOperation          OperationName = "CreateTask"
+	DeleteTaskOperation          OperationName = "DeleteTask"
+	GetTaskOperation             OperationName = "GetTask"
+	GetTaskListOperation         OperationName = "GetTaskList"
+	ListTaskListsOperation       OperationName = "ListTaskLists"
+	ListTasksOperation           OperationName = "ListTasks"
+	UpdateTaskOperation          OperationName = "UpdateTask"
 )
@@ -2,6 +2,11 @@
 
 package api
 
+// ClearCompletedTasksParams is parameters of clearCompletedTasks operation.
+type ClearCompletedTasksParams struct {
+	TaskListId string
+}
+
 // CreateTaskParams is parameters of createTask operation.
 type CreateTaskParams struct {
 	TaskListId string
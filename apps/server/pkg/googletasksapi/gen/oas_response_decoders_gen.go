@@ -13,6 +13,15 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+func decodeClearCompletedTasksResponse(resp *http.Response) (res *ClearCompletedTasksNoContent, _ error) {
+	switch resp.StatusCode {
+	case 204:
+		// Code 204.
+		return &ClearCompletedTasksNoContent{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
 func decodeCreateTaskResponse(resp *http.Response) (res *Task, _ error) {
 	switch resp.StatusCode {
 	case 200:
@@ -27,6 +27,9 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// ClearCompletedTasksNoContent is response for ClearCompletedTasks operation.
+type ClearCompletedTasksNoContent struct{}
+
 // DeleteTaskNoContent is response for DeleteTask operation.
 type DeleteTaskNoContent struct{}
 
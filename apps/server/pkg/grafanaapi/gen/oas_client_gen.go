@@ -53,12 +53,24 @@ type Invoker interface {
 	//
 	// POST /api/folders
 	CreateFolder(ctx context.Context, request *CreateFolderRequest) (*Folder, error)
+	// CreateLibraryElement invokes createLibraryElement operation.
+	//
+	// Create a library element.
+	//
+	// POST /api/library-elements
+	CreateLibraryElement(ctx context.Context, request *CreateLibraryElementRequest) (*LibraryElementResponse, error)
 	// CreateOrUpdateDashboard invokes createOrUpdateDashboard operation.
 	//
 	// Create or update a dashboard.
 	//
 	// POST /api/dashboards/db
 	CreateOrUpdateDashboard(ctx context.Context, request *SaveDashboardRequest) (*SaveDashboardResponse, error)
+	// CreateServiceAccountToken invokes createServiceAccountToken operation.
+	//
+	// Create a service account token.
+	//
+	// POST /api/serviceaccounts/{serviceAccountId}/tokens
+	CreateServiceAccountToken(ctx context.Context, request *CreateServiceAccountTokenRequest, params CreateServiceAccountTokenParams) (*ServiceAccountToken, error)
 	// DeleteAnnotation invokes deleteAnnotation operation.
 	//
 	// Delete an annotation.
@@ -89,6 +101,12 @@ type Invoker interface {
 	//
 	// GET /api/v1/provisioning/alert-rules/{uid}
 	GetAlertRule(ctx context.Context, params GetAlertRuleParams) (*AlertRule, error)
+	// GetAnnotation invokes getAnnotation operation.
+	//
+	// Get an annotation by ID.
+	//
+	// GET /api/annotations/{id}
+	GetAnnotation(ctx context.Context, params GetAnnotationParams) (*Annotation, error)
 	// GetDashboardByUid invokes getDashboardByUid operation.
 	//
 	// Get dashboard by UID.
@@ -101,12 +119,24 @@ type Invoker interface {
 	//
 	// GET /api/datasources/uid/{uid}
 	GetDatasourceByUid(ctx context.Context, params GetDatasourceByUidParams) (*Datasource, error)
+	// GetLibraryElementByUid invokes getLibraryElementByUid operation.
+	//
+	// Get a library element by UID.
+	//
+	// GET /api/library-elements/{uid}
+	GetLibraryElementByUid(ctx context.Context, params GetLibraryElementByUidParams) (*LibraryElementResponse, error)
 	// GetNotificationPolicy invokes getNotificationPolicy operation.
 	//
 	// Get the notification policy tree.
 	//
 	// GET /api/v1/provisioning/policies
 	GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error)
+	// ListAlertInstances invokes listAlertInstances operation.
+	//
+	// List alert instances known to the Grafana Alertmanager.
+	//
+	// GET /api/alertmanager/grafana/api/v2/alerts
+	ListAlertInstances(ctx context.Context, params ListAlertInstancesParams) ([]GettableAlert, error)
 	// ListAlertRules invokes listAlertRules operation.
 	//
 	// List all alert rules.
@@ -131,6 +161,18 @@ type Invoker interface {
 	//
 	// GET /api/folders
 	ListFolders(ctx context.Context, params ListFoldersParams) ([]Folder, error)
+	// ListLibraryElements invokes listLibraryElements operation.
+	//
+	// List library elements.
+	//
+	// GET /api/library-elements
+	ListLibraryElements(ctx context.Context, params ListLibraryElementsParams) (*LibraryElementSearchResponse, error)
+	// ListRuleStatuses invokes listRuleStatuses operation.
+	//
+	// Get the evaluation state of Grafana-managed alert rules.
+	//
+	// GET /api/prometheus/grafana/api/v1/rules
+	ListRuleStatuses(ctx context.Context, params ListRuleStatusesParams) (*RuleStatusResponse, error)
 	// QueryAnnotations invokes queryAnnotations operation.
 	//
 	// Query annotations.
@@ -149,6 +191,18 @@ type Invoker interface {
 	//
 	// GET /api/search
 	Search(ctx context.Context, params SearchParams) ([]SearchResult, error)
+	// SearchServiceAccounts invokes searchServiceAccounts operation.
+	//
+	// Search service accounts.
+	//
+	// GET /api/serviceaccounts/search
+	SearchServiceAccounts(ctx context.Context, params SearchServiceAccountsParams) (*ServiceAccountSearchResult, error)
+	// UpdateAnnotation invokes updateAnnotation operation.
+	//
+	// Update an annotation.
+	//
+	// PUT /api/annotations/{id}
+	UpdateAnnotation(ctx context.Context, request *UpdateAnnotationRequest, params UpdateAnnotationParams) (*UpdateAnnotationResponse, error)
 	// UpdateContactPoint invokes updateContactPoint operation.
 	//
 	// Update a contact point.
@@ -688,21 +742,21 @@ func (c *Client) sendCreateFolder(ctx context.Context, request *CreateFolderRequ
 	return result, nil
 }
 
-// CreateOrUpdateDashboard invokes createOrUpdateDashboard operation.
+// CreateLibraryElement invokes createLibraryElement operation.
 //
-// Create or update a dashboard.
+// Create a library element.
 //
-// POST /api/dashboards/db
-func (c *Client) CreateOrUpdateDashboard(ctx context.Context, request *SaveDashboardRequest) (*SaveDashboardResponse, error) {
-	res, err := c.sendCreateOrUpdateDashboard(ctx, request)
+// POST /api/library-elements
+func (c *Client) CreateLibraryElement(ctx context.Context, request *CreateLibraryElementRequest) (*LibraryElementResponse, error) {
+	res, err := c.sendCreateLibraryElement(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveDashboardRequest) (res *SaveDashboardResponse, err error) {
+func (c *Client) sendCreateLibraryElement(ctx context.Context, request *CreateLibraryElementRequest) (res *LibraryElementResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createOrUpdateDashboard"),
+		otelogen.OperationID("createLibraryElement"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/dashboards/db"),
+		semconv.URLTemplateKey.String("/api/library-elements"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -718,7 +772,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateOrUpdateDashboardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateLibraryElementOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -736,7 +790,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/dashboards/db"
+	pathParts[0] = "/api/library-elements"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -744,7 +798,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateOrUpdateDashboardRequest(request, r); err != nil {
+	if err := encodeCreateLibraryElementRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -753,7 +807,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, CreateOrUpdateDashboardOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateLibraryElementOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -764,7 +818,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, CreateOrUpdateDashboardOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CreateLibraryElementOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -801,7 +855,7 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateOrUpdateDashboardResponse(resp)
+	result, err := decodeCreateLibraryElementResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -809,21 +863,21 @@ func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveD
 	return result, nil
 }
 
-// DeleteAnnotation invokes deleteAnnotation operation.
+// CreateOrUpdateDashboard invokes createOrUpdateDashboard operation.
 //
-// Delete an annotation.
+// Create or update a dashboard.
 //
-// DELETE /api/annotations/{id}
-func (c *Client) DeleteAnnotation(ctx context.Context, params DeleteAnnotationParams) (*DeleteAnnotationResponse, error) {
-	res, err := c.sendDeleteAnnotation(ctx, params)
+// POST /api/dashboards/db
+func (c *Client) CreateOrUpdateDashboard(ctx context.Context, request *SaveDashboardRequest) (*SaveDashboardResponse, error) {
+	res, err := c.sendCreateOrUpdateDashboard(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotationParams) (res *DeleteAnnotationResponse, err error) {
+func (c *Client) sendCreateOrUpdateDashboard(ctx context.Context, request *SaveDashboardRequest) (res *SaveDashboardResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteAnnotation"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/annotations/{id}"),
+		otelogen.OperationID("createOrUpdateDashboard"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/dashboards/db"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -839,7 +893,7 @@ func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotati
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteAnnotationOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateOrUpdateDashboardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -856,40 +910,25 @@ func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotati
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/annotations/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/api/dashboards/db"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateOrUpdateDashboardRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteAnnotationOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateOrUpdateDashboardOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -900,7 +939,7 @@ func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotati
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteAnnotationOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CreateOrUpdateDashboardOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -937,7 +976,7 @@ func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotati
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteAnnotationResponse(resp)
+	result, err := decodeCreateOrUpdateDashboardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -945,21 +984,21 @@ func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotati
 	return result, nil
 }
 
-// DeleteContactPoint invokes deleteContactPoint operation.
+// CreateServiceAccountToken invokes createServiceAccountToken operation.
 //
-// Delete a contact point.
+// Create a service account token.
 //
-// DELETE /api/v1/provisioning/contact-points/{uid}
-func (c *Client) DeleteContactPoint(ctx context.Context, params DeleteContactPointParams) error {
-	_, err := c.sendDeleteContactPoint(ctx, params)
-	return err
+// POST /api/serviceaccounts/{serviceAccountId}/tokens
+func (c *Client) CreateServiceAccountToken(ctx context.Context, request *CreateServiceAccountTokenRequest, params CreateServiceAccountTokenParams) (*ServiceAccountToken, error) {
+	res, err := c.sendCreateServiceAccountToken(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContactPointParams) (res *DeleteContactPointNoContent, err error) {
+func (c *Client) sendCreateServiceAccountToken(ctx context.Context, request *CreateServiceAccountTokenRequest, params CreateServiceAccountTokenParams) (res *ServiceAccountToken, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteContactPoint"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/v1/provisioning/contact-points/{uid}"),
+		otelogen.OperationID("createServiceAccountToken"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/serviceaccounts/{serviceAccountId}/tokens"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -975,7 +1014,7 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteContactPointOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateServiceAccountTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -992,17 +1031,17 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/api/v1/provisioning/contact-points/"
+	var pathParts [3]string
+	pathParts[0] = "/api/serviceaccounts/"
 	{
-		// Encode "uid" parameter.
+		// Encode "serviceAccountId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uid",
+			Param:   "serviceAccountId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UID))
+			return e.EncodeValue(conv.Int64ToString(params.ServiceAccountId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1012,20 +1051,24 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/tokens"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateServiceAccountTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteContactPointOperation, r); {
+			switch err := c.securityBearerAuth(ctx, CreateServiceAccountTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1036,7 +1079,7 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteContactPointOperation, r); {
+			switch err := c.securityBasicAuth(ctx, CreateServiceAccountTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1073,7 +1116,7 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteContactPointResponse(resp)
+	result, err := decodeCreateServiceAccountTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1081,21 +1124,21 @@ func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContac
 	return result, nil
 }
 
-// DeleteDashboardByUid invokes deleteDashboardByUid operation.
+// DeleteAnnotation invokes deleteAnnotation operation.
 //
-// Delete dashboard by UID.
+// Delete an annotation.
 //
-// DELETE /api/dashboards/uid/{uid}
-func (c *Client) DeleteDashboardByUid(ctx context.Context, params DeleteDashboardByUidParams) (*DeleteDashboardResponse, error) {
-	res, err := c.sendDeleteDashboardByUid(ctx, params)
+// DELETE /api/annotations/{id}
+func (c *Client) DeleteAnnotation(ctx context.Context, params DeleteAnnotationParams) (*DeleteAnnotationResponse, error) {
+	res, err := c.sendDeleteAnnotation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDashboardByUidParams) (res *DeleteDashboardResponse, err error) {
+func (c *Client) sendDeleteAnnotation(ctx context.Context, params DeleteAnnotationParams) (res *DeleteAnnotationResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDashboardByUid"),
+		otelogen.OperationID("deleteAnnotation"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/dashboards/uid/{uid}"),
+		semconv.URLTemplateKey.String("/api/annotations/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1111,7 +1154,7 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDashboardByUidOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteAnnotationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1129,16 +1172,16 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/dashboards/uid/"
+	pathParts[0] = "/api/annotations/"
 	{
-		// Encode "uid" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "uid",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.UID))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1161,7 +1204,7 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteDashboardByUidOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1172,7 +1215,7 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteDashboardByUidOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1209,7 +1252,7 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteDashboardByUidResponse(resp)
+	result, err := decodeDeleteAnnotationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1217,21 +1260,21 @@ func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDash
 	return result, nil
 }
 
-// DeleteFolderByUid invokes deleteFolderByUid operation.
+// DeleteContactPoint invokes deleteContactPoint operation.
 //
-// Delete a folder by UID.
+// Delete a contact point.
 //
-// DELETE /api/folders/{uid}
-func (c *Client) DeleteFolderByUid(ctx context.Context, params DeleteFolderByUidParams) (*DeleteFolderResponse, error) {
-	res, err := c.sendDeleteFolderByUid(ctx, params)
-	return res, err
+// DELETE /api/v1/provisioning/contact-points/{uid}
+func (c *Client) DeleteContactPoint(ctx context.Context, params DeleteContactPointParams) error {
+	_, err := c.sendDeleteContactPoint(ctx, params)
+	return err
 }
 
-func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderByUidParams) (res *DeleteFolderResponse, err error) {
+func (c *Client) sendDeleteContactPoint(ctx context.Context, params DeleteContactPointParams) (res *DeleteContactPointNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteFolderByUid"),
+		otelogen.OperationID("deleteContactPoint"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/api/folders/{uid}"),
+		semconv.URLTemplateKey.String("/api/v1/provisioning/contact-points/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1247,7 +1290,7 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteFolderByUidOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteContactPointOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1265,7 +1308,7 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/folders/"
+	pathParts[0] = "/api/v1/provisioning/contact-points/"
 	{
 		// Encode "uid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1297,7 +1340,7 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, DeleteFolderByUidOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteContactPointOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1308,7 +1351,7 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, DeleteFolderByUidOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteContactPointOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1345,7 +1388,7 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteFolderByUidResponse(resp)
+	result, err := decodeDeleteContactPointResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1353,21 +1396,21 @@ func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderB
 	return result, nil
 }
 
-// GetAlertRule invokes getAlertRule operation.
+// DeleteDashboardByUid invokes deleteDashboardByUid operation.
 //
-// Get alert rule by UID.
+// Delete dashboard by UID.
 //
-// GET /api/v1/provisioning/alert-rules/{uid}
-func (c *Client) GetAlertRule(ctx context.Context, params GetAlertRuleParams) (*AlertRule, error) {
-	res, err := c.sendGetAlertRule(ctx, params)
+// DELETE /api/dashboards/uid/{uid}
+func (c *Client) DeleteDashboardByUid(ctx context.Context, params DeleteDashboardByUidParams) (*DeleteDashboardResponse, error) {
+	res, err := c.sendDeleteDashboardByUid(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams) (res *AlertRule, err error) {
+func (c *Client) sendDeleteDashboardByUid(ctx context.Context, params DeleteDashboardByUidParams) (res *DeleteDashboardResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAlertRule"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/provisioning/alert-rules/{uid}"),
+		otelogen.OperationID("deleteDashboardByUid"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/dashboards/uid/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1383,7 +1426,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetAlertRuleOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDashboardByUidOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1401,7 +1444,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/v1/provisioning/alert-rules/"
+	pathParts[0] = "/api/dashboards/uid/"
 	{
 		// Encode "uid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1423,7 +1466,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1433,7 +1476,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetAlertRuleOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteDashboardByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1444,7 +1487,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetAlertRuleOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteDashboardByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1481,7 +1524,7 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetAlertRuleResponse(resp)
+	result, err := decodeDeleteDashboardByUidResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1489,21 +1532,21 @@ func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams
 	return result, nil
 }
 
-// GetDashboardByUid invokes getDashboardByUid operation.
+// DeleteFolderByUid invokes deleteFolderByUid operation.
 //
-// Get dashboard by UID.
+// Delete a folder by UID.
 //
-// GET /api/dashboards/uid/{uid}
-func (c *Client) GetDashboardByUid(ctx context.Context, params GetDashboardByUidParams) (*DashboardFullWithMeta, error) {
-	res, err := c.sendGetDashboardByUid(ctx, params)
+// DELETE /api/folders/{uid}
+func (c *Client) DeleteFolderByUid(ctx context.Context, params DeleteFolderByUidParams) (*DeleteFolderResponse, error) {
+	res, err := c.sendDeleteFolderByUid(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardByUidParams) (res *DashboardFullWithMeta, err error) {
+func (c *Client) sendDeleteFolderByUid(ctx context.Context, params DeleteFolderByUidParams) (res *DeleteFolderResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDashboardByUid"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/dashboards/uid/{uid}"),
+		otelogen.OperationID("deleteFolderByUid"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/api/folders/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1519,7 +1562,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDashboardByUidOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteFolderByUidOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1537,7 +1580,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/dashboards/uid/"
+	pathParts[0] = "/api/folders/"
 	{
 		// Encode "uid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1559,7 +1602,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1569,7 +1612,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetDashboardByUidOperation, r); {
+			switch err := c.securityBearerAuth(ctx, DeleteFolderByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1580,7 +1623,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetDashboardByUidOperation, r); {
+			switch err := c.securityBasicAuth(ctx, DeleteFolderByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1617,7 +1660,7 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDashboardByUidResponse(resp)
+	result, err := decodeDeleteFolderByUidResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1625,21 +1668,21 @@ func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardB
 	return result, nil
 }
 
-// GetDatasourceByUid invokes getDatasourceByUid operation.
+// GetAlertRule invokes getAlertRule operation.
 //
-// Get data source by UID.
+// Get alert rule by UID.
 //
-// GET /api/datasources/uid/{uid}
-func (c *Client) GetDatasourceByUid(ctx context.Context, params GetDatasourceByUidParams) (*Datasource, error) {
-	res, err := c.sendGetDatasourceByUid(ctx, params)
+// GET /api/v1/provisioning/alert-rules/{uid}
+func (c *Client) GetAlertRule(ctx context.Context, params GetAlertRuleParams) (*AlertRule, error) {
+	res, err := c.sendGetAlertRule(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourceByUidParams) (res *Datasource, err error) {
+func (c *Client) sendGetAlertRule(ctx context.Context, params GetAlertRuleParams) (res *AlertRule, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDatasourceByUid"),
+		otelogen.OperationID("getAlertRule"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/datasources/uid/{uid}"),
+		semconv.URLTemplateKey.String("/api/v1/provisioning/alert-rules/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1655,7 +1698,7 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetDatasourceByUidOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAlertRuleOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1673,7 +1716,7 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/api/datasources/uid/"
+	pathParts[0] = "/api/v1/provisioning/alert-rules/"
 	{
 		// Encode "uid" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1705,7 +1748,7 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetDatasourceByUidOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetAlertRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1716,7 +1759,7 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetDatasourceByUidOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetAlertRuleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1753,7 +1796,7 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetDatasourceByUidResponse(resp)
+	result, err := decodeGetAlertRuleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1761,21 +1804,21 @@ func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourc
 	return result, nil
 }
 
-// GetNotificationPolicy invokes getNotificationPolicy operation.
+// GetAnnotation invokes getAnnotation operation.
 //
-// Get the notification policy tree.
+// Get an annotation by ID.
 //
-// GET /api/v1/provisioning/policies
-func (c *Client) GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error) {
-	res, err := c.sendGetNotificationPolicy(ctx)
+// GET /api/annotations/{id}
+func (c *Client) GetAnnotation(ctx context.Context, params GetAnnotationParams) (*Annotation, error) {
+	res, err := c.sendGetAnnotation(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *NotificationPolicy, err error) {
+func (c *Client) sendGetAnnotation(ctx context.Context, params GetAnnotationParams) (res *Annotation, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNotificationPolicy"),
+		otelogen.OperationID("getAnnotation"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/provisioning/policies"),
+		semconv.URLTemplateKey.String("/api/annotations/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1791,7 +1834,7 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationPolicyOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetAnnotationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1808,8 +1851,26 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/provisioning/policies"
+	var pathParts [2]string
+	pathParts[0] = "/api/annotations/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1823,7 +1884,7 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GetNotificationPolicyOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1834,7 +1895,7 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, GetNotificationPolicyOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1871,7 +1932,7 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetNotificationPolicyResponse(resp)
+	result, err := decodeGetAnnotationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1879,21 +1940,21 @@ func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *Notificati
 	return result, nil
 }
 
-// ListAlertRules invokes listAlertRules operation.
+// GetDashboardByUid invokes getDashboardByUid operation.
 //
-// List all alert rules.
+// Get dashboard by UID.
 //
-// GET /api/v1/provisioning/alert-rules
-func (c *Client) ListAlertRules(ctx context.Context) ([]AlertRule, error) {
-	res, err := c.sendListAlertRules(ctx)
+// GET /api/dashboards/uid/{uid}
+func (c *Client) GetDashboardByUid(ctx context.Context, params GetDashboardByUidParams) (*DashboardFullWithMeta, error) {
+	res, err := c.sendGetDashboardByUid(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err error) {
+func (c *Client) sendGetDashboardByUid(ctx context.Context, params GetDashboardByUidParams) (res *DashboardFullWithMeta, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listAlertRules"),
+		otelogen.OperationID("getDashboardByUid"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/provisioning/alert-rules"),
+		semconv.URLTemplateKey.String("/api/dashboards/uid/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1909,7 +1970,7 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListAlertRulesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDashboardByUidOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1926,8 +1987,26 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/provisioning/alert-rules"
+	var pathParts [2]string
+	pathParts[0] = "/api/dashboards/uid/"
+	{
+		// Encode "uid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1941,7 +2020,7 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListAlertRulesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetDashboardByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1952,7 +2031,7 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListAlertRulesOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetDashboardByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1989,7 +2068,7 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListAlertRulesResponse(resp)
+	result, err := decodeGetDashboardByUidResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1997,21 +2076,21 @@ func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err e
 	return result, nil
 }
 
-// ListContactPoints invokes listContactPoints operation.
+// GetDatasourceByUid invokes getDatasourceByUid operation.
 //
-// List all contact points.
+// Get data source by UID.
 //
-// GET /api/v1/provisioning/contact-points
-func (c *Client) ListContactPoints(ctx context.Context) ([]ContactPoint, error) {
-	res, err := c.sendListContactPoints(ctx)
+// GET /api/datasources/uid/{uid}
+func (c *Client) GetDatasourceByUid(ctx context.Context, params GetDatasourceByUidParams) (*Datasource, error) {
+	res, err := c.sendGetDatasourceByUid(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint, err error) {
+func (c *Client) sendGetDatasourceByUid(ctx context.Context, params GetDatasourceByUidParams) (res *Datasource, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listContactPoints"),
+		otelogen.OperationID("getDatasourceByUid"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/v1/provisioning/contact-points"),
+		semconv.URLTemplateKey.String("/api/datasources/uid/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2027,7 +2106,7 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListContactPointsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDatasourceByUidOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2044,8 +2123,26 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/v1/provisioning/contact-points"
+	var pathParts [2]string
+	pathParts[0] = "/api/datasources/uid/"
+	{
+		// Encode "uid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2059,7 +2156,7 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListContactPointsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetDatasourceByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2070,7 +2167,7 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListContactPointsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetDatasourceByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2107,7 +2204,7 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListContactPointsResponse(resp)
+	result, err := decodeGetDatasourceByUidResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2115,21 +2212,21 @@ func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint,
 	return result, nil
 }
 
-// ListDatasources invokes listDatasources operation.
+// GetLibraryElementByUid invokes getLibraryElementByUid operation.
 //
-// List all data sources.
+// Get a library element by UID.
 //
-// GET /api/datasources
-func (c *Client) ListDatasources(ctx context.Context) ([]Datasource, error) {
-	res, err := c.sendListDatasources(ctx)
+// GET /api/library-elements/{uid}
+func (c *Client) GetLibraryElementByUid(ctx context.Context, params GetLibraryElementByUidParams) (*LibraryElementResponse, error) {
+	res, err := c.sendGetLibraryElementByUid(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err error) {
+func (c *Client) sendGetLibraryElementByUid(ctx context.Context, params GetLibraryElementByUidParams) (res *LibraryElementResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listDatasources"),
+		otelogen.OperationID("getLibraryElementByUid"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/datasources"),
+		semconv.URLTemplateKey.String("/api/library-elements/{uid}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2145,7 +2242,7 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListDatasourcesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetLibraryElementByUidOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2162,8 +2259,26 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/datasources"
+	var pathParts [2]string
+	pathParts[0] = "/api/library-elements/"
+	{
+		// Encode "uid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "uid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.UID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2177,7 +2292,7 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListDatasourcesOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetLibraryElementByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2188,7 +2303,7 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListDatasourcesOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetLibraryElementByUidOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2225,7 +2340,7 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListDatasourcesResponse(resp)
+	result, err := decodeGetLibraryElementByUidResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2233,21 +2348,21 @@ func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err
 	return result, nil
 }
 
-// ListFolders invokes listFolders operation.
+// GetNotificationPolicy invokes getNotificationPolicy operation.
 //
-// List all folders.
+// Get the notification policy tree.
 //
-// GET /api/folders
-func (c *Client) ListFolders(ctx context.Context, params ListFoldersParams) ([]Folder, error) {
-	res, err := c.sendListFolders(ctx, params)
+// GET /api/v1/provisioning/policies
+func (c *Client) GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error) {
+	res, err := c.sendGetNotificationPolicy(ctx)
 	return res, err
 }
 
-func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams) (res []Folder, err error) {
+func (c *Client) sendGetNotificationPolicy(ctx context.Context) (res *NotificationPolicy, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listFolders"),
+		otelogen.OperationID("getNotificationPolicy"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/folders"),
+		semconv.URLTemplateKey.String("/api/v1/provisioning/policies"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2263,7 +2378,7 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListFoldersOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetNotificationPolicyOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2281,47 +2396,9 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/folders"
+	pathParts[0] = "/api/v1/provisioning/policies"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -2333,7 +2410,7 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ListFoldersOperation, r); {
+			switch err := c.securityBearerAuth(ctx, GetNotificationPolicyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2344,7 +2421,7 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, ListFoldersOperation, r); {
+			switch err := c.securityBasicAuth(ctx, GetNotificationPolicyOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2381,7 +2458,7 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListFoldersResponse(resp)
+	result, err := decodeGetNotificationPolicyResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2389,21 +2466,21 @@ func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams)
 	return result, nil
 }
 
-// QueryAnnotations invokes queryAnnotations operation.
+// ListAlertInstances invokes listAlertInstances operation.
 //
-// Query annotations.
+// List alert instances known to the Grafana Alertmanager.
 //
-// GET /api/annotations
-func (c *Client) QueryAnnotations(ctx context.Context, params QueryAnnotationsParams) ([]Annotation, error) {
-	res, err := c.sendQueryAnnotations(ctx, params)
+// GET /api/alertmanager/grafana/api/v2/alerts
+func (c *Client) ListAlertInstances(ctx context.Context, params ListAlertInstancesParams) ([]GettableAlert, error) {
+	res, err := c.sendListAlertInstances(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotationsParams) (res []Annotation, err error) {
+func (c *Client) sendListAlertInstances(ctx context.Context, params ListAlertInstancesParams) (res []GettableAlert, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("queryAnnotations"),
+		otelogen.OperationID("listAlertInstances"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/annotations"),
+		semconv.URLTemplateKey.String("/api/alertmanager/grafana/api/v2/alerts"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2419,7 +2496,7 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, QueryAnnotationsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAlertInstancesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2437,22 +2514,22 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/annotations"
+	pathParts[0] = "/api/alertmanager/grafana/api/v2/alerts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "from" parameter.
+		// Encode "active" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "from",
+			Name:    "active",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.From.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
+			if val, ok := params.Active.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2460,16 +2537,16 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 	}
 	{
-		// Encode "to" parameter.
+		// Encode "silenced" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "to",
+			Name:    "silenced",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.To.Get(); ok {
-				return e.EncodeValue(conv.Int64ToString(val))
+			if val, ok := params.Silenced.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2477,16 +2554,16 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 	}
 	{
-		// Encode "dashboardUID" parameter.
+		// Encode "inhibited" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "dashboardUID",
+			Name:    "inhibited",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.DashboardUID.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Inhibited.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2494,34 +2571,1452 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 	}
 	{
-		// Encode "panelId" parameter.
+		// Encode "filter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "panelId",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.PanelId.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+			if params.Filter != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Filter {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAlertInstancesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListAlertInstancesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAlertInstancesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAlertRules invokes listAlertRules operation.
+//
+// List all alert rules.
+//
+// GET /api/v1/provisioning/alert-rules
+func (c *Client) ListAlertRules(ctx context.Context) ([]AlertRule, error) {
+	res, err := c.sendListAlertRules(ctx)
+	return res, err
+}
+
+func (c *Client) sendListAlertRules(ctx context.Context) (res []AlertRule, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAlertRules"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/provisioning/alert-rules"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListAlertRulesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/provisioning/alert-rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListAlertRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListAlertRulesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAlertRulesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListContactPoints invokes listContactPoints operation.
+//
+// List all contact points.
+//
+// GET /api/v1/provisioning/contact-points
+func (c *Client) ListContactPoints(ctx context.Context) ([]ContactPoint, error) {
+	res, err := c.sendListContactPoints(ctx)
+	return res, err
+}
+
+func (c *Client) sendListContactPoints(ctx context.Context) (res []ContactPoint, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listContactPoints"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/v1/provisioning/contact-points"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListContactPointsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/v1/provisioning/contact-points"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListContactPointsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListContactPointsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListContactPointsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListDatasources invokes listDatasources operation.
+//
+// List all data sources.
+//
+// GET /api/datasources
+func (c *Client) ListDatasources(ctx context.Context) ([]Datasource, error) {
+	res, err := c.sendListDatasources(ctx)
+	return res, err
+}
+
+func (c *Client) sendListDatasources(ctx context.Context) (res []Datasource, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listDatasources"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/datasources"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListDatasourcesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/datasources"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListDatasourcesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListDatasourcesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListDatasourcesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListFolders invokes listFolders operation.
+//
+// List all folders.
+//
+// GET /api/folders
+func (c *Client) ListFolders(ctx context.Context, params ListFoldersParams) ([]Folder, error) {
+	res, err := c.sendListFolders(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListFolders(ctx context.Context, params ListFoldersParams) (res []Folder, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listFolders"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/folders"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListFoldersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/folders"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListFoldersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListFoldersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListFoldersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListLibraryElements invokes listLibraryElements operation.
+//
+// List library elements.
+//
+// GET /api/library-elements
+func (c *Client) ListLibraryElements(ctx context.Context, params ListLibraryElementsParams) (*LibraryElementSearchResponse, error) {
+	res, err := c.sendListLibraryElements(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListLibraryElements(ctx context.Context, params ListLibraryElementsParams) (res *LibraryElementSearchResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listLibraryElements"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/library-elements"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListLibraryElementsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/library-elements"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "searchString" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "searchString",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SearchString.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "folderFilterUIDs" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "folderFilterUIDs",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.FolderFilterUIDs.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "perPage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "perPage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PerPage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListLibraryElementsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListLibraryElementsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListLibraryElementsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRuleStatuses invokes listRuleStatuses operation.
+//
+// Get the evaluation state of Grafana-managed alert rules.
+//
+// GET /api/prometheus/grafana/api/v1/rules
+func (c *Client) ListRuleStatuses(ctx context.Context, params ListRuleStatusesParams) (*RuleStatusResponse, error) {
+	res, err := c.sendListRuleStatuses(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRuleStatuses(ctx context.Context, params ListRuleStatusesParams) (res *RuleStatusResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listRuleStatuses"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/prometheus/grafana/api/v1/rules"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListRuleStatusesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/prometheus/grafana/api/v1/rules"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "rule_uid" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "rule_uid",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.RuleUID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ListRuleStatusesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, ListRuleStatusesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListRuleStatusesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// QueryAnnotations invokes queryAnnotations operation.
+//
+// Query annotations.
+//
+// GET /api/annotations
+func (c *Client) QueryAnnotations(ctx context.Context, params QueryAnnotationsParams) ([]Annotation, error) {
+	res, err := c.sendQueryAnnotations(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotationsParams) (res []Annotation, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("queryAnnotations"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/annotations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, QueryAnnotationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/annotations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.Int64ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dashboardUID" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dashboardUID",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DashboardUID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "panelId" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "panelId",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PanelId.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Tags != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Tags {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Type.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, QueryAnnotationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, QueryAnnotationsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeQueryAnnotationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// QueryDatasource invokes queryDatasource operation.
+//
+// Query a data source.
+//
+// POST /api/ds/query
+func (c *Client) QueryDatasource(ctx context.Context, request *DsQueryRequest) (jx.Raw, error) {
+	res, err := c.sendQueryDatasource(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryRequest) (res jx.Raw, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("queryDatasource"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/api/ds/query"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, QueryDatasourceOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/ds/query"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeQueryDatasourceRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
-		// Encode "tags" parameter.
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, QueryDatasourceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+		{
+			stage = "Security:BasicAuth"
+			switch err := c.securityBasicAuth(ctx, QueryDatasourceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BasicAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeQueryDatasourceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Search invokes search operation.
+//
+// Search dashboards and folders.
+//
+// GET /api/search
+func (c *Client) Search(ctx context.Context, params SearchParams) ([]SearchResult, error) {
+	res, err := c.sendSearch(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []SearchResult, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("search"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/search"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Query.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tag" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tag",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Tag != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Tag {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Type.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "folderUIDs" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "folderUIDs",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.Tags != nil {
+			if params.FolderUIDs != nil {
 				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.Tags {
+					for i, item := range params.FolderUIDs {
 						if err := func() error {
 							return e.EncodeValue(conv.StringToString(item))
 						}(); err != nil {
@@ -2537,16 +4032,16 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 	}
 	{
-		// Encode "type" parameter.
+		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "type",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Type.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2554,15 +4049,15 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 	}
 	{
-		// Encode "limit" parameter.
+		// Encode "page" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
+			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
+			if val, ok := params.Page.Get(); ok {
 				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
@@ -2583,7 +4078,7 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, QueryAnnotationsOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SearchOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2594,7 +4089,7 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, QueryAnnotationsOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SearchOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2631,7 +4126,7 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeQueryAnnotationsResponse(resp)
+	result, err := decodeSearchResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2639,21 +4134,21 @@ func (c *Client) sendQueryAnnotations(ctx context.Context, params QueryAnnotatio
 	return result, nil
 }
 
-// QueryDatasource invokes queryDatasource operation.
+// SearchServiceAccounts invokes searchServiceAccounts operation.
 //
-// Query a data source.
+// Search service accounts.
 //
-// POST /api/ds/query
-func (c *Client) QueryDatasource(ctx context.Context, request *DsQueryRequest) (jx.Raw, error) {
-	res, err := c.sendQueryDatasource(ctx, request)
+// GET /api/serviceaccounts/search
+func (c *Client) SearchServiceAccounts(ctx context.Context, params SearchServiceAccountsParams) (*ServiceAccountSearchResult, error) {
+	res, err := c.sendSearchServiceAccounts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryRequest) (res jx.Raw, err error) {
+func (c *Client) sendSearchServiceAccounts(ctx context.Context, params SearchServiceAccountsParams) (res *ServiceAccountSearchResult, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("queryDatasource"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/api/ds/query"),
+		otelogen.OperationID("searchServiceAccounts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/api/serviceaccounts/search"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2669,7 +4164,7 @@ func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryReques
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, QueryDatasourceOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchServiceAccountsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2687,24 +4182,76 @@ func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryReques
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/api/ds/query"
+	pathParts[0] = "/api/serviceaccounts/search"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "query" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Query.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "perpage" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "perpage",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Perpage.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeQueryDatasourceRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, QueryDatasourceOperation, r); {
+			switch err := c.securityBearerAuth(ctx, SearchServiceAccountsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2715,7 +4262,7 @@ func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryReques
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, QueryDatasourceOperation, r); {
+			switch err := c.securityBasicAuth(ctx, SearchServiceAccountsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2752,7 +4299,7 @@ func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryReques
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeQueryDatasourceResponse(resp)
+	result, err := decodeSearchServiceAccountsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2760,21 +4307,21 @@ func (c *Client) sendQueryDatasource(ctx context.Context, request *DsQueryReques
 	return result, nil
 }
 
-// Search invokes search operation.
+// UpdateAnnotation invokes updateAnnotation operation.
 //
-// Search dashboards and folders.
+// Update an annotation.
 //
-// GET /api/search
-func (c *Client) Search(ctx context.Context, params SearchParams) ([]SearchResult, error) {
-	res, err := c.sendSearch(ctx, params)
+// PUT /api/annotations/{id}
+func (c *Client) UpdateAnnotation(ctx context.Context, request *UpdateAnnotationRequest, params UpdateAnnotationParams) (*UpdateAnnotationResponse, error) {
+	res, err := c.sendUpdateAnnotation(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []SearchResult, err error) {
+func (c *Client) sendUpdateAnnotation(ctx context.Context, request *UpdateAnnotationRequest, params UpdateAnnotationParams) (res *UpdateAnnotationResponse, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("search"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/api/search"),
+		otelogen.OperationID("updateAnnotation"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/api/annotations/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2790,7 +4337,7 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []Sea
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SearchOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateAnnotationOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2807,146 +4354,43 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []Sea
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/api/search"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "query" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "query",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Query.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tag" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tag",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.Tag != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.Tag {
-						if err := func() error {
-							return e.EncodeValue(conv.StringToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "type" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "type",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Type.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "folderUIDs" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "folderUIDs",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if params.FolderUIDs != nil {
-				return e.EncodeArray(func(e uri.Encoder) error {
-					for i, item := range params.FolderUIDs {
-						if err := func() error {
-							return e.EncodeValue(conv.StringToString(item))
-						}(); err != nil {
-							return errors.Wrapf(err, "[%d]", i)
-						}
-					}
-					return nil
-				})
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [2]string
+	pathParts[0] = "/api/annotations/"
 	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateAnnotationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, SearchOperation, r); {
+			switch err := c.securityBearerAuth(ctx, UpdateAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2957,7 +4401,7 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []Sea
 		}
 		{
 			stage = "Security:BasicAuth"
-			switch err := c.securityBasicAuth(ctx, SearchOperation, r); {
+			switch err := c.securityBasicAuth(ctx, UpdateAnnotationOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2994,7 +4438,7 @@ func (c *Client) sendSearch(ctx context.Context, params SearchParams) (res []Sea
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSearchResponse(resp)
+	result, err := decodeUpdateAnnotationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -6,26 +6,35 @@ package api
 type OperationName = string
 
 const (
-	CreateAlertRuleOperation          OperationName = "CreateAlertRule"
-	CreateAnnotationOperation         OperationName = "CreateAnnotation"
-	CreateContactPointOperation       OperationName = "CreateContactPoint"
-	CreateFolderOperation             OperationName = "CreateFolder"
-	CreateOrUpdateDashboardOperation  OperationName = "CreateOrUpdateDashboard"
-	DeleteAnnotationOperation         OperationName = "DeleteAnnotation"
-	DeleteContactPointOperation       OperationName = "DeleteContactPoint"
-	DeleteDashboardByUidOperation     OperationName = "DeleteDashboardByUid"
-	DeleteFolderByUidOperation        OperationName = "DeleteFolderByUid"
-	GetAlertRuleOperation             OperationName = "GetAlertRule"
-	GetDashboardByUidOperation        OperationName = "GetDashboardByUid"
-	GetDatasourceByUidOperation       OperationName = "GetDatasourceByUid"
-	GetNotificationPolicyOperation    OperationName = "GetNotificationPolicy"
-	ListAlertRulesOperation           OperationName = "ListAlertRules"
-	ListContactPointsOperation        OperationName = "ListContactPoints"
-	ListDatasourcesOperation          OperationName = "ListDatasources"
-	ListFoldersOperation              OperationName = "ListFolders"
-	QueryAnnotationsOperation         OperationName = "QueryAnnotations"
-	QueryDatasourceOperation          OperationName = "QueryDatasource"
-	SearchOperation                   OperationName = "Search"
-	UpdateContactPointOperation       OperationName = "UpdateContactPoint"
-	UpdateNotificationPolicyOperation OperationName = "UpdateNotificationPolicy"
+	CreateAlertRuleOperation           OperationName = "CreateAlertRule"
+	CreateAnnotationOperation          OperationName = "CreateAnnotation"
+	CreateContactPointOperation        OperationName = "CreateContactPoint"
+	CreateFolderOperation              OperationName = "CreateFolder"
+	CreateLibraryElementOperation      OperationName = "CreateLibraryElement"
+	CreateOrUpdateDashboardOperation   OperationName = "CreateOrUpdateDashboard"
+	CreateServiceAccountTokenOperation OperationName = "CreateServiceAccountToken"
+	DeleteAnnotationOperation          OperationName = "DeleteAnnotation"
+	DeleteContactPointOperation        OperationName = "DeleteContactPoint"
+	DeleteDashboardByUidOperation      OperationName = "DeleteDashboardByUid"
+	DeleteFolderByUidOperation         OperationName = "DeleteFolderByUid"
+	GetAlertRuleOperation              OperationName = "GetAlertRule"
+	GetAnnotationOperation             OperationName = "GetAnnotation"
+	GetDashboardByUidOperation         OperationName = "GetDashboardByUid"
+	GetDatasourceByUidOperation        OperationName = "GetDatasourceByUid"
+	GetLibraryElementByUidOperation    OperationName = "GetLibraryElementByUid"
+	GetNotificationPolicyOperation     OperationName = "GetNotificationPolicy"
+	ListAlertInstancesOperation        OperationName = "ListAlertInstances"
+	ListAlertRulesOperation            OperationName = "ListAlertRules"
+	ListContactPointsOperation         OperationName = "ListContactPoints"
+	ListDatasourcesOperation           OperationName = "ListDatasources"
+	ListFoldersOperation               OperationName = "ListFolders"
+	ListLibraryElementsOperation       OperationName = "ListLibraryElements"
+	ListRuleStatusesOperation          OperationName = "ListRuleStatuses"
+	QueryAnnotationsOperation          OperationName = "QueryAnnotations"
+	QueryDatasourceOperation           OperationName = "QueryDatasource"
+	SearchOperation                    OperationName = "Search"
+	SearchServiceAccountsOperation     OperationName = "SearchServiceAccounts"
+	UpdateAnnotationOperation          OperationName = "UpdateAnnotation"
+	UpdateContactPointOperation        OperationName = "UpdateContactPoint"
+	UpdateNotificationPolicyOperation  OperationName = "UpdateNotificationPolicy"
 )
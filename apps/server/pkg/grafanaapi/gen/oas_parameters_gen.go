@@ -2,6 +2,11 @@
 
 package api
 
+// CreateServiceAccountTokenParams is parameters of createServiceAccountToken operation.
+type CreateServiceAccountTokenParams struct {
+	ServiceAccountId int64
+}
+
 // DeleteAnnotationParams is parameters of deleteAnnotation operation.
 type DeleteAnnotationParams struct {
 	ID int
@@ -27,6 +32,11 @@ type GetAlertRuleParams struct {
 	UID string
 }
 
+// GetAnnotationParams is parameters of getAnnotation operation.
+type GetAnnotationParams struct {
+	ID int
+}
+
 // GetDashboardByUidParams is parameters of getDashboardByUid operation.
 type GetDashboardByUidParams struct {
 	UID string
@@ -37,12 +47,39 @@ type GetDatasourceByUidParams struct {
 	UID string
 }
 
+// GetLibraryElementByUidParams is parameters of getLibraryElementByUid operation.
+type GetLibraryElementByUidParams struct {
+	UID string
+}
+
+// ListAlertInstancesParams is parameters of listAlertInstances operation.
+type ListAlertInstancesParams struct {
+	Active    OptBool  `json:",omitempty,omitzero"`
+	Silenced  OptBool  `json:",omitempty,omitzero"`
+	Inhibited OptBool  `json:",omitempty,omitzero"`
+	Filter    []string `json:",omitempty"`
+}
+
 // ListFoldersParams is parameters of listFolders operation.
 type ListFoldersParams struct {
 	Limit OptInt `json:",omitempty,omitzero"`
 	Page  OptInt `json:",omitempty,omitzero"`
 }
 
+// ListLibraryElementsParams is parameters of listLibraryElements operation.
+type ListLibraryElementsParams struct {
+	SearchString     OptString `json:",omitempty,omitzero"`
+	Kind             OptInt    `json:",omitempty,omitzero"`
+	FolderFilterUIDs OptString `json:",omitempty,omitzero"`
+	PerPage          OptInt    `json:",omitempty,omitzero"`
+	Page             OptInt    `json:",omitempty,omitzero"`
+}
+
+// ListRuleStatusesParams is parameters of listRuleStatuses operation.
+type ListRuleStatusesParams struct {
+	RuleUID OptString `json:",omitempty,omitzero"`
+}
+
 // QueryAnnotationsParams is parameters of queryAnnotations operation.
 type QueryAnnotationsParams struct {
 	From         OptInt64  `json:",omitempty,omitzero"`
@@ -64,6 +101,18 @@ type SearchParams struct {
 	Page       OptInt    `json:",omitempty,omitzero"`
 }
 
+// SearchServiceAccountsParams is parameters of searchServiceAccounts operation.
+type SearchServiceAccountsParams struct {
+	Query   OptString `json:",omitempty,omitzero"`
+	Perpage OptInt    `json:",omitempty,omitzero"`
+	Page    OptInt    `json:",omitempty,omitzero"`
+}
+
+// UpdateAnnotationParams is parameters of updateAnnotation operation.
+type UpdateAnnotationParams struct {
+	ID int
+}
+
 // UpdateContactPointParams is parameters of updateContactPoint operation.
 type UpdateContactPointParams struct {
 	UID string
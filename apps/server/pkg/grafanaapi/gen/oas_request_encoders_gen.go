@@ -66,6 +66,20 @@ func encodeCreateFolderRequest(
 	return nil
 }
 
+func encodeCreateLibraryElementRequest(
+	req *CreateLibraryElementRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateOrUpdateDashboardRequest(
 	req *SaveDashboardRequest,
 	r *http.Request,
@@ -80,6 +94,20 @@ func encodeCreateOrUpdateDashboardRequest(
 	return nil
 }
 
+func encodeCreateServiceAccountTokenRequest(
+	req *CreateServiceAccountTokenRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeQueryDatasourceRequest(
 	req *DsQueryRequest,
 	r *http.Request,
@@ -94,6 +122,20 @@ func encodeQueryDatasourceRequest(
 	return nil
 }
 
+func encodeUpdateAnnotationRequest(
+	req *UpdateAnnotationRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateContactPointRequest(
 	req *UpdateContactPointRequest,
 	r *http.Request,
@@ -770,6 +770,91 @@ func (s *CreateFolderRequest) SetParentUid(val OptString) {
 	s.ParentUid = val
 }
 
+// Ref: #/components/schemas/CreateLibraryElementRequest
+type CreateLibraryElementRequest struct {
+	UID       OptString `json:"uid"`
+	FolderUid OptString `json:"folderUid"`
+	Name      string    `json:"name"`
+	Model     jx.Raw    `json:"model"`
+	Kind      int       `json:"kind"`
+}
+
+// GetUID returns the value of UID.
+func (s *CreateLibraryElementRequest) GetUID() OptString {
+	return s.UID
+}
+
+// GetFolderUid returns the value of FolderUid.
+func (s *CreateLibraryElementRequest) GetFolderUid() OptString {
+	return s.FolderUid
+}
+
+// GetName returns the value of Name.
+func (s *CreateLibraryElementRequest) GetName() string {
+	return s.Name
+}
+
+// GetModel returns the value of Model.
+func (s *CreateLibraryElementRequest) GetModel() jx.Raw {
+	return s.Model
+}
+
+// GetKind returns the value of Kind.
+func (s *CreateLibraryElementRequest) GetKind() int {
+	return s.Kind
+}
+
+// SetUID sets the value of UID.
+func (s *CreateLibraryElementRequest) SetUID(val OptString) {
+	s.UID = val
+}
+
+// SetFolderUid sets the value of FolderUid.
+func (s *CreateLibraryElementRequest) SetFolderUid(val OptString) {
+	s.FolderUid = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateLibraryElementRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetModel sets the value of Model.
+func (s *CreateLibraryElementRequest) SetModel(val jx.Raw) {
+	s.Model = val
+}
+
+// SetKind sets the value of Kind.
+func (s *CreateLibraryElementRequest) SetKind(val int) {
+	s.Kind = val
+}
+
+// Ref: #/components/schemas/CreateServiceAccountTokenRequest
+type CreateServiceAccountTokenRequest struct {
+	Name          string   `json:"name"`
+	SecondsToLive OptInt64 `json:"secondsToLive"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateServiceAccountTokenRequest) GetName() string {
+	return s.Name
+}
+
+// GetSecondsToLive returns the value of SecondsToLive.
+func (s *CreateServiceAccountTokenRequest) GetSecondsToLive() OptInt64 {
+	return s.SecondsToLive
+}
+
+// SetName sets the value of Name.
+func (s *CreateServiceAccountTokenRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetSecondsToLive sets the value of SecondsToLive.
+func (s *CreateServiceAccountTokenRequest) SetSecondsToLive(val OptInt64) {
+	s.SecondsToLive = val
+}
+
 // Ref: #/components/schemas/DashboardFullWithMeta
 type DashboardFullWithMeta struct {
 	Meta      OptDashboardMeta `json:"meta"`
@@ -1218,6 +1303,21 @@ func (s *DeleteAnnotationResponse) SetMessage(val OptString) {
 // DeleteContactPointNoContent is response for DeleteContactPoint operation.
 type DeleteContactPointNoContent struct{}
 
+// Ref: #/components/schemas/DeleteContactPointResponse
+type DeleteContactPointResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteContactPointResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteContactPointResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
 // Ref: #/components/schemas/DeleteDashboardResponse
 type DeleteDashboardResponse struct {
 	Title   OptString `json:"title"`
@@ -1498,315 +1598,1340 @@ func (s *Folder) SetParentUid(val OptString) {
 	s.ParentUid = val
 }
 
-// Ref: #/components/schemas/NotificationPolicy
-type NotificationPolicy struct {
-	Receiver       OptString `json:"receiver"`
-	GroupBy        []string  `json:"group_by"`
-	Routes         []jx.Raw  `json:"routes"`
-	GroupWait      OptString `json:"group_wait"`
-	GroupInterval  OptString `json:"group_interval"`
-	RepeatInterval OptString `json:"repeat_interval"`
-	Provenance     OptString `json:"provenance"`
+// Ref: #/components/schemas/GettableAlert
+type GettableAlert struct {
+	Fingerprint  OptString              `json:"fingerprint"`
+	Labels       jx.Raw                 `json:"labels"`
+	Annotations  jx.Raw                 `json:"annotations"`
+	StartsAt     OptString              `json:"startsAt"`
+	EndsAt       OptString              `json:"endsAt"`
+	UpdatedAt    OptString              `json:"updatedAt"`
+	GeneratorURL OptString              `json:"generatorURL"`
+	Status       OptGettableAlertStatus `json:"status"`
 }
 
-// GetReceiver returns the value of Receiver.
-func (s *NotificationPolicy) GetReceiver() OptString {
-	return s.Receiver
+// GetFingerprint returns the value of Fingerprint.
+func (s *GettableAlert) GetFingerprint() OptString {
+	return s.Fingerprint
 }
 
-// GetGroupBy returns the value of GroupBy.
-func (s *NotificationPolicy) GetGroupBy() []string {
-	return s.GroupBy
+// GetLabels returns the value of Labels.
+func (s *GettableAlert) GetLabels() jx.Raw {
+	return s.Labels
 }
 
-// GetRoutes returns the value of Routes.
-func (s *NotificationPolicy) GetRoutes() []jx.Raw {
-	return s.Routes
+// GetAnnotations returns the value of Annotations.
+func (s *GettableAlert) GetAnnotations() jx.Raw {
+	return s.Annotations
 }
 
-// GetGroupWait returns the value of GroupWait.
-func (s *NotificationPolicy) GetGroupWait() OptString {
-	return s.GroupWait
+// GetStartsAt returns the value of StartsAt.
+func (s *GettableAlert) GetStartsAt() OptString {
+	return s.StartsAt
 }
 
-// GetGroupInterval returns the value of GroupInterval.
-func (s *NotificationPolicy) GetGroupInterval() OptString {
-	return s.GroupInterval
+// GetEndsAt returns the value of EndsAt.
+func (s *GettableAlert) GetEndsAt() OptString {
+	return s.EndsAt
 }
 
-// GetRepeatInterval returns the value of RepeatInterval.
-func (s *NotificationPolicy) GetRepeatInterval() OptString {
-	return s.RepeatInterval
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GettableAlert) GetUpdatedAt() OptString {
+	return s.UpdatedAt
 }
 
-// GetProvenance returns the value of Provenance.
-func (s *NotificationPolicy) GetProvenance() OptString {
-	return s.Provenance
+// GetGeneratorURL returns the value of GeneratorURL.
+func (s *GettableAlert) GetGeneratorURL() OptString {
+	return s.GeneratorURL
 }
 
-// SetReceiver sets the value of Receiver.
-func (s *NotificationPolicy) SetReceiver(val OptString) {
-	s.Receiver = val
+// GetStatus returns the value of Status.
+func (s *GettableAlert) GetStatus() OptGettableAlertStatus {
+	return s.Status
 }
 
-// SetGroupBy sets the value of GroupBy.
-func (s *NotificationPolicy) SetGroupBy(val []string) {
-	s.GroupBy = val
+// SetFingerprint sets the value of Fingerprint.
+func (s *GettableAlert) SetFingerprint(val OptString) {
+	s.Fingerprint = val
 }
 
-// SetRoutes sets the value of Routes.
-func (s *NotificationPolicy) SetRoutes(val []jx.Raw) {
-	s.Routes = val
+// SetLabels sets the value of Labels.
+func (s *GettableAlert) SetLabels(val jx.Raw) {
+	s.Labels = val
 }
 
-// SetGroupWait sets the value of GroupWait.
-func (s *NotificationPolicy) SetGroupWait(val OptString) {
-	s.GroupWait = val
+// SetAnnotations sets the value of Annotations.
+func (s *GettableAlert) SetAnnotations(val jx.Raw) {
+	s.Annotations = val
 }
 
-// SetGroupInterval sets the value of GroupInterval.
-func (s *NotificationPolicy) SetGroupInterval(val OptString) {
-	s.GroupInterval = val
+// SetStartsAt sets the value of StartsAt.
+func (s *GettableAlert) SetStartsAt(val OptString) {
+	s.StartsAt = val
 }
 
-// SetRepeatInterval sets the value of RepeatInterval.
-func (s *NotificationPolicy) SetRepeatInterval(val OptString) {
-	s.RepeatInterval = val
+// SetEndsAt sets the value of EndsAt.
+func (s *GettableAlert) SetEndsAt(val OptString) {
+	s.EndsAt = val
 }
 
-// SetProvenance sets the value of Provenance.
-func (s *NotificationPolicy) SetProvenance(val OptString) {
-	s.Provenance = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GettableAlert) SetUpdatedAt(val OptString) {
+	s.UpdatedAt = val
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// SetGeneratorURL sets the value of GeneratorURL.
+func (s *GettableAlert) SetGeneratorURL(val OptString) {
+	s.GeneratorURL = val
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// SetStatus sets the value of Status.
+func (s *GettableAlert) SetStatus(val OptGettableAlertStatus) {
+	s.Status = val
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/GettableAlertStatus
+type GettableAlertStatus struct {
+	State       OptString `json:"state"`
+	SilencedBy  []string  `json:"silencedBy"`
+	InhibitedBy []string  `json:"inhibitedBy"`
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetState returns the value of State.
+func (s *GettableAlertStatus) GetState() OptString {
+	return s.State
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetSilencedBy returns the value of SilencedBy.
+func (s *GettableAlertStatus) GetSilencedBy() []string {
+	return s.SilencedBy
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetInhibitedBy returns the value of InhibitedBy.
+func (s *GettableAlertStatus) GetInhibitedBy() []string {
+	return s.InhibitedBy
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetState sets the value of State.
+func (s *GettableAlertStatus) SetState(val OptString) {
+	s.State = val
 }
 
-// NewOptDashboardMeta returns new OptDashboardMeta with value set to v.
-func NewOptDashboardMeta(v DashboardMeta) OptDashboardMeta {
-	return OptDashboardMeta{
-		Value: v,
-		Set:   true,
-	}
+// SetSilencedBy sets the value of SilencedBy.
+func (s *GettableAlertStatus) SetSilencedBy(val []string) {
+	s.SilencedBy = val
 }
 
-// OptDashboardMeta is optional DashboardMeta.
-type OptDashboardMeta struct {
-	Value DashboardMeta
-	Set   bool
+// SetInhibitedBy sets the value of InhibitedBy.
+func (s *GettableAlertStatus) SetInhibitedBy(val []string) {
+	s.InhibitedBy = val
 }
 
-// IsSet returns true if OptDashboardMeta was set.
-func (o OptDashboardMeta) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/LibraryElement
+type LibraryElement struct {
+	ID          OptInt                `json:"id"`
+	OrgId       OptInt                `json:"orgId"`
+	FolderUid   OptString             `json:"folderUid"`
+	UID         OptString             `json:"uid"`
+	Name        OptString             `json:"name"`
+	Kind        OptInt                `json:"kind"`
+	Type        OptString             `json:"type"`
+	Description OptString             `json:"description"`
+	Model       jx.Raw                `json:"model"`
+	Version     OptInt                `json:"version"`
+	Meta        OptLibraryElementMeta `json:"meta"`
+}
 
-// Reset unsets value.
-func (o *OptDashboardMeta) Reset() {
-	var v DashboardMeta
-	o.Value = v
-	o.Set = false
+// GetID returns the value of ID.
+func (s *LibraryElement) GetID() OptInt {
+	return s.ID
 }
 
-// SetTo sets value to v.
-func (o *OptDashboardMeta) SetTo(v DashboardMeta) {
-	o.Set = true
-	o.Value = v
+// GetOrgId returns the value of OrgId.
+func (s *LibraryElement) GetOrgId() OptInt {
+	return s.OrgId
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDashboardMeta) Get() (v DashboardMeta, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetFolderUid returns the value of FolderUid.
+func (s *LibraryElement) GetFolderUid() OptString {
+	return s.FolderUid
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDashboardMeta) Or(d DashboardMeta) DashboardMeta {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUID returns the value of UID.
+func (s *LibraryElement) GetUID() OptString {
+	return s.UID
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetName returns the value of Name.
+func (s *LibraryElement) GetName() OptString {
+	return s.Name
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// GetKind returns the value of Kind.
+func (s *LibraryElement) GetKind() OptInt {
+	return s.Kind
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetType returns the value of Type.
+func (s *LibraryElement) GetType() OptString {
+	return s.Type
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetDescription returns the value of Description.
+func (s *LibraryElement) GetDescription() OptString {
+	return s.Description
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetModel returns the value of Model.
+func (s *LibraryElement) GetModel() jx.Raw {
+	return s.Model
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetVersion returns the value of Version.
+func (s *LibraryElement) GetVersion() OptInt {
+	return s.Version
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMeta returns the value of Meta.
+func (s *LibraryElement) GetMeta() OptLibraryElementMeta {
+	return s.Meta
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *LibraryElement) SetID(val OptInt) {
+	s.ID = val
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
-	Set   bool
+// SetOrgId sets the value of OrgId.
+func (s *LibraryElement) SetOrgId(val OptInt) {
+	s.OrgId = val
+}
+
+// SetFolderUid sets the value of FolderUid.
+func (s *LibraryElement) SetFolderUid(val OptString) {
+	s.FolderUid = val
+}
+
+// SetUID sets the value of UID.
+func (s *LibraryElement) SetUID(val OptString) {
+	s.UID = val
+}
+
+// SetName sets the value of Name.
+func (s *LibraryElement) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *LibraryElement) SetKind(val OptInt) {
+	s.Kind = val
+}
+
+// SetType sets the value of Type.
+func (s *LibraryElement) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetDescription sets the value of Description.
+func (s *LibraryElement) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetModel sets the value of Model.
+func (s *LibraryElement) SetModel(val jx.Raw) {
+	s.Model = val
+}
+
+// SetVersion sets the value of Version.
+func (s *LibraryElement) SetVersion(val OptInt) {
+	s.Version = val
+}
+
+// SetMeta sets the value of Meta.
+func (s *LibraryElement) SetMeta(val OptLibraryElementMeta) {
+	s.Meta = val
+}
+
+// Ref: #/components/schemas/LibraryElementMeta
+type LibraryElementMeta struct {
+	FolderName          OptString `json:"folderName"`
+	FolderUid           OptString `json:"folderUid"`
+	ConnectedDashboards OptInt    `json:"connectedDashboards"`
+	Created             OptString `json:"created"`
+	Updated             OptString `json:"updated"`
+}
+
+// GetFolderName returns the value of FolderName.
+func (s *LibraryElementMeta) GetFolderName() OptString {
+	return s.FolderName
+}
+
+// GetFolderUid returns the value of FolderUid.
+func (s *LibraryElementMeta) GetFolderUid() OptString {
+	return s.FolderUid
+}
+
+// GetConnectedDashboards returns the value of ConnectedDashboards.
+func (s *LibraryElementMeta) GetConnectedDashboards() OptInt {
+	return s.ConnectedDashboards
+}
+
+// GetCreated returns the value of Created.
+func (s *LibraryElementMeta) GetCreated() OptString {
+	return s.Created
+}
+
+// GetUpdated returns the value of Updated.
+func (s *LibraryElementMeta) GetUpdated() OptString {
+	return s.Updated
+}
+
+// SetFolderName sets the value of FolderName.
+func (s *LibraryElementMeta) SetFolderName(val OptString) {
+	s.FolderName = val
+}
+
+// SetFolderUid sets the value of FolderUid.
+func (s *LibraryElementMeta) SetFolderUid(val OptString) {
+	s.FolderUid = val
+}
+
+// SetConnectedDashboards sets the value of ConnectedDashboards.
+func (s *LibraryElementMeta) SetConnectedDashboards(val OptInt) {
+	s.ConnectedDashboards = val
+}
+
+// SetCreated sets the value of Created.
+func (s *LibraryElementMeta) SetCreated(val OptString) {
+	s.Created = val
+}
+
+// SetUpdated sets the value of Updated.
+func (s *LibraryElementMeta) SetUpdated(val OptString) {
+	s.Updated = val
+}
+
+// Ref: #/components/schemas/LibraryElementResponse
+type LibraryElementResponse struct {
+	Result OptLibraryElement `json:"result"`
+}
+
+// GetResult returns the value of Result.
+func (s *LibraryElementResponse) GetResult() OptLibraryElement {
+	return s.Result
+}
+
+// SetResult sets the value of Result.
+func (s *LibraryElementResponse) SetResult(val OptLibraryElement) {
+	s.Result = val
+}
+
+// Ref: #/components/schemas/LibraryElementSearchResponse
+type LibraryElementSearchResponse struct {
+	Result OptLibraryElementSearchResult `json:"result"`
+}
+
+// GetResult returns the value of Result.
+func (s *LibraryElementSearchResponse) GetResult() OptLibraryElementSearchResult {
+	return s.Result
+}
+
+// SetResult sets the value of Result.
+func (s *LibraryElementSearchResponse) SetResult(val OptLibraryElementSearchResult) {
+	s.Result = val
+}
+
+// Ref: #/components/schemas/LibraryElementSearchResult
+type LibraryElementSearchResult struct {
+	TotalCount OptInt           `json:"totalCount"`
+	Page       OptInt           `json:"page"`
+	PerPage    OptInt           `json:"perPage"`
+	Elements   []LibraryElement `json:"elements"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *LibraryElementSearchResult) GetTotalCount() OptInt {
+	return s.TotalCount
+}
+
+// GetPage returns the value of Page.
+func (s *LibraryElementSearchResult) GetPage() OptInt {
+	return s.Page
+}
+
+// GetPerPage returns the value of PerPage.
+func (s *LibraryElementSearchResult) GetPerPage() OptInt {
+	return s.PerPage
+}
+
+// GetElements returns the value of Elements.
+func (s *LibraryElementSearchResult) GetElements() []LibraryElement {
+	return s.Elements
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *LibraryElementSearchResult) SetTotalCount(val OptInt) {
+	s.TotalCount = val
+}
+
+// SetPage sets the value of Page.
+func (s *LibraryElementSearchResult) SetPage(val OptInt) {
+	s.Page = val
+}
+
+// SetPerPage sets the value of PerPage.
+func (s *LibraryElementSearchResult) SetPerPage(val OptInt) {
+	s.PerPage = val
+}
+
+// SetElements sets the value of Elements.
+func (s *LibraryElementSearchResult) SetElements(val []LibraryElement) {
+	s.Elements = val
+}
+
+// Ref: #/components/schemas/NotificationPolicy
+type NotificationPolicy struct {
+	Receiver       OptString `json:"receiver"`
+	GroupBy        []string  `json:"group_by"`
+	Routes         []jx.Raw  `json:"routes"`
+	GroupWait      OptString `json:"group_wait"`
+	GroupInterval  OptString `json:"group_interval"`
+	RepeatInterval OptString `json:"repeat_interval"`
+	Provenance     OptString `json:"provenance"`
+}
+
+// GetReceiver returns the value of Receiver.
+func (s *NotificationPolicy) GetReceiver() OptString {
+	return s.Receiver
+}
+
+// GetGroupBy returns the value of GroupBy.
+func (s *NotificationPolicy) GetGroupBy() []string {
+	return s.GroupBy
+}
+
+// GetRoutes returns the value of Routes.
+func (s *NotificationPolicy) GetRoutes() []jx.Raw {
+	return s.Routes
+}
+
+// GetGroupWait returns the value of GroupWait.
+func (s *NotificationPolicy) GetGroupWait() OptString {
+	return s.GroupWait
+}
+
+// GetGroupInterval returns the value of GroupInterval.
+func (s *NotificationPolicy) GetGroupInterval() OptString {
+	return s.GroupInterval
+}
+
+// GetRepeatInterval returns the value of RepeatInterval.
+func (s *NotificationPolicy) GetRepeatInterval() OptString {
+	return s.RepeatInterval
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *NotificationPolicy) GetProvenance() OptString {
+	return s.Provenance
+}
+
+// SetReceiver sets the value of Receiver.
+func (s *NotificationPolicy) SetReceiver(val OptString) {
+	s.Receiver = val
+}
+
+// SetGroupBy sets the value of GroupBy.
+func (s *NotificationPolicy) SetGroupBy(val []string) {
+	s.GroupBy = val
+}
+
+// SetRoutes sets the value of Routes.
+func (s *NotificationPolicy) SetRoutes(val []jx.Raw) {
+	s.Routes = val
+}
+
+// SetGroupWait sets the value of GroupWait.
+func (s *NotificationPolicy) SetGroupWait(val OptString) {
+	s.GroupWait = val
+}
+
+// SetGroupInterval sets the value of GroupInterval.
+func (s *NotificationPolicy) SetGroupInterval(val OptString) {
+	s.GroupInterval = val
+}
+
+// SetRepeatInterval sets the value of RepeatInterval.
+func (s *NotificationPolicy) SetRepeatInterval(val OptString) {
+	s.RepeatInterval = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *NotificationPolicy) SetProvenance(val OptString) {
+	s.Provenance = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDashboardMeta returns new OptDashboardMeta with value set to v.
+func NewOptDashboardMeta(v DashboardMeta) OptDashboardMeta {
+	return OptDashboardMeta{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDashboardMeta is optional DashboardMeta.
+type OptDashboardMeta struct {
+	Value DashboardMeta
+	Set   bool
+}
+
+// IsSet returns true if OptDashboardMeta was set.
+func (o OptDashboardMeta) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDashboardMeta) Reset() {
+	var v DashboardMeta
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDashboardMeta) SetTo(v DashboardMeta) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDashboardMeta) Get() (v DashboardMeta, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDashboardMeta) Or(d DashboardMeta) DashboardMeta {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGettableAlertStatus returns new OptGettableAlertStatus with value set to v.
+func NewOptGettableAlertStatus(v GettableAlertStatus) OptGettableAlertStatus {
+	return OptGettableAlertStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGettableAlertStatus is optional GettableAlertStatus.
+type OptGettableAlertStatus struct {
+	Value GettableAlertStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGettableAlertStatus was set.
+func (o OptGettableAlertStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGettableAlertStatus) Reset() {
+	var v GettableAlertStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGettableAlertStatus) SetTo(v GettableAlertStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGettableAlertStatus) Get() (v GettableAlertStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGettableAlertStatus) Or(d GettableAlertStatus) GettableAlertStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
 }
 
 // IsSet returns true if OptInt64 was set.
 func (o OptInt64) IsSet() bool { return o.Set }
 
-// Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLibraryElement returns new OptLibraryElement with value set to v.
+func NewOptLibraryElement(v LibraryElement) OptLibraryElement {
+	return OptLibraryElement{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLibraryElement is optional LibraryElement.
+type OptLibraryElement struct {
+	Value LibraryElement
+	Set   bool
+}
+
+// IsSet returns true if OptLibraryElement was set.
+func (o OptLibraryElement) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLibraryElement) Reset() {
+	var v LibraryElement
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLibraryElement) SetTo(v LibraryElement) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLibraryElement) Get() (v LibraryElement, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLibraryElement) Or(d LibraryElement) LibraryElement {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLibraryElementMeta returns new OptLibraryElementMeta with value set to v.
+func NewOptLibraryElementMeta(v LibraryElementMeta) OptLibraryElementMeta {
+	return OptLibraryElementMeta{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLibraryElementMeta is optional LibraryElementMeta.
+type OptLibraryElementMeta struct {
+	Value LibraryElementMeta
+	Set   bool
+}
+
+// IsSet returns true if OptLibraryElementMeta was set.
+func (o OptLibraryElementMeta) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLibraryElementMeta) Reset() {
+	var v LibraryElementMeta
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLibraryElementMeta) SetTo(v LibraryElementMeta) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLibraryElementMeta) Get() (v LibraryElementMeta, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLibraryElementMeta) Or(d LibraryElementMeta) LibraryElementMeta {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLibraryElementSearchResult returns new OptLibraryElementSearchResult with value set to v.
+func NewOptLibraryElementSearchResult(v LibraryElementSearchResult) OptLibraryElementSearchResult {
+	return OptLibraryElementSearchResult{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLibraryElementSearchResult is optional LibraryElementSearchResult.
+type OptLibraryElementSearchResult struct {
+	Value LibraryElementSearchResult
+	Set   bool
+}
+
+// IsSet returns true if OptLibraryElementSearchResult was set.
+func (o OptLibraryElementSearchResult) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLibraryElementSearchResult) Reset() {
+	var v LibraryElementSearchResult
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLibraryElementSearchResult) SetTo(v LibraryElementSearchResult) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLibraryElementSearchResult) Get() (v LibraryElementSearchResult, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLibraryElementSearchResult) Or(d LibraryElementSearchResult) LibraryElementSearchResult {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRuleStatusData returns new OptRuleStatusData with value set to v.
+func NewOptRuleStatusData(v RuleStatusData) OptRuleStatusData {
+	return OptRuleStatusData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRuleStatusData is optional RuleStatusData.
+type OptRuleStatusData struct {
+	Value RuleStatusData
+	Set   bool
+}
+
+// IsSet returns true if OptRuleStatusData was set.
+func (o OptRuleStatusData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRuleStatusData) Reset() {
+	var v RuleStatusData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRuleStatusData) SetTo(v RuleStatusData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRuleStatusData) Get() (v RuleStatusData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRuleStatusData) Or(d RuleStatusData) RuleStatusData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/RuleStatus
+type RuleStatus struct {
+	UID            OptString         `json:"uid"`
+	Name           OptString         `json:"name"`
+	State          OptString         `json:"state"`
+	Health         OptString         `json:"health"`
+	Type           OptString         `json:"type"`
+	LastError      OptString         `json:"lastError"`
+	LastEvaluation OptString         `json:"lastEvaluation"`
+	EvaluationTime OptFloat64        `json:"evaluationTime"`
+	ActiveAt       OptNilString      `json:"activeAt"`
+	Labels         jx.Raw            `json:"labels"`
+	Annotations    jx.Raw            `json:"annotations"`
+	Alerts         []RuleStatusAlert `json:"alerts"`
+}
+
+// GetUID returns the value of UID.
+func (s *RuleStatus) GetUID() OptString {
+	return s.UID
+}
+
+// GetName returns the value of Name.
+func (s *RuleStatus) GetName() OptString {
+	return s.Name
+}
+
+// GetState returns the value of State.
+func (s *RuleStatus) GetState() OptString {
+	return s.State
+}
+
+// GetHealth returns the value of Health.
+func (s *RuleStatus) GetHealth() OptString {
+	return s.Health
+}
+
+// GetType returns the value of Type.
+func (s *RuleStatus) GetType() OptString {
+	return s.Type
+}
+
+// GetLastError returns the value of LastError.
+func (s *RuleStatus) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetLastEvaluation returns the value of LastEvaluation.
+func (s *RuleStatus) GetLastEvaluation() OptString {
+	return s.LastEvaluation
+}
+
+// GetEvaluationTime returns the value of EvaluationTime.
+func (s *RuleStatus) GetEvaluationTime() OptFloat64 {
+	return s.EvaluationTime
+}
+
+// GetActiveAt returns the value of ActiveAt.
+func (s *RuleStatus) GetActiveAt() OptNilString {
+	return s.ActiveAt
+}
+
+// GetLabels returns the value of Labels.
+func (s *RuleStatus) GetLabels() jx.Raw {
+	return s.Labels
+}
+
+// GetAnnotations returns the value of Annotations.
+func (s *RuleStatus) GetAnnotations() jx.Raw {
+	return s.Annotations
+}
+
+// GetAlerts returns the value of Alerts.
+func (s *RuleStatus) GetAlerts() []RuleStatusAlert {
+	return s.Alerts
+}
+
+// SetUID sets the value of UID.
+func (s *RuleStatus) SetUID(val OptString) {
+	s.UID = val
+}
+
+// SetName sets the value of Name.
+func (s *RuleStatus) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetState sets the value of State.
+func (s *RuleStatus) SetState(val OptString) {
+	s.State = val
+}
+
+// SetHealth sets the value of Health.
+func (s *RuleStatus) SetHealth(val OptString) {
+	s.Health = val
+}
+
+// SetType sets the value of Type.
+func (s *RuleStatus) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *RuleStatus) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetLastEvaluation sets the value of LastEvaluation.
+func (s *RuleStatus) SetLastEvaluation(val OptString) {
+	s.LastEvaluation = val
+}
+
+// SetEvaluationTime sets the value of EvaluationTime.
+func (s *RuleStatus) SetEvaluationTime(val OptFloat64) {
+	s.EvaluationTime = val
+}
+
+// SetActiveAt sets the value of ActiveAt.
+func (s *RuleStatus) SetActiveAt(val OptNilString) {
+	s.ActiveAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
-	o.Set = true
-	o.Value = v
+// SetLabels sets the value of Labels.
+func (s *RuleStatus) SetLabels(val jx.Raw) {
+	s.Labels = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetAnnotations sets the value of Annotations.
+func (s *RuleStatus) SetAnnotations(val jx.Raw) {
+	s.Annotations = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetAlerts sets the value of Alerts.
+func (s *RuleStatus) SetAlerts(val []RuleStatusAlert) {
+	s.Alerts = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/RuleStatusAlert
+type RuleStatusAlert struct {
+	Labels      jx.Raw       `json:"labels"`
+	Annotations jx.Raw       `json:"annotations"`
+	State       OptString    `json:"state"`
+	ActiveAt    OptNilString `json:"activeAt"`
+	Value       OptString    `json:"value"`
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetLabels returns the value of Labels.
+func (s *RuleStatusAlert) GetLabels() jx.Raw {
+	return s.Labels
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetAnnotations returns the value of Annotations.
+func (s *RuleStatusAlert) GetAnnotations() jx.Raw {
+	return s.Annotations
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetState returns the value of State.
+func (s *RuleStatusAlert) GetState() OptString {
+	return s.State
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetActiveAt returns the value of ActiveAt.
+func (s *RuleStatusAlert) GetActiveAt() OptNilString {
+	return s.ActiveAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetValue returns the value of Value.
+func (s *RuleStatusAlert) GetValue() OptString {
+	return s.Value
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLabels sets the value of Labels.
+func (s *RuleStatusAlert) SetLabels(val jx.Raw) {
+	s.Labels = val
+}
+
+// SetAnnotations sets the value of Annotations.
+func (s *RuleStatusAlert) SetAnnotations(val jx.Raw) {
+	s.Annotations = val
+}
+
+// SetState sets the value of State.
+func (s *RuleStatusAlert) SetState(val OptString) {
+	s.State = val
+}
+
+// SetActiveAt sets the value of ActiveAt.
+func (s *RuleStatusAlert) SetActiveAt(val OptNilString) {
+	s.ActiveAt = val
+}
+
+// SetValue sets the value of Value.
+func (s *RuleStatusAlert) SetValue(val OptString) {
+	s.Value = val
+}
+
+// Ref: #/components/schemas/RuleStatusData
+type RuleStatusData struct {
+	Groups []RuleStatusGroup `json:"groups"`
+}
+
+// GetGroups returns the value of Groups.
+func (s *RuleStatusData) GetGroups() []RuleStatusGroup {
+	return s.Groups
+}
+
+// SetGroups sets the value of Groups.
+func (s *RuleStatusData) SetGroups(val []RuleStatusGroup) {
+	s.Groups = val
+}
+
+// Ref: #/components/schemas/RuleStatusGroup
+type RuleStatusGroup struct {
+	Name           OptString    `json:"name"`
+	File           OptString    `json:"file"`
+	Rules          []RuleStatus `json:"rules"`
+	LastEvaluation OptString    `json:"lastEvaluation"`
+	EvaluationTime OptFloat64   `json:"evaluationTime"`
+}
+
+// GetName returns the value of Name.
+func (s *RuleStatusGroup) GetName() OptString {
+	return s.Name
+}
+
+// GetFile returns the value of File.
+func (s *RuleStatusGroup) GetFile() OptString {
+	return s.File
+}
+
+// GetRules returns the value of Rules.
+func (s *RuleStatusGroup) GetRules() []RuleStatus {
+	return s.Rules
+}
+
+// GetLastEvaluation returns the value of LastEvaluation.
+func (s *RuleStatusGroup) GetLastEvaluation() OptString {
+	return s.LastEvaluation
+}
+
+// GetEvaluationTime returns the value of EvaluationTime.
+func (s *RuleStatusGroup) GetEvaluationTime() OptFloat64 {
+	return s.EvaluationTime
+}
+
+// SetName sets the value of Name.
+func (s *RuleStatusGroup) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetFile sets the value of File.
+func (s *RuleStatusGroup) SetFile(val OptString) {
+	s.File = val
+}
+
+// SetRules sets the value of Rules.
+func (s *RuleStatusGroup) SetRules(val []RuleStatus) {
+	s.Rules = val
+}
+
+// SetLastEvaluation sets the value of LastEvaluation.
+func (s *RuleStatusGroup) SetLastEvaluation(val OptString) {
+	s.LastEvaluation = val
+}
+
+// SetEvaluationTime sets the value of EvaluationTime.
+func (s *RuleStatusGroup) SetEvaluationTime(val OptFloat64) {
+	s.EvaluationTime = val
+}
+
+// Ref: #/components/schemas/RuleStatusResponse
+type RuleStatusResponse struct {
+	Status OptString         `json:"status"`
+	Data   OptRuleStatusData `json:"data"`
+}
+
+// GetStatus returns the value of Status.
+func (s *RuleStatusResponse) GetStatus() OptString {
+	return s.Status
+}
+
+// GetData returns the value of Data.
+func (s *RuleStatusResponse) GetData() OptRuleStatusData {
+	return s.Data
+}
+
+// SetStatus sets the value of Status.
+func (s *RuleStatusResponse) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetData sets the value of Data.
+func (s *RuleStatusResponse) SetData(val OptRuleStatusData) {
+	s.Data = val
 }
 
 // Ref: #/components/schemas/SaveDashboardRequest
@@ -2074,6 +3199,246 @@ func (s *SearchResult) SetFolderUrl(val OptString) {
 	s.FolderUrl = val
 }
 
+// Ref: #/components/schemas/ServiceAccount
+type ServiceAccount struct {
+	ID         OptInt64  `json:"id"`
+	Name       OptString `json:"name"`
+	Login      OptString `json:"login"`
+	OrgId      OptInt64  `json:"orgId"`
+	IsDisabled OptBool   `json:"isDisabled"`
+	Role       OptString `json:"role"`
+	Tokens     OptInt64  `json:"tokens"`
+	AvatarUrl  OptString `json:"avatarUrl"`
+}
+
+// GetID returns the value of ID.
+func (s *ServiceAccount) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ServiceAccount) GetName() OptString {
+	return s.Name
+}
+
+// GetLogin returns the value of Login.
+func (s *ServiceAccount) GetLogin() OptString {
+	return s.Login
+}
+
+// GetOrgId returns the value of OrgId.
+func (s *ServiceAccount) GetOrgId() OptInt64 {
+	return s.OrgId
+}
+
+// GetIsDisabled returns the value of IsDisabled.
+func (s *ServiceAccount) GetIsDisabled() OptBool {
+	return s.IsDisabled
+}
+
+// GetRole returns the value of Role.
+func (s *ServiceAccount) GetRole() OptString {
+	return s.Role
+}
+
+// GetTokens returns the value of Tokens.
+func (s *ServiceAccount) GetTokens() OptInt64 {
+	return s.Tokens
+}
+
+// GetAvatarUrl returns the value of AvatarUrl.
+func (s *ServiceAccount) GetAvatarUrl() OptString {
+	return s.AvatarUrl
+}
+
+// SetID sets the value of ID.
+func (s *ServiceAccount) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ServiceAccount) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetLogin sets the value of Login.
+func (s *ServiceAccount) SetLogin(val OptString) {
+	s.Login = val
+}
+
+// SetOrgId sets the value of OrgId.
+func (s *ServiceAccount) SetOrgId(val OptInt64) {
+	s.OrgId = val
+}
+
+// SetIsDisabled sets the value of IsDisabled.
+func (s *ServiceAccount) SetIsDisabled(val OptBool) {
+	s.IsDisabled = val
+}
+
+// SetRole sets the value of Role.
+func (s *ServiceAccount) SetRole(val OptString) {
+	s.Role = val
+}
+
+// SetTokens sets the value of Tokens.
+func (s *ServiceAccount) SetTokens(val OptInt64) {
+	s.Tokens = val
+}
+
+// SetAvatarUrl sets the value of AvatarUrl.
+func (s *ServiceAccount) SetAvatarUrl(val OptString) {
+	s.AvatarUrl = val
+}
+
+// Ref: #/components/schemas/ServiceAccountSearchResult
+type ServiceAccountSearchResult struct {
+	TotalCount      OptInt64         `json:"totalCount"`
+	ServiceAccounts []ServiceAccount `json:"serviceAccounts"`
+	Page            OptInt64         `json:"page"`
+	PerPage         OptInt64         `json:"perPage"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *ServiceAccountSearchResult) GetTotalCount() OptInt64 {
+	return s.TotalCount
+}
+
+// GetServiceAccounts returns the value of ServiceAccounts.
+func (s *ServiceAccountSearchResult) GetServiceAccounts() []ServiceAccount {
+	return s.ServiceAccounts
+}
+
+// GetPage returns the value of Page.
+func (s *ServiceAccountSearchResult) GetPage() OptInt64 {
+	return s.Page
+}
+
+// GetPerPage returns the value of PerPage.
+func (s *ServiceAccountSearchResult) GetPerPage() OptInt64 {
+	return s.PerPage
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *ServiceAccountSearchResult) SetTotalCount(val OptInt64) {
+	s.TotalCount = val
+}
+
+// SetServiceAccounts sets the value of ServiceAccounts.
+func (s *ServiceAccountSearchResult) SetServiceAccounts(val []ServiceAccount) {
+	s.ServiceAccounts = val
+}
+
+// SetPage sets the value of Page.
+func (s *ServiceAccountSearchResult) SetPage(val OptInt64) {
+	s.Page = val
+}
+
+// SetPerPage sets the value of PerPage.
+func (s *ServiceAccountSearchResult) SetPerPage(val OptInt64) {
+	s.PerPage = val
+}
+
+// Ref: #/components/schemas/ServiceAccountToken
+type ServiceAccountToken struct {
+	ID   OptInt64  `json:"id"`
+	Name OptString `json:"name"`
+	Key  OptString `json:"key"`
+}
+
+// GetID returns the value of ID.
+func (s *ServiceAccountToken) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ServiceAccountToken) GetName() OptString {
+	return s.Name
+}
+
+// GetKey returns the value of Key.
+func (s *ServiceAccountToken) GetKey() OptString {
+	return s.Key
+}
+
+// SetID sets the value of ID.
+func (s *ServiceAccountToken) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ServiceAccountToken) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetKey sets the value of Key.
+func (s *ServiceAccountToken) SetKey(val OptString) {
+	s.Key = val
+}
+
+// Ref: #/components/schemas/UpdateAnnotationRequest
+type UpdateAnnotationRequest struct {
+	Time    OptInt64 `json:"time"`
+	TimeEnd OptInt64 `json:"timeEnd"`
+	Text    string   `json:"text"`
+	Tags    []string `json:"tags"`
+}
+
+// GetTime returns the value of Time.
+func (s *UpdateAnnotationRequest) GetTime() OptInt64 {
+	return s.Time
+}
+
+// GetTimeEnd returns the value of TimeEnd.
+func (s *UpdateAnnotationRequest) GetTimeEnd() OptInt64 {
+	return s.TimeEnd
+}
+
+// GetText returns the value of Text.
+func (s *UpdateAnnotationRequest) GetText() string {
+	return s.Text
+}
+
+// GetTags returns the value of Tags.
+func (s *UpdateAnnotationRequest) GetTags() []string {
+	return s.Tags
+}
+
+// SetTime sets the value of Time.
+func (s *UpdateAnnotationRequest) SetTime(val OptInt64) {
+	s.Time = val
+}
+
+// SetTimeEnd sets the value of TimeEnd.
+func (s *UpdateAnnotationRequest) SetTimeEnd(val OptInt64) {
+	s.TimeEnd = val
+}
+
+// SetText sets the value of Text.
+func (s *UpdateAnnotationRequest) SetText(val string) {
+	s.Text = val
+}
+
+// SetTags sets the value of Tags.
+func (s *UpdateAnnotationRequest) SetTags(val []string) {
+	s.Tags = val
+}
+
+// Ref: #/components/schemas/UpdateAnnotationResponse
+type UpdateAnnotationResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UpdateAnnotationResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UpdateAnnotationResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
 // Ref: #/components/schemas/UpdateContactPointRequest
 type UpdateContactPointRequest struct {
 	Name                  string  `json:"name"`
@@ -26,10 +26,17 @@ func (s *AddCommentRequest) encodeFields(e *jx.Encoder) {
 			e.Raw(s.Body)
 		}
 	}
+	{
+		if len(s.Visibility) != 0 {
+			e.FieldStart("visibility")
+			e.Raw(s.Visibility)
+		}
+	}
 }
 
-var jsonFieldsNameOfAddCommentRequest = [1]string{
+var jsonFieldsNameOfAddCommentRequest = [2]string{
 	0: "body",
+	1: "visibility",
 }
 
 // Decode decodes AddCommentRequest from json.
@@ -53,6 +60,17 @@ func (s *AddCommentRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"body\"")
 			}
+		case "visibility":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Visibility = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -154,15 +172,22 @@ func (s *Comment) encodeFields(e *jx.Encoder) {
 			s.Updated.Encode(e)
 		}
 	}
+	{
+		if len(s.Visibility) != 0 {
+			e.FieldStart("visibility")
+			e.Raw(s.Visibility)
+		}
+	}
 }
 
-var jsonFieldsNameOfComment = [6]string{
+var jsonFieldsNameOfComment = [7]string{
 	0: "id",
 	1: "self",
 	2: "author",
 	3: "body",
 	4: "created",
 	5: "updated",
+	6: "visibility",
 }
 
 // Decode decodes Comment from json.
@@ -235,6 +260,17 @@ func (s *Comment) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"updated\"")
 			}
+		case "visibility":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Visibility = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"visibility\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -1128,6 +1164,239 @@ func (s *IssueSearchResult) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *IssueTypeDetails) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *IssueTypeDetails) encodeFields(e *jx.Encoder) {
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Subtask.Set {
+			e.FieldStart("subtask")
+			s.Subtask.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfIssueTypeDetails = [3]string{
+	0: "id",
+	1: "name",
+	2: "subtask",
+}
+
+// Decode decodes IssueTypeDetails from json.
+func (s *IssueTypeDetails) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode IssueTypeDetails to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "subtask":
+			if err := func() error {
+				s.Subtask.Reset()
+				if err := s.Subtask.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"subtask\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode IssueTypeDetails")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *IssueTypeDetails) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *IssueTypeDetails) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *LinkIssuesRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LinkIssuesRequest) encodeFields(e *jx.Encoder) {
+	{
+		if len(s.Type) != 0 {
+			e.FieldStart("type")
+			e.Raw(s.Type)
+		}
+	}
+	{
+		if len(s.InwardIssue) != 0 {
+			e.FieldStart("inwardIssue")
+			e.Raw(s.InwardIssue)
+		}
+	}
+	{
+		if len(s.OutwardIssue) != 0 {
+			e.FieldStart("outwardIssue")
+			e.Raw(s.OutwardIssue)
+		}
+	}
+}
+
+var jsonFieldsNameOfLinkIssuesRequest = [3]string{
+	0: "type",
+	1: "inwardIssue",
+	2: "outwardIssue",
+}
+
+// Decode decodes LinkIssuesRequest from json.
+func (s *LinkIssuesRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LinkIssuesRequest to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Type = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "inwardIssue":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.InwardIssue = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"inwardIssue\"")
+			}
+		case "outwardIssue":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.OutwardIssue = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"outwardIssue\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LinkIssuesRequest")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLinkIssuesRequest) {
+					name = jsonFieldsNameOfLinkIssuesRequest[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LinkIssuesRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LinkIssuesRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes bool as json.
 func (o OptBool) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -1335,19 +1604,30 @@ func (s *Project) encodeFields(e *jx.Encoder) {
 			e.Raw(s.AvatarUrls)
 		}
 	}
+	{
+		if s.IssueTypes != nil {
+			e.FieldStart("issueTypes")
+			e.ArrStart()
+			for _, elem := range s.IssueTypes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
 }
 
-var jsonFieldsNameOfProject = [10]string{
-	0: "id",
-	1: "key",
-	2: "name",
-	3: "projectTypeKey",
-	4: "simplified",
-	5: "style",
-	6: "isPrivate",
-	7: "description",
-	8: "lead",
-	9: "avatarUrls",
+var jsonFieldsNameOfProject = [11]string{
+	0:  "id",
+	1:  "key",
+	2:  "name",
+	3:  "projectTypeKey",
+	4:  "simplified",
+	5:  "style",
+	6:  "isPrivate",
+	7:  "description",
+	8:  "lead",
+	9:  "avatarUrls",
+	10: "issueTypes",
 }
 
 // Decode decodes Project from json.
@@ -1460,6 +1740,23 @@ func (s *Project) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"avatarUrls\"")
 			}
+		case "issueTypes":
+			if err := func() error {
+				s.IssueTypes = make([]IssueTypeDetails, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem IssueTypeDetails
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.IssueTypes = append(s.IssueTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"issueTypes\"")
+			}
 		default:
 			return d.Skip()
 		}
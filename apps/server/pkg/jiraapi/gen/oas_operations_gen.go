@@ -14,6 +14,7 @@ const (
 	GetMyselfOperation            OperationName = "GetMyself"
 	GetProjectOperation           OperationName = "GetProject"
 	GetTransitionsOperation       OperationName = "GetTransitions"
+	LinkIssuesOperation           OperationName = "LinkIssues"
 	SearchIssuesUsingJqlOperation OperationName = "SearchIssuesUsingJql"
 	SearchProjectsOperation       OperationName = "SearchProjects"
 	UpdateIssueOperation          OperationName = "UpdateIssue"
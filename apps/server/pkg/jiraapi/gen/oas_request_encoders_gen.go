@@ -52,6 +52,20 @@ func encodeDoTransitionRequest(
 	return nil
 }
 
+func encodeLinkIssuesRequest(
+	req *LinkIssuesRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateIssueRequest(
 	req *UpdateIssueRequest,
 	r *http.Request,
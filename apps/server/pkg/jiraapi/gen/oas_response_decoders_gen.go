@@ -309,6 +309,15 @@ func decodeGetTransitionsResponse(resp *http.Response) (res *TransitionsResponse
 	return res, validate.UnexpectedStatusCodeWithResponse(resp)
 }
 
+func decodeLinkIssuesResponse(resp *http.Response) (res *LinkIssuesOK, _ error) {
+	switch resp.StatusCode {
+	case 201:
+		// Code 201.
+		return &LinkIssuesOK{}, nil
+	}
+	return res, validate.UnexpectedStatusCodeWithResponse(resp)
+}
+
 func decodeSearchIssuesUsingJqlResponse(resp *http.Response) (res *IssueSearchResult, _ error) {
 	switch resp.StatusCode {
 	case 200:
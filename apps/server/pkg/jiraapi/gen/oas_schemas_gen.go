@@ -8,7 +8,8 @@ import (
 
 // Ref: #/components/schemas/AddCommentRequest
 type AddCommentRequest struct {
-	Body jx.Raw `json:"body"`
+	Body       jx.Raw `json:"body"`
+	Visibility jx.Raw `json:"visibility"`
 }
 
 // GetBody returns the value of Body.
@@ -16,11 +17,21 @@ func (s *AddCommentRequest) GetBody() jx.Raw {
 	return s.Body
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *AddCommentRequest) GetVisibility() jx.Raw {
+	return s.Visibility
+}
+
 // SetBody sets the value of Body.
 func (s *AddCommentRequest) SetBody(val jx.Raw) {
 	s.Body = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *AddCommentRequest) SetVisibility(val jx.Raw) {
+	s.Visibility = val
+}
+
 type BasicAuth struct {
 	Username string
 	Password string
@@ -84,12 +95,13 @@ func (s *BearerAuth) SetRoles(val []string) {
 
 // Ref: #/components/schemas/Comment
 type Comment struct {
-	ID      OptString `json:"id"`
-	Self    OptString `json:"self"`
-	Author  jx.Raw    `json:"author"`
-	Body    jx.Raw    `json:"body"`
-	Created OptString `json:"created"`
-	Updated OptString `json:"updated"`
+	ID         OptString `json:"id"`
+	Self       OptString `json:"self"`
+	Author     jx.Raw    `json:"author"`
+	Body       jx.Raw    `json:"body"`
+	Created    OptString `json:"created"`
+	Updated    OptString `json:"updated"`
+	Visibility jx.Raw    `json:"visibility"`
 }
 
 // GetID returns the value of ID.
@@ -122,6 +134,11 @@ func (s *Comment) GetUpdated() OptString {
 	return s.Updated
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *Comment) GetVisibility() jx.Raw {
+	return s.Visibility
+}
+
 // SetID sets the value of ID.
 func (s *Comment) SetID(val OptString) {
 	s.ID = val
@@ -152,6 +169,11 @@ func (s *Comment) SetUpdated(val OptString) {
 	s.Updated = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *Comment) SetVisibility(val jx.Raw) {
+	s.Visibility = val
+}
+
 // Ref: #/components/schemas/CommentSearchResult
 type CommentSearchResult struct {
 	Comments   []Comment `json:"comments"`
@@ -469,6 +491,83 @@ func (s *IssueSearchResult) SetTotal(val OptInt) {
 	s.Total = val
 }
 
+// Ref: #/components/schemas/IssueTypeDetails
+type IssueTypeDetails struct {
+	ID      OptString `json:"id"`
+	Name    OptString `json:"name"`
+	Subtask OptBool   `json:"subtask"`
+}
+
+// GetID returns the value of ID.
+func (s *IssueTypeDetails) GetID() OptString {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *IssueTypeDetails) GetName() OptString {
+	return s.Name
+}
+
+// GetSubtask returns the value of Subtask.
+func (s *IssueTypeDetails) GetSubtask() OptBool {
+	return s.Subtask
+}
+
+// SetID sets the value of ID.
+func (s *IssueTypeDetails) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *IssueTypeDetails) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetSubtask sets the value of Subtask.
+func (s *IssueTypeDetails) SetSubtask(val OptBool) {
+	s.Subtask = val
+}
+
+// LinkIssuesOK is response for LinkIssues operation.
+type LinkIssuesOK struct{}
+
+// Ref: #/components/schemas/LinkIssuesRequest
+type LinkIssuesRequest struct {
+	Type         jx.Raw `json:"type"`
+	InwardIssue  jx.Raw `json:"inwardIssue"`
+	OutwardIssue jx.Raw `json:"outwardIssue"`
+}
+
+// GetType returns the value of Type.
+func (s *LinkIssuesRequest) GetType() jx.Raw {
+	return s.Type
+}
+
+// GetInwardIssue returns the value of InwardIssue.
+func (s *LinkIssuesRequest) GetInwardIssue() jx.Raw {
+	return s.InwardIssue
+}
+
+// GetOutwardIssue returns the value of OutwardIssue.
+func (s *LinkIssuesRequest) GetOutwardIssue() jx.Raw {
+	return s.OutwardIssue
+}
+
+// SetType sets the value of Type.
+func (s *LinkIssuesRequest) SetType(val jx.Raw) {
+	s.Type = val
+}
+
+// SetInwardIssue sets the value of InwardIssue.
+func (s *LinkIssuesRequest) SetInwardIssue(val jx.Raw) {
+	s.InwardIssue = val
+}
+
+// SetOutwardIssue sets the value of OutwardIssue.
+func (s *LinkIssuesRequest) SetOutwardIssue(val jx.Raw) {
+	s.OutwardIssue = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -655,16 +754,17 @@ func (o OptString) Or(d string) string {
 
 // Ref: #/components/schemas/Project
 type Project struct {
-	ID             OptString `json:"id"`
-	Key            OptString `json:"key"`
-	Name           OptString `json:"name"`
-	ProjectTypeKey OptString `json:"projectTypeKey"`
-	Simplified     OptBool   `json:"simplified"`
-	Style          OptString `json:"style"`
-	IsPrivate      OptBool   `json:"isPrivate"`
-	Description    OptString `json:"description"`
-	Lead           jx.Raw    `json:"lead"`
-	AvatarUrls     jx.Raw    `json:"avatarUrls"`
+	ID             OptString          `json:"id"`
+	Key            OptString          `json:"key"`
+	Name           OptString          `json:"name"`
+	ProjectTypeKey OptString          `json:"projectTypeKey"`
+	Simplified     OptBool            `json:"simplified"`
+	Style          OptString          `json:"style"`
+	IsPrivate      OptBool            `json:"isPrivate"`
+	Description    OptString          `json:"description"`
+	Lead           jx.Raw             `json:"lead"`
+	AvatarUrls     jx.Raw             `json:"avatarUrls"`
+	IssueTypes     []IssueTypeDetails `json:"issueTypes"`
 }
 
 // GetID returns the value of ID.
@@ -717,6 +817,11 @@ func (s *Project) GetAvatarUrls() jx.Raw {
 	return s.AvatarUrls
 }
 
+// GetIssueTypes returns the value of IssueTypes.
+func (s *Project) GetIssueTypes() []IssueTypeDetails {
+	return s.IssueTypes
+}
+
 // SetID sets the value of ID.
 func (s *Project) SetID(val OptString) {
 	s.ID = val
@@ -767,6 +872,11 @@ func (s *Project) SetAvatarUrls(val jx.Raw) {
 	s.AvatarUrls = val
 }
 
+// SetIssueTypes sets the value of IssueTypes.
+func (s *Project) SetIssueTypes(val []IssueTypeDetails) {
+	s.IssueTypes = val
+}
+
 // Ref: #/components/schemas/ProjectSearchResult
 type ProjectSearchResult struct {
 	Values     []Project `json:"values"`
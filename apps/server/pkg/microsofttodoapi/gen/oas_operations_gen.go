@@ -6,14 +6,16 @@ package api
 type OperationName = string
 
 const (
-	CreateListOperation OperationName = "CreateList"
-	CreateTaskOperation OperationName = "CreateTask"
-	DeleteListOperation OperationName = "DeleteList"
-	DeleteTaskOperation OperationName = "DeleteTask"
-	GetListOperation    OperationName = "GetList"
-	GetTaskOperation    OperationName = "GetTask"
-	ListListsOperation  OperationName = "ListLists"
-	ListTasksOperation  OperationName = "ListTasks"
-	UpdateListOperation OperationName = "UpdateList"
-	UpdateTaskOperation OperationName = "UpdateTask"
+	CreateChecklistItemOperation  OperationName = "CreateChecklistItem"
+	CreateLinkedResourceOperation OperationName = "CreateLinkedResource"
+	CreateListOperation           OperationName = "CreateList"
+	CreateTaskOperation           OperationName = "CreateTask"
+	DeleteListOperation           OperationName = "DeleteList"
+	DeleteTaskOperation           OperationName = "DeleteTask"
+	GetListOperation              OperationName = "GetList"
+	GetTaskOperation              OperationName = "GetTask"
+	ListListsOperation            OperationName = "ListLists"
+	ListTasksOperation            OperationName = "ListTasks"
+	UpdateListOperation           OperationName = "UpdateList"
+	UpdateTaskOperation           OperationName = "UpdateTask"
 )
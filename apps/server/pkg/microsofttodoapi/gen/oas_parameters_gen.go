@@ -14,6 +14,18 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// CreateChecklistItemParams is parameters of createChecklistItem operation.
+type CreateChecklistItemParams struct {
+	ListId string
+	TaskId string
+}
+
+// CreateLinkedResourceParams is parameters of createLinkedResource operation.
+type CreateLinkedResourceParams struct {
+	ListId string
+	TaskId string
+}
+
 // CreateTaskParams is parameters of createTask operation.
 type CreateTaskParams struct {
 	ListId string
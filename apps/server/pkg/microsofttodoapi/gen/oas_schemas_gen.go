@@ -27,6 +27,128 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// Ref: #/components/schemas/ChecklistItem
+type ChecklistItem struct {
+	ID              OptString    `json:"id"`
+	DisplayName     OptString    `json:"displayName"`
+	IsChecked       OptBool      `json:"isChecked"`
+	CreatedDateTime OptString    `json:"createdDateTime"`
+	CheckedDateTime OptNilString `json:"checkedDateTime"`
+}
+
+// GetID returns the value of ID.
+func (s *ChecklistItem) GetID() OptString {
+	return s.ID
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *ChecklistItem) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// GetIsChecked returns the value of IsChecked.
+func (s *ChecklistItem) GetIsChecked() OptBool {
+	return s.IsChecked
+}
+
+// GetCreatedDateTime returns the value of CreatedDateTime.
+func (s *ChecklistItem) GetCreatedDateTime() OptString {
+	return s.CreatedDateTime
+}
+
+// GetCheckedDateTime returns the value of CheckedDateTime.
+func (s *ChecklistItem) GetCheckedDateTime() OptNilString {
+	return s.CheckedDateTime
+}
+
+// SetID sets the value of ID.
+func (s *ChecklistItem) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *ChecklistItem) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
+// SetIsChecked sets the value of IsChecked.
+func (s *ChecklistItem) SetIsChecked(val OptBool) {
+	s.IsChecked = val
+}
+
+// SetCreatedDateTime sets the value of CreatedDateTime.
+func (s *ChecklistItem) SetCreatedDateTime(val OptString) {
+	s.CreatedDateTime = val
+}
+
+// SetCheckedDateTime sets the value of CheckedDateTime.
+func (s *ChecklistItem) SetCheckedDateTime(val OptNilString) {
+	s.CheckedDateTime = val
+}
+
+// Ref: #/components/schemas/CreateChecklistItemReq
+type CreateChecklistItemReq struct {
+	DisplayName string  `json:"displayName"`
+	IsChecked   OptBool `json:"isChecked"`
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *CreateChecklistItemReq) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// GetIsChecked returns the value of IsChecked.
+func (s *CreateChecklistItemReq) GetIsChecked() OptBool {
+	return s.IsChecked
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *CreateChecklistItemReq) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// SetIsChecked sets the value of IsChecked.
+func (s *CreateChecklistItemReq) SetIsChecked(val OptBool) {
+	s.IsChecked = val
+}
+
+// Ref: #/components/schemas/CreateLinkedResourceReq
+type CreateLinkedResourceReq struct {
+	WebUrl          string    `json:"webUrl"`
+	ApplicationName string    `json:"applicationName"`
+	DisplayName     OptString `json:"displayName"`
+}
+
+// GetWebUrl returns the value of WebUrl.
+func (s *CreateLinkedResourceReq) GetWebUrl() string {
+	return s.WebUrl
+}
+
+// GetApplicationName returns the value of ApplicationName.
+func (s *CreateLinkedResourceReq) GetApplicationName() string {
+	return s.ApplicationName
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *CreateLinkedResourceReq) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// SetWebUrl sets the value of WebUrl.
+func (s *CreateLinkedResourceReq) SetWebUrl(val string) {
+	s.WebUrl = val
+}
+
+// SetApplicationName sets the value of ApplicationName.
+func (s *CreateLinkedResourceReq) SetApplicationName(val string) {
+	s.ApplicationName = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *CreateLinkedResourceReq) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
 // Ref: #/components/schemas/CreateTaskListReq
 type CreateTaskListReq struct {
 	DisplayName string `json:"displayName"`
@@ -170,6 +292,65 @@ func (s *ItemBody) SetContentType(val OptString) {
 	s.ContentType = val
 }
 
+// Ref: #/components/schemas/LinkedResource
+type LinkedResource struct {
+	ID              OptString    `json:"id"`
+	WebUrl          OptString    `json:"webUrl"`
+	DisplayName     OptString    `json:"displayName"`
+	ApplicationName OptString    `json:"applicationName"`
+	ExternalId      OptNilString `json:"externalId"`
+}
+
+// GetID returns the value of ID.
+func (s *LinkedResource) GetID() OptString {
+	return s.ID
+}
+
+// GetWebUrl returns the value of WebUrl.
+func (s *LinkedResource) GetWebUrl() OptString {
+	return s.WebUrl
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *LinkedResource) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// GetApplicationName returns the value of ApplicationName.
+func (s *LinkedResource) GetApplicationName() OptString {
+	return s.ApplicationName
+}
+
+// GetExternalId returns the value of ExternalId.
+func (s *LinkedResource) GetExternalId() OptNilString {
+	return s.ExternalId
+}
+
+// SetID sets the value of ID.
+func (s *LinkedResource) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetWebUrl sets the value of WebUrl.
+func (s *LinkedResource) SetWebUrl(val OptString) {
+	s.WebUrl = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *LinkedResource) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
+// SetApplicationName sets the value of ApplicationName.
+func (s *LinkedResource) SetApplicationName(val OptString) {
+	s.ApplicationName = val
+}
+
+// SetExternalId sets the value of ExternalId.
+func (s *LinkedResource) SetExternalId(val OptNilString) {
+	s.ExternalId = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
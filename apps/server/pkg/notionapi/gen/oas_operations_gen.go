@@ -8,6 +8,8 @@ type OperationName = string
 const (
 	AddCommentOperation          OperationName = "AddComment"
 	AppendBlockChildrenOperation OperationName = "AppendBlockChildren"
+	CreateDatabaseOperation      OperationName = "CreateDatabase"
+	CreateFileUploadOperation    OperationName = "CreateFileUpload"
 	CreatePageOperation          OperationName = "CreatePage"
 	DeleteBlockOperation         OperationName = "DeleteBlock"
 	GetBlockChildrenOperation    OperationName = "GetBlockChildren"
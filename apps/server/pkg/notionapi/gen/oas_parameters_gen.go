@@ -36,8 +36,9 @@ type GetUserParams struct {
 
 // ListCommentsParams is parameters of listComments operation.
 type ListCommentsParams struct {
-	BlockID  string
-	PageSize OptInt `json:",omitempty,omitzero"`
+	BlockID     string
+	PageSize    OptInt    `json:",omitempty,omitzero"`
+	StartCursor OptString `json:",omitempty,omitzero"`
 }
 
 // ListUsersParams is parameters of listUsers operation.
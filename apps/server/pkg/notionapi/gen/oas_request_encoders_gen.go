@@ -38,6 +38,34 @@ func encodeAppendBlockChildrenRequest(
 	return nil
 }
 
+func encodeCreateDatabaseRequest(
+	req *CreateDatabaseRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateFileUploadRequest(
+	req *CreateFileUploadRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreatePageRequest(
 	req *CreatePageRequest,
 	r *http.Request,
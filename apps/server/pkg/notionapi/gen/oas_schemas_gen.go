@@ -8,8 +8,9 @@ import (
 
 // Ref: #/components/schemas/AddCommentRequest
 type AddCommentRequest struct {
-	Parent   jx.Raw   `json:"parent"`
-	RichText []jx.Raw `json:"rich_text"`
+	Parent       jx.Raw    `json:"parent"`
+	DiscussionID OptString `json:"discussion_id"`
+	RichText     []jx.Raw  `json:"rich_text"`
 }
 
 // GetParent returns the value of Parent.
@@ -17,6 +18,11 @@ func (s *AddCommentRequest) GetParent() jx.Raw {
 	return s.Parent
 }
 
+// GetDiscussionID returns the value of DiscussionID.
+func (s *AddCommentRequest) GetDiscussionID() OptString {
+	return s.DiscussionID
+}
+
 // GetRichText returns the value of RichText.
 func (s *AddCommentRequest) GetRichText() []jx.Raw {
 	return s.RichText
@@ -27,6 +33,11 @@ func (s *AddCommentRequest) SetParent(val jx.Raw) {
 	s.Parent = val
 }
 
+// SetDiscussionID sets the value of DiscussionID.
+func (s *AddCommentRequest) SetDiscussionID(val OptString) {
+	s.DiscussionID = val
+}
+
 // SetRichText sets the value of RichText.
 func (s *AddCommentRequest) SetRichText(val []jx.Raw) {
 	s.RichText = val
@@ -223,6 +234,80 @@ func (s *Comment) SetCreatedBy(val jx.Raw) {
 	s.CreatedBy = val
 }
 
+// Ref: #/components/schemas/CreateDatabaseRequest
+type CreateDatabaseRequest struct {
+	Parent     jx.Raw   `json:"parent"`
+	Title      []jx.Raw `json:"title"`
+	Properties jx.Raw   `json:"properties"`
+}
+
+// GetParent returns the value of Parent.
+func (s *CreateDatabaseRequest) GetParent() jx.Raw {
+	return s.Parent
+}
+
+// GetTitle returns the value of Title.
+func (s *CreateDatabaseRequest) GetTitle() []jx.Raw {
+	return s.Title
+}
+
+// GetProperties returns the value of Properties.
+func (s *CreateDatabaseRequest) GetProperties() jx.Raw {
+	return s.Properties
+}
+
+// SetParent sets the value of Parent.
+func (s *CreateDatabaseRequest) SetParent(val jx.Raw) {
+	s.Parent = val
+}
+
+// SetTitle sets the value of Title.
+func (s *CreateDatabaseRequest) SetTitle(val []jx.Raw) {
+	s.Title = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *CreateDatabaseRequest) SetProperties(val jx.Raw) {
+	s.Properties = val
+}
+
+// Ref: #/components/schemas/CreateFileUploadRequest
+type CreateFileUploadRequest struct {
+	Mode        OptString `json:"mode"`
+	Filename    OptString `json:"filename"`
+	ContentType OptString `json:"content_type"`
+}
+
+// GetMode returns the value of Mode.
+func (s *CreateFileUploadRequest) GetMode() OptString {
+	return s.Mode
+}
+
+// GetFilename returns the value of Filename.
+func (s *CreateFileUploadRequest) GetFilename() OptString {
+	return s.Filename
+}
+
+// GetContentType returns the value of ContentType.
+func (s *CreateFileUploadRequest) GetContentType() OptString {
+	return s.ContentType
+}
+
+// SetMode sets the value of Mode.
+func (s *CreateFileUploadRequest) SetMode(val OptString) {
+	s.Mode = val
+}
+
+// SetFilename sets the value of Filename.
+func (s *CreateFileUploadRequest) SetFilename(val OptString) {
+	s.Filename = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CreateFileUploadRequest) SetContentType(val OptString) {
+	s.ContentType = val
+}
+
 // Ref: #/components/schemas/CreatePageRequest
 type CreatePageRequest struct {
 	Parent     jx.Raw `json:"parent"`
@@ -341,6 +426,98 @@ func (s *Database) SetArchived(val OptBool) {
 	s.Archived = val
 }
 
+// Ref: #/components/schemas/FileUpload
+type FileUpload struct {
+	Object        OptString    `json:"object"`
+	ID            OptString    `json:"id"`
+	Status        OptString    `json:"status"`
+	Filename      OptNilString `json:"filename"`
+	ContentType   OptNilString `json:"content_type"`
+	ContentLength OptNilInt    `json:"content_length"`
+	UploadURL     OptString    `json:"upload_url"`
+	ExpiryTime    OptNilString `json:"expiry_time"`
+}
+
+// GetObject returns the value of Object.
+func (s *FileUpload) GetObject() OptString {
+	return s.Object
+}
+
+// GetID returns the value of ID.
+func (s *FileUpload) GetID() OptString {
+	return s.ID
+}
+
+// GetStatus returns the value of Status.
+func (s *FileUpload) GetStatus() OptString {
+	return s.Status
+}
+
+// GetFilename returns the value of Filename.
+func (s *FileUpload) GetFilename() OptNilString {
+	return s.Filename
+}
+
+// GetContentType returns the value of ContentType.
+func (s *FileUpload) GetContentType() OptNilString {
+	return s.ContentType
+}
+
+// GetContentLength returns the value of ContentLength.
+func (s *FileUpload) GetContentLength() OptNilInt {
+	return s.ContentLength
+}
+
+// GetUploadURL returns the value of UploadURL.
+func (s *FileUpload) GetUploadURL() OptString {
+	return s.UploadURL
+}
+
+// GetExpiryTime returns the value of ExpiryTime.
+func (s *FileUpload) GetExpiryTime() OptNilString {
+	return s.ExpiryTime
+}
+
+// SetObject sets the value of Object.
+func (s *FileUpload) SetObject(val OptString) {
+	s.Object = val
+}
+
+// SetID sets the value of ID.
+func (s *FileUpload) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetStatus sets the value of Status.
+func (s *FileUpload) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetFilename sets the value of Filename.
+func (s *FileUpload) SetFilename(val OptNilString) {
+	s.Filename = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *FileUpload) SetContentType(val OptNilString) {
+	s.ContentType = val
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *FileUpload) SetContentLength(val OptNilInt) {
+	s.ContentLength = val
+}
+
+// SetUploadURL sets the value of UploadURL.
+func (s *FileUpload) SetUploadURL(val OptString) {
+	s.UploadURL = val
+}
+
+// SetExpiryTime sets the value of ExpiryTime.
+func (s *FileUpload) SetExpiryTime(val OptNilString) {
+	s.ExpiryTime = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -433,6 +610,69 @@ func (o OptInt) Or(d int) int {
 	return d
 }
 
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilString returns new OptNilString with value set to v.
 func NewOptNilString(v string) OptNilString {
 	return OptNilString{
@@ -717,9 +957,10 @@ func (s *PaginatedList) SetType(val OptString) {
 
 // Ref: #/components/schemas/QueryDatabaseRequest
 type QueryDatabaseRequest struct {
-	Filter   jx.Raw `json:"filter"`
-	Sorts    jx.Raw `json:"sorts"`
-	PageSize OptInt `json:"page_size"`
+	Filter      jx.Raw    `json:"filter"`
+	Sorts       jx.Raw    `json:"sorts"`
+	PageSize    OptInt    `json:"page_size"`
+	StartCursor OptString `json:"start_cursor"`
 }
 
 // GetFilter returns the value of Filter.
@@ -737,6 +978,11 @@ func (s *QueryDatabaseRequest) GetPageSize() OptInt {
 	return s.PageSize
 }
 
+// GetStartCursor returns the value of StartCursor.
+func (s *QueryDatabaseRequest) GetStartCursor() OptString {
+	return s.StartCursor
+}
+
 // SetFilter sets the value of Filter.
 func (s *QueryDatabaseRequest) SetFilter(val jx.Raw) {
 	s.Filter = val
@@ -752,12 +998,18 @@ func (s *QueryDatabaseRequest) SetPageSize(val OptInt) {
 	s.PageSize = val
 }
 
+// SetStartCursor sets the value of StartCursor.
+func (s *QueryDatabaseRequest) SetStartCursor(val OptString) {
+	s.StartCursor = val
+}
+
 // Ref: #/components/schemas/SearchRequest
 type SearchRequest struct {
-	Query    OptString `json:"query"`
-	Filter   jx.Raw    `json:"filter"`
-	Sort     jx.Raw    `json:"sort"`
-	PageSize OptInt    `json:"page_size"`
+	Query       OptString `json:"query"`
+	Filter      jx.Raw    `json:"filter"`
+	Sort        jx.Raw    `json:"sort"`
+	PageSize    OptInt    `json:"page_size"`
+	StartCursor OptString `json:"start_cursor"`
 }
 
 // GetQuery returns the value of Query.
@@ -780,6 +1032,11 @@ func (s *SearchRequest) GetPageSize() OptInt {
 	return s.PageSize
 }
 
+// GetStartCursor returns the value of StartCursor.
+func (s *SearchRequest) GetStartCursor() OptString {
+	return s.StartCursor
+}
+
 // SetQuery sets the value of Query.
 func (s *SearchRequest) SetQuery(val OptString) {
 	s.Query = val
@@ -800,6 +1057,11 @@ func (s *SearchRequest) SetPageSize(val OptInt) {
 	s.PageSize = val
 }
 
+// SetStartCursor sets the value of StartCursor.
+func (s *SearchRequest) SetStartCursor(val OptString) {
+	s.StartCursor = val
+}
+
 // Ref: #/components/schemas/UpdatePageRequest
 type UpdatePageRequest struct {
 	Properties jx.Raw `json:"properties"`
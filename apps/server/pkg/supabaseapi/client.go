@@ -7,7 +7,8 @@ import (
 	gen "mcpist/server/pkg/supabaseapi/gen"
 )
 
-const serverURL = "https://api.supabase.com/v1"
+// DefaultServerURL is the Supabase Management API endpoint, used unless a base_url is configured.
+const DefaultServerURL = "https://api.supabase.com/v1"
 
 // tokenSecuritySource implements gen.SecuritySource using a static token.
 type tokenSecuritySource struct {
@@ -20,5 +21,11 @@ func (s *tokenSecuritySource) BearerAuth(_ context.Context, _ gen.OperationName)
 
 // NewClient creates a new Supabase Management API client with the given access token.
 func NewClient(token string) (*gen.Client, error) {
+	return NewClientWithURL(DefaultServerURL, token)
+}
+
+// NewClientWithURL creates a new Supabase Management API client for serverURL,
+// e.g. the Management API of a self-hosted instance.
+func NewClientWithURL(serverURL, token string) (*gen.Client, error) {
 	return gen.NewClient(serverURL, &tokenSecuritySource{token: token})
 }
@@ -8,6 +8,8 @@ type OperationName = string
 const (
 	CloseTaskOperation     OperationName = "CloseTask"
 	CreateCommentOperation OperationName = "CreateComment"
+	CreateProjectOperation OperationName = "CreateProject"
+	CreateSectionOperation OperationName = "CreateSection"
 	CreateTaskOperation    OperationName = "CreateTask"
 	DeleteCommentOperation OperationName = "DeleteComment"
 	DeleteTaskOperation    OperationName = "DeleteTask"
@@ -19,6 +21,7 @@ const (
 	ListProjectsOperation  OperationName = "ListProjects"
 	ListSectionsOperation  OperationName = "ListSections"
 	ListTasksOperation     OperationName = "ListTasks"
+	MoveTaskOperation      OperationName = "MoveTask"
 	ReopenTaskOperation    OperationName = "ReopenTask"
 	UpdateCommentOperation OperationName = "UpdateComment"
 	UpdateTaskOperation    OperationName = "UpdateTask"
@@ -69,6 +69,11 @@ type ListTasksParams struct {
 	Limit     OptInt    `json:",omitempty,omitzero"`
 }
 
+// MoveTaskParams is parameters of moveTask operation.
+type MoveTaskParams struct {
+	TaskId string
+}
+
 // ReopenTaskParams is parameters of reopenTask operation.
 type ReopenTaskParams struct {
 	TaskId string
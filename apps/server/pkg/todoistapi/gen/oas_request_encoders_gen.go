@@ -24,6 +24,34 @@ func encodeCreateCommentRequest(
 	return nil
 }
 
+func encodeCreateProjectRequest(
+	req *CreateProjectReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateSectionRequest(
+	req *CreateSectionReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateTaskRequest(
 	req *CreateTaskReq,
 	r *http.Request,
@@ -38,6 +66,20 @@ func encodeCreateTaskRequest(
 	return nil
 }
 
+func encodeMoveTaskRequest(
+	req *MoveTaskReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateCommentRequest(
 	req *UpdateCommentReq,
 	r *http.Request,
@@ -34,6 +34,43 @@ func (s *BearerAuth) SetRoles(val []string) {
 // CloseTaskNoContent is response for CloseTask operation.
 type CloseTaskNoContent struct{}
 
+// Ref: #/components/schemas/Collaborator
+type Collaborator struct {
+	ID    OptString `json:"id"`
+	Name  OptString `json:"name"`
+	Email OptString `json:"email"`
+}
+
+// GetID returns the value of ID.
+func (s *Collaborator) GetID() OptString {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Collaborator) GetName() OptString {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *Collaborator) GetEmail() OptString {
+	return s.Email
+}
+
+// SetID sets the value of ID.
+func (s *Collaborator) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Collaborator) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *Collaborator) SetEmail(val OptString) {
+	s.Email = val
+}
+
 // Ref: #/components/schemas/Comment
 type Comment struct {
 	ID             OptString    `json:"id"`
@@ -166,6 +203,111 @@ func (s *CreateCommentReq) SetContent(val string) {
 	s.Content = val
 }
 
+type CreateProjectReq struct {
+	Name        string    `json:"name"`
+	Description OptString `json:"description"`
+	ParentId    OptString `json:"parentId"`
+	Color       OptString `json:"color"`
+	IsFavorite  OptBool   `json:"isFavorite"`
+	ViewStyle   OptString `json:"viewStyle"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateProjectReq) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateProjectReq) GetDescription() OptString {
+	return s.Description
+}
+
+// GetParentId returns the value of ParentId.
+func (s *CreateProjectReq) GetParentId() OptString {
+	return s.ParentId
+}
+
+// GetColor returns the value of Color.
+func (s *CreateProjectReq) GetColor() OptString {
+	return s.Color
+}
+
+// GetIsFavorite returns the value of IsFavorite.
+func (s *CreateProjectReq) GetIsFavorite() OptBool {
+	return s.IsFavorite
+}
+
+// GetViewStyle returns the value of ViewStyle.
+func (s *CreateProjectReq) GetViewStyle() OptString {
+	return s.ViewStyle
+}
+
+// SetName sets the value of Name.
+func (s *CreateProjectReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateProjectReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetParentId sets the value of ParentId.
+func (s *CreateProjectReq) SetParentId(val OptString) {
+	s.ParentId = val
+}
+
+// SetColor sets the value of Color.
+func (s *CreateProjectReq) SetColor(val OptString) {
+	s.Color = val
+}
+
+// SetIsFavorite sets the value of IsFavorite.
+func (s *CreateProjectReq) SetIsFavorite(val OptBool) {
+	s.IsFavorite = val
+}
+
+// SetViewStyle sets the value of ViewStyle.
+func (s *CreateProjectReq) SetViewStyle(val OptString) {
+	s.ViewStyle = val
+}
+
+type CreateSectionReq struct {
+	Name      string `json:"name"`
+	ProjectId string `json:"projectId"`
+	Order     OptInt `json:"order"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateSectionReq) GetName() string {
+	return s.Name
+}
+
+// GetProjectId returns the value of ProjectId.
+func (s *CreateSectionReq) GetProjectId() string {
+	return s.ProjectId
+}
+
+// GetOrder returns the value of Order.
+func (s *CreateSectionReq) GetOrder() OptInt {
+	return s.Order
+}
+
+// SetName sets the value of Name.
+func (s *CreateSectionReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetProjectId sets the value of ProjectId.
+func (s *CreateSectionReq) SetProjectId(val string) {
+	s.ProjectId = val
+}
+
+// SetOrder sets the value of Order.
+func (s *CreateSectionReq) SetOrder(val OptInt) {
+	s.Order = val
+}
+
 type CreateTaskReq struct {
 	Content     string    `json:"content"`
 	Description OptString `json:"description"`
@@ -477,6 +619,42 @@ func (s *LabelListResponse) SetNextCursor(val OptNilString) {
 	s.NextCursor = val
 }
 
+type MoveTaskReq struct {
+	ProjectId OptString `json:"projectId"`
+	SectionId OptString `json:"sectionId"`
+	ParentId  OptString `json:"parentId"`
+}
+
+// GetProjectId returns the value of ProjectId.
+func (s *MoveTaskReq) GetProjectId() OptString {
+	return s.ProjectId
+}
+
+// GetSectionId returns the value of SectionId.
+func (s *MoveTaskReq) GetSectionId() OptString {
+	return s.SectionId
+}
+
+// GetParentId returns the value of ParentId.
+func (s *MoveTaskReq) GetParentId() OptString {
+	return s.ParentId
+}
+
+// SetProjectId sets the value of ProjectId.
+func (s *MoveTaskReq) SetProjectId(val OptString) {
+	s.ProjectId = val
+}
+
+// SetSectionId sets the value of SectionId.
+func (s *MoveTaskReq) SetSectionId(val OptString) {
+	s.SectionId = val
+}
+
+// SetParentId sets the value of ParentId.
+func (s *MoveTaskReq) SetParentId(val OptString) {
+	s.ParentId = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -1371,14 +1549,14 @@ func (s *UpdateCommentReq) SetContent(val string) {
 }
 
 type UpdateTaskReq struct {
-	Content     OptString `json:"content"`
-	Description OptString `json:"description"`
-	Priority    OptInt    `json:"priority"`
-	DueString   OptString `json:"dueString"`
-	DueDate     OptString `json:"dueDate"`
-	DueDatetime OptString `json:"dueDatetime"`
-	Labels      []string  `json:"labels"`
-	AssigneeId  OptString `json:"assigneeId"`
+	Content     OptString    `json:"content"`
+	Description OptString    `json:"description"`
+	Priority    OptInt       `json:"priority"`
+	DueString   OptString    `json:"dueString"`
+	DueDate     OptString    `json:"dueDate"`
+	DueDatetime OptString    `json:"dueDatetime"`
+	Labels      []string     `json:"labels"`
+	AssigneeId  OptNilString `json:"assigneeId"`
 }
 
 // GetContent returns the value of Content.
@@ -1417,7 +1595,7 @@ func (s *UpdateTaskReq) GetLabels() []string {
 }
 
 // GetAssigneeId returns the value of AssigneeId.
-func (s *UpdateTaskReq) GetAssigneeId() OptString {
+func (s *UpdateTaskReq) GetAssigneeId() OptNilString {
 	return s.AssigneeId
 }
 
@@ -1457,6 +1635,6 @@ func (s *UpdateTaskReq) SetLabels(val []string) {
 }
 
 // SetAssigneeId sets the value of AssigneeId.
-func (s *UpdateTaskReq) SetAssigneeId(val OptString) {
+func (s *UpdateTaskReq) SetAssigneeId(val OptNilString) {
 	s.AssigneeId = val
 }
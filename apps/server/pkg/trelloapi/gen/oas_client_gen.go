@@ -28,6 +28,18 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AddCardAttachment invokes addCardAttachment operation.
+	//
+	// POST /cards/{cardId}/attachments
+	AddCardAttachment(ctx context.Context, params AddCardAttachmentParams) (*Attachment, error)
+	// AddCardComment invokes addCardComment operation.
+	//
+	// POST /cards/{cardId}/actions/comments
+	AddCardComment(ctx context.Context, params AddCardCommentParams) (*Action, error)
+	// AddCardLabel invokes addCardLabel operation.
+	//
+	// POST /cards/{cardId}/idLabels
+	AddCardLabel(ctx context.Context, params AddCardLabelParams) ([]string, error)
 	// AddChecklistItem invokes addChecklistItem operation.
 	//
 	// POST /checklists/{checklistId}/checkItems
@@ -36,10 +48,18 @@ type Invoker interface {
 	//
 	// POST /cards
 	CreateCard(ctx context.Context, params CreateCardParams) (*Card, error)
+	// CreateCardLabel invokes createCardLabel operation.
+	//
+	// POST /cards/{cardId}/labels
+	CreateCardLabel(ctx context.Context, params CreateCardLabelParams) (*Label, error)
 	// CreateChecklist invokes createChecklist operation.
 	//
 	// POST /checklists
 	CreateChecklist(ctx context.Context, params CreateChecklistParams) (*Checklist, error)
+	// CreateWebhook invokes createWebhook operation.
+	//
+	// POST /webhooks
+	CreateWebhook(ctx context.Context, params CreateWebhookParams) (*Webhook, error)
 	// DeleteCard invokes deleteCard operation.
 	//
 	// DELETE /cards/{cardId}
@@ -52,14 +72,26 @@ type Invoker interface {
 	//
 	// DELETE /checklists/{checklistId}/checkItems/{checkItemId}
 	DeleteChecklistItem(ctx context.Context, params DeleteChecklistItemParams) error
+	// DeleteWebhook invokes deleteWebhook operation.
+	//
+	// DELETE /webhooks/{webhookId}
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error
 	// GetBoard invokes getBoard operation.
 	//
 	// GET /boards/{boardId}
 	GetBoard(ctx context.Context, params GetBoardParams) (*Board, error)
+	// GetBoardLabels invokes getBoardLabels operation.
+	//
+	// GET /boards/{boardId}/labels
+	GetBoardLabels(ctx context.Context, params GetBoardLabelsParams) ([]Label, error)
 	// GetCard invokes getCard operation.
 	//
 	// GET /cards/{cardId}
 	GetCard(ctx context.Context, params GetCardParams) (*Card, error)
+	// GetCardActions invokes getCardActions operation.
+	//
+	// GET /cards/{cardId}/actions
+	GetCardActions(ctx context.Context, params GetCardActionsParams) ([]Action, error)
 	// GetCardsByBoard invokes getCardsByBoard operation.
 	//
 	// GET /boards/{boardId}/cards
@@ -84,6 +116,10 @@ type Invoker interface {
 	//
 	// GET /members/me/boards
 	ListBoards(ctx context.Context, params ListBoardsParams) ([]Board, error)
+	// RemoveCardLabel invokes removeCardLabel operation.
+	//
+	// DELETE /cards/{cardId}/idLabels/{idLabel}
+	RemoveCardLabel(ctx context.Context, params RemoveCardLabelParams) error
 	// UpdateCard invokes updateCard operation.
 	//
 	// PUT /cards/{cardId}
@@ -135,19 +171,19 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// AddChecklistItem invokes addChecklistItem operation.
+// AddCardAttachment invokes addCardAttachment operation.
 //
-// POST /checklists/{checklistId}/checkItems
-func (c *Client) AddChecklistItem(ctx context.Context, params AddChecklistItemParams) (*CheckItem, error) {
-	res, err := c.sendAddChecklistItem(ctx, params)
+// POST /cards/{cardId}/attachments
+func (c *Client) AddCardAttachment(ctx context.Context, params AddCardAttachmentParams) (*Attachment, error) {
+	res, err := c.sendAddCardAttachment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistItemParams) (res *CheckItem, err error) {
+func (c *Client) sendAddCardAttachment(ctx context.Context, params AddCardAttachmentParams) (res *Attachment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addChecklistItem"),
+		otelogen.OperationID("addCardAttachment"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/checklists/{checklistId}/checkItems"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/attachments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -163,7 +199,7 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AddChecklistItemOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddCardAttachmentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -181,16 +217,16 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/checklists/"
+	pathParts[0] = "/cards/"
 	{
-		// Encode "checklistId" parameter.
+		// Encode "cardId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "checklistId",
+			Param:   "cardId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ChecklistId))
+			return e.EncodeValue(conv.StringToString(params.CardId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -200,52 +236,35 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/checkItems"
+	pathParts[2] = "/attachments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "pos" parameter.
+		// Encode "url" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "pos",
+			Name:    "url",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Pos.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.URL))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "checked" parameter.
+		// Encode "name" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "checked",
+			Name:    "name",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Checked.Get(); ok {
+			if val, ok := params.Name.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -266,7 +285,7 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, AddChecklistItemOperation, r); {
+			switch err := c.securityApiKey(ctx, AddCardAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -277,7 +296,7 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, AddChecklistItemOperation, r); {
+			switch err := c.securityApiToken(ctx, AddCardAttachmentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -313,7 +332,7 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAddChecklistItemResponse(resp)
+	result, err := decodeAddCardAttachmentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -321,19 +340,19 @@ func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistIt
 	return result, nil
 }
 
-// CreateCard invokes createCard operation.
+// AddCardComment invokes addCardComment operation.
 //
-// POST /cards
-func (c *Client) CreateCard(ctx context.Context, params CreateCardParams) (*Card, error) {
-	res, err := c.sendCreateCard(ctx, params)
+// POST /cards/{cardId}/actions/comments
+func (c *Client) AddCardComment(ctx context.Context, params AddCardCommentParams) (*Action, error) {
+	res, err := c.sendAddCardComment(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (res *Card, err error) {
+func (c *Client) sendAddCardComment(ctx context.Context, params AddCardCommentParams) (res *Action, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createCard"),
+		otelogen.OperationID("addCardComment"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/cards"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/actions/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -349,7 +368,7 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateCardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddCardCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -366,121 +385,41 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/cards"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "idList" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "idList",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.IdList))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "desc" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "desc",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Desc.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "pos" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "pos",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Pos.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/cards/"
 	{
-		// Encode "due" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "due",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "cardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Due.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "idLabels" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "idLabels",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[2] = "/actions/comments"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IdLabels.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "idMembers" parameter.
+		// Encode "text" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "idMembers",
+			Name:    "text",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IdMembers.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.Text))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -498,7 +437,7 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateCardOperation, r); {
+			switch err := c.securityApiKey(ctx, AddCardCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -509,7 +448,7 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, CreateCardOperation, r); {
+			switch err := c.securityApiToken(ctx, AddCardCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -545,7 +484,7 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateCardResponse(resp)
+	result, err := decodeAddCardCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -553,19 +492,19 @@ func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (r
 	return result, nil
 }
 
-// CreateChecklist invokes createChecklist operation.
+// AddCardLabel invokes addCardLabel operation.
 //
-// POST /checklists
-func (c *Client) CreateChecklist(ctx context.Context, params CreateChecklistParams) (*Checklist, error) {
-	res, err := c.sendCreateChecklist(ctx, params)
+// POST /cards/{cardId}/idLabels
+func (c *Client) AddCardLabel(ctx context.Context, params AddCardLabelParams) ([]string, error) {
+	res, err := c.sendAddCardLabel(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklistParams) (res *Checklist, err error) {
+func (c *Client) sendAddCardLabel(ctx context.Context, params AddCardLabelParams) (res []string, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createChecklist"),
+		otelogen.OperationID("addCardLabel"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/checklists"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/idLabels"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -581,7 +520,7 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateChecklistOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddCardLabelOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -598,53 +537,41 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/checklists"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/cards/"
 	{
-		// Encode "idCard" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "idCard",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "cardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.IdCard))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	pathParts[2] = "/idLabels"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "pos" parameter.
+		// Encode "value" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "pos",
+			Name:    "value",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Pos.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.Value))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -662,7 +589,7 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, CreateChecklistOperation, r); {
+			switch err := c.securityApiKey(ctx, AddCardLabelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -673,7 +600,7 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, CreateChecklistOperation, r); {
+			switch err := c.securityApiToken(ctx, AddCardLabelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -709,7 +636,7 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateChecklistResponse(resp)
+	result, err := decodeAddCardLabelResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -717,19 +644,1217 @@ func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklist
 	return result, nil
 }
 
-// DeleteCard invokes deleteCard operation.
+// AddChecklistItem invokes addChecklistItem operation.
 //
-// DELETE /cards/{cardId}
+// POST /checklists/{checklistId}/checkItems
+func (c *Client) AddChecklistItem(ctx context.Context, params AddChecklistItemParams) (*CheckItem, error) {
+	res, err := c.sendAddChecklistItem(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAddChecklistItem(ctx context.Context, params AddChecklistItemParams) (res *CheckItem, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("addChecklistItem"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/checklists/{checklistId}/checkItems"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AddChecklistItemOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/checklists/"
+	{
+		// Encode "checklistId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "checklistId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChecklistId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/checkItems"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "pos" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pos",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Pos.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "checked" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "checked",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Checked.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, AddChecklistItemOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, AddChecklistItemOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAddChecklistItemResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateCard invokes createCard operation.
+//
+// POST /cards
+func (c *Client) CreateCard(ctx context.Context, params CreateCardParams) (*Card, error) {
+	res, err := c.sendCreateCard(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCreateCard(ctx context.Context, params CreateCardParams) (res *Card, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCard"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/cards"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCardOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/cards"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "idList" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "idList",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.IdList))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "desc" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "desc",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Desc.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "pos" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pos",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Pos.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "due" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "due",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Due.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dueComplete" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dueComplete",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DueComplete.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "idLabels" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "idLabels",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IdLabels.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "idMembers" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "idMembers",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IdMembers.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, CreateCardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, CreateCardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateCardResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateCardLabel invokes createCardLabel operation.
+//
+// POST /cards/{cardId}/labels
+func (c *Client) CreateCardLabel(ctx context.Context, params CreateCardLabelParams) (*Label, error) {
+	res, err := c.sendCreateCardLabel(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCreateCardLabel(ctx context.Context, params CreateCardLabelParams) (res *Label, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCardLabel"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/labels"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCardLabelOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/cards/"
+	{
+		// Encode "cardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/labels"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "color" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "color",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Color))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, CreateCardLabelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, CreateCardLabelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateCardLabelResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateChecklist invokes createChecklist operation.
+//
+// POST /checklists
+func (c *Client) CreateChecklist(ctx context.Context, params CreateChecklistParams) (*Checklist, error) {
+	res, err := c.sendCreateChecklist(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCreateChecklist(ctx context.Context, params CreateChecklistParams) (res *Checklist, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createChecklist"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/checklists"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateChecklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/checklists"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "idCard" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "idCard",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.IdCard))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "pos" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "pos",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Pos.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, CreateChecklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, CreateChecklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateChecklistResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateWebhook invokes createWebhook operation.
+//
+// POST /webhooks
+func (c *Client) CreateWebhook(ctx context.Context, params CreateWebhookParams) (*Webhook, error) {
+	res, err := c.sendCreateWebhook(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCreateWebhook(ctx context.Context, params CreateWebhookParams) (res *Webhook, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createWebhook"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/webhooks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateWebhookOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/webhooks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "callbackURL" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "callbackURL",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.CallbackURL))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "idModel" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "idModel",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.IdModel))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "description" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "description",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Description.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, CreateWebhookOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, CreateWebhookOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateWebhookResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteCard invokes deleteCard operation.
+//
+// DELETE /cards/{cardId}
 func (c *Client) DeleteCard(ctx context.Context, params DeleteCardParams) error {
 	_, err := c.sendDeleteCard(ctx, params)
 	return err
 }
 
-func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (res *DeleteCardOK, err error) {
+func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (res *DeleteCardOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteCard"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/cards/{cardId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCardOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/cards/"
+	{
+		// Encode "cardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, DeleteCardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, DeleteCardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCardResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteChecklist invokes deleteChecklist operation.
+//
+// DELETE /checklists/{checklistId}
+func (c *Client) DeleteChecklist(ctx context.Context, params DeleteChecklistParams) error {
+	_, err := c.sendDeleteChecklist(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklistParams) (res *DeleteChecklistOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteChecklist"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/checklists/{checklistId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChecklistOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/checklists/"
+	{
+		// Encode "checklistId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "checklistId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ChecklistId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, DeleteChecklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, DeleteChecklistOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteChecklistResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteChecklistItem invokes deleteChecklistItem operation.
+//
+// DELETE /checklists/{checklistId}/checkItems/{checkItemId}
+func (c *Client) DeleteChecklistItem(ctx context.Context, params DeleteChecklistItemParams) error {
+	_, err := c.sendDeleteChecklistItem(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteChecklistItemParams) (res *DeleteChecklistItemOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteCard"),
+		otelogen.OperationID("deleteChecklistItem"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/cards/{cardId}"),
+		semconv.URLTemplateKey.String("/checklists/{checklistId}/checkItems/{checkItemId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -745,7 +1870,7 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChecklistItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -762,17 +1887,17 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/cards/"
+	var pathParts [4]string
+	pathParts[0] = "/checklists/"
 	{
-		// Encode "cardId" parameter.
+		// Encode "checklistId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "cardId",
+			Param:   "checklistId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CardId))
+			return e.EncodeValue(conv.StringToString(params.ChecklistId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -782,6 +1907,25 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/checkItems/"
+	{
+		// Encode "checkItemId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "checkItemId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CheckItemId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -795,7 +1939,7 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteCardOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteChecklistItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -806,7 +1950,7 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, DeleteCardOperation, r); {
+			switch err := c.securityApiToken(ctx, DeleteChecklistItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -842,7 +1986,7 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteCardResponse(resp)
+	result, err := decodeDeleteChecklistItemResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -850,19 +1994,19 @@ func (c *Client) sendDeleteCard(ctx context.Context, params DeleteCardParams) (r
 	return result, nil
 }
 
-// DeleteChecklist invokes deleteChecklist operation.
+// DeleteWebhook invokes deleteWebhook operation.
 //
-// DELETE /checklists/{checklistId}
-func (c *Client) DeleteChecklist(ctx context.Context, params DeleteChecklistParams) error {
-	_, err := c.sendDeleteChecklist(ctx, params)
+// DELETE /webhooks/{webhookId}
+func (c *Client) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error {
+	_, err := c.sendDeleteWebhook(ctx, params)
 	return err
 }
 
-func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklistParams) (res *DeleteChecklistOK, err error) {
+func (c *Client) sendDeleteWebhook(ctx context.Context, params DeleteWebhookParams) (res *DeleteWebhookOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteChecklist"),
+		otelogen.OperationID("deleteWebhook"),
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/checklists/{checklistId}"),
+		semconv.URLTemplateKey.String("/webhooks/{webhookId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -878,7 +2022,7 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChecklistOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteWebhookOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -896,16 +2040,16 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/checklists/"
+	pathParts[0] = "/webhooks/"
 	{
-		// Encode "checklistId" parameter.
+		// Encode "webhookId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "checklistId",
+			Param:   "webhookId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ChecklistId))
+			return e.EncodeValue(conv.StringToString(params.WebhookId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -928,7 +2072,7 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteChecklistOperation, r); {
+			switch err := c.securityApiKey(ctx, DeleteWebhookOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -939,7 +2083,7 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, DeleteChecklistOperation, r); {
+			switch err := c.securityApiToken(ctx, DeleteWebhookOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -975,7 +2119,7 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteChecklistResponse(resp)
+	result, err := decodeDeleteWebhookResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -983,19 +2127,173 @@ func (c *Client) sendDeleteChecklist(ctx context.Context, params DeleteChecklist
 	return result, nil
 }
 
-// DeleteChecklistItem invokes deleteChecklistItem operation.
+// GetBoard invokes getBoard operation.
 //
-// DELETE /checklists/{checklistId}/checkItems/{checkItemId}
-func (c *Client) DeleteChecklistItem(ctx context.Context, params DeleteChecklistItemParams) error {
-	_, err := c.sendDeleteChecklistItem(ctx, params)
-	return err
+// GET /boards/{boardId}
+func (c *Client) GetBoard(ctx context.Context, params GetBoardParams) (*Board, error) {
+	res, err := c.sendGetBoard(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteChecklistItemParams) (res *DeleteChecklistItemOK, err error) {
+func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *Board, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteChecklistItem"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/checklists/{checklistId}/checkItems/{checkItemId}"),
+		otelogen.OperationID("getBoard"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/boards/{boardId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBoardOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/boards/"
+	{
+		// Encode "boardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "boardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.BoardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "fields" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "fields",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Fields.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, GetBoardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, GetBoardOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBoardResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBoardLabels invokes getBoardLabels operation.
+//
+// GET /boards/{boardId}/labels
+func (c *Client) GetBoardLabels(ctx context.Context, params GetBoardLabelsParams) ([]Label, error) {
+	res, err := c.sendGetBoardLabels(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBoardLabels(ctx context.Context, params GetBoardLabelsParams) (res []Label, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBoardLabels"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/boards/{boardId}/labels"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1011,7 +2309,7 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteChecklistItemOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBoardLabelsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1028,17 +2326,17 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/checklists/"
+	var pathParts [3]string
+	pathParts[0] = "/boards/"
 	{
-		// Encode "checklistId" parameter.
+		// Encode "boardId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "checklistId",
+			Param:   "boardId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ChecklistId))
+			return e.EncodeValue(conv.StringToString(params.BoardId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1048,29 +2346,11 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/checkItems/"
-	{
-		// Encode "checkItemId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "checkItemId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CheckItemId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	pathParts[2] = "/labels"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1080,7 +2360,7 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, DeleteChecklistItemOperation, r); {
+			switch err := c.securityApiKey(ctx, GetBoardLabelsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1091,7 +2371,7 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, DeleteChecklistItemOperation, r); {
+			switch err := c.securityApiToken(ctx, GetBoardLabelsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1127,7 +2407,7 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteChecklistItemResponse(resp)
+	result, err := decodeGetBoardLabelsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1135,19 +2415,19 @@ func (c *Client) sendDeleteChecklistItem(ctx context.Context, params DeleteCheck
 	return result, nil
 }
 
-// GetBoard invokes getBoard operation.
+// GetCard invokes getCard operation.
 //
-// GET /boards/{boardId}
-func (c *Client) GetBoard(ctx context.Context, params GetBoardParams) (*Board, error) {
-	res, err := c.sendGetBoard(ctx, params)
+// GET /cards/{cardId}
+func (c *Client) GetCard(ctx context.Context, params GetCardParams) (*Card, error) {
+	res, err := c.sendGetCard(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *Board, err error) {
+func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Card, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBoard"),
+		otelogen.OperationID("getCard"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/boards/{boardId}"),
+		semconv.URLTemplateKey.String("/cards/{cardId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1163,7 +2443,7 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBoardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCardOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1181,16 +2461,16 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/boards/"
+	pathParts[0] = "/cards/"
 	{
-		// Encode "boardId" parameter.
+		// Encode "cardId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "boardId",
+			Param:   "cardId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.BoardId))
+			return e.EncodeValue(conv.StringToString(params.CardId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1221,6 +2501,23 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "checklists" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "checklists",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Checklists.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
@@ -1234,7 +2531,7 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetBoardOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCardOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1245,7 +2542,7 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, GetBoardOperation, r); {
+			switch err := c.securityApiToken(ctx, GetCardOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1281,7 +2578,7 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBoardResponse(resp)
+	result, err := decodeGetCardResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1289,19 +2586,19 @@ func (c *Client) sendGetBoard(ctx context.Context, params GetBoardParams) (res *
 	return result, nil
 }
 
-// GetCard invokes getCard operation.
+// GetCardActions invokes getCardActions operation.
 //
-// GET /cards/{cardId}
-func (c *Client) GetCard(ctx context.Context, params GetCardParams) (*Card, error) {
-	res, err := c.sendGetCard(ctx, params)
+// GET /cards/{cardId}/actions
+func (c *Client) GetCardActions(ctx context.Context, params GetCardActionsParams) ([]Action, error) {
+	res, err := c.sendGetCardActions(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Card, err error) {
+func (c *Client) sendGetCardActions(ctx context.Context, params GetCardActionsParams) (res []Action, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCard"),
+		otelogen.OperationID("getCardActions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/cards/{cardId}"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/actions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1317,7 +2614,7 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCardOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCardActionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1334,7 +2631,7 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/cards/"
 	{
 		// Encode "cardId" parameter.
@@ -1354,20 +2651,21 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/actions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "fields" parameter.
+		// Encode "filter" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "fields",
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Fields.Get(); ok {
+			if val, ok := params.Filter.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -1376,16 +2674,16 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 		}
 	}
 	{
-		// Encode "checklists" parameter.
+		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "checklists",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Checklists.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -1405,7 +2703,7 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 		var satisfied bitset
 		{
 			stage = "Security:ApiKey"
-			switch err := c.securityApiKey(ctx, GetCardOperation, r); {
+			switch err := c.securityApiKey(ctx, GetCardActionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1416,7 +2714,7 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 		}
 		{
 			stage = "Security:ApiToken"
-			switch err := c.securityApiToken(ctx, GetCardOperation, r); {
+			switch err := c.securityApiToken(ctx, GetCardActionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1452,7 +2750,7 @@ func (c *Client) sendGetCard(ctx context.Context, params GetCardParams) (res *Ca
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCardResponse(resp)
+	result, err := decodeGetCardActionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2329,6 +3627,158 @@ func (c *Client) sendListBoards(ctx context.Context, params ListBoardsParams) (r
 	return result, nil
 }
 
+// RemoveCardLabel invokes removeCardLabel operation.
+//
+// DELETE /cards/{cardId}/idLabels/{idLabel}
+func (c *Client) RemoveCardLabel(ctx context.Context, params RemoveCardLabelParams) error {
+	_, err := c.sendRemoveCardLabel(ctx, params)
+	return err
+}
+
+func (c *Client) sendRemoveCardLabel(ctx context.Context, params RemoveCardLabelParams) (res *RemoveCardLabelOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("removeCardLabel"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/cards/{cardId}/idLabels/{idLabel}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveCardLabelOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/cards/"
+	{
+		// Encode "cardId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "cardId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CardId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/idLabels/"
+	{
+		// Encode "idLabel" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "idLabel",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.IdLabel))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ApiKey"
+			switch err := c.securityApiKey(ctx, RemoveCardLabelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiKey\"")
+			}
+		}
+		{
+			stage = "Security:ApiToken"
+			switch err := c.securityApiToken(ctx, RemoveCardLabelOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ApiToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000011},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRemoveCardLabelResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpdateCard invokes updateCard operation.
 //
 // PUT /cards/{cardId}
@@ -2466,6 +3916,23 @@ func (c *Client) sendUpdateCard(ctx context.Context, params UpdateCardParams) (r
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "dueComplete" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dueComplete",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DueComplete.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "idList" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -6,20 +6,29 @@ package gen
 type OperationName = string
 
 const (
+	AddCardAttachmentOperation   OperationName = "AddCardAttachment"
+	AddCardCommentOperation      OperationName = "AddCardComment"
+	AddCardLabelOperation        OperationName = "AddCardLabel"
 	AddChecklistItemOperation    OperationName = "AddChecklistItem"
 	CreateCardOperation          OperationName = "CreateCard"
+	CreateCardLabelOperation     OperationName = "CreateCardLabel"
 	CreateChecklistOperation     OperationName = "CreateChecklist"
+	CreateWebhookOperation       OperationName = "CreateWebhook"
 	DeleteCardOperation          OperationName = "DeleteCard"
 	DeleteChecklistOperation     OperationName = "DeleteChecklist"
 	DeleteChecklistItemOperation OperationName = "DeleteChecklistItem"
+	DeleteWebhookOperation       OperationName = "DeleteWebhook"
 	GetBoardOperation            OperationName = "GetBoard"
+	GetBoardLabelsOperation      OperationName = "GetBoardLabels"
 	GetCardOperation             OperationName = "GetCard"
+	GetCardActionsOperation      OperationName = "GetCardActions"
 	GetCardsByBoardOperation     OperationName = "GetCardsByBoard"
 	GetCardsByListOperation      OperationName = "GetCardsByList"
 	GetChecklistItemsOperation   OperationName = "GetChecklistItems"
 	GetChecklistsOperation       OperationName = "GetChecklists"
 	GetListsOperation            OperationName = "GetLists"
 	ListBoardsOperation          OperationName = "ListBoards"
+	RemoveCardLabelOperation     OperationName = "RemoveCardLabel"
 	UpdateCardOperation          OperationName = "UpdateCard"
 	UpdateChecklistItemOperation OperationName = "UpdateChecklistItem"
 )
@@ -2,6 +2,25 @@
 
 package gen
 
+// AddCardAttachmentParams is parameters of addCardAttachment operation.
+type AddCardAttachmentParams struct {
+	CardId string
+	URL    string
+	Name   OptString `json:",omitempty,omitzero"`
+}
+
+// AddCardCommentParams is parameters of addCardComment operation.
+type AddCardCommentParams struct {
+	CardId string
+	Text   string
+}
+
+// AddCardLabelParams is parameters of addCardLabel operation.
+type AddCardLabelParams struct {
+	CardId string
+	Value  string
+}
+
 // AddChecklistItemParams is parameters of addChecklistItem operation.
 type AddChecklistItemParams struct {
 	ChecklistId string
@@ -12,13 +31,21 @@ type AddChecklistItemParams struct {
 
 // CreateCardParams is parameters of createCard operation.
 type CreateCardParams struct {
-	IdList    string
-	Name      string
-	Desc      OptString `json:",omitempty,omitzero"`
-	Pos       OptString `json:",omitempty,omitzero"`
-	Due       OptString `json:",omitempty,omitzero"`
-	IdLabels  OptString `json:",omitempty,omitzero"`
-	IdMembers OptString `json:",omitempty,omitzero"`
+	IdList      string
+	Name        string
+	Desc        OptString `json:",omitempty,omitzero"`
+	Pos         OptString `json:",omitempty,omitzero"`
+	Due         OptString `json:",omitempty,omitzero"`
+	DueComplete OptString `json:",omitempty,omitzero"`
+	IdLabels    OptString `json:",omitempty,omitzero"`
+	IdMembers   OptString `json:",omitempty,omitzero"`
+}
+
+// CreateCardLabelParams is parameters of createCardLabel operation.
+type CreateCardLabelParams struct {
+	CardId string
+	Color  string
+	Name   OptString `json:",omitempty,omitzero"`
 }
 
 // CreateChecklistParams is parameters of createChecklist operation.
@@ -28,6 +55,13 @@ type CreateChecklistParams struct {
 	Pos    OptString `json:",omitempty,omitzero"`
 }
 
+// CreateWebhookParams is parameters of createWebhook operation.
+type CreateWebhookParams struct {
+	CallbackURL string
+	IdModel     string
+	Description OptString `json:",omitempty,omitzero"`
+}
+
 // DeleteCardParams is parameters of deleteCard operation.
 type DeleteCardParams struct {
 	CardId string
@@ -44,12 +78,22 @@ type DeleteChecklistItemParams struct {
 	CheckItemId string
 }
 
+// DeleteWebhookParams is parameters of deleteWebhook operation.
+type DeleteWebhookParams struct {
+	WebhookId string
+}
+
 // GetBoardParams is parameters of getBoard operation.
 type GetBoardParams struct {
 	BoardId string
 	Fields  OptString `json:",omitempty,omitzero"`
 }
 
+// GetBoardLabelsParams is parameters of getBoardLabels operation.
+type GetBoardLabelsParams struct {
+	BoardId string
+}
+
 // GetCardParams is parameters of getCard operation.
 type GetCardParams struct {
 	CardId     string
@@ -57,6 +101,13 @@ type GetCardParams struct {
 	Checklists OptString `json:",omitempty,omitzero"`
 }
 
+// GetCardActionsParams is parameters of getCardActions operation.
+type GetCardActionsParams struct {
+	CardId string
+	Filter OptString `json:",omitempty,omitzero"`
+	Limit  OptInt    `json:",omitempty,omitzero"`
+}
+
 // GetCardsByBoardParams is parameters of getCardsByBoard operation.
 type GetCardsByBoardParams struct {
 	BoardId string
@@ -90,15 +141,22 @@ type ListBoardsParams struct {
 	Fields OptString `json:",omitempty,omitzero"`
 }
 
+// RemoveCardLabelParams is parameters of removeCardLabel operation.
+type RemoveCardLabelParams struct {
+	CardId  string
+	IdLabel string
+}
+
 // UpdateCardParams is parameters of updateCard operation.
 type UpdateCardParams struct {
-	CardId string
-	Name   OptString `json:",omitempty,omitzero"`
-	Desc   OptString `json:",omitempty,omitzero"`
-	Closed OptString `json:",omitempty,omitzero"`
-	Due    OptString `json:",omitempty,omitzero"`
-	IdList OptString `json:",omitempty,omitzero"`
-	Pos    OptString `json:",omitempty,omitzero"`
+	CardId      string
+	Name        OptString `json:",omitempty,omitzero"`
+	Desc        OptString `json:",omitempty,omitzero"`
+	Closed      OptString `json:",omitempty,omitzero"`
+	Due         OptString `json:",omitempty,omitzero"`
+	DueComplete OptString `json:",omitempty,omitzero"`
+	IdList      OptString `json:",omitempty,omitzero"`
+	Pos         OptString `json:",omitempty,omitzero"`
 }
 
 // UpdateChecklistItemParams is parameters of updateChecklistItem operation.
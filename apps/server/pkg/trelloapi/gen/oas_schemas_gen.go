@@ -2,6 +2,80 @@
 
 package gen
 
+import (
+	"github.com/go-faster/jx"
+)
+
+// Ref: #/components/schemas/Action
+type Action struct {
+	ID              OptString `json:"id"`
+	Type            OptString `json:"type"`
+	Date            OptString `json:"date"`
+	IdMemberCreator OptString `json:"idMemberCreator"`
+	MemberCreator   OptMember `json:"memberCreator"`
+	Data            jx.Raw    `json:"data"`
+}
+
+// GetID returns the value of ID.
+func (s *Action) GetID() OptString {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *Action) GetType() OptString {
+	return s.Type
+}
+
+// GetDate returns the value of Date.
+func (s *Action) GetDate() OptString {
+	return s.Date
+}
+
+// GetIdMemberCreator returns the value of IdMemberCreator.
+func (s *Action) GetIdMemberCreator() OptString {
+	return s.IdMemberCreator
+}
+
+// GetMemberCreator returns the value of MemberCreator.
+func (s *Action) GetMemberCreator() OptMember {
+	return s.MemberCreator
+}
+
+// GetData returns the value of Data.
+func (s *Action) GetData() jx.Raw {
+	return s.Data
+}
+
+// SetID sets the value of ID.
+func (s *Action) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *Action) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetDate sets the value of Date.
+func (s *Action) SetDate(val OptString) {
+	s.Date = val
+}
+
+// SetIdMemberCreator sets the value of IdMemberCreator.
+func (s *Action) SetIdMemberCreator(val OptString) {
+	s.IdMemberCreator = val
+}
+
+// SetMemberCreator sets the value of MemberCreator.
+func (s *Action) SetMemberCreator(val OptMember) {
+	s.MemberCreator = val
+}
+
+// SetData sets the value of Data.
+func (s *Action) SetData(val jx.Raw) {
+	s.Data = val
+}
+
 type ApiKey struct {
 	APIKey string
 	Roles  []string
@@ -52,6 +126,76 @@ func (s *ApiToken) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// Ref: #/components/schemas/Attachment
+type Attachment struct {
+	ID       OptString `json:"id"`
+	Name     OptString `json:"name"`
+	URL      OptString `json:"url"`
+	MimeType OptString `json:"mimeType"`
+	Date     OptString `json:"date"`
+	IsUpload OptBool   `json:"isUpload"`
+}
+
+// GetID returns the value of ID.
+func (s *Attachment) GetID() OptString {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Attachment) GetName() OptString {
+	return s.Name
+}
+
+// GetURL returns the value of URL.
+func (s *Attachment) GetURL() OptString {
+	return s.URL
+}
+
+// GetMimeType returns the value of MimeType.
+func (s *Attachment) GetMimeType() OptString {
+	return s.MimeType
+}
+
+// GetDate returns the value of Date.
+func (s *Attachment) GetDate() OptString {
+	return s.Date
+}
+
+// GetIsUpload returns the value of IsUpload.
+func (s *Attachment) GetIsUpload() OptBool {
+	return s.IsUpload
+}
+
+// SetID sets the value of ID.
+func (s *Attachment) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Attachment) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetURL sets the value of URL.
+func (s *Attachment) SetURL(val OptString) {
+	s.URL = val
+}
+
+// SetMimeType sets the value of MimeType.
+func (s *Attachment) SetMimeType(val OptString) {
+	s.MimeType = val
+}
+
+// SetDate sets the value of Date.
+func (s *Attachment) SetDate(val OptString) {
+	s.Date = val
+}
+
+// SetIsUpload sets the value of IsUpload.
+func (s *Attachment) SetIsUpload(val OptBool) {
+	s.IsUpload = val
+}
+
 // Ref: #/components/schemas/Board
 type Board struct {
 	ID     OptString `json:"id"`
@@ -113,16 +257,17 @@ func (s *Board) SetClosed(val OptBool) {
 
 // Ref: #/components/schemas/Card
 type Card struct {
-	ID         OptString    `json:"id"`
-	Name       OptString    `json:"name"`
-	Desc       OptString    `json:"desc"`
-	Due        OptNilString `json:"due"`
-	Closed     OptBool      `json:"closed"`
-	Pos        OptFloat64   `json:"pos"`
-	Labels     []Label      `json:"labels"`
-	IdList     OptString    `json:"idList"`
-	IdBoard    OptString    `json:"idBoard"`
-	Checklists []Checklist  `json:"checklists"`
+	ID          OptString    `json:"id"`
+	Name        OptString    `json:"name"`
+	Desc        OptString    `json:"desc"`
+	Due         OptNilString `json:"due"`
+	DueComplete OptBool      `json:"dueComplete"`
+	Closed      OptBool      `json:"closed"`
+	Pos         OptFloat64   `json:"pos"`
+	Labels      []Label      `json:"labels"`
+	IdList      OptString    `json:"idList"`
+	IdBoard     OptString    `json:"idBoard"`
+	Checklists  []Checklist  `json:"checklists"`
 }
 
 // GetID returns the value of ID.
@@ -145,6 +290,11 @@ func (s *Card) GetDue() OptNilString {
 	return s.Due
 }
 
+// GetDueComplete returns the value of DueComplete.
+func (s *Card) GetDueComplete() OptBool {
+	return s.DueComplete
+}
+
 // GetClosed returns the value of Closed.
 func (s *Card) GetClosed() OptBool {
 	return s.Closed
@@ -195,6 +345,11 @@ func (s *Card) SetDue(val OptNilString) {
 	s.Due = val
 }
 
+// SetDueComplete sets the value of DueComplete.
+func (s *Card) SetDueComplete(val OptBool) {
+	s.DueComplete = val
+}
+
 // SetClosed sets the value of Closed.
 func (s *Card) SetClosed(val OptBool) {
 	s.Closed = val
@@ -341,6 +496,9 @@ type DeleteChecklistItemOK struct{}
 // DeleteChecklistOK is response for DeleteChecklist operation.
 type DeleteChecklistOK struct{}
 
+// DeleteWebhookOK is response for DeleteWebhook operation.
+type DeleteWebhookOK struct{}
+
 // Ref: #/components/schemas/Label
 type Label struct {
 	ID    OptString `json:"id"`
@@ -378,6 +536,43 @@ func (s *Label) SetColor(val OptString) {
 	s.Color = val
 }
 
+// Ref: #/components/schemas/Member
+type Member struct {
+	ID       OptString `json:"id"`
+	FullName OptString `json:"fullName"`
+	Username OptString `json:"username"`
+}
+
+// GetID returns the value of ID.
+func (s *Member) GetID() OptString {
+	return s.ID
+}
+
+// GetFullName returns the value of FullName.
+func (s *Member) GetFullName() OptString {
+	return s.FullName
+}
+
+// GetUsername returns the value of Username.
+func (s *Member) GetUsername() OptString {
+	return s.Username
+}
+
+// SetID sets the value of ID.
+func (s *Member) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetFullName sets the value of FullName.
+func (s *Member) SetFullName(val OptString) {
+	s.FullName = val
+}
+
+// SetUsername sets the value of Username.
+func (s *Member) SetUsername(val OptString) {
+	s.Username = val
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
@@ -470,6 +665,98 @@ func (o OptFloat64) Or(d float64) float64 {
 	return d
 }
 
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMember returns new OptMember with value set to v.
+func NewOptMember(v Member) OptMember {
+	return OptMember{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMember is optional Member.
+type OptMember struct {
+	Value Member
+	Set   bool
+}
+
+// IsSet returns true if OptMember was set.
+func (o OptMember) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMember) Reset() {
+	var v Member
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMember) SetTo(v Member) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMember) Get() (v Member, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMember) Or(d Member) Member {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilString returns new OptNilString with value set to v.
 func NewOptNilString(v string) OptNilString {
 	return OptNilString{
@@ -579,6 +866,9 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// RemoveCardLabelOK is response for RemoveCardLabel operation.
+type RemoveCardLabelOK struct{}
+
 // Ref: #/components/schemas/TrelloList
 type TrelloList struct {
 	ID     OptString  `json:"id"`
@@ -626,3 +916,62 @@ func (s *TrelloList) SetClosed(val OptBool) {
 func (s *TrelloList) SetPos(val OptFloat64) {
 	s.Pos = val
 }
+
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID          OptString `json:"id"`
+	Description OptString `json:"description"`
+	IdModel     OptString `json:"idModel"`
+	CallbackURL OptString `json:"callbackURL"`
+	Active      OptBool   `json:"active"`
+}
+
+// GetID returns the value of ID.
+func (s *Webhook) GetID() OptString {
+	return s.ID
+}
+
+// GetDescription returns the value of Description.
+func (s *Webhook) GetDescription() OptString {
+	return s.Description
+}
+
+// GetIdModel returns the value of IdModel.
+func (s *Webhook) GetIdModel() OptString {
+	return s.IdModel
+}
+
+// GetCallbackURL returns the value of CallbackURL.
+func (s *Webhook) GetCallbackURL() OptString {
+	return s.CallbackURL
+}
+
+// GetActive returns the value of Active.
+func (s *Webhook) GetActive() OptBool {
+	return s.Active
+}
+
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Webhook) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetIdModel sets the value of IdModel.
+func (s *Webhook) SetIdModel(val OptString) {
+	s.IdModel = val
+}
+
+// SetCallbackURL sets the value of CallbackURL.
+func (s *Webhook) SetCallbackURL(val OptString) {
+	s.CallbackURL = val
+}
+
+// SetActive sets the value of Active.
+func (s *Webhook) SetActive(val OptBool) {
+	s.Active = val
+}
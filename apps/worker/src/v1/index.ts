@@ -18,6 +18,7 @@ import { plans } from "./routes/plans";
 import { me } from "./routes/me";
 import { admin } from "./routes/admin";
 import { stripe } from "./routes/stripe";
+import { events } from "./routes/events";
 import { oauth } from "./routes/oauth";
 
 type Bindings = Env;
@@ -36,6 +37,7 @@ v1.route("/plans", plans);
 v1.route("/me", me);
 v1.route("/admin", admin);
 v1.route("/stripe", stripe);
+v1.route("/events", events);
 v1.route("/oauth", oauth);
 
 // MCP Proxy: MCP Client → Worker → Go Server
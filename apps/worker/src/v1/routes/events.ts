@@ -0,0 +1,37 @@
+/**
+ * /v1/events 関連ルート
+ *
+ * HEAD/POST /events/:id → Go Server /v1/events/:id にプロキシ
+ * subscribe_events で登録した外部サービスの Webhook 受信口。
+ * URL トークンの検証は Go Server 側で実施。
+ */
+
+import { Hono } from "hono";
+import type { Env } from "../../types";
+
+type Bindings = Env;
+
+const events = new Hono<{ Bindings: Bindings }>();
+
+// HEAD/POST /events/:id — Forward to Go Server (token verification done there)
+events.on(["HEAD", "POST"], "/:id", async (c) => {
+  const search = new URL(c.req.url).search;
+  const url = `${c.env.SERVER_URL}/v1/events/${encodeURIComponent(c.req.param("id"))}${search}`;
+
+  const headers = new Headers(c.req.raw.headers);
+  headers.delete("host");
+
+  const response = await fetch(url, {
+    method: c.req.method,
+    headers,
+    body: c.req.method === "POST" ? await c.req.text() : undefined,
+  });
+
+  return new Response(response.body, {
+    status: response.status,
+    statusText: response.statusText,
+    headers: response.headers,
+  });
+});
+
+export { events };
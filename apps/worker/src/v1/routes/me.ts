@@ -184,6 +184,20 @@ me.delete("/prompts/:id", async (c) => {
   return proxy(c.env, r, "DELETE", `/prompts/${id}`);
 });
 
+// ── Tool Calls (audit trail) ─────────────────────────────────────
+
+me.get("/tool-calls", async (c) => {
+  const r = await requireAuth(c.req.raw, c.env);
+  if (r instanceof Response) return r;
+  const params = new URLSearchParams();
+  const module = c.req.query("module");
+  const limit = c.req.query("limit");
+  if (module) params.set("module", module);
+  if (limit) params.set("limit", limit);
+  const query = params.size > 0 ? `?${params}` : "";
+  return proxy(c.env, r, "GET", `/tool-calls${query}`);
+});
+
 // ── Module Config ───────────────────────────────────────────────
 
 me.get("/modules/config", async (c) => {
@@ -0,0 +1,21 @@
+-- =============================================================================
+-- Event Subscriptions
+-- =============================================================================
+-- Upstream webhooks registered by the subscribe_events meta-tool.
+-- Events received at /v1/events/{id} are forwarded to callback_url.
+-- =============================================================================
+
+CREATE TABLE mcpist.event_subscriptions (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id          UUID NOT NULL REFERENCES mcpist.users(id) ON DELETE CASCADE,
+    module           TEXT NOT NULL,
+    resource         TEXT NOT NULL,
+    events           JSONB NOT NULL DEFAULT '[]',
+    callback_url     TEXT NOT NULL,
+    token_hash       TEXT NOT NULL,
+    encrypted_secret TEXT NOT NULL,
+    hook_id          TEXT,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_event_subscriptions_user_id ON mcpist.event_subscriptions(user_id);
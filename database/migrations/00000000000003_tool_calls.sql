@@ -0,0 +1,21 @@
+-- =============================================================================
+-- Tool Calls (audit trail)
+-- =============================================================================
+-- One row per tool run on a user's behalf (run and batch meta-tools).
+-- Params and results are never stored. Read via GET /v1/me/tool-calls.
+-- =============================================================================
+
+CREATE TABLE mcpist.tool_calls (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id     UUID NOT NULL REFERENCES mcpist.users(id) ON DELETE CASCADE,
+    request_id  TEXT,
+    meta_tool   TEXT NOT NULL,
+    module      TEXT NOT NULL,
+    tool        TEXT NOT NULL,
+    annotation  TEXT NOT NULL,
+    status      TEXT NOT NULL,
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_tool_calls_user_created ON mcpist.tool_calls(user_id, created_at DESC);